@@ -0,0 +1,91 @@
+package agenda
+
+import "strings"
+
+// excluded holds the config's agenda_exclude list: contexts (@waiting) and
+// tags (#someday) whose items are parked, kept off the agenda and out of
+// the overdue counts even when they have dates. Set once at startup.
+var excluded struct {
+	contexts map[string]bool
+	tags     map[string]bool
+}
+
+// SetExcluded parks the items with any of the given contexts ("@waiting")
+// or tags ("#someday"; the # is optional). Matching ignores case.
+func SetExcluded(tokens []string) {
+	excluded.contexts = make(map[string]bool)
+	excluded.tags = make(map[string]bool)
+	for _, tok := range tokens {
+		tok = strings.ToLower(strings.TrimSpace(tok))
+		switch {
+		case strings.HasPrefix(tok, "@") && len(tok) > 1:
+			excluded.contexts[tok[1:]] = true
+		case strings.HasPrefix(tok, "#") && len(tok) > 1:
+			excluded.tags[tok[1:]] = true
+		case tok != "":
+			excluded.tags[tok] = true
+		}
+	}
+}
+
+// HasExclusions reports whether any context or tag is parked.
+func HasExclusions() bool {
+	return len(excluded.contexts) > 0 || len(excluded.tags) > 0
+}
+
+// Excluded reports whether item is parked: a task with a parked @context or
+// #tag word in its text, or a card or note with a parked tag.
+func Excluded(item AgendaItem) bool {
+	if !HasExclusions() {
+		return false
+	}
+	switch {
+	case item.Task != nil:
+		for _, c := range item.Task.Contexts {
+			if excluded.contexts[strings.ToLower(c)] {
+				return true
+			}
+		}
+		for _, word := range strings.Fields(item.Task.Name) {
+			if len(word) > 1 && word[0] == '#' && excluded.tags[strings.ToLower(word[1:])] {
+				return true
+			}
+		}
+	case item.Card != nil:
+		return anyExcludedTag(item.Card.Tags)
+	case item.Note != nil:
+		return anyExcludedTag(item.Note.Tags)
+	}
+	return false
+}
+
+func anyExcludedTag(tags []string) bool {
+	for _, t := range tags {
+		if excluded.tags[strings.ToLower(strings.TrimPrefix(t, "#"))] {
+			return true
+		}
+	}
+	return false
+}
+
+// ExcludeBuckets drops parked items from buckets.
+func ExcludeBuckets(buckets []DateBucket) []DateBucket {
+	if !HasExclusions() {
+		return buckets
+	}
+	return FilterBuckets(buckets, func(item AgendaItem) bool { return !Excluded(item) })
+}
+
+// ExcludeItems drops parked items from items.
+func ExcludeItems(items []AgendaItem) []AgendaItem {
+	if !HasExclusions() {
+		return items
+	}
+	var kept []AgendaItem
+	for _, item := range items {
+		if !Excluded(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
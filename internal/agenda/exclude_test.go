@@ -0,0 +1,45 @@
+package agenda
+
+import (
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/data"
+)
+
+func TestExcluded(t *testing.T) {
+	SetExcluded([]string{"@Waiting", "#someday", "maybe"})
+	t.Cleanup(func() { SetExcluded(nil) })
+
+	tests := []struct {
+		name string
+		item AgendaItem
+		want bool
+	}{
+		{"task context", AgendaItem{Task: &data.Task{Name: "Call Bob", Contexts: []string{"waiting"}}}, true},
+		{"task tag word", AgendaItem{Task: &data.Task{Name: "Learn Go #Someday"}}, true},
+		{"task other context", AgendaItem{Task: &data.Task{Name: "Call Bob", Contexts: []string{"phone"}}}, false},
+		{"task bare tag is not a context", AgendaItem{Task: &data.Task{Name: "Call Bob", Contexts: []string{"maybe"}}}, false},
+		{"card tag", AgendaItem{Card: &kanbanmodels.Card{Tags: []string{"maybe"}}}, true},
+		{"card context is not a tag", AgendaItem{Card: &kanbanmodels.Card{Tags: []string{"waiting"}}}, false},
+		{"note tag", AgendaItem{Note: &notes.Note{Tags: []string{"#someday"}}}, true},
+	}
+	for _, tt := range tests {
+		if got := Excluded(tt.item); got != tt.want {
+			t.Errorf("%s: Excluded = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	parked, kept := tests[0].item, tests[2].item
+	parked.Source, kept.Source = SourceTask, SourceTask
+	buckets := []DateBucket{{Tasks: []AgendaItem{parked, kept}}}
+	if got := ExcludeBuckets(buckets); len(got) != 1 || got[0].TotalCount() != 1 {
+		t.Errorf("ExcludeBuckets = %+v, want one item left", got)
+	}
+
+	SetExcluded(nil)
+	if Excluded(tests[0].item) {
+		t.Error("nothing should be excluded without a list")
+	}
+}
@@ -0,0 +1,61 @@
+package agenda
+
+import (
+	"fmt"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// DayChecklist returns day's agenda as markdown checklist lines for the
+// daily journal, overdue items first: "- [ ] Call Bob", "- [x] Ship release
+// (Platform / Done)". Items parked by agenda_exclude are left out.
+func DayChecklist(svc service.TaskService, workspaces []*workspace.Workspace, day time.Time) []string {
+	var boards []kanbanmodels.Board
+	var allNotes []notes.Note
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+		allNotes = append(allNotes, ws.Notes...)
+	}
+	dateRange := DayRange(day)
+	buckets := ExcludeBuckets(QueryAgenda(svc, boards, allNotes, CollectProjectDates(workspaces), dateRange))
+	overdue := ExcludeItems(QueryOverdueItems(svc, boards, dateRange.Start))
+
+	var lines []string
+	for _, item := range overdue {
+		lines = append(lines, checklistLine(item, fmt.Sprintf("overdue since %s", item.Date.Format("Jan 2"))))
+	}
+	for _, b := range buckets {
+		for _, item := range b.AllItems() {
+			lines = append(lines, checklistLine(item, ""))
+		}
+		for _, item := range b.AllCompletedItems() {
+			lines = append(lines, checklistLine(item, ""))
+		}
+	}
+	return lines
+}
+
+// checklistLine formats one agenda item as a checklist line. A card gets its
+// board and column, and note ("overdue since Oct 12") follows a dash; it
+// defaults to the date reason unless that is due.
+func checklistLine(item AgendaItem, note string) string {
+	check := " "
+	if item.Completed {
+		check = "x"
+	}
+	line := "- [" + check + "] " + item.Title()
+	if item.Source == SourceCard {
+		line += fmt.Sprintf(" (%s / %s)", item.BoardName, item.ColumnName)
+	}
+	if note == "" && item.Reason != ReasonDue {
+		note = item.Reason.String()
+	}
+	if note != "" {
+		line += " — " + note
+	}
+	return line
+}
@@ -1,6 +1,7 @@
 package agenda
 
 import (
+	"sort"
 	"time"
 
 	kanbanmodels "wydo/internal/kanban/models"
@@ -16,8 +17,26 @@ const (
 	SourceCard
 	SourceNote
 	SourceProjectDate
+	SourceBoardReview // a board whose review cadence has come round
 )
 
+func (s ItemSource) String() string {
+	switch s {
+	case SourceTask:
+		return "task"
+	case SourceCard:
+		return "card"
+	case SourceNote:
+		return "note"
+	case SourceProjectDate:
+		return "project"
+	case SourceBoardReview:
+		return "review"
+	default:
+		return ""
+	}
+}
+
 // DateReason identifies why an item appears on a given date
 type DateReason int
 
@@ -26,6 +45,8 @@ const (
 	ReasonScheduled
 	ReasonNote
 	ReasonMilestone
+	ReasonStart  // a task's start: date, when it becomes actionable
+	ReasonReview // a board's review, due or done
 )
 
 func (r DateReason) String() string {
@@ -38,11 +59,46 @@ func (r DateReason) String() string {
 		return "note"
 	case ReasonMilestone:
 		return "milestone"
+	case ReasonStart:
+		return "start"
+	case ReasonReview:
+		return "review"
 	default:
 		return ""
 	}
 }
 
+// PriorityLevel returns the item's priority from 1 (task priority A, the
+// highest) to 6 (F), or 0 when it has none. Card priorities use the same
+// levels.
+func (item AgendaItem) PriorityLevel() int {
+	switch {
+	case item.Task != nil && item.Task.Priority >= data.PriorityA && item.Task.Priority <= data.PriorityF:
+		return int(item.Task.Priority-data.PriorityA) + 1
+	case item.Card != nil && item.Card.Priority > 0:
+		return item.Card.Priority
+	}
+	return 0
+}
+
+// SortByPriority orders items by priority, highest first and items without
+// one last, then by source: tasks, cards, notes, project dates. Ties keep
+// their order.
+func SortByPriority(items []AgendaItem) {
+	rank := func(item AgendaItem) int {
+		if level := item.PriorityLevel(); level > 0 {
+			return level
+		}
+		return 7
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		if ri, rj := rank(items[i]), rank(items[j]); ri != rj {
+			return ri < rj
+		}
+		return items[i].Source < items[j].Source
+	})
+}
+
 // ProjectDateSource holds a labeled project date to be passed into QueryAgenda
 type ProjectDateSource struct {
 	ProjectName string
@@ -50,7 +106,7 @@ type ProjectDateSource struct {
 	Date        time.Time
 }
 
-// AgendaItem wraps either a task, card, note, or project date with its date context
+// AgendaItem wraps either a task, card, note, project date or board review with its date context
 type AgendaItem struct {
 	Source       ItemSource
 	Reason       DateReason
@@ -64,42 +120,130 @@ type AgendaItem struct {
 	ColIndex     int
 	CardIndex    int
 	Completed    bool
+	Projected    bool   // a future occurrence of a recurring task, not yet added
 	ProjectName  string // for SourceProjectDate
 	ProjectLabel string // for SourceProjectDate
 }
 
+// Title returns the item's one-line title: the task text, card or note
+// title, "project: label" for a project date, or "Review board: name".
+func (item AgendaItem) Title() string {
+	switch item.Source {
+	case SourceTask:
+		return item.Task.Name
+	case SourceCard:
+		return item.Card.Title
+	case SourceNote:
+		return item.Note.Title
+	case SourceProjectDate:
+		return item.ProjectName + ": " + item.ProjectLabel
+	case SourceBoardReview:
+		return "Review board: " + item.BoardName
+	}
+	return ""
+}
+
 // DateBucket groups agenda items by date
 type DateBucket struct {
 	Date           time.Time
 	Tasks          []AgendaItem
+	Starting       []AgendaItem // pending tasks whose start: date is this day
 	Cards          []AgendaItem
+	BlockedCards   []AgendaItem // pending cards with a blocked reason, kept apart from Cards
 	Notes          []AgendaItem
 	ProjectDates   []AgendaItem
 	CompletedTasks []AgendaItem
 	CompletedCards []AgendaItem
+	// Items from sources other than the built-in four, pending and completed
+	Other          []AgendaItem
+	CompletedOther []AgendaItem
 }
 
-// AllItems returns all items in the bucket (tasks first, then cards, then notes, then project dates)
+// Add files item under the bucket's list for its source: cards are split
+// into completed, blocked and pending, tasks into completed, starting and
+// pending, and other sources into completed and pending.
+func (b *DateBucket) Add(item AgendaItem) {
+	switch item.Source {
+	case SourceTask:
+		switch {
+		case item.Completed:
+			b.CompletedTasks = append(b.CompletedTasks, item)
+		case item.Reason == ReasonStart:
+			b.Starting = append(b.Starting, item)
+		default:
+			b.Tasks = append(b.Tasks, item)
+		}
+	case SourceCard:
+		switch {
+		case item.Completed:
+			b.CompletedCards = append(b.CompletedCards, item)
+		case item.Card != nil && item.Card.IsBlocked():
+			b.BlockedCards = append(b.BlockedCards, item)
+		default:
+			b.Cards = append(b.Cards, item)
+		}
+	case SourceNote:
+		b.Notes = append(b.Notes, item)
+	case SourceProjectDate:
+		b.ProjectDates = append(b.ProjectDates, item)
+	default:
+		if item.Completed {
+			b.CompletedOther = append(b.CompletedOther, item)
+		} else {
+			b.Other = append(b.Other, item)
+		}
+	}
+}
+
+// AllItems returns all items in the bucket (tasks first, then starting tasks, then cards, then blocked cards, then notes, then project dates, then other sources)
 func (b DateBucket) AllItems() []AgendaItem {
-	items := make([]AgendaItem, 0, len(b.Tasks)+len(b.Cards)+len(b.Notes)+len(b.ProjectDates))
+	items := make([]AgendaItem, 0, len(b.Tasks)+len(b.Starting)+len(b.Cards)+len(b.BlockedCards)+len(b.Notes)+len(b.ProjectDates)+len(b.Other))
 	items = append(items, b.Tasks...)
+	items = append(items, b.Starting...)
 	items = append(items, b.Cards...)
+	items = append(items, b.BlockedCards...)
 	items = append(items, b.Notes...)
 	items = append(items, b.ProjectDates...)
+	items = append(items, b.Other...)
 	return items
 }
 
-// AllCompletedItems returns all completed items in the bucket (tasks first, then cards)
+// AllCompletedItems returns all completed items in the bucket (tasks first, then cards, then other sources)
 func (b DateBucket) AllCompletedItems() []AgendaItem {
-	items := make([]AgendaItem, 0, len(b.CompletedTasks)+len(b.CompletedCards))
+	items := make([]AgendaItem, 0, len(b.CompletedTasks)+len(b.CompletedCards)+len(b.CompletedOther))
 	items = append(items, b.CompletedTasks...)
 	items = append(items, b.CompletedCards...)
+	items = append(items, b.CompletedOther...)
 	return items
 }
 
 // TotalCount returns the total number of items in the bucket (including completed)
 func (b DateBucket) TotalCount() int {
-	return len(b.Tasks) + len(b.Cards) + len(b.Notes) + len(b.ProjectDates) + len(b.CompletedTasks) + len(b.CompletedCards)
+	return len(b.Tasks) + len(b.Starting) + len(b.Cards) + len(b.BlockedCards) + len(b.Notes) + len(b.ProjectDates) + len(b.Other) +
+		len(b.CompletedTasks) + len(b.CompletedCards) + len(b.CompletedOther)
+}
+
+// FilterBuckets returns the buckets with only the items keep accepts.
+// Buckets left empty are dropped.
+func FilterBuckets(buckets []DateBucket, keep func(AgendaItem) bool) []DateBucket {
+	var result []DateBucket
+	for _, b := range buckets {
+		filtered := DateBucket{Date: b.Date}
+		for _, item := range b.AllItems() {
+			if keep(item) {
+				filtered.Add(item)
+			}
+		}
+		for _, item := range b.AllCompletedItems() {
+			if keep(item) {
+				filtered.Add(item)
+			}
+		}
+		if filtered.TotalCount() > 0 {
+			result = append(result, filtered)
+		}
+	}
+	return result
 }
 
 // DateRange represents a range of dates for querying
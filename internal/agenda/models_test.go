@@ -0,0 +1,59 @@
+package agenda
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/data"
+)
+
+func TestSortByPriority(t *testing.T) {
+	items := []AgendaItem{
+		{Source: SourceNote, Note: &notes.Note{Title: "note"}},
+		{Source: SourceTask, Task: &data.Task{Name: "plain task"}},
+		{Source: SourceCard, Card: &kanbanmodels.Card{Title: "card 2", Priority: 2}},
+		{Source: SourceTask, Task: &data.Task{Name: "task B", Priority: data.PriorityB}},
+		{Source: SourceCard, Card: &kanbanmodels.Card{Title: "plain card"}},
+		{Source: SourceTask, Task: &data.Task{Name: "task A", Priority: data.PriorityA}},
+	}
+	SortByPriority(items)
+
+	want := []string{"task A", "task B", "card 2", "plain task", "plain card", "note"}
+	for i, item := range items {
+		if item.Title() != want[i] {
+			t.Errorf("item %d = %q, want %q", i, item.Title(), want[i])
+		}
+	}
+}
+
+func TestBoardReviewSource(t *testing.T) {
+	now := time.Date(2026, 3, 4, 9, 30, 0, 0, time.Local)
+	lastWeek := time.Date(2026, 2, 24, 18, 0, 0, 0, time.Local)
+	yesterday := time.Date(2026, 3, 3, 10, 0, 0, 0, time.Local)
+	src := BoardReviewSource{Now: now, Boards: []kanbanmodels.Board{
+		{Name: "Sprint", Path: "/b/sprint", Review: "weekly", LastReviewed: &lastWeek}, // due Tue 3rd
+		{Name: "Ops", Path: "/b/ops", Review: "monthly", LastReviewed: &yesterday},     // due Apr 3rd
+		{Name: "New", Path: "/b/new", Review: "2w"},                                    // never reviewed: today
+		{Name: "Plain", Path: "/b/plain"},
+		{Name: "Old", Path: "/b/old", Review: "weekly", Archived: true},
+	}}
+
+	overdue := src.Overdue(startOfDay(now))
+	if len(overdue) != 1 || overdue[0].Title() != "Review board: Sprint" || overdue[0].Date.Day() != 3 {
+		t.Errorf("Overdue = %+v, want Sprint's review of the 3rd", overdue)
+	}
+
+	week := src.Items(WeekRange(now))
+	var got []string
+	for _, item := range week {
+		got = append(got, fmt.Sprintf("%s %s %v", item.Date.Format("01-02"), item.BoardName, item.Completed))
+	}
+	want := []string{"03-03 Sprint false", "03-03 Ops true", "03-04 New false"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("week items = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,96 @@
+package agenda
+
+import (
+	"path/filepath"
+	"strings"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+// TaskMyDayKey identifies a task in the My Day list by its text, which stays
+// the same when lines around it move or it is completed into a done file,
+// unlike its ID.
+func TaskMyDayKey(task data.Task) string {
+	return "task:" + task.Name
+}
+
+// CardMyDayKey identifies a card in the My Day list by its file.
+func CardMyDayKey(boardPath, filename string) string {
+	return "card:" + filepath.Join(boardPath, "cards", filename)
+}
+
+// MyDayKey returns the item's My Day key, or "" for notes, project dates and
+// projected occurrences of recurring tasks, which can't be picked.
+func (item AgendaItem) MyDayKey() string {
+	switch {
+	case item.Projected:
+		return ""
+	case item.Source == SourceTask && item.Task != nil:
+		return TaskMyDayKey(*item.Task)
+	case item.Source == SourceCard && item.Card != nil:
+		return CardMyDayKey(item.BoardPath, item.Card.Filename)
+	}
+	return ""
+}
+
+// QueryMyDay returns the tasks and cards with the given My Day keys, in the
+// order of keys, done ones included. Keys of items that no longer exist are
+// skipped. Items are dated by their due date, or else their scheduled date.
+func QueryMyDay(taskSvc service.TaskService, boards []kanbanmodels.Board, keys []string) []AgendaItem {
+	if len(keys) == 0 {
+		return nil
+	}
+	found := make(map[string]AgendaItem)
+	add := func(item AgendaItem) {
+		if key := item.MyDayKey(); key != "" {
+			if _, ok := found[key]; !ok {
+				found[key] = item
+			}
+		}
+	}
+
+	if taskSvc != nil {
+		// Pending tasks first, so they win over a done task of the same text
+		pending, _ := taskSvc.ListPending()
+		done, _ := taskSvc.ListDone()
+		for _, list := range [][]data.Task{pending, done} {
+			for i := range list {
+				task := &list[i]
+				item := AgendaItem{Source: SourceTask, Task: task, Completed: task.Done}
+				if d, ok := parseTaskDate(task.GetDueDate()); ok {
+					item.Date = d
+				} else if d, ok := parseTaskDate(task.GetScheduledDate()); ok {
+					item.Reason, item.Date = ReasonScheduled, d
+				}
+				add(item)
+			}
+		}
+	}
+
+	for _, board := range boards {
+		for colIdx, col := range board.Columns {
+			for cardIdx := range col.Cards {
+				card := &col.Cards[cardIdx]
+				reason, date := ReasonDue, time.Time{}
+				if card.DueDate != nil {
+					date = *card.DueDate
+				} else if card.ScheduledDate != nil {
+					reason, date = ReasonScheduled, *card.ScheduledDate
+				}
+				completed := strings.EqualFold(col.Name, "done") || card.Archived
+				add(cardItem(board, col, colIdx, cardIdx, card, reason, date, completed))
+			}
+		}
+	}
+
+	var items []AgendaItem
+	for _, key := range keys {
+		if item, ok := found[key]; ok {
+			items = append(items, item)
+		}
+	}
+	return items
+}
@@ -0,0 +1,51 @@
+package agenda
+
+import (
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+func TestQueryMyDay_KeepsPickOrderAndSkipsMissing(t *testing.T) {
+	svc := &mockTaskService{tasks: []data.Task{
+		{ID: "1", Name: "Write report", Tags: map[string]string{"due": "2026-03-05"}},
+		{ID: "2", Name: "Call Sam", Done: true},
+		{ID: "3", Name: "Unpicked"},
+	}}
+	boards := []kanbanmodels.Board{{Name: "Platform", Path: "/ws/boards/platform", Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{{Filename: "deploy.md", Title: "Deploy"}}},
+		{Name: "Done", Cards: []kanbanmodels.Card{{Filename: "review.md", Title: "Review"}}},
+	}}}
+	keys := []string{
+		CardMyDayKey("/ws/boards/platform", "review.md"),
+		"task:Call Sam",
+		"task:Gone",
+		"task:Write report",
+		CardMyDayKey("/ws/boards/platform", "deploy.md"),
+	}
+
+	items := QueryMyDay(svc, boards, keys)
+	var titles []string
+	for _, item := range items {
+		titles = append(titles, item.Title())
+	}
+	want := []string{"Review", "Call Sam", "Write report", "Deploy"}
+	if len(titles) != len(want) {
+		t.Fatalf("got %v, want %v", titles, want)
+	}
+	for i := range want {
+		if titles[i] != want[i] {
+			t.Fatalf("got %v, want %v", titles, want)
+		}
+	}
+	if !items[0].Completed || !items[1].Completed || items[2].Completed || items[3].Completed {
+		t.Errorf("expected Review and Call Sam done, got %+v", items)
+	}
+	if items[2].Date.Format("2006-01-02") != "2026-03-05" {
+		t.Errorf("expected the task dated by its due date, got %v", items[2].Date)
+	}
+	if items[3].ColIndex != 0 || items[3].BoardPath != "/ws/boards/platform" {
+		t.Errorf("expected the card's position, got %+v", items[3])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"wydo/internal/golden"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/tasks/data"
 )
@@ -34,13 +35,17 @@ func (m *mockTaskService) ListDone() ([]data.Task, error) {
 	}
 	return done, nil
 }
+func (m *mockTaskService) ListAll() ([]data.Task, error)                      { return m.tasks, nil }
 func (m *mockTaskService) Get(string) (*data.Task, error)                     { return nil, nil }
 func (m *mockTaskService) Add(string) (*data.Task, error)                     { return nil, nil }
 func (m *mockTaskService) Update(data.Task) error                             { return nil }
+func (m *mockTaskService) UpdateAll([]data.Task) error                        { return nil }
 func (m *mockTaskService) Complete(string) error                              { return nil }
 func (m *mockTaskService) Delete(string) error                                { return nil }
 func (m *mockTaskService) Archive() error                                     { return nil }
+func (m *mockTaskService) Annotate(string, string) error                      { return nil }
 func (m *mockTaskService) GetProjects() map[string]data.Project               { return nil }
+func (m *mockTaskService) TodoFile() string                                   { return "" }
 func (m *mockTaskService) Reload() error                                      { return nil }
 
 func date(y int, m time.Month, d int) time.Time {
@@ -139,6 +144,38 @@ func TestQueryAgenda_TaskScheduledDate(t *testing.T) {
 	}
 }
 
+func TestQueryAgenda_TaskStartDate(t *testing.T) {
+	svc := &mockTaskService{
+		tasks: []data.Task{
+			{ID: "t1", Name: "File taxes", Tags: map[string]string{"start": "2026-02-06", "due": "2026-02-20"}},
+			{ID: "t2", Name: "Book venue", Tags: map[string]string{"start": "2026-02-09", "scheduled": "2026-02-06"}},
+			{ID: "t3", Name: "Ship release", Tags: map[string]string{"start": "2026-02-06", "due": "2026-02-06"}},
+		},
+	}
+
+	buckets := QueryAgenda(svc, nil, nil, nil, DayRange(date(2026, 2, 6)))
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	b := buckets[0]
+	if len(b.Starting) != 1 || b.Starting[0].Task.Name != "File taxes" || b.Starting[0].Reason != ReasonStart {
+		t.Errorf("expected File taxes to start, got %+v", b.Starting)
+	}
+	// Book venue is scheduled before it starts, so it is hidden; Ship
+	// release starts on its due date and shows once, as due
+	if len(b.Tasks) != 1 || b.Tasks[0].Task.Name != "Ship release" || b.Tasks[0].Reason != ReasonDue {
+		t.Errorf("expected only Ship release as due, got %+v", b.Tasks)
+	}
+
+	// Not yet started tasks are not overdue either
+	svc.tasks = append(svc.tasks, data.Task{ID: "t4", Name: "Later", Tags: map[string]string{"start": "2026-03-01", "due": "2026-02-01"}})
+	for _, item := range QueryOverdueItems(svc, nil, date(2026, 2, 6)) {
+		if item.Task.Name == "Later" {
+			t.Error("a task that has not started should not be overdue")
+		}
+	}
+}
+
 func TestQueryAgenda_CardDueDate(t *testing.T) {
 	boards := []kanbanmodels.Board{
 		{
@@ -194,6 +231,39 @@ func TestQueryAgenda_CardDueDate(t *testing.T) {
 	}
 }
 
+func TestQueryAgenda_BlockedCards(t *testing.T) {
+	boards := []kanbanmodels.Board{
+		{
+			Name: "Sprint",
+			Path: "/boards/sprint",
+			Columns: []kanbanmodels.Column{
+				{
+					Name: "To Do",
+					Cards: []kanbanmodels.Card{
+						{Title: "Deploy v2", DueDate: datePtr(2026, 2, 6)},
+						{Title: "Migrate DB", DueDate: datePtr(2026, 2, 6), Blocked: "waiting on ops"},
+					},
+				},
+			},
+		},
+	}
+
+	buckets := QueryAgenda(nil, boards, nil, nil, DayRange(date(2026, 2, 6)))
+
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	if len(buckets[0].Cards) != 1 || buckets[0].Cards[0].Card.Title != "Deploy v2" {
+		t.Errorf("expected only the unblocked card in Cards, got %+v", buckets[0].Cards)
+	}
+	if len(buckets[0].BlockedCards) != 1 || buckets[0].BlockedCards[0].Card.Title != "Migrate DB" {
+		t.Errorf("expected the blocked card in BlockedCards, got %+v", buckets[0].BlockedCards)
+	}
+	if buckets[0].TotalCount() != 2 {
+		t.Errorf("expected total count 2, got %d", buckets[0].TotalCount())
+	}
+}
+
 func TestQueryAgenda_MultiDayRange(t *testing.T) {
 	svc := &mockTaskService{
 		tasks: []data.Task{
@@ -483,3 +553,69 @@ func TestQueryAgenda_TaskWithBothDates(t *testing.T) {
 		t.Fatalf("expected 1 task item (deduplicated), got %d", len(buckets[0].Tasks))
 	}
 }
+
+// fakeSource is an AgendaSource outside the built-in kinds.
+type fakeSource struct{ items []AgendaItem }
+
+func (s fakeSource) Kind() ItemSource                      { return ItemSource(99) }
+func (s fakeSource) Items(dateRange DateRange) []AgendaItem { return s.items }
+func (s fakeSource) Overdue(time.Time) []AgendaItem         { return nil }
+
+func TestQuerySources_CustomSource(t *testing.T) {
+	svc := &mockTaskService{tasks: []data.Task{
+		{ID: "1", Name: "Task", Tags: map[string]string{"due": "2026-02-06"}},
+	}}
+	issue := AgendaItem{Source: ItemSource(99), Date: date(2026, 2, 6)}
+	sources := append(DefaultSources(svc, nil, nil, nil), fakeSource{items: []AgendaItem{issue}})
+
+	buckets := QuerySources(sources, DayRange(date(2026, 2, 6)))
+	if len(buckets) != 1 {
+		t.Fatalf("expected 1 bucket, got %d", len(buckets))
+	}
+	b := buckets[0]
+	if len(b.Tasks) != 1 || len(b.Other) != 1 {
+		t.Fatalf("expected 1 task and 1 other item, got %d and %d", len(b.Tasks), len(b.Other))
+	}
+	if all := b.AllItems(); all[len(all)-1].Source != ItemSource(99) {
+		t.Errorf("other items should come last, got %v", all)
+	}
+
+	tasksOnly := FilterBuckets(buckets, func(item AgendaItem) bool { return item.Source == SourceTask })
+	if len(tasksOnly) != 1 || tasksOnly[0].TotalCount() != 1 || len(tasksOnly[0].Tasks) != 1 {
+		t.Errorf("FilterBuckets kept %+v", tasksOnly)
+	}
+	if none := FilterBuckets(buckets, func(AgendaItem) bool { return false }); len(none) != 0 {
+		t.Errorf("expected empty buckets to be dropped, got %d", len(none))
+	}
+}
+
+func TestQueryAgenda_RecurringTaskProjected(t *testing.T) {
+	golden.FixClock(t) // Wednesday 2026-03-04
+	svc := &mockTaskService{
+		tasks: []data.Task{
+			data.ParseTask("Standup rec:1w due:2026-03-04", "t1", "todo.txt"),
+			data.ParseTask("Overdue chore rec:1d due:2026-03-02", "t2", "todo.txt"),
+		},
+	}
+
+	buckets := QueryAgenda(svc, nil, nil, nil, DateRange{Start: date(2026, 3, 1), End: date(2026, 3, 18)})
+
+	projected := map[string][]string{}
+	for _, b := range buckets {
+		for _, item := range b.Tasks {
+			if item.Projected {
+				projected[item.Task.Name] = append(projected[item.Task.Name], item.Task.GetDueDate())
+				if item.Task.ID != "t1" && item.Task.ID != "t2" {
+					t.Errorf("expected projected items to keep the task's ID, got %q", item.Task.ID)
+				}
+			}
+		}
+	}
+	if got := projected["Standup"]; len(got) != 2 || got[0] != "2026-03-11" || got[1] != "2026-03-18" {
+		t.Errorf("Standup projected on %v, want Mar 11 and 18", got)
+	}
+	// Only occurrences after today are projected for a task still to be done
+	if got := projected["Overdue chore"]; len(got) != 14 || got[0] != "2026-03-05" {
+		t.Errorf("Overdue chore projected on %v, want each day from Mar 5", got)
+	}
+}
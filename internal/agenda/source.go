@@ -0,0 +1,318 @@
+package agenda
+
+import (
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+// AgendaSource contributes dated items to the agenda. Tasks, cards, notes
+// and project dates are sources; a new kind of item (calendar files, issues,
+// goals) plugs in by implementing it and adding an ItemSource for its items.
+type AgendaSource interface {
+	// Kind is the ItemSource of the items the source returns
+	Kind() ItemSource
+	// Items returns the source's items dated within dateRange
+	Items(dateRange DateRange) []AgendaItem
+	// Overdue returns the pending items dated before cutoff, or nil if the
+	// source has no notion of overdue
+	Overdue(cutoff time.Time) []AgendaItem
+}
+
+// DefaultSources returns the built-in sources, in the order their items are
+// filed into buckets.
+func DefaultSources(taskSvc service.TaskService, boards []kanbanmodels.Board, allNotes []notes.Note, projectDates []ProjectDateSource) []AgendaSource {
+	return []AgendaSource{
+		TaskSource{Svc: taskSvc},
+		CardSource{Boards: boards},
+		NoteSource{Notes: allNotes},
+		MilestoneSource{Dates: projectDates},
+		BoardReviewSource{Boards: boards, Now: clock.Now()},
+	}
+}
+
+// TaskSource lists tasks by due, scheduled and start date. A pending task
+// is left out on the days before its start date. The future occurrences of
+// a recurring task are listed too, as projected items.
+type TaskSource struct {
+	Svc service.TaskService
+}
+
+func (s TaskSource) Kind() ItemSource { return SourceTask }
+
+func (s TaskSource) Items(dateRange DateRange) []AgendaItem {
+	if s.Svc == nil {
+		return nil
+	}
+	today := startOfDay(clock.Now())
+	var items []AgendaItem
+	if tasks, err := s.Svc.ListPending(); err == nil {
+		for i := range tasks {
+			items = append(items, taskItems(&tasks[i], false, dateRange)...)
+			// Occurrences due by today are left to the task itself, which
+			// is still to be done
+			for _, occurrence := range tasks[i].ProjectOccurrences(dateRange.End) {
+				for _, item := range taskItems(&occurrence, false, dateRange) {
+					if startOfDay(item.Date).After(today) {
+						item.Projected = true
+						items = append(items, item)
+					}
+				}
+			}
+		}
+	}
+	if tasks, err := s.Svc.ListDone(); err == nil {
+		for i := range tasks {
+			items = append(items, taskItems(&tasks[i], true, dateRange)...)
+		}
+	}
+	return items
+}
+
+// Overdue returns pending tasks due, or else scheduled, before cutoff. A
+// task with both dates overdue appears once, by its due date.
+func (s TaskSource) Overdue(cutoff time.Time) []AgendaItem {
+	if s.Svc == nil {
+		return nil
+	}
+	tasks, err := s.Svc.ListPending()
+	if err != nil {
+		return nil
+	}
+	var items []AgendaItem
+	for i := range tasks {
+		task := &tasks[i]
+		if task.NotStarted(cutoff) {
+			continue
+		}
+		if dueDate, ok := parseTaskDate(task.GetDueDate()); ok && startOfDay(dueDate).Before(cutoff) {
+			items = append(items, AgendaItem{Source: SourceTask, Reason: ReasonDue, Date: dueDate, Task: task})
+		} else if schedDate, ok := parseTaskDate(task.GetScheduledDate()); ok && startOfDay(schedDate).Before(cutoff) {
+			items = append(items, AgendaItem{Source: SourceTask, Reason: ReasonScheduled, Date: schedDate, Task: task})
+		}
+	}
+	return items
+}
+
+func taskItems(task *data.Task, completed bool, dateRange DateRange) []AgendaItem {
+	var items []AgendaItem
+	// A pending task is not shown before it starts
+	startDate, hasStart := parseTaskDate(task.GetStartDate())
+	started := func(d time.Time) bool {
+		return completed || !hasStart || !d.Before(startDate)
+	}
+
+	dueStr := task.GetDueDate()
+	if dueDate, ok := parseTaskDate(dueStr); ok && inRange(dueDate, dateRange) && started(dueDate) {
+		items = append(items, AgendaItem{Source: SourceTask, Reason: ReasonDue, Date: dueDate, Task: task, Completed: completed})
+	}
+	// Skip the scheduled and start dates when they repeat an earlier date
+	// to avoid duplicates
+	schedStr := task.GetScheduledDate()
+	if schedStr != dueStr {
+		if schedDate, ok := parseTaskDate(schedStr); ok && inRange(schedDate, dateRange) && started(schedDate) {
+			items = append(items, AgendaItem{Source: SourceTask, Reason: ReasonScheduled, Date: schedDate, Task: task, Completed: completed})
+		}
+	}
+	startStr := task.GetStartDate()
+	if !completed && hasStart && startStr != dueStr && startStr != schedStr && inRange(startDate, dateRange) {
+		items = append(items, AgendaItem{Source: SourceTask, Reason: ReasonStart, Date: startDate, Task: task})
+	}
+	return items
+}
+
+func parseTaskDate(s string) (time.Time, bool) {
+	if s == "" {
+		return time.Time{}, false
+	}
+	t, err := time.Parse("2006-01-02", s)
+	return t, err == nil
+}
+
+// CardSource lists the cards of unarchived boards by due and scheduled date.
+// Cards in a column named "done" count as completed.
+type CardSource struct {
+	Boards []kanbanmodels.Board
+}
+
+func (s CardSource) Kind() ItemSource { return SourceCard }
+
+func (s CardSource) Items(dateRange DateRange) []AgendaItem {
+	var items []AgendaItem
+	s.eachCard(func(board kanbanmodels.Board, col kanbanmodels.Column, colIdx, cardIdx int, card *kanbanmodels.Card) {
+		completed := strings.EqualFold(col.Name, "done")
+		if card.DueDate != nil && inRange(*card.DueDate, dateRange) {
+			items = append(items, cardItem(board, col, colIdx, cardIdx, card, ReasonDue, *card.DueDate, completed))
+		}
+		// Skip the scheduled date when it is the due date to avoid duplicates
+		if card.ScheduledDate != nil {
+			if card.DueDate != nil && card.DueDate.Format("2006-01-02") == card.ScheduledDate.Format("2006-01-02") {
+				return
+			}
+			if inRange(*card.ScheduledDate, dateRange) {
+				items = append(items, cardItem(board, col, colIdx, cardIdx, card, ReasonScheduled, *card.ScheduledDate, completed))
+			}
+		}
+	})
+	return items
+}
+
+// Overdue returns cards outside done columns due, or else scheduled, before
+// cutoff. A card with both dates overdue appears once, by its due date.
+func (s CardSource) Overdue(cutoff time.Time) []AgendaItem {
+	var items []AgendaItem
+	s.eachCard(func(board kanbanmodels.Board, col kanbanmodels.Column, colIdx, cardIdx int, card *kanbanmodels.Card) {
+		if strings.EqualFold(col.Name, "done") {
+			return
+		}
+		if card.DueDate != nil && startOfDay(*card.DueDate).Before(cutoff) {
+			items = append(items, cardItem(board, col, colIdx, cardIdx, card, ReasonDue, *card.DueDate, false))
+		} else if card.ScheduledDate != nil && startOfDay(*card.ScheduledDate).Before(cutoff) {
+			items = append(items, cardItem(board, col, colIdx, cardIdx, card, ReasonScheduled, *card.ScheduledDate, false))
+		}
+	})
+	return items
+}
+
+// eachCard calls fn for every unarchived card on an unarchived board.
+func (s CardSource) eachCard(fn func(board kanbanmodels.Board, col kanbanmodels.Column, colIdx, cardIdx int, card *kanbanmodels.Card)) {
+	for _, board := range s.Boards {
+		if board.Archived {
+			continue
+		}
+		for colIdx, col := range board.Columns {
+			for cardIdx := range col.Cards {
+				card := &col.Cards[cardIdx]
+				if card.Archived {
+					continue
+				}
+				fn(board, col, colIdx, cardIdx, card)
+			}
+		}
+	}
+}
+
+func cardItem(board kanbanmodels.Board, col kanbanmodels.Column, colIdx, cardIdx int, card *kanbanmodels.Card, reason DateReason, date time.Time, completed bool) AgendaItem {
+	return AgendaItem{
+		Source:     SourceCard,
+		Reason:     reason,
+		Date:       date,
+		Card:       card,
+		BoardName:  board.Name,
+		BoardPath:  board.Path,
+		ColumnName: col.Name,
+		ColIndex:   colIdx,
+		CardIndex:  cardIdx,
+		Completed:  completed,
+	}
+}
+
+// NoteSource lists dated notes on their date.
+type NoteSource struct {
+	Notes []notes.Note
+}
+
+func (s NoteSource) Kind() ItemSource { return SourceNote }
+
+func (s NoteSource) Items(dateRange DateRange) []AgendaItem {
+	var items []AgendaItem
+	for i := range s.Notes {
+		note := &s.Notes[i]
+		noteDate := startOfDay(note.Date)
+		if inRange(noteDate, dateRange) {
+			items = append(items, AgendaItem{Source: SourceNote, Reason: ReasonNote, Date: noteDate, Note: note})
+		}
+	}
+	return items
+}
+
+func (s NoteSource) Overdue(time.Time) []AgendaItem { return nil }
+
+// MilestoneSource lists labeled project dates (see CollectProjectDates).
+type MilestoneSource struct {
+	Dates []ProjectDateSource
+}
+
+func (s MilestoneSource) Kind() ItemSource { return SourceProjectDate }
+
+func (s MilestoneSource) Items(dateRange DateRange) []AgendaItem {
+	var items []AgendaItem
+	for _, src := range s.Dates {
+		if inRange(src.Date, dateRange) {
+			items = append(items, AgendaItem{
+				Source:       SourceProjectDate,
+				Reason:       ReasonMilestone,
+				Date:         src.Date,
+				ProjectName:  src.ProjectName,
+				ProjectLabel: src.Label,
+			})
+		}
+	}
+	return items
+}
+
+func (s MilestoneSource) Overdue(time.Time) []AgendaItem { return nil }
+
+// BoardReviewSource lists a "Review board" item for each unarchived board
+// with a review: cadence, on the day its next review is due, and a completed
+// one on the day it was last reviewed.
+type BoardReviewSource struct {
+	Boards []kanbanmodels.Board
+	Now    time.Time // a board never reviewed is due on this day
+}
+
+func (s BoardReviewSource) Kind() ItemSource { return SourceBoardReview }
+
+func (s BoardReviewSource) Items(dateRange DateRange) []AgendaItem {
+	var items []AgendaItem
+	for _, board := range s.Boards {
+		if board.Archived {
+			continue
+		}
+		next, ok := board.NextReview(s.Now)
+		if !ok {
+			continue
+		}
+		if inRange(next, dateRange) {
+			items = append(items, reviewItem(board, next, false))
+		}
+		if board.LastReviewed != nil && inRange(*board.LastReviewed, dateRange) {
+			items = append(items, reviewItem(board, startOfDay(*board.LastReviewed), true))
+		}
+	}
+	return items
+}
+
+// Overdue returns the boards whose review was due before cutoff.
+func (s BoardReviewSource) Overdue(cutoff time.Time) []AgendaItem {
+	var items []AgendaItem
+	for _, board := range s.Boards {
+		if board.Archived {
+			continue
+		}
+		if next, ok := board.NextReview(s.Now); ok && next.Before(cutoff) {
+			items = append(items, reviewItem(board, next, false))
+		}
+	}
+	return items
+}
+
+func reviewItem(board kanbanmodels.Board, date time.Time, completed bool) AgendaItem {
+	return AgendaItem{
+		Source:    SourceBoardReview,
+		Reason:    ReasonReview,
+		Date:      date,
+		BoardName: board.Name,
+		BoardPath: board.Path,
+		Completed: completed,
+	}
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
@@ -0,0 +1,215 @@
+// Package attachments files specs, screenshots, PDFs and the like with a
+// workspace so tasks and cards can refer to them. A file is copied (or
+// symlinked, see SetSymlink) into the workspace's attachments/ directory and
+// recorded by its path relative to the workspace root, which stays valid
+// when the workspace is synced to another machine.
+package attachments
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"wydo/internal/config"
+)
+
+// Dir is the directory of a workspace attachments are stored in.
+const Dir = "attachments"
+
+// symlink is set once at startup from config.SymlinkAttachments.
+var symlink bool
+
+// SetSymlink makes Store symlink files instead of copying them.
+func SetSymlink(enabled bool) {
+	symlink = enabled
+}
+
+// NormalizePath turns what a terminal pastes when a file is dragged onto it
+// into a plain path: surrounding quotes are dropped, backslash escapes
+// ("my\ file.pdf") undone, a file:// URL decoded and ~ expanded.
+func NormalizePath(input string) string {
+	p := strings.TrimSpace(input)
+	if len(p) >= 2 && (p[0] == '\'' || p[0] == '"') && p[len(p)-1] == p[0] {
+		p = p[1 : len(p)-1]
+	} else if strings.Contains(p, `\`) {
+		var b strings.Builder
+		for i := 0; i < len(p); i++ {
+			if p[i] == '\\' && i+1 < len(p) {
+				i++
+			}
+			b.WriteByte(p[i])
+		}
+		p = b.String()
+	}
+	if strings.HasPrefix(p, "file://") {
+		if u, err := url.Parse(p); err == nil {
+			p = u.Path
+		}
+	}
+	return config.ExpandPath(p)
+}
+
+// Validate returns an error unless input names a regular file.
+func Validate(input string) error {
+	p := NormalizePath(input)
+	if p == "" {
+		return fmt.Errorf("no file given")
+	}
+	info, err := os.Stat(p)
+	if err != nil {
+		return fmt.Errorf("no such file: %s", p)
+	}
+	if !info.Mode().IsRegular() {
+		return fmt.Errorf("not a file: %s", p)
+	}
+	return nil
+}
+
+// Complete extends input to the longest path prefix shared by the files and
+// directories it could name, the way a shell completes on tab. A single
+// directory match gets a trailing slash. Input is returned unchanged when
+// nothing matches.
+func Complete(input string) string {
+	p := NormalizePath(input)
+	if p == "" {
+		return input
+	}
+	dir, prefix := filepath.Split(p)
+	readDir := dir
+	if readDir == "" {
+		readDir = "."
+	}
+	entries, err := os.ReadDir(readDir)
+	if err != nil {
+		return input
+	}
+	var matches []string
+	for _, e := range entries {
+		if !strings.HasPrefix(e.Name(), prefix) {
+			continue
+		}
+		if strings.HasPrefix(e.Name(), ".") && !strings.HasPrefix(prefix, ".") {
+			continue
+		}
+		name := e.Name()
+		if info, err := os.Stat(filepath.Join(readDir, name)); err == nil && info.IsDir() {
+			name += string(filepath.Separator)
+		}
+		matches = append(matches, name)
+	}
+	if len(matches) == 0 {
+		return input
+	}
+	sort.Strings(matches)
+	common := matches[0]
+	for _, m := range matches[1:] {
+		for !strings.HasPrefix(m, common) {
+			common = common[:len(common)-1]
+		}
+	}
+	if len(matches) > 1 {
+		common = strings.TrimSuffix(common, string(filepath.Separator))
+	}
+	return dir + common
+}
+
+// Store files the file at src with the workspace at root and returns its
+// path relative to root, e.g. "attachments/spec.pdf". Spaces in the name
+// become dashes, which keeps the path easy to type and link. A different
+// file already stored under the name gets a numbered one (spec-2.pdf); the
+// same file stored again is reused.
+func Store(root, src string) (string, error) {
+	src, err := filepath.Abs(NormalizePath(src))
+	if err != nil {
+		return "", err
+	}
+	if err := Validate(src); err != nil {
+		return "", err
+	}
+	dir := filepath.Join(root, Dir)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", err
+	}
+
+	name := strings.Join(strings.Fields(filepath.Base(src)), "-")
+	ext := filepath.Ext(name)
+	stem := strings.TrimSuffix(name, ext)
+	for n := 1; ; n++ {
+		if n > 1 {
+			name = stem + "-" + strconv.Itoa(n) + ext
+		}
+		dst := filepath.Join(dir, name)
+		if _, err := os.Lstat(dst); err == nil {
+			if sameFile(src, dst) {
+				return filepath.ToSlash(filepath.Join(Dir, name)), nil
+			}
+			continue
+		}
+		if symlink {
+			err = os.Symlink(src, dst)
+		} else {
+			err = copyFile(src, dst)
+		}
+		if err != nil {
+			return "", err
+		}
+		return filepath.ToSlash(filepath.Join(Dir, name)), nil
+	}
+}
+
+// sameFile reports whether dst already holds src: a symlink to it, or a
+// copy with the same content.
+func sameFile(src, dst string) bool {
+	if target, err := os.Readlink(dst); err == nil {
+		return target == src
+	}
+	a, err := os.ReadFile(src)
+	if err != nil {
+		return false
+	}
+	b, err := os.ReadFile(dst)
+	return err == nil && bytes.Equal(a, b)
+}
+
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		os.Remove(dst)
+		return err
+	}
+	return out.Close()
+}
+
+// Root returns the workspace among roots that holds path (the longest one
+// it is under), or the directory of path when none does.
+func Root(roots []string, path string) string {
+	best := ""
+	for _, r := range roots {
+		rel, err := filepath.Rel(r, path)
+		if err != nil || rel == ".." || strings.HasPrefix(rel, ".."+string(filepath.Separator)) {
+			continue
+		}
+		if len(r) > len(best) {
+			best = r
+		}
+	}
+	if best == "" {
+		return filepath.Dir(path)
+	}
+	return best
+}
@@ -0,0 +1,107 @@
+package attachments
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNormalizePath(t *testing.T) {
+	home, _ := os.UserHomeDir()
+	tests := map[string]string{
+		"  /tmp/spec.pdf ":           "/tmp/spec.pdf",
+		"'/tmp/my spec.pdf'":         "/tmp/my spec.pdf",
+		`"/tmp/my spec.pdf"`:         "/tmp/my spec.pdf",
+		`/tmp/my\ spec\ \(v2\).pdf`:  "/tmp/my spec (v2).pdf",
+		"file:///tmp/my%20spec.pdf":  "/tmp/my spec.pdf",
+		"~/Downloads/screenshot.png": filepath.Join(home, "Downloads/screenshot.png"),
+	}
+	for in, want := range tests {
+		if got := NormalizePath(in); got != want {
+			t.Errorf("NormalizePath(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestComplete(t *testing.T) {
+	dir := t.TempDir()
+	os.WriteFile(filepath.Join(dir, "spec-v1.pdf"), nil, 0644)
+	os.WriteFile(filepath.Join(dir, "spec-v2.pdf"), nil, 0644)
+	os.WriteFile(filepath.Join(dir, "notes.txt"), nil, 0644)
+	os.Mkdir(filepath.Join(dir, "shots"), 0755)
+
+	tests := map[string]string{
+		filepath.Join(dir, "sp"):  filepath.Join(dir, "spec-v"),
+		filepath.Join(dir, "no"):  filepath.Join(dir, "notes.txt"),
+		filepath.Join(dir, "sh"):  filepath.Join(dir, "shots") + string(filepath.Separator),
+		filepath.Join(dir, "s"):   filepath.Join(dir, "s"),
+		filepath.Join(dir, "zzz"): filepath.Join(dir, "zzz"),
+	}
+	for in, want := range tests {
+		if got := Complete(in); got != want {
+			t.Errorf("Complete(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestStore(t *testing.T) {
+	src := t.TempDir()
+	root := t.TempDir()
+	spec := filepath.Join(src, "my spec.pdf")
+	os.WriteFile(spec, []byte("v1"), 0644)
+
+	rel, err := Store(root, spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rel != "attachments/my-spec.pdf" {
+		t.Errorf("rel = %q", rel)
+	}
+	if data, _ := os.ReadFile(filepath.Join(root, rel)); string(data) != "v1" {
+		t.Errorf("copied content = %q", data)
+	}
+
+	// The same file again is reused, a different one with the name numbered
+	if again, _ := Store(root, spec); again != rel {
+		t.Errorf("storing the same file again gave %q", again)
+	}
+	os.WriteFile(spec, []byte("v2"), 0644)
+	if other, _ := Store(root, spec); other != "attachments/my-spec-2.pdf" {
+		t.Errorf("storing a changed file gave %q", other)
+	}
+
+	if _, err := Store(root, src); err == nil {
+		t.Error("expected an error for a directory")
+	}
+}
+
+func TestStore_Symlink(t *testing.T) {
+	SetSymlink(true)
+	t.Cleanup(func() { SetSymlink(false) })
+	src := filepath.Join(t.TempDir(), "shot.png")
+	os.WriteFile(src, []byte("png"), 0644)
+	root := t.TempDir()
+
+	rel, err := Store(root, src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if target, err := os.Readlink(filepath.Join(root, rel)); err != nil || target != src {
+		t.Errorf("expected a symlink to %s, got %q (%v)", src, target, err)
+	}
+}
+
+func TestRoot(t *testing.T) {
+	roots := []string{"/ws", "/ws/nested", "/other"}
+	tests := map[string]string{
+		"/ws/todo.txt":              "/ws",
+		"/ws/nested/tasks/todo.txt": "/ws/nested",
+		"/wsx/todo.txt":             "/wsx",
+		"/elsewhere/todo.txt":       "/elsewhere",
+	}
+	for path, want := range tests {
+		if got := Root(roots, path); got != want {
+			t.Errorf("Root(%q) = %q, want %q", path, got, want)
+		}
+	}
+}
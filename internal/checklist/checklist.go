@@ -0,0 +1,72 @@
+// Package checklist parses markdown list items and their [ ] / [x]
+// checkboxes, for card bodies, imported checklists and journal notes.
+package checklist
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Item is one markdown list item.
+type Item struct {
+	Indent  int    // leading spaces, a tab counting as four
+	Box     bool   // the item has a checkbox
+	Checked bool   // the checkbox is [x] or [X]
+	Text    string // the item's text after the bullet and checkbox
+}
+
+var itemRe = regexp.MustCompile(`^(\s*)(?:[-*+]|\d+[.)])\s+(?:\[([ xX])\]\s*)?(.*)$`)
+
+// ParseItem parses a list item line ("- a", "1. b", "- [ ] c", "  * [x] d").
+// It reports false for any other line.
+func ParseItem(line string) (Item, bool) {
+	line = strings.ReplaceAll(strings.TrimRight(line, " \t\r"), "\t", "    ")
+	m := itemRe.FindStringSubmatch(line)
+	if m == nil {
+		return Item{}, false
+	}
+	return Item{
+		Indent:  len(m[1]),
+		Box:     m[2] != "",
+		Checked: strings.EqualFold(m[2], "x"),
+		Text:    strings.TrimSpace(m[3]),
+	}, true
+}
+
+// String formats the item as a checkbox line at its indent; an item without
+// a checkbox gets an unchecked one.
+func (it Item) String() string {
+	check := " "
+	if it.Checked {
+		check = "x"
+	}
+	return strings.Repeat(" ", it.Indent) + "- [" + check + "] " + it.Text
+}
+
+// Unchecked returns the unchecked checkbox items of a markdown document in
+// order. Items nested under a checked item are left out with it.
+func Unchecked(content string) []Item {
+	var items []Item
+	doneIndent := -1 // indent of the checked item being skipped, -1 for none
+	for _, line := range strings.Split(content, "\n") {
+		it, ok := ParseItem(line)
+		if !ok {
+			continue
+		}
+		if doneIndent >= 0 {
+			if it.Indent > doneIndent {
+				continue
+			}
+			doneIndent = -1
+		}
+		if !it.Box || it.Text == "" {
+			continue
+		}
+		if it.Checked {
+			doneIndent = it.Indent
+			continue
+		}
+		items = append(items, it)
+	}
+	return items
+}
@@ -0,0 +1,48 @@
+package checklist
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseItem(t *testing.T) {
+	cases := map[string]Item{
+		"- plain":         {Text: "plain"},
+		"* [ ] open":      {Box: true, Text: "open"},
+		"  - [X] done":    {Indent: 2, Box: true, Checked: true, Text: "done"},
+		"\t1. [x] number": {Indent: 4, Box: true, Checked: true, Text: "number"},
+	}
+	for line, want := range cases {
+		got, ok := ParseItem(line)
+		if !ok || got != want {
+			t.Errorf("ParseItem(%q) = %+v, %v; want %+v", line, got, ok, want)
+		}
+	}
+	for _, line := range []string{"", "text", "# heading", "-no space"} {
+		if _, ok := ParseItem(line); ok {
+			t.Errorf("ParseItem(%q) should not be an item", line)
+		}
+	}
+}
+
+func TestUnchecked(t *testing.T) {
+	content := `# Today
+
+- [ ] write report
+  - [ ] outline
+  - [x] gather numbers
+- [x] call Bob
+  - [ ] under a done item
+- plain bullet
+- [ ]
+Some text
+* [ ] last`
+	var got []string
+	for _, it := range Unchecked(content) {
+		got = append(got, it.String())
+	}
+	want := []string{"- [ ] write report", "  - [ ] outline", "- [ ] last"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Unchecked = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,211 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"wydo/internal/agenda"
+	"wydo/internal/clock"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// agendaItemJSON is the --json shape of one agenda item.
+type agendaItemJSON struct {
+	Source    string   `json:"source"`
+	Reason    string   `json:"reason"`
+	Date      string   `json:"date"`
+	Title     string   `json:"title"`
+	Completed bool     `json:"completed,omitempty"`
+	Projected bool     `json:"projected,omitempty"`
+	TaskID    string   `json:"task_id,omitempty"`
+	Projects  []string `json:"projects,omitempty"`
+	Board     string   `json:"board,omitempty"`
+	Column    string   `json:"column,omitempty"`
+	Blocked   string   `json:"blocked,omitempty"`
+	Path      string   `json:"path,omitempty"`
+}
+
+type agendaDayJSON struct {
+	Date      string           `json:"date"`
+	Items     []agendaItemJSON `json:"items"`
+	Completed []agendaItemJSON `json:"completed"`
+}
+
+type agendaJSON struct {
+	Start   string           `json:"start"`
+	End     string           `json:"end"`
+	Overdue []agendaItemJSON `json:"overdue"`
+	Days    []agendaDayJSON  `json:"days"`
+}
+
+func runAgenda(args []string, svc service.TaskService, workspaces []*workspace.Workspace) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printAgendaUsage()
+		return 0
+	}
+
+	fs := flag.NewFlagSet("agenda", flag.ContinueOnError)
+	day := fs.Bool("day", false, "Today's agenda (default)")
+	week := fs.Bool("week", false, "This week's agenda (Mon-Sun)")
+	asJSON := fs.Bool("json", false, "Print JSON")
+	plain := fs.Bool("plain", false, "Print plain text (default)")
+	all := fs.Bool("all", false, "Include items parked by agenda_exclude")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if *day && *week {
+		fmt.Fprintln(os.Stderr, "Error: --day and --week are mutually exclusive")
+		return 1
+	}
+	if *asJSON && *plain {
+		fmt.Fprintln(os.Stderr, "Error: --json and --plain are mutually exclusive")
+		return 1
+	}
+
+	var boards []kanbanmodels.Board
+	var allNotes []notes.Note
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+		allNotes = append(allNotes, ws.Notes...)
+	}
+
+	dateRange := agenda.DayRange(clock.Now())
+	if *week {
+		dateRange = agenda.WeekRange(clock.Now())
+	}
+	buckets := agenda.QueryAgenda(svc, boards, allNotes, agenda.CollectProjectDates(workspaces), dateRange)
+	overdue := agenda.QueryOverdueItems(svc, boards, dateRange.Start)
+	if !*all {
+		buckets = agenda.ExcludeBuckets(buckets)
+		overdue = agenda.ExcludeItems(overdue)
+	}
+
+	if *asJSON {
+		out := agendaJSON{
+			Start:   dateRange.Start.Format("2006-01-02"),
+			End:     dateRange.End.Format("2006-01-02"),
+			Overdue: toAgendaJSON(overdue),
+			Days:    []agendaDayJSON{},
+		}
+		for _, b := range buckets {
+			out.Days = append(out.Days, agendaDayJSON{
+				Date:      b.Date.Format("2006-01-02"),
+				Items:     toAgendaJSON(b.AllItems()),
+				Completed: toAgendaJSON(b.AllCompletedItems()),
+			})
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	printAgendaPlain(overdue, buckets)
+	return 0
+}
+
+func toAgendaJSON(items []agenda.AgendaItem) []agendaItemJSON {
+	out := make([]agendaItemJSON, 0, len(items))
+	for _, item := range items {
+		j := agendaItemJSON{
+			Source:    item.Source.String(),
+			Reason:    item.Reason.String(),
+			Date:      item.Date.Format("2006-01-02"),
+			Title:     item.Title(),
+			Completed: item.Completed,
+			Projected: item.Projected,
+		}
+		switch item.Source {
+		case agenda.SourceTask:
+			j.TaskID = item.Task.ID
+			j.Projects = item.Task.Projects
+			j.Path = item.Task.File
+		case agenda.SourceCard:
+			j.Projects = item.Card.Projects
+			j.Board = item.BoardName
+			j.Column = item.ColumnName
+			j.Blocked = item.Card.Blocked
+		case agenda.SourceNote:
+			j.Path = item.Note.FilePath
+		case agenda.SourceProjectDate:
+			j.Projects = []string{item.ProjectName}
+		case agenda.SourceBoardReview:
+			j.Board = item.BoardName
+			j.Path = item.BoardPath
+		}
+		out = append(out, j)
+	}
+	return out
+}
+
+// printAgendaPlain prints one item per line under a heading per day, e.g.
+//
+//	Thu 2026-10-15
+//	  [due] Write report +work
+//	  [sched] Ship release (Platform / In Progress)
+func printAgendaPlain(overdue []agenda.AgendaItem, buckets []agenda.DateBucket) {
+	if len(overdue) > 0 {
+		fmt.Println("Overdue")
+		for _, item := range overdue {
+			fmt.Printf("  %s %s\n", item.Date.Format("01-02"), agendaPlainLine(item))
+		}
+	}
+	for _, b := range buckets {
+		if len(b.AllItems()) == 0 && len(b.AllCompletedItems()) == 0 {
+			continue
+		}
+		fmt.Println(b.Date.Format("Mon 2006-01-02"))
+		for _, item := range b.AllItems() {
+			fmt.Printf("  %s\n", agendaPlainLine(item))
+		}
+		for _, item := range b.AllCompletedItems() {
+			fmt.Printf("  x %s\n", agendaPlainLine(item))
+		}
+	}
+}
+
+func agendaPlainLine(item agenda.AgendaItem) string {
+	var sb strings.Builder
+	sb.WriteString("[" + item.Reason.String() + "] ")
+	sb.WriteString(item.Title())
+	switch item.Source {
+	case agenda.SourceTask:
+		for _, p := range item.Task.Projects {
+			sb.WriteString(" +" + p)
+		}
+		if item.Projected {
+			sb.WriteString(" (repeats)")
+		}
+	case agenda.SourceCard:
+		fmt.Fprintf(&sb, " (%s / %s)", item.BoardName, item.ColumnName)
+		if item.Card.IsBlocked() {
+			sb.WriteString(" blocked: " + item.Card.Blocked)
+		}
+	}
+	return sb.String()
+}
+
+func printAgendaUsage() {
+	fmt.Println(`wydo agenda - Print the agenda without launching the TUI
+
+Usage: wydo agenda [--day|--week] [--json|--plain] [--all]
+
+Without flags, "wydo agenda" opens the TUI day view.
+
+Flags:
+  --day       Today's items, plus overdue (default)
+  --week      This week's items (Mon-Sun), plus overdue
+  --json      Print JSON
+  --plain     Print plain text, one item per line (default)
+  --all       Include items parked by the agenda_exclude config`)
+}
@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/stats"
+	"wydo/internal/workspace"
+)
+
+func runBoardCommand(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 {
+		printBoardUsage()
+		return 1
+	}
+
+	command := args[0]
+	cmdArgs := args[1:]
+
+	switch command {
+	case "import-md":
+		return runBoardImportMD(cmdArgs, workspaces)
+	case "metrics":
+		return runBoardMetrics(cmdArgs, workspaces)
+	case "help", "-h", "--help":
+		printBoardUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown board command: %s\n", command)
+		printBoardUsage()
+		return 1
+	}
+}
+
+// runBoardImportMD turns the list items of a markdown file into cards on a
+// board, printing the column and title of each.
+func runBoardImportMD(args []string, workspaces []*workspace.Workspace) int {
+	fs := flag.NewFlagSet("import-md", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "Show the cards without creating them")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo board import-md [--dry-run] <board> <file.md>")
+		return 1
+	}
+
+	board, ok := lookupBoard(workspaces, fs.Arg(0))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: board %q not found\n", fs.Arg(0))
+		return 1
+	}
+	if len(board.Columns) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: board %s has no columns\n", board.Name)
+		return 1
+	}
+	content, err := os.ReadFile(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	cards := operations.ParseMarkdownCards(string(content))
+	if len(cards) == 0 {
+		fmt.Println("No list items found.")
+		return 0
+	}
+
+	for _, c := range cards {
+		fmt.Printf("%s / %s\n", board.Columns[operations.ImportColumn(&board, c)].Name, c.Title)
+		for _, line := range strings.Split(c.Body, "\n") {
+			if line != "" {
+				fmt.Printf("    %s\n", line)
+			}
+		}
+	}
+	if *dryRun {
+		fmt.Printf("\n%d card(s) would be added to %s\n", len(cards), board.Name)
+		return 0
+	}
+
+	n, err := operations.ImportMarkdownCards(&board, cards, nil, nil)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error importing cards: %v\n", err)
+		return 1
+	}
+	fmt.Printf("\nAdded %d card(s) to %s\n", n, board.Name)
+	return 0
+}
+
+// runBoardMetrics prints the lead and cycle time of each finished card on a
+// board, followed by their averages and medians.
+func runBoardMetrics(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo board metrics <board>")
+		return 1
+	}
+	board, ok := lookupBoard(workspaces, args[0])
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: board %q not found\n", args[0])
+		return 1
+	}
+
+	flow := stats.BoardFlow(board)
+	if len(flow.Cards) == 0 {
+		fmt.Printf("No finished cards with column history on %s.\n", board.Name)
+		fmt.Println("History is recorded as cards are created and moved.")
+		return 0
+	}
+
+	for _, cf := range flow.Cards {
+		cycle := "-"
+		if cf.Started {
+			cycle = stats.FormatFlowDuration(cf.Cycle)
+		}
+		fmt.Printf("%s  lead %-7s  cycle %-7s  %s\n",
+			cf.Finished.Local().Format("2006-01-02"), stats.FormatFlowDuration(cf.Lead), cycle, cf.Card.Title)
+	}
+
+	fmt.Printf("\n%d finished card(s) on %s\n", len(flow.Cards), board.Name)
+	fmt.Printf("lead time   avg %-7s  median %s\n",
+		stats.FormatFlowDuration(flow.AvgLead), stats.FormatFlowDuration(flow.MedianLead))
+	if flow.Cycled > 0 {
+		fmt.Printf("cycle time  avg %-7s  median %s  (%d card(s))\n",
+			stats.FormatFlowDuration(flow.AvgCycle), stats.FormatFlowDuration(flow.MedianCycle), flow.Cycled)
+	}
+	return 0
+}
+
+// lookupBoard finds a board by name or directory basename (case-insensitive)
+// across all workspaces.
+func lookupBoard(workspaces []*workspace.Workspace, query string) (kanbanmodels.Board, bool) {
+	for _, ws := range workspaces {
+		for _, b := range ws.Boards {
+			if strings.EqualFold(b.Name, query) || strings.EqualFold(filepath.Base(b.Path), query) {
+				return b, true
+			}
+		}
+	}
+	return kanbanmodels.Board{}, false
+}
+
+func printBoardUsage() {
+	fmt.Println(`wydo board - Board commands
+
+Usage: wydo board <command> [arguments]
+
+Commands:
+  import-md   Turn the list items of a markdown file into cards
+              wydo board import-md Platform notes.md
+              wydo board import-md --dry-run Platform notes.md   # only show the cards
+
+  metrics     Lead and cycle time of the cards finished on a board
+              wydo board metrics Platform
+
+Each top-level list item becomes a card and the items nested under it its
+checklist. Items go to the column named like the heading above them, or where
+new cards go (default_new_column, else the first column). Checked items go
+to Done.
+
+Lead time runs from when a card was created on the board to when it was
+finished, cycle time from when it first left the new-card column. Both come
+from the history: list wydo adds to a card each time it enters a column.`)
+}
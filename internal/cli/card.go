@@ -0,0 +1,107 @@
+package cli
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/webmeta"
+	"wydo/internal/workspace"
+)
+
+func runCardCommand(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 {
+		printCardUsage()
+		return 1
+	}
+
+	command := args[0]
+	cmdArgs := args[1:]
+
+	switch command {
+	case "add-url":
+		return runCardAddURL(cmdArgs, workspaces)
+	case "help", "-h", "--help":
+		printCardUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown card command: %s\n", command)
+		printCardUsage()
+		return 1
+	}
+}
+
+// runCardAddURL creates a card for a web page, titled with the page's title,
+// and prints its column and title.
+func runCardAddURL(args []string, workspaces []*workspace.Workspace) int {
+	fs := flag.NewFlagSet("add-url", flag.ContinueOnError)
+	column := fs.String("column", "", "Column to add the card to (default: the board's new-card column)")
+	title := fs.String("title", "", "Card title instead of the page's")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo card add-url [--column name] [--title text] <board> <url>")
+		return 1
+	}
+
+	board, ok := lookupBoard(workspaces, fs.Arg(0))
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Error: board %q not found\n", fs.Arg(0))
+		return 1
+	}
+	if len(board.Columns) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: board %s has no columns\n", board.Name)
+		return 1
+	}
+	colIdx := board.NewCardColumnIndex()
+	if *column != "" {
+		colIdx = slices.IndexFunc(board.Columns, func(c kanbanmodels.Column) bool {
+			return strings.EqualFold(c.Name, *column)
+		})
+		if colIdx < 0 {
+			fmt.Fprintf(os.Stderr, "Error: board %s has no column %q\n", board.Name, *column)
+			return 1
+		}
+	}
+	url, err := webmeta.Normalize(fs.Arg(1))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *title == "" {
+		ctx, cancel := context.WithTimeout(context.Background(), webmeta.Timeout)
+		*title, err = webmeta.Title(ctx, url)
+		cancel()
+		if err != nil {
+			// The card is still worth having; it keeps the URL as its title
+			fmt.Fprintf(os.Stderr, "Warning: couldn't fetch the page title: %v\n", err)
+		}
+	}
+
+	card, err := operations.CreateCardFromURL(&board, colIdx, url, *title)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error adding card: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%s / %s\n", board.Columns[colIdx].Name, card.Title)
+	return 0
+}
+
+func printCardUsage() {
+	fmt.Println(`wydo card - Card commands
+
+Usage: wydo card <command> [arguments]
+
+Commands:
+  add-url [--column name] [--title text] <board> <url>
+      Add a card for a web page: titled with the page's title, with the
+      URL in its frontmatter and the page's domain as a tag. It goes to
+      the board's new-card column unless --column is given.`)
+}
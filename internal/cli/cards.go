@@ -0,0 +1,262 @@
+package cli
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/workspace"
+)
+
+// cardJSON is the --json shape of one card.
+type cardJSON struct {
+	Board     string   `json:"board"`
+	Column    string   `json:"column"`
+	Title     string   `json:"title"`
+	Path      string   `json:"path"`
+	Tags      []string `json:"tags,omitempty"`
+	Projects  []string `json:"projects,omitempty"`
+	Due       string   `json:"due,omitempty"`
+	Scheduled string   `json:"scheduled,omitempty"`
+	Completed string   `json:"completed,omitempty"`
+	Priority  int      `json:"priority,omitempty"`
+	Blocked   string   `json:"blocked,omitempty"`
+	Archived  bool     `json:"archived,omitempty"`
+	JiraKey   string   `json:"jira_key,omitempty"`
+	URLs      []string `json:"urls,omitempty"`
+}
+
+// cardQuery holds the filters of wydo cards. Empty fields match everything.
+type cardQuery struct {
+	board       string
+	column      string
+	project     string
+	tag         string
+	dueBefore   *time.Time
+	dueAfter    *time.Time
+	blocked     bool
+	withArchive bool
+}
+
+// cardMatch is a card with the board and column it was found in.
+type cardMatch struct {
+	board  kanbanmodels.Board
+	column string
+	card   kanbanmodels.Card
+}
+
+func runCards(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printCardsUsage()
+		return 0
+	}
+
+	fs := flag.NewFlagSet("cards", flag.ContinueOnError)
+	board := fs.String("board", "", "Only cards on this board (name or directory)")
+	column := fs.String("column", "", "Only cards in this column")
+	project := fs.String("project", "", "Only cards linked to this project")
+	tag := fs.String("tag", "", "Only cards with this tag")
+	dueBefore := fs.String("due-before", "", "Only cards due before this date (yyyy-mm-dd)")
+	dueAfter := fs.String("due-after", "", "Only cards due after this date (yyyy-mm-dd)")
+	blocked := fs.Bool("blocked", false, "Only blocked cards")
+	archived := fs.Bool("archived", false, "Include archived cards")
+	asJSON := fs.Bool("json", false, "Print JSON")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	q := cardQuery{
+		board:       *board,
+		column:      *column,
+		project:     *project,
+		tag:         *tag,
+		blocked:     *blocked,
+		withArchive: *archived,
+	}
+	for _, d := range []struct {
+		flag  string
+		value string
+		dest  **time.Time
+	}{{"--due-before", *dueBefore, &q.dueBefore}, {"--due-after", *dueAfter, &q.dueAfter}} {
+		if d.value == "" {
+			continue
+		}
+		t, err := time.Parse("2006-01-02", d.value)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s wants a yyyy-mm-dd date, got %q\n", d.flag, d.value)
+			return 1
+		}
+		*d.dest = &t
+	}
+
+	var boards []kanbanmodels.Board
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+	}
+	matches := queryCards(boards, q)
+
+	if *asJSON {
+		out := make([]cardJSON, 0, len(matches))
+		for _, m := range matches {
+			out = append(out, toCardJSON(m))
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(out); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	if len(matches) == 0 {
+		fmt.Println("No cards found.")
+		return 0
+	}
+	for _, m := range matches {
+		fmt.Println(cardPlainLine(m))
+	}
+	fmt.Printf("\n%d card(s)\n", len(matches))
+	return 0
+}
+
+// queryCards returns the cards of boards that match every filter in q, in
+// board and column order.
+func queryCards(boards []kanbanmodels.Board, q cardQuery) []cardMatch {
+	var matches []cardMatch
+	for _, b := range boards {
+		if q.board != "" && !strings.EqualFold(b.Name, q.board) && !strings.EqualFold(filepath.Base(b.Path), q.board) {
+			continue
+		}
+		for _, col := range b.Columns {
+			if q.column != "" && !strings.EqualFold(col.Name, q.column) {
+				continue
+			}
+			for _, card := range col.Cards {
+				if q.matches(card) {
+					matches = append(matches, cardMatch{board: b, column: col.Name, card: card})
+				}
+			}
+		}
+	}
+	return matches
+}
+
+func (q cardQuery) matches(card kanbanmodels.Card) bool {
+	if card.Archived && !q.withArchive {
+		return false
+	}
+	if q.project != "" && !containsFold(card.Projects, q.project) {
+		return false
+	}
+	if q.tag != "" && !containsFold(card.Tags, strings.TrimPrefix(q.tag, "#")) {
+		return false
+	}
+	if q.blocked && !card.IsBlocked() {
+		return false
+	}
+	if q.dueBefore != nil && (card.DueDate == nil || !card.DueDate.Before(*q.dueBefore)) {
+		return false
+	}
+	if q.dueAfter != nil && (card.DueDate == nil || !card.DueDate.After(*q.dueAfter)) {
+		return false
+	}
+	return true
+}
+
+func containsFold(values []string, want string) bool {
+	for _, v := range values {
+		if strings.EqualFold(v, want) {
+			return true
+		}
+	}
+	return false
+}
+
+func toCardJSON(m cardMatch) cardJSON {
+	c := m.card
+	j := cardJSON{
+		Board:    m.board.Name,
+		Column:   m.column,
+		Title:    c.Title,
+		Path:     filepath.Join(m.board.Path, "cards", c.Filename),
+		Tags:     c.Tags,
+		Projects: c.Projects,
+		Priority: c.Priority,
+		Blocked:  c.Blocked,
+		Archived: c.Archived,
+		JiraKey:  c.JiraKey,
+	}
+	if c.DueDate != nil {
+		j.Due = c.DueDate.Format("2006-01-02")
+	}
+	if c.ScheduledDate != nil {
+		j.Scheduled = c.ScheduledDate.Format("2006-01-02")
+	}
+	if c.DateCompleted != nil {
+		j.Completed = c.DateCompleted.Format(time.RFC3339)
+	}
+	for _, u := range c.URLs {
+		j.URLs = append(j.URLs, u.URL)
+	}
+	return j
+}
+
+// cardPlainLine formats a card as one line, e.g.
+//
+//	Platform / In Progress  Ship release  due:2026-07-01 +alpha #infra
+func cardPlainLine(m cardMatch) string {
+	c := m.card
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "%s / %s  %s", m.board.Name, m.column, c.Title)
+	if c.Priority > 0 {
+		fmt.Fprintf(&sb, " priority:%d", c.Priority)
+	}
+	if c.DueDate != nil {
+		sb.WriteString(" due:" + c.DueDate.Format("2006-01-02"))
+	}
+	if c.ScheduledDate != nil {
+		sb.WriteString(" scheduled:" + c.ScheduledDate.Format("2006-01-02"))
+	}
+	for _, p := range c.Projects {
+		sb.WriteString(" +" + p)
+	}
+	for _, t := range c.Tags {
+		sb.WriteString(" #" + t)
+	}
+	if c.IsBlocked() {
+		sb.WriteString(" blocked: " + c.Blocked)
+	}
+	if c.Archived {
+		sb.WriteString(" (archived)")
+	}
+	return sb.String()
+}
+
+func printCardsUsage() {
+	fmt.Println(`wydo cards - Query cards across all boards
+
+Usage: wydo cards [flags]
+
+Flags:
+  --board <name>         Only cards on this board (name or directory)
+  --column <name>        Only cards in this column
+  --project <name>       Only cards linked to this project
+  --tag <name>           Only cards with this tag
+  --due-before <date>    Only cards due before this date (yyyy-mm-dd)
+  --due-after <date>     Only cards due after this date (yyyy-mm-dd)
+  --blocked              Only blocked cards
+  --archived             Include archived cards
+  --json                 Print JSON instead of one card per line
+
+Names match case-insensitively. Filters combine with AND.
+
+Example:
+  wydo cards --board Platform --column "In Progress" --project alpha --due-before 2026-07-01 --json`)
+}
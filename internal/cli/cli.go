@@ -5,11 +5,13 @@ import (
 	"os"
 
 	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
 )
 
 // Run executes the CLI with the given arguments.
-// The first argument should be the namespace ("task" or "board").
-func Run(args []string, svc service.TaskService) int {
+// The first argument should be the namespace ("task", "agenda", "cards", "card", "dedupe", "merge-todo", "project", "stats", "doctor", "board", "print", "journal" or "workspace")
+// or one of the todo.txt-cli verbs ("add", "do", "pri", ...).
+func Run(args []string, svc service.TaskService, workspaces []*workspace.Workspace) int {
 	if len(args) == 0 {
 		printUsage()
 		return 1
@@ -21,9 +23,57 @@ func Run(args []string, svc service.TaskService) int {
 	switch namespace {
 	case "task":
 		return runTaskCommand(subArgs, svc)
+	case "annotate", "ann":
+		return runAnnotate(subArgs, svc)
+	case "agenda":
+		return runAgenda(subArgs, svc, workspaces)
+	case "stats":
+		return runStatsCommand(subArgs, workspaces)
+	case "doctor":
+		return runDoctor(subArgs, workspaces)
+	case "cards":
+		return runCards(subArgs, workspaces)
+	case "card":
+		return runCardCommand(subArgs, workspaces)
+	case "dedupe":
+		return runDedupe(subArgs, svc)
+	case "merge-todo":
+		return runMergeTodo(subArgs)
+	case "project":
+		return runProjectCommand(subArgs, workspaces)
 	case "board":
-		fmt.Fprintln(os.Stderr, "Board CLI commands are not yet implemented.")
-		return 1
+		return runBoardCommand(subArgs, workspaces)
+	case "print":
+		return runPrint(subArgs, svc, workspaces)
+	case "journal":
+		return runJournal(subArgs, svc, workspaces)
+	case "workspace":
+		return runWorkspaceCommand(subArgs, workspaces)
+	// todo.txt-cli compatible verbs (see todosh.go)
+	case "add", "a":
+		return runTodoAdd(subArgs, svc)
+	case "ls", "list":
+		return runTodoList(subArgs, svc)
+	case "do", "done":
+		return runTodoDo(subArgs, svc)
+	case "rm", "del":
+		return runTodoRm(subArgs, svc)
+	case "pri", "p":
+		return runTodoPri(subArgs, svc)
+	case "depri", "dp":
+		return runTodoDepri(subArgs, svc)
+	case "append", "app":
+		return runTodoAppend(subArgs, svc, false)
+	case "prepend", "prep":
+		return runTodoAppend(subArgs, svc, true)
+	case "replace":
+		return runTodoReplace(subArgs, svc)
+	case "archive":
+		return runTodoArchive(subArgs, svc)
+	case "lsprj":
+		return runTodoListTerms(subArgs, svc, false)
+	case "lscon":
+		return runTodoListTerms(subArgs, svc, true)
 	case "help", "-h", "--help":
 		printUsage()
 		return 0
@@ -52,6 +102,12 @@ func runTaskCommand(args []string, svc service.TaskService) int {
 		return runDone(cmdArgs, svc)
 	case "delete", "rm", "del":
 		return runDelete(cmdArgs, svc)
+	case "annotate", "ann":
+		return runAnnotate(cmdArgs, svc)
+	case "show", "s":
+		return runShow(cmdArgs, svc)
+	case "suggest-priorities", "suggest":
+		return runSuggestPriorities(cmdArgs, svc)
 	case "help", "-h", "--help":
 		printTaskUsage()
 		return 0
@@ -68,18 +124,49 @@ func printUsage() {
 Usage: wydo [flags] [command] [arguments]
 
 Views (launch TUI into a specific view):
-  agenda      Day agenda view
+  agenda      Day agenda view (with --day/--week/--json/--plain, print it instead)
   boards [name]  Board picker, or open a specific board by name
   tasks       Task manager
   projects    Projects (coming soon)
+  goals       Monthly goals and their progress
+  tour        Replay the interactive onboarding tour
 
 Commands:
   task        Task management commands
-  board       Board management commands (coming soon)
+  annotate    Append a timestamped note to a task (wydo annotate <id> "text")
+  cards       Query cards across boards (wydo cards --column "In Progress" --json)
+  card        Card commands (wydo card add-url <board> <url>)
+  dedupe      Remove task lines duplicated across todo and done files (--dry-run to preview)
+  merge-todo  Merge two diverged copies of a todo.txt (wydo merge-todo [--base f] [--out f] [-i] <a> <b>)
+  project     Project commands (wydo project rename <old> <new>)
+  status      Count workspaces, boards, open tasks and overdue items; list load errors
+  stats       Completion statistics (wydo stats heatmap)
+  doctor      Report malformed dates and frontmatter (file:line: field: message)
+  board       Board commands (wydo board import-md <board> <file.md>, wydo board metrics <board>)
+  print       A week or month on paper, with checkboxes (wydo print week [--html])
+  journal     Open today's journal note, started with the agenda and yesterday's open items
+  workspace   Workspace commands (wydo workspace merge <src> <dst>, wydo workspace split --project <name> <newdir>)
+
+todo.txt-cli verbs (ITEM# is a line of todo.txt, or a task ID):
+  add, a      wydo add "(A) Call Bob +home"
+  ls, list    List todo.txt with line numbers (wydo ls [--ids] [TERM...])
+              TERMs may be +project, @context, (A) or due:EXPR filters
+  do, done    wydo do ITEM# [ITEM#...]
+  rm, del     Delete an item, or only TERM from it (wydo rm ITEM# [TERM])
+  pri, p      wydo pri ITEM# PRIORITY (A-F)
+  depri, dp   wydo depri ITEM#
+  append, app    wydo append ITEM# "text"
+  prepend, prep  wydo prepend ITEM# "text"
+  replace     wydo replace ITEM# "UPDATED ITEM"
+  archive     Move done tasks into the done files
+  lsprj, lscon   List the +projects / @contexts of open tasks
 
 Flags:
   -w, --workspaces       Workspace directories (comma-separated)
-      --view <name>      Initial view: day, week, month, tasks, boards, projects
+      --view <name>      Initial view: day, week, month, year, tasks, boards, projects, goals
+      --compact          Single auto-refreshing pane: today's agenda, or one column with --board
+      --column <name>    Column shown by --compact --board (default: the first)
+      --debug            Log debug detail too (debug.log in the state directory, ctrl+g in the TUI)
 
 Running wydo without arguments launches the interactive TUI.
 Use "wydo task help" for task subcommands.`)
@@ -107,5 +194,18 @@ Commands:
   delete, rm  Delete a task
               wydo task delete <task-id>
 
+  annotate, ann  Append a timestamped annotation to a task
+              wydo task annotate <task-id> "waiting on Bob"
+
+  show, s     Show a task with its annotations
+              wydo task show <task-id>
+
+  suggest-priorities, suggest  Propose priorities from due dates, age and
+              project weights (priority_weights in the config), and
+              accept or skip each
+              wydo task suggest-priorities
+              wydo task suggest --list    # only list them
+              wydo task suggest --yes     # accept them all
+
   help        Show this help message`)
 }
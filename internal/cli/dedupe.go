@@ -0,0 +1,72 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+// runDedupe finds task lines repeated within or across todo and done files,
+// usually left behind by sync conflicts, and removes the extra copies.
+func runDedupe(args []string, svc service.TaskService) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printDedupeUsage()
+		return 0
+	}
+
+	fs := flag.NewFlagSet("dedupe", flag.ContinueOnError)
+	dryRun := fs.Bool("dry-run", false, "Report duplicates without removing them")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	tasks, err := svc.ListAll()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	groups := data.FindDuplicates(tasks)
+	if len(groups) == 0 {
+		fmt.Println("No duplicate tasks found.")
+		return 0
+	}
+
+	removed := 0
+	for _, g := range groups {
+		fmt.Println(g.Keep.Name)
+		fmt.Printf("  keep    %s:%d  %s\n", g.Keep.File, g.Keep.Line, g.Keep.String())
+		for _, t := range g.Remove {
+			fmt.Printf("  remove  %s:%d  %s\n", t.File, t.Line, t.String())
+			removed++
+		}
+	}
+	fmt.Println()
+
+	if *dryRun {
+		fmt.Printf("%d duplicate line(s) would be removed (dry run).\n", removed)
+		return 0
+	}
+	if err := data.RemoveDuplicates(groups); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%d duplicate line(s) removed.\n", removed)
+	return 0
+}
+
+func printDedupeUsage() {
+	fmt.Println(`wydo dedupe - Remove duplicate task lines
+
+Usage: wydo dedupe [--dry-run]
+
+Lines are duplicates when they match after ignoring the completion mark,
+completion date and priority, whether in one file or across todo.txt and
+done files. Of each set the completed line with the earliest completion
+date is kept, or the first line when none is completed.
+
+Flags:
+  --dry-run    Print the report without changing any file`)
+}
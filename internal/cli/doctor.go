@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"fmt"
+
+	"wydo/internal/workspace"
+)
+
+// runDoctor prints the values that could not be parsed while loading the
+// workspaces, one "file:line: field: message" per line. It exits 1 when
+// there are any, so it can be used in scripts.
+func runDoctor(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		fmt.Println(`wydo doctor - Report dates and frontmatter wydo could not parse
+
+Usage: wydo doctor
+
+Items with a malformed date are left out of the agenda; fix the reported
+line and they show up again.`)
+		return 0
+	}
+
+	count := 0
+	for _, ws := range workspaces {
+		for _, d := range ws.Diagnostics {
+			fmt.Println(d.String())
+			count++
+		}
+	}
+	if count == 0 {
+		fmt.Println("No problems found.")
+		return 0
+	}
+	fmt.Printf("%d problem(s) found.\n", count)
+	return 1
+}
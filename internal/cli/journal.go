@@ -0,0 +1,84 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"os/exec"
+	"time"
+
+	"wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// runJournal opens today's journal note in $EDITOR, creating it with the
+// day's agenda and yesterday's unchecked items first.
+func runJournal(args []string, svc service.TaskService, workspaces []*workspace.Workspace) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printJournalUsage()
+		return 0
+	}
+
+	fs := flag.NewFlagSet("journal", flag.ContinueOnError)
+	pathOnly := fs.Bool("path", false, "Create the note if needed and print its path instead of opening it")
+	dateStr := fs.String("date", "", "Journal day (YYYY-MM-DD, default today)")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if len(workspaces) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: no workspace to keep the journal in")
+		return 1
+	}
+
+	day := clock.Now()
+	if *dateStr != "" {
+		d, err := time.ParseInLocation("2006-01-02", *dateStr, time.Local)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --date %q, expected YYYY-MM-DD\n", *dateStr)
+			return 1
+		}
+		day = d
+	}
+
+	dir := config.Get().JournalDirFor(workspaces[0].RootDir)
+	path, _, err := notes.OpenJournal(dir, day, agenda.DayChecklist(svc, workspaces, day))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *pathOnly {
+		fmt.Println(path)
+		return 0
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vim"
+	}
+	cmd := exec.Command(editor, path)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s: %v\n", editor, err)
+		return 1
+	}
+	return 0
+}
+
+func printJournalUsage() {
+	fmt.Println(`wydo journal - Open today's journal note
+
+Usage: wydo journal [--path] [--date YYYY-MM-DD]
+
+The journal keeps one note per day, YYYY-MM-DD.md in journal_dir (default
+journal/ in the first workspace). A new note starts with the day's agenda
+as a checklist, then the unchecked items of the previous journal note
+(outside its agenda), carried forward.
+
+Flags:
+  --path      Print the note's path instead of opening it in $EDITOR
+  --date      Another day's note (default today)`)
+}
@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"wydo/internal/tasks/data"
+)
+
+// runMergeTodo merges two diverged copies of a todo.txt file, as sync tools
+// leave them ("todo.txt" and "todo (conflicted copy).txt"), and reports the
+// tasks that need a decision.
+func runMergeTodo(args []string) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		printMergeTodoUsage()
+		return 0
+	}
+
+	fs := flag.NewFlagSet("merge-todo", flag.ContinueOnError)
+	basePath := fs.String("base", "", "Common ancestor of the two copies")
+	outPath := fs.String("out", "", "Write the merged file here instead of stdout")
+	interactive := fs.Bool("i", false, "Ask how to resolve each conflict")
+	// Flags may come before or after the two files
+	var files []string
+	for {
+		if err := fs.Parse(args); err != nil {
+			return 1
+		}
+		if fs.NArg() == 0 {
+			break
+		}
+		files = append(files, fs.Arg(0))
+		args = fs.Args()[1:]
+	}
+	if len(files) != 2 {
+		printMergeTodoUsage()
+		return 1
+	}
+
+	var base []string
+	if *basePath != "" {
+		lines, err := readLines(*basePath)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		base = lines
+	}
+	a, err := readLines(files[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	b, err := readLines(files[1])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	result := data.Merge(base, a, b)
+	if *interactive {
+		in := bufio.NewReader(os.Stdin)
+		for i, c := range result.Conflicts {
+			resolveConflict(result, i, c, in)
+		}
+	}
+
+	merged := strings.Join(result.Lines(), "\n") + "\n"
+	if *outPath == "" {
+		fmt.Print(merged)
+	} else if err := os.WriteFile(*outPath, []byte(merged), 0644); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	// The report goes to stderr so stdout stays the merged file
+	fmt.Fprintf(os.Stderr, "%d task(s) added from %s, %d from %s, %d completed, %d deleted.\n",
+		result.AddedA, files[0], result.AddedB, files[1], result.Completed, result.Deleted)
+	if len(result.Conflicts) == 0 || *interactive {
+		return 0
+	}
+	fmt.Fprintf(os.Stderr, "%d task(s) need attention; the merge kept the completed or changed version, else A's:\n", len(result.Conflicts))
+	for _, c := range result.Conflicts {
+		fmt.Fprintf(os.Stderr, "  %s\n    A: %s\n    B: %s\n", c.Reason, orDeleted(c.A), orDeleted(c.B))
+	}
+	return 1
+}
+
+// resolveConflict asks whether to keep conflict i's A version, its B
+// version, both or neither. Anything else keeps the merge's choice.
+func resolveConflict(result *data.MergeResult, i int, c data.MergeConflict, in *bufio.Reader) {
+	fmt.Fprintf(os.Stderr, "\n%s\n  A: %s\n  B: %s\n", c.Reason, orDeleted(c.A), orDeleted(c.B))
+	fmt.Fprint(os.Stderr, "Keep [a], [b], [2] both, [n]either? ")
+	line, _ := in.ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "a":
+		result.Resolve(i, c.A)
+	case "b":
+		result.Resolve(i, c.B)
+	case "2":
+		result.Resolve(i, c.A, c.B)
+	case "n":
+		result.Resolve(i)
+	}
+}
+
+func orDeleted(line string) string {
+	if line == "" {
+		return "(deleted)"
+	}
+	return line
+}
+
+func readLines(path string) ([]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return strings.Split(string(content), "\n"), nil
+}
+
+func printMergeTodoUsage() {
+	fmt.Println(`wydo merge-todo - Merge two diverged copies of a todo.txt file
+
+Usage: wydo merge-todo [--base file] [--out file] [-i] <fileA> <fileB>
+
+Tasks are matched across the copies by their ann: key, else by their text.
+Tasks in only one copy are kept and a completed task wins over its pending
+copy. With --base, the copy both started from, a change made in one copy
+only wins and tasks one copy deleted are dropped. Tasks the copies changed
+differently are listed at the end; the merge keeps the completed or changed
+version of each, else A's.
+
+Flags:
+  --base file  Common ancestor of the two copies
+  --out file   Write the merged file here instead of printing it
+  -i           Ask how to resolve each conflicting task
+
+Exits with 1 when tasks were left needing attention.`)
+}
@@ -0,0 +1,342 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"wydo/internal/agenda"
+	"wydo/internal/clock"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// printMinLines is how many lines each day of a printed week gets at least;
+// the empty ones are left as blank checkboxes to write on.
+const printMinLines = 4
+
+// printDay is one day of a printed week or month.
+type printDay struct {
+	Date    time.Time
+	InRange bool // false for the days of a month grid outside the month
+	Items   []printItem
+}
+
+type printItem struct {
+	Done  bool
+	Date  string // only set for overdue items
+	Title string
+	Note  string // "(Board / Column)", "+project", "scheduled", ...
+}
+
+// printPage is everything a printed page shows.
+type printPage struct {
+	Title   string
+	Month   bool
+	Overdue []printItem
+	Weeks   [][]printDay
+	Printed string
+}
+
+func runPrint(args []string, svc service.TaskService, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		printPrintUsage()
+		if len(args) == 0 {
+			return 1
+		}
+		return 0
+	}
+
+	period := args[0]
+	if period != "week" && period != "month" {
+		fmt.Fprintf(os.Stderr, "Unknown print command: %s\n", period)
+		printPrintUsage()
+		return 1
+	}
+
+	fs := flag.NewFlagSet("print "+period, flag.ContinueOnError)
+	asHTML := fs.Bool("html", false, "Print an HTML page to print or save as PDF from a browser")
+	dateStr := fs.String("date", "", "Any day of the week or month to print (YYYY-MM-DD, default today)")
+	next := fs.Bool("next", false, "Print the next week or month")
+	all := fs.Bool("all", false, "Include items parked by agenda_exclude")
+	if err := fs.Parse(args[1:]); err != nil {
+		return 1
+	}
+
+	date := clock.Now()
+	if *dateStr != "" {
+		d, err := time.ParseInLocation("2006-01-02", *dateStr, time.Local)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --date %q, expected YYYY-MM-DD\n", *dateStr)
+			return 1
+		}
+		date = d
+	}
+
+	var dateRange agenda.DateRange
+	if period == "week" {
+		if *next {
+			date = date.AddDate(0, 0, 7)
+		}
+		dateRange = agenda.WeekRange(date)
+	} else {
+		if *next {
+			date = time.Date(date.Year(), date.Month()+1, 1, 0, 0, 0, 0, time.Local)
+		}
+		dateRange = agenda.MonthRange(date)
+	}
+
+	var boards []kanbanmodels.Board
+	var allNotes []notes.Note
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+		allNotes = append(allNotes, ws.Notes...)
+	}
+	buckets := agenda.QueryAgenda(svc, boards, allNotes, agenda.CollectProjectDates(workspaces), dateRange)
+	// Overdue only means something for the period that has started
+	var overdue []agenda.AgendaItem
+	if today := startOfToday(); !today.Before(dateRange.Start) && !today.After(dateRange.End) {
+		overdue = agenda.QueryOverdueItems(svc, boards, today)
+	}
+	if !*all {
+		buckets = agenda.ExcludeBuckets(buckets)
+		overdue = agenda.ExcludeItems(overdue)
+	}
+
+	page := buildPrintPage(period == "month", dateRange, buckets, overdue)
+	if *asHTML {
+		if err := writePrintHTML(os.Stdout, page); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+	writePrintText(os.Stdout, page)
+	return 0
+}
+
+func startOfToday() time.Time {
+	now := clock.Now()
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// buildPrintPage lays the buckets of dateRange out in Monday-first weeks. A
+// month is padded to whole weeks with days outside the range.
+func buildPrintPage(month bool, dateRange agenda.DateRange, buckets []agenda.DateBucket, overdue []agenda.AgendaItem) printPage {
+	byDay := make(map[string]agenda.DateBucket, len(buckets))
+	for _, b := range buckets {
+		byDay[b.Date.Format("2006-01-02")] = b
+	}
+
+	page := printPage{Month: month, Printed: clock.Now().Format("Mon Jan 2, 2006 15:04")}
+	last := dateRange.End
+	if month {
+		page.Title = dateRange.Start.Format("January 2006")
+	} else if dateRange.Start.Year() == last.Year() {
+		page.Title = fmt.Sprintf("Week of %s – %s", dateRange.Start.Format("Mon Jan 2"), last.Format("Mon Jan 2, 2006"))
+	} else {
+		page.Title = fmt.Sprintf("Week of %s – %s", dateRange.Start.Format("Mon Jan 2, 2006"), last.Format("Mon Jan 2, 2006"))
+	}
+
+	for _, item := range overdue {
+		p := toPrintItem(item)
+		p.Date = item.Date.Format("01-02")
+		page.Overdue = append(page.Overdue, p)
+	}
+
+	start := agenda.WeekRange(dateRange.Start).Start
+	end := agenda.WeekRange(last).End
+	var week []printDay
+	for d := start; d.Before(end); d = d.AddDate(0, 0, 1) {
+		day := printDay{Date: d, InRange: !d.Before(dateRange.Start) && !d.After(last)}
+		if day.InRange {
+			b := byDay[d.Format("2006-01-02")]
+			for _, item := range b.AllItems() {
+				day.Items = append(day.Items, toPrintItem(item))
+			}
+			for _, item := range b.AllCompletedItems() {
+				day.Items = append(day.Items, toPrintItem(item))
+			}
+		}
+		week = append(week, day)
+		if len(week) == 7 {
+			page.Weeks = append(page.Weeks, week)
+			week = nil
+		}
+	}
+	return page
+}
+
+func toPrintItem(item agenda.AgendaItem) printItem {
+	p := printItem{Done: item.Completed, Title: item.Title()}
+	var extra []string
+	switch item.Source {
+	case agenda.SourceTask:
+		for _, proj := range item.Task.Projects {
+			extra = append(extra, "+"+proj)
+		}
+	case agenda.SourceCard:
+		extra = append(extra, fmt.Sprintf("(%s / %s)", item.BoardName, item.ColumnName))
+	}
+	if item.Reason != agenda.ReasonDue {
+		extra = append(extra, item.Reason.String())
+	}
+	p.Note = strings.Join(extra, " ")
+	return p
+}
+
+func (p printItem) box() string {
+	if p.Done {
+		return "[x]"
+	}
+	return "[ ]"
+}
+
+// writePrintText prints the page as plain text for a monospace printout: a
+// week lists every day with blank checkboxes to fill in, a month only the
+// days with items.
+func writePrintText(w io.Writer, page printPage) {
+	fmt.Fprintln(w, page.Title)
+	fmt.Fprintln(w, strings.Repeat("=", len([]rune(page.Title))))
+	if len(page.Overdue) > 0 {
+		fmt.Fprintln(w)
+		fmt.Fprintln(w, "Overdue")
+		for _, item := range page.Overdue {
+			fmt.Fprintf(w, "  %s %s %s\n", item.box(), item.Date, printTextLine(item))
+		}
+	}
+	for _, week := range page.Weeks {
+		for _, day := range week {
+			if !day.InRange || (page.Month && len(day.Items) == 0) {
+				continue
+			}
+			fmt.Fprintln(w)
+			fmt.Fprintln(w, day.Date.Format("Mon Jan 2"))
+			for _, item := range day.Items {
+				fmt.Fprintf(w, "  %s %s\n", item.box(), printTextLine(item))
+			}
+			if !page.Month {
+				for i := len(day.Items); i < printMinLines; i++ {
+					fmt.Fprintln(w, "  [ ] ____________________________________")
+				}
+			}
+		}
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Printed "+page.Printed)
+}
+
+func printTextLine(item printItem) string {
+	if item.Note == "" {
+		return item.Title
+	}
+	return item.Title + "  " + item.Note
+}
+
+var printTemplate = template.Must(template.New("print").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>{{.Title}}</title>
+<style>
+@page { size: landscape; margin: 1cm; }
+body { font: 10pt/1.35 -apple-system, "Helvetica Neue", Arial, sans-serif; margin: 0; color: #000; }
+h1 { font-size: 14pt; margin: 0 0 6pt; }
+h2 { font-size: 10pt; margin: 0 0 3pt; }
+table { width: 100%; border-collapse: collapse; table-layout: fixed; }
+th { font-size: 9pt; text-align: left; padding: 2pt 4pt; border-bottom: 1px solid #000; }
+td { vertical-align: top; border: 1px solid #999; padding: 3pt 4pt; height: {{if .Month}}2.6cm{{else}}14cm{{end}}; }
+td.out { background: #f2f2f2; }
+.day { font-weight: bold; margin-bottom: 2pt; }
+ul { list-style: none; margin: 0; padding: 0; }
+li { margin: 0 0 2pt; padding-left: 1.2em; text-indent: -1.2em; }
+li.done { color: #666; text-decoration: line-through; }
+li.blank { border-bottom: 1px dotted #999; height: 1.2em; }
+.note { color: #555; font-size: 8pt; }
+.overdue { margin-bottom: 8pt; }
+.overdue li { display: inline-block; margin-right: 1.5em; }
+footer { font-size: 8pt; color: #666; margin-top: 4pt; }
+</style>
+</head>
+<body>
+<h1>{{.Title}}</h1>
+{{- if .Overdue}}
+<div class="overdue">
+<h2>Overdue</h2>
+<ul>
+{{- range .Overdue}}
+<li>☐ {{.Date}} {{.Title}}{{if .Note}} <span class="note">{{.Note}}</span>{{end}}</li>
+{{- end}}
+</ul>
+</div>
+{{- end}}
+<table>
+<tr><th>Mon</th><th>Tue</th><th>Wed</th><th>Thu</th><th>Fri</th><th>Sat</th><th>Sun</th></tr>
+{{- $month := .Month}}
+{{- range .Weeks}}
+<tr>
+{{- range .}}
+{{- if .InRange}}
+<td><div class="day">{{if $month}}{{.Date.Day}}{{else}}{{.Date.Format "Jan 2"}}{{end}}</div>
+<ul>
+{{- range .Items}}
+<li{{if .Done}} class="done"{{end}}>{{if .Done}}☑{{else}}☐{{end}} {{.Title}}{{if .Note}} <span class="note">{{.Note}}</span>{{end}}</li>
+{{- end}}
+{{- if not $month}}{{range .Blanks}}
+<li class="blank">☐</li>
+{{- end}}{{end}}
+</ul></td>
+{{- else}}
+<td class="out"></td>
+{{- end}}
+{{- end}}
+</tr>
+{{- end}}
+</table>
+<footer>Printed {{.Printed}}</footer>
+</body>
+</html>
+`))
+
+// Blanks is one element per empty checkbox line under a day of a printed
+// week.
+func (d printDay) Blanks() []struct{} {
+	if n := printMinLines - len(d.Items); n > 0 {
+		return make([]struct{}, n)
+	}
+	return nil
+}
+
+// writePrintHTML writes the page as a self-contained HTML document laid out
+// as a calendar, one landscape page, for printing or saving as PDF from a
+// browser.
+func writePrintHTML(w io.Writer, page printPage) error {
+	return printTemplate.Execute(w, page)
+}
+
+func printPrintUsage() {
+	fmt.Println(`wydo print - Print a week or month to put on paper
+
+Usage: wydo print <week|month> [--html] [--date YYYY-MM-DD] [--next] [--all]
+
+The agenda of the period, plus overdue items for the current one, with a
+checkbox per item. Plain text lists each day of a week with blank lines to
+write on; --html lays it out as a one-page calendar to print, or save as
+PDF, from a browser.
+
+Flags:
+  --html      Print an HTML page instead of plain text
+  --date      Any day of the week or month to print (default today)
+  --next      Print the week or month after it
+  --all       Include items parked by the agenda_exclude config
+
+Examples:
+  wydo print week | lpr
+  wydo print month --next --html > month.html`)
+}
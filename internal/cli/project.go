@@ -0,0 +1,143 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"wydo/internal/workspace"
+)
+
+func runProjectCommand(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 {
+		printProjectUsage()
+		return 1
+	}
+
+	command := args[0]
+	cmdArgs := args[1:]
+
+	switch command {
+	case "rename", "mv":
+		return runProjectRename(cmdArgs, workspaces)
+	case "merges":
+		return runProjectMerges(workspaces)
+	case "help", "-h", "--help":
+		printProjectUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown project command: %s\n", command)
+		printProjectUsage()
+		return 1
+	}
+}
+
+// runProjectRename renames a project in every workspace that has it, after
+// showing how many tasks, cards and directories change and asking to go on.
+func runProjectRename(args []string, workspaces []*workspace.Workspace) int {
+	fs := flag.NewFlagSet("rename", flag.ContinueOnError)
+	yes := fs.Bool("yes", false, "Rename without asking")
+	fs.BoolVar(yes, "y", false, "Rename without asking (shorthand)")
+	dryRun := fs.Bool("dry-run", false, "Show what would change without renaming")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo project rename [--yes] [--dry-run] <old> <new>")
+		return 1
+	}
+	oldName := strings.TrimPrefix(fs.Arg(0), "+")
+	newName := strings.TrimPrefix(strings.TrimSpace(fs.Arg(1)), "+")
+	if newName == "" || newName == oldName {
+		fmt.Fprintln(os.Stderr, "Error: the new name must differ from the old one")
+		return 1
+	}
+
+	var targets []*workspace.Workspace
+	for _, ws := range workspaces {
+		p, err := ws.PreviewRename(oldName, newName)
+		if err != nil {
+			continue
+		}
+		targets = append(targets, ws)
+		fmt.Printf("%s: %s", ws.RootDir, p.String())
+		if p.Merge {
+			fmt.Printf(" (merged into the existing project %s)", newName)
+		}
+		fmt.Println()
+	}
+	if len(targets) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: project %q not found\n", oldName)
+		return 1
+	}
+	if *dryRun {
+		return 0
+	}
+	if !*yes && !confirm(fmt.Sprintf("Rename %s to %s?", oldName, newName)) {
+		fmt.Println("Cancelled.")
+		return 0
+	}
+
+	for _, ws := range targets {
+		if err := ws.RenameProject(oldName, newName); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s: %v\n", ws.RootDir, err)
+			return 1
+		}
+	}
+	fmt.Printf("Renamed %s to %s.\n", oldName, newName)
+	return 0
+}
+
+// runProjectMerges lists, per workspace, the projects found under several
+// spellings that project_matching merged into one.
+func runProjectMerges(workspaces []*workspace.Workspace) int {
+	count := 0
+	for _, ws := range workspaces {
+		if ws.Projects == nil {
+			continue
+		}
+		for _, m := range ws.Projects.Merges() {
+			fmt.Printf("%s: %s ← %s", ws.RootDir, m.Name, strings.Join(m.Spellings, ", "))
+			if len(m.Aliased) > 0 {
+				fmt.Printf(" (aliases: %s)", strings.Join(m.Aliased, ", "))
+			}
+			fmt.Println()
+			count++
+		}
+	}
+	if count == 0 {
+		fmt.Println("No project spellings were merged.")
+		return 0
+	}
+	fmt.Printf("%d project(s) merged. wydo project rename <spelling> <name> rewrites a spelling on disk.\n", count)
+	return 0
+}
+
+// confirm asks a yes/no question on stdin; anything but y or yes is no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N] ", question)
+	line, _ := bufio.NewReader(os.Stdin).ReadString('\n')
+	switch strings.ToLower(strings.TrimSpace(line)) {
+	case "y", "yes":
+		return true
+	}
+	return false
+}
+
+func printProjectUsage() {
+	fmt.Println(`wydo project - Project commands
+
+Usage: wydo project <command> [arguments]
+
+Commands:
+  rename, mv  Rename a project across tasks, cards and its directory
+              wydo project rename alpha beta
+              wydo project rename --dry-run alpha beta   # only show the counts
+              wydo project rename --yes alpha beta       # don't ask
+  merges      List the project spellings merged into one project
+              ("Alpha" and "alpha", or aliases in project_matching)
+
+Renaming to an existing project merges the two.`)
+}
@@ -0,0 +1,93 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"wydo/internal/clock"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/stats"
+	"wydo/internal/tasks/data"
+	"wydo/internal/workspace"
+)
+
+func runStatsCommand(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 {
+		printStatsUsage()
+		return 1
+	}
+
+	command := args[0]
+	cmdArgs := args[1:]
+
+	switch command {
+	case "heatmap", "hm":
+		return runHeatmap(cmdArgs, workspaces)
+	case "help", "-h", "--help":
+		printStatsUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown stats command: %s\n", command)
+		printStatsUsage()
+		return 1
+	}
+}
+
+func runHeatmap(args []string, workspaces []*workspace.Workspace) int {
+	fs := flag.NewFlagSet("heatmap", flag.ContinueOnError)
+	year := fs.Int("year", 0, "Show a calendar year instead of the last 52 weeks")
+
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	var tasks []data.Task
+	var boards []kanbanmodels.Board
+	for _, ws := range workspaces {
+		if ws.TaskSvc != nil {
+			// Earlier years' done files aren't part of ws.Tasks
+			all, err := ws.TaskSvc.ListAll()
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error loading tasks: %v\n", err)
+				return 1
+			}
+			tasks = append(tasks, all...)
+		}
+		boards = append(boards, ws.Boards...)
+	}
+	completions := stats.CollectCompletions(tasks, boards)
+
+	today := clock.Now()
+	start := today.AddDate(0, 0, -7*52)
+	end := today
+	if *year != 0 {
+		start = time.Date(*year, time.January, 1, 0, 0, 0, 0, time.Local)
+		end = time.Date(*year, time.December, 31, 0, 0, 0, 0, time.Local)
+		if end.After(today) {
+			end = today
+		}
+	}
+
+	fmt.Println(stats.RenderHeatmap(completions, start, end, nil))
+	fmt.Println()
+
+	current, longest := stats.Streaks(completions, start, end)
+	fmt.Printf("%d completion(s)  current streak: %d day(s)  longest streak: %d day(s)\n",
+		completions.Total(start, end), current, longest)
+	return 0
+}
+
+func printStatsUsage() {
+	fmt.Println(`wydo stats - Statistics about completed work
+
+Usage: wydo stats <command> [arguments]
+
+Commands:
+  heatmap, hm Show a GitHub-style heatmap of completed tasks and cards
+              wydo stats heatmap              # Last 52 weeks
+              wydo stats heatmap --year 2025  # A calendar year
+
+  help        Show this help message`)
+}
@@ -0,0 +1,36 @@
+package cli
+
+import (
+	"fmt"
+
+	"wydo/internal/clock"
+	"wydo/internal/stats"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// RunStatus prints the workspace health summary and any workspace or board
+// that could not be loaded. It exits 1 when something was skipped.
+func RunStatus(args []string, svc service.TaskService, workspaces []*workspace.Workspace, scanErrs []workspace.ScanError) int {
+	if len(args) > 0 && (args[0] == "help" || args[0] == "-h" || args[0] == "--help") {
+		fmt.Println(`wydo status - Summarize the loaded workspaces
+
+Usage: wydo status
+
+Prints the number of workspaces, boards, open tasks and overdue items,
+then each workspace or board that could not be loaded. Exits 1 if any
+could not.`)
+		return 0
+	}
+
+	health := stats.CollectHealth(workspaces, svc, scanErrs, clock.Now())
+	fmt.Println(health.String())
+	if len(health.ScanErrors) == 0 {
+		return 0
+	}
+	fmt.Println()
+	for _, e := range health.ScanErrors {
+		fmt.Println(e.Error())
+	}
+	return 1
+}
@@ -0,0 +1,108 @@
+package cli
+
+import (
+	"bufio"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+// runSuggestPriorities proposes priorities for pending tasks with none or a
+// low one and asks whether to accept each, writing accepted ones through
+// the task service.
+func runSuggestPriorities(args []string, svc service.TaskService) int {
+	fs := flag.NewFlagSet("suggest-priorities", flag.ContinueOnError)
+	list := fs.Bool("list", false, "Only list the suggestions")
+	yes := fs.Bool("yes", false, "Accept every suggestion without asking")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	tasks, err := svc.ListPending()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tasks: %v\n", err)
+		return 1
+	}
+	suggestions := data.SuggestPriorities(tasks, priorityWeights(config.Get()), clock.Now())
+	if len(suggestions) == 0 {
+		fmt.Println("No priority changes to suggest.")
+		return 0
+	}
+
+	if *list {
+		for _, s := range suggestions {
+			printSuggestion(s)
+		}
+		fmt.Printf("\n%d suggestion(s)\n", len(suggestions))
+		return 0
+	}
+
+	accepted, err := reviewSuggestions(suggestions, svc, os.Stdin, *yes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating task: %v\n", err)
+		return 1
+	}
+	fmt.Printf("\n%d of %d priority change(s) accepted.\n", accepted, len(suggestions))
+	return 0
+}
+
+// reviewSuggestions asks about each suggestion in turn: a accepts it, s (or
+// just enter) skips it and q stops. It returns how many were accepted.
+func reviewSuggestions(suggestions []data.PrioritySuggestion, svc service.TaskService, r io.Reader, acceptAll bool) (int, error) {
+	in := bufio.NewReader(r)
+	accepted := 0
+	for _, s := range suggestions {
+		printSuggestion(s)
+		if !acceptAll {
+			fmt.Print("  [a]ccept, [s]kip, [q]uit? ")
+			line, err := in.ReadString('\n')
+			answer := strings.ToLower(strings.TrimSpace(line))
+			if answer == "q" || (err != nil && answer == "") {
+				break
+			}
+			if answer != "a" {
+				continue
+			}
+		}
+		task := s.Task
+		task.Priority = s.Priority
+		if err := svc.Update(task); err != nil {
+			return accepted, err
+		}
+		accepted++
+	}
+	return accepted, nil
+}
+
+func printSuggestion(s data.PrioritySuggestion) {
+	from := "none"
+	if s.Task.Priority != data.PriorityNone {
+		from = fmt.Sprintf("(%c)", s.Task.Priority)
+	}
+	fmt.Printf("[%s] %s\n", s.Task.ID[:7], s.Task.Name)
+	fmt.Printf("  %s -> (%c): %s\n", from, s.Priority, strings.Join(s.Reasons, ", "))
+}
+
+// priorityWeights returns the configured priority_weights over the defaults.
+func priorityWeights(cfg *config.Config) data.PriorityWeights {
+	w := data.DefaultPriorityWeights
+	if cfg == nil || cfg.PriorityWeights == nil {
+		return w
+	}
+	pw := cfg.PriorityWeights
+	if pw.Due != nil {
+		w.Due = *pw.Due
+	}
+	if pw.Age != nil {
+		w.Age = *pw.Age
+	}
+	w.Projects = pw.Projects
+	return w
+}
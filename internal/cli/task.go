@@ -45,9 +45,16 @@ func runList(args []string, svc service.TaskService) int {
 	var err error
 
 	if *showDone {
-		tasks, err = svc.ListDone()
+		// Include earlier years' done files, not just this year's
+		var all []data.Task
+		all, err = svc.ListAll()
+		for _, t := range all {
+			if t.Done {
+				tasks = append(tasks, t)
+			}
+		}
 	} else if *showAll {
-		tasks, err = svc.List()
+		tasks, err = svc.ListAll()
 	} else {
 		tasks, err = svc.ListPending()
 	}
@@ -132,6 +139,54 @@ func runDelete(args []string, svc service.TaskService) int {
 	return 0
 }
 
+func runAnnotate(args []string, svc service.TaskService) int {
+	if len(args) < 2 {
+		fmt.Fprintln(os.Stderr, "Error: task ID and annotation text required")
+		fmt.Fprintln(os.Stderr, "Usage: wydo annotate <task-id> \"annotation text\"")
+		return 1
+	}
+
+	task, err := findTaskByPartialID(svc, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	text := strings.TrimSpace(strings.Join(args[1:], " "))
+	if text == "" {
+		fmt.Fprintln(os.Stderr, "Error: annotation text required")
+		return 1
+	}
+
+	if err := svc.Annotate(task.ID, text); err != nil {
+		fmt.Fprintf(os.Stderr, "Error annotating task: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("Annotated: %s\n", task.Name)
+	return 0
+}
+
+func runShow(args []string, svc service.TaskService) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Error: task ID required")
+		fmt.Fprintln(os.Stderr, "Usage: wydo task show <task-id>")
+		return 1
+	}
+
+	task, err := findTaskByPartialID(svc, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	printTask(*task)
+	for _, a := range data.TaskAnnotations(*task) {
+		fmt.Printf("        %s  %s\n", a.Time.Format("2006-01-02 15:04"), a.Text)
+	}
+	return 0
+}
+
 func filterByProject(tasks []data.Task, project string) []data.Task {
 	var filtered []data.Task
 	for _, t := range tasks {
@@ -187,9 +242,14 @@ func findTaskByPartialID(svc service.TaskService, partialID string) (*data.Task,
 		return nil, err
 	}
 
+	// Content IDs (as ls --ids prints) are accepted too
+	contentIDs := data.ContentIDs(tasks)
+	matchID := func(id string) bool {
+		return id == partialID || (len(partialID) >= 4 && strings.HasPrefix(id, partialID))
+	}
 	var matches []data.Task
 	for _, t := range tasks {
-		if t.ID == partialID || (len(partialID) >= 4 && len(t.ID) >= len(partialID) && t.ID[:len(partialID)] == partialID) {
+		if matchID(t.ID) || matchID(contentIDs[t.ID]) {
 			matches = append(matches, t)
 		}
 	}
@@ -0,0 +1,484 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"wydo/internal/clock"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/filter"
+	"wydo/internal/tasks/service"
+)
+
+// The verbs in this file follow todo.txt-cli (todo.sh), so scripts and habits
+// written for it keep working: wydo add, wydo do 3, wydo pri 3 A, ...
+//
+// An item is addressed the way todo.sh does it, by its line number in
+// todo.txt (the file add appends to), or else by a task ID as with
+// "wydo task". ls --ids prints content IDs (see data.ContentIDs), which are
+// accepted wherever a task ID is.
+
+// resolveItem finds the task an item argument refers to: a line number in
+// the todo file, or a task ID (prefix).
+func resolveItem(svc service.TaskService, item string) (*data.Task, error) {
+	if n, err := strconv.Atoi(item); err == nil && n > 0 {
+		tasks, err := svc.List()
+		if err != nil {
+			return nil, err
+		}
+		todoFile := svc.TodoFile()
+		for _, t := range tasks {
+			if t.File == todoFile && t.Line == n {
+				return &t, nil
+			}
+		}
+		// An all-digit ID prefix is still an ID
+		if t, err := findTaskByPartialID(svc, item); err == nil {
+			return t, nil
+		}
+		return nil, fmt.Errorf("no task on line %d of %s", n, todoFile)
+	}
+	return findTaskByPartialID(svc, item)
+}
+
+// refind looks a task up again after an earlier change rewrote its file.
+// IDs and line numbers move when lines before a task go, so it is matched by
+// file and text.
+func refind(svc service.TaskService, task data.Task) (*data.Task, error) {
+	tasks, err := svc.List()
+	if err != nil {
+		return nil, err
+	}
+	line := task.String()
+	for _, t := range tasks {
+		if t.File == task.File && t.String() == line {
+			return &t, nil
+		}
+	}
+	return nil, fmt.Errorf("task changed on disk: %s", task.Name)
+}
+
+// itemLabel is the number todo.sh would print for a task: its line in the
+// todo file, or its short content ID for tasks in other files.
+func itemLabel(svc service.TaskService, t data.Task) string {
+	if t.File == svc.TodoFile() && t.Line > 0 {
+		return strconv.Itoa(t.Line)
+	}
+	tasks, err := svc.List()
+	if err != nil {
+		return t.ID[:7]
+	}
+	return data.ContentIDs(tasks)[t.ID][:7]
+}
+
+func runTodoAdd(args []string, svc service.TaskService) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo add \"THING I NEED TO DO +project @context\"")
+		return 1
+	}
+	task, err := svc.Add(strings.Join(args, " "))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error adding task: %v\n", err)
+		return 1
+	}
+	// Add returns the task before it is re-read, so look up its line
+	if t, err := refind(svc, *task); err == nil {
+		task = t
+	}
+	label := itemLabel(svc, *task)
+	fmt.Printf("%s %s\n", label, task.String())
+	fmt.Printf("TODO: %s added.\n", label)
+	return 0
+}
+
+// runTodoList prints the tasks of the todo file as "<line> <task>", sorted
+// by text like todo.sh, keeping only those that contain every term. Terms
+// that are filter expressions (+project, @context, (A), due:EXPR) filter as
+// --filter does instead. With --ids each task is labelled by its content
+// ID rather than its line, for scripts that outlive a change to the file.
+func runTodoList(args []string, svc service.TaskService) int {
+	fs := flag.NewFlagSet("ls", flag.ContinueOnError)
+	ids := fs.Bool("ids", false, "Label tasks by content ID instead of line number")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+
+	state, terms, err := parseListFilter(fs.Args())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	tasks, err := svc.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tasks: %v\n", err)
+		return 1
+	}
+
+	todoFile := svc.TodoFile()
+	var inFile []data.Task
+	for _, t := range tasks {
+		if t.File == todoFile {
+			inFile = append(inFile, t)
+		}
+	}
+	var shown []data.Task
+	for _, t := range filter.Apply(inFile, state) {
+		if containsAll(t.String(), terms) {
+			shown = append(shown, t)
+		}
+	}
+	sort.SliceStable(shown, func(i, j int) bool {
+		return strings.ToLower(shown[i].String()) < strings.ToLower(shown[j].String())
+	})
+
+	width := len(strconv.Itoa(len(inFile)))
+	contentIDs := data.ContentIDs(tasks)
+	for _, t := range shown {
+		if *ids {
+			fmt.Printf("%s %s\n", contentIDs[t.ID][:7], t.String())
+		} else {
+			fmt.Printf("%0*d %s\n", width, t.Line, t.String())
+		}
+	}
+	fmt.Println("--")
+	fmt.Printf("TODO: %d of %d tasks shown\n", len(shown), len(inFile))
+	return 0
+}
+
+// parseListFilter splits the arguments of ls into a filter, parsed by
+// filter.Parse, and the plain terms todo.sh matches as substrings.
+// Done tasks stay listed, as todo.sh lists every line of the file.
+func parseListFilter(args []string) (filter.State, []string, error) {
+	var exprs, terms []string
+	for _, arg := range args {
+		if isFilterExpr(arg) {
+			exprs = append(exprs, arg)
+		} else {
+			terms = append(terms, arg)
+		}
+	}
+	state, err := filter.Parse(strings.Join(exprs, " "), clock.Now())
+	if err != nil {
+		return filter.State{}, nil, err
+	}
+	state.StatusFilter = filter.StatusAll
+	state.ShowUnstarted = true
+	return state, terms, nil
+}
+
+func isFilterExpr(arg string) bool {
+	switch {
+	case strings.ContainsAny(arg, " \t"):
+		return false
+	case len(arg) > 1 && (arg[0] == '+' || arg[0] == '@'):
+		return true
+	case len(arg) == 3 && data.ParsePriority(arg) != data.PriorityNone:
+		return true
+	}
+	return strings.HasPrefix(strings.ToLower(arg), "due:")
+}
+
+func containsAll(line string, terms []string) bool {
+	line = strings.ToLower(line)
+	for _, term := range terms {
+		if !strings.Contains(line, strings.ToLower(term)) {
+			return false
+		}
+	}
+	return true
+}
+
+// runTodoDo completes one or more items. All items are resolved first, so
+// the numbers refer to the lines as they were before any was completed.
+func runTodoDo(args []string, svc service.TaskService) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo do ITEM# [ITEM#...]")
+		return 1
+	}
+
+	type target struct {
+		label string
+		task  data.Task
+	}
+	var targets []target
+	for _, item := range args {
+		// todo.sh also accepts "do 1,2,3"
+		for _, part := range strings.Split(item, ",") {
+			if part == "" {
+				continue
+			}
+			t, err := resolveItem(svc, part)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return 1
+			}
+			targets = append(targets, target{label: itemLabel(svc, *t), task: *t})
+		}
+	}
+
+	code := 0
+	for _, tg := range targets {
+		if tg.task.Done {
+			fmt.Printf("TODO: %s is already marked done.\n", tg.label)
+			continue
+		}
+		t, err := refind(svc, tg.task)
+		if err == nil {
+			err = svc.Complete(t.ID)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error completing %s: %v\n", tg.label, err)
+			code = 1
+			continue
+		}
+		done := *t
+		done.Done = true
+		done.CompletionDate = clock.Now().Format("2006-01-02")
+		fmt.Printf("%s %s\n", tg.label, done.String())
+		fmt.Printf("TODO: %s marked as done.\n", tg.label)
+	}
+	return code
+}
+
+// runTodoRm deletes an item, or with a term only removes that term from it.
+// Unlike todo.sh it does not ask first.
+func runTodoRm(args []string, svc service.TaskService) int {
+	if len(args) == 0 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo rm ITEM# [TERM]")
+		return 1
+	}
+	task, err := resolveItem(svc, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	label := itemLabel(svc, *task)
+
+	if len(args) == 1 {
+		if err := svc.Delete(task.ID); err != nil {
+			fmt.Fprintf(os.Stderr, "Error deleting task: %v\n", err)
+			return 1
+		}
+		fmt.Printf("%s %s\n", label, task.String())
+		fmt.Printf("TODO: %s deleted.\n", label)
+		return 0
+	}
+
+	term := strings.Join(args[1:], " ")
+	line := task.String()
+	if !strings.Contains(line, term) {
+		fmt.Printf("%s %s\n", label, line)
+		fmt.Printf("TODO: '%s' not found; no removal done.\n", term)
+		return 1
+	}
+	updated := data.ParseTask(strings.ReplaceAll(line, term, ""), task.ID, task.File)
+	updated.Line = task.Line
+	if err := svc.Update(updated); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating task: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%s %s\n", label, updated.String())
+	fmt.Printf("TODO: Removed '%s' from task.\n", term)
+	return 0
+}
+
+// runTodoPri sets an item's priority; "wydo pri 3 -" or depri clears it.
+func runTodoPri(args []string, svc service.TaskService) int {
+	if len(args) != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo pri ITEM# PRIORITY   (A-F)")
+		return 1
+	}
+	priority := data.PriorityNone
+	if args[1] != "-" {
+		priority = data.ParsePriority("(" + args[1] + ")")
+		if priority == data.PriorityNone || len(args[1]) != 1 {
+			fmt.Fprintf(os.Stderr, "Error: %q is not a priority (A-F)\n", args[1])
+			return 1
+		}
+	}
+	return setTodoPriority(args[0], priority, svc)
+}
+
+func runTodoDepri(args []string, svc service.TaskService) int {
+	if len(args) != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo depri ITEM#")
+		return 1
+	}
+	return setTodoPriority(args[0], data.PriorityNone, svc)
+}
+
+func setTodoPriority(item string, priority data.Priority, svc service.TaskService) int {
+	task, err := resolveItem(svc, item)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	label := itemLabel(svc, *task)
+	task.Priority = priority
+	if err := svc.Update(*task); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating task: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%s %s\n", label, task.String())
+	if priority == data.PriorityNone {
+		fmt.Printf("TODO: %s deprioritized.\n", label)
+	} else {
+		fmt.Printf("TODO: %s prioritized (%c).\n", label, priority)
+	}
+	return 0
+}
+
+// runTodoAppend adds text to the end (or, with prepend, the start) of an
+// item's description. Projects, contexts and tags in the text join the
+// task's own.
+func runTodoAppend(args []string, svc service.TaskService, prepend bool) int {
+	if len(args) < 2 {
+		verb := "append"
+		if prepend {
+			verb = "prepend"
+		}
+		fmt.Fprintf(os.Stderr, "Usage: wydo %s ITEM# \"TEXT TO ADD\"\n", verb)
+		return 1
+	}
+	task, err := resolveItem(svc, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	label := itemLabel(svc, *task)
+
+	extra := data.ParseTask(" "+strings.Join(args[1:], " "), "", "")
+	if extra.Name != "" {
+		if prepend {
+			task.Name = strings.TrimSpace(extra.Name + " " + task.Name)
+		} else {
+			task.Name = strings.TrimSpace(task.Name + " " + extra.Name)
+		}
+	}
+	for _, p := range extra.Projects {
+		if !task.HasProject(p) {
+			task.Projects = append(task.Projects, p)
+		}
+	}
+	for _, c := range extra.Contexts {
+		if !task.HasContext(c) {
+			task.Contexts = append(task.Contexts, c)
+		}
+	}
+	for k, v := range extra.Tags {
+		if task.Tags == nil {
+			task.Tags = make(map[string]string)
+		}
+		task.Tags[k] = v
+	}
+	sort.Strings(task.Projects)
+	sort.Strings(task.Contexts)
+
+	if err := svc.Update(*task); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating task: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%s %s\n", label, task.String())
+	return 0
+}
+
+// runTodoListTerms prints each +project (or @context) used by open tasks
+// once, sorted.
+func runTodoListTerms(args []string, svc service.TaskService, contexts bool) int {
+	tasks, err := svc.ListPending()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tasks: %v\n", err)
+		return 1
+	}
+	seen := make(map[string]bool)
+	var terms []string
+	for _, t := range tasks {
+		if !containsAll(t.String(), args) {
+			continue
+		}
+		names, sigil := t.Projects, "+"
+		if contexts {
+			names, sigil = t.Contexts, "@"
+		}
+		for _, n := range names {
+			if !seen[n] {
+				seen[n] = true
+				terms = append(terms, sigil+n)
+			}
+		}
+	}
+	sort.Strings(terms)
+	for _, term := range terms {
+		fmt.Println(term)
+	}
+	return 0
+}
+
+// runTodoReplace replaces an item's text, keeping its priority and creation
+// date unless the new text has its own, like todo.sh replace.
+func runTodoReplace(args []string, svc service.TaskService) int {
+	if len(args) < 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo replace ITEM# \"UPDATED ITEM\"")
+		return 1
+	}
+	task, err := resolveItem(svc, args[0])
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	label := itemLabel(svc, *task)
+
+	updated := data.ParseTask(strings.Join(args[1:], " "), task.ID, task.File)
+	updated.Line = task.Line
+	if updated.Priority == data.PriorityNone {
+		updated.Priority = task.Priority
+	}
+	if updated.CreatedDate == "" {
+		updated.CreatedDate = task.CreatedDate
+	}
+	if task.Done && !updated.Done {
+		updated.Done, updated.CompletionDate = true, task.CompletionDate
+	}
+	if err := svc.Update(updated); err != nil {
+		fmt.Fprintf(os.Stderr, "Error updating task: %v\n", err)
+		return 1
+	}
+	fmt.Printf("%s %s\n", label, task.String())
+	fmt.Println("TODO: Replaced task with:")
+	fmt.Printf("%s %s\n", label, updated.String())
+	return 0
+}
+
+// runTodoArchive moves the done tasks of the todo files into the done files
+// beside them, printing each one moved.
+func runTodoArchive(args []string, svc service.TaskService) int {
+	if len(args) != 0 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo archive")
+		return 1
+	}
+	tasks, err := svc.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error loading tasks: %v\n", err)
+		return 1
+	}
+	var moved []data.Task
+	for _, t := range tasks {
+		if t.Done && !data.IsDoneFile(t.File) {
+			moved = append(moved, t)
+		}
+	}
+	if err := svc.Archive(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error archiving tasks: %v\n", err)
+		return 1
+	}
+	for _, t := range moved {
+		fmt.Println(t.String())
+	}
+	fmt.Printf("TODO: %s archived.\n", svc.TodoFile())
+	return 0
+}
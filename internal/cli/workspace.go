@@ -0,0 +1,168 @@
+package cli
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"wydo/internal/config"
+	"wydo/internal/workspace"
+)
+
+func runWorkspaceCommand(args []string, workspaces []*workspace.Workspace) int {
+	if len(args) == 0 {
+		printWorkspaceUsage()
+		return 1
+	}
+
+	command := args[0]
+	cmdArgs := args[1:]
+
+	switch command {
+	case "merge":
+		return runWorkspaceMerge(cmdArgs)
+	case "split":
+		return runWorkspaceSplit(cmdArgs, workspaces)
+	case "help", "-h", "--help":
+		printWorkspaceUsage()
+		return 0
+	default:
+		fmt.Fprintf(os.Stderr, "Unknown workspace command: %s\n", command)
+		printWorkspaceUsage()
+		return 1
+	}
+}
+
+// runWorkspaceMerge moves everything in one workspace directory into another,
+// after listing what goes where and asking to go on.
+func runWorkspaceMerge(args []string) int {
+	fs := flag.NewFlagSet("merge", flag.ContinueOnError)
+	yes := fs.Bool("yes", false, "Merge without asking")
+	fs.BoolVar(yes, "y", false, "Merge without asking (shorthand)")
+	dryRun := fs.Bool("dry-run", false, "Show what would move without moving anything")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	if fs.NArg() != 2 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo workspace merge [--yes] [--dry-run] <src> <dst>")
+		return 1
+	}
+	src, err := filepath.Abs(config.ExpandPath(fs.Arg(0)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	dst, err := filepath.Abs(config.ExpandPath(fs.Arg(1)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	plan, err := workspace.PlanMerge(src, dst)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return applyRelocatePlan(plan, *dryRun, *yes, fmt.Sprintf("Merge %s into %s?", src, dst),
+		fmt.Sprintf("Merged. Remove %s from your workspaces config if it is listed there.", src))
+}
+
+// runWorkspaceSplit moves a project, its boards, task lines and linked notes
+// out of the workspace that has it into a new workspace directory.
+func runWorkspaceSplit(args []string, workspaces []*workspace.Workspace) int {
+	fs := flag.NewFlagSet("split", flag.ContinueOnError)
+	project := fs.String("project", "", "Project to extract (required)")
+	fs.StringVar(project, "p", "", "Project to extract (shorthand)")
+	yes := fs.Bool("yes", false, "Split without asking")
+	fs.BoolVar(yes, "y", false, "Split without asking (shorthand)")
+	dryRun := fs.Bool("dry-run", false, "Show what would move without moving anything")
+	if err := fs.Parse(args); err != nil {
+		return 1
+	}
+	name := strings.TrimPrefix(*project, "+")
+	if name == "" || fs.NArg() != 1 {
+		fmt.Fprintln(os.Stderr, "Usage: wydo workspace split --project <name> [--yes] [--dry-run] <newdir>")
+		return 1
+	}
+	newDir, err := filepath.Abs(config.ExpandPath(fs.Arg(0)))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var source *workspace.Workspace
+	for _, ws := range workspaces {
+		if ws.Projects != nil && ws.Projects.Get(name) != nil {
+			if source != nil {
+				fmt.Fprintf(os.Stderr, "Error: project %q is in more than one workspace (%s, %s); pass -w to pick one\n", name, source.RootDir, ws.RootDir)
+				return 1
+			}
+			source = ws
+		}
+	}
+	if source == nil {
+		fmt.Fprintf(os.Stderr, "Error: project %q not found\n", name)
+		return 1
+	}
+
+	plan, err := workspace.PlanSplit(source, name, newDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return applyRelocatePlan(plan, *dryRun, *yes, fmt.Sprintf("Move project %s to %s?", name, newDir),
+		fmt.Sprintf("Split. Add %s to your workspaces config to keep seeing it.", newDir))
+}
+
+// applyRelocatePlan lists the steps of plan and, unless dryRun, applies them
+// once confirmed.
+func applyRelocatePlan(plan *workspace.RelocatePlan, dryRun, yes bool, question, done string) int {
+	if len(plan.Steps) == 0 {
+		fmt.Println("Nothing to move.")
+		return 0
+	}
+	for _, step := range plan.Steps {
+		fmt.Println("  " + step.String())
+	}
+	if dryRun {
+		return 0
+	}
+	if !yes && !confirm(question) {
+		fmt.Println("Cancelled.")
+		return 0
+	}
+	if err := plan.Apply(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Println(done)
+	return 0
+}
+
+func printWorkspaceUsage() {
+	fmt.Println(`wydo workspace - Workspace commands
+
+Usage: wydo workspace <command> [arguments]
+
+Commands:
+  merge [--yes] [--dry-run] <src> <dst>
+              Move every board, task file, project and note of src into dst.
+              A board whose name dst already has gets a -2 suffix, task files
+              are appended to the dst file of the same name, project and note
+              directories are merged and a note whose name is taken gets a
+              suffix. Hidden files such as .git stay in src.
+
+  split --project <name> [--yes] [--dry-run] <newdir>
+              Move a project out into a fresh workspace: its directory (with
+              the projects below it), the boards linked to it, its task lines
+              with their annotations, and the notes that list it in their
+              projects frontmatter. newdir must not exist or be empty.
+
+Both list what moves where and ask before moving anything.
+
+Examples:
+  wydo workspace merge --dry-run ~/old-notes ~/notes
+  wydo workspace split --project alpha ~/alpha`)
+}
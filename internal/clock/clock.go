@@ -0,0 +1,93 @@
+// Package clock is where wydo reads the time and makes up IDs, so that tests
+// and bug reproductions can pin both: Set fixes the time, and while it is
+// fixed NewID counts instead of mixing in the time.
+package clock
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// EnvNow is the environment variable that starts wydo at a fixed time, in
+// RFC 3339 or as yyyy-MM-dd (9:00 local time), for screenshots and for
+// reproducing a report from a given day.
+const EnvNow = "WYDO_NOW"
+
+var (
+	mu    sync.Mutex
+	fixed *time.Time
+	seq   int
+)
+
+// Now returns the current time, or the time set by Set.
+func Now() time.Time {
+	mu.Lock()
+	defer mu.Unlock()
+	if fixed != nil {
+		return *fixed
+	}
+	return time.Now()
+}
+
+// Set fixes the time Now returns at t and restarts the NewID sequence; the
+// zero time goes back to the real clock.
+func Set(t time.Time) {
+	mu.Lock()
+	defer mu.Unlock()
+	seq = 0
+	if t.IsZero() {
+		fixed = nil
+		return
+	}
+	fixed = &t
+}
+
+// Fixed reports whether the time is fixed by Set.
+func Fixed() bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return fixed != nil
+}
+
+// SetFromEnv fixes the time from $WYDO_NOW when it is set.
+func SetFromEnv() error {
+	v := os.Getenv(EnvNow)
+	if v == "" {
+		return nil
+	}
+	t, err := ParseNow(v)
+	if err != nil {
+		return err
+	}
+	Set(t)
+	return nil
+}
+
+// ParseNow parses a $WYDO_NOW value.
+func ParseNow(v string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, v); err == nil {
+		return t, nil
+	}
+	if d, err := time.ParseInLocation("2006-01-02", v, time.Local); err == nil {
+		return d.Add(9 * time.Hour), nil
+	}
+	return time.Time{}, fmt.Errorf("invalid %s %q, use 2006-01-02 or RFC 3339", EnvNow, v)
+}
+
+// NewID returns a short hex ID derived from seed. With the real clock the
+// time makes it unique; with a fixed one a counter does, so the same steps
+// give the same IDs.
+func NewID(seed string) string {
+	mu.Lock()
+	salt := time.Now().String()
+	if fixed != nil {
+		seq++
+		salt = fmt.Sprintf("#%d", seq)
+	}
+	mu.Unlock()
+	sum := sha256.Sum256([]byte(seed + salt))
+	return fmt.Sprintf("%x", sum[:5])
+}
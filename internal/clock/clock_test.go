@@ -0,0 +1,46 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSetFixesNowAndIDs(t *testing.T) {
+	at := time.Date(2026, time.March, 4, 9, 30, 0, 0, time.UTC)
+	Set(at)
+	defer Set(time.Time{})
+
+	if !Now().Equal(at) || !Fixed() {
+		t.Fatalf("Now() = %v, want %v", Now(), at)
+	}
+	first, second := NewID("task"), NewID("task")
+	if first == second {
+		t.Errorf("expected distinct IDs, got %s twice", first)
+	}
+
+	Set(at)
+	if again := NewID("task"); again != first {
+		t.Errorf("expected the same first ID after Set, got %s and %s", first, again)
+	}
+
+	Set(time.Time{})
+	if Fixed() || time.Since(Now()) > time.Minute {
+		t.Errorf("expected the real clock after Set(zero), got %v", Now())
+	}
+}
+
+func TestParseNow(t *testing.T) {
+	d, err := ParseNow("2026-03-04")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Hour() != 9 || d.Day() != 4 {
+		t.Errorf("ParseNow(date) = %v, want 9:00 that day", d)
+	}
+	if _, err := ParseNow("2026-03-04T10:00:00Z"); err != nil {
+		t.Errorf("RFC 3339: %v", err)
+	}
+	if _, err := ParseNow("tomorrow"); err == nil {
+		t.Error("expected an error for an invalid value")
+	}
+}
@@ -14,12 +14,98 @@ type JiraConfig struct {
 	APIToken string `json:"api_token"`
 }
 
+// SMTPConfig is the mail server card watchers with an email address in
+// their notify: list are told through
+type SMTPConfig struct {
+	Host     string `json:"host"`
+	Port     int    `json:"port,omitempty"`
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	From     string `json:"from,omitempty"`
+}
+
+// ProjectMatchingConfig decides which spellings of a project name are the
+// same project
+type ProjectMatchingConfig struct {
+	// CaseSensitive keeps "Alpha" and "alpha" apart; case is folded by default
+	CaseSensitive bool `json:"case_sensitive,omitempty"`
+	// Aliases maps other spellings to a project's name, e.g.
+	// {"alpha-project": "alpha"}
+	Aliases map[string]string `json:"aliases,omitempty"`
+}
+
+// PriorityWeightsConfig weighs what wydo task suggest-priorities scores
+// tasks by
+type PriorityWeightsConfig struct {
+	// Due and Age scale the due date and age scores; unset keeps 1 and 0
+	// leaves the score out
+	Due *float64 `json:"due,omitempty"`
+	Age *float64 `json:"age,omitempty"`
+	// Projects adds an importance to the tasks of a project, e.g.
+	// {"alpha": 2, "chores": -1}
+	Projects map[string]float64 `json:"projects,omitempty"`
+}
+
 // Config holds the unified application configuration
 type Config struct {
 	Workspaces   []string    `json:"workspaces"`
 	DefaultView  string      `json:"default_view"`
 	DefaultBoard string      `json:"-"` // runtime-only: open a specific board by name
+	FirstRun     bool        `json:"-"` // runtime-only: no config file existed at startup
+	ShowTour     bool        `json:"-"` // runtime-only: start the onboarding tour even if already completed
 	Jira         *JiraConfig `json:"jira,omitempty"`
+	Ignore       []string    `json:"ignore,omitempty"`     // gitignore-style patterns skipped when scanning every workspace
+	Hyperlinks   bool        `json:"hyperlinks,omitempty"` // render URLs and paths as OSC 8 terminal hyperlinks
+	// RestoreSession reopens the view, board and filters the TUI last quit
+	// from; off via "restore_session": false
+	RestoreSession bool `json:"restore_session"`
+	ExplicitView   bool `json:"-"` // runtime-only: the command line picked a view or board, so don't restore
+	// DefaultCard selects the card of DefaultBoard with that title
+	// (runtime-only: --card)
+	DefaultCard string `json:"-"`
+	// DefaultFilter presets the filter of DefaultBoard or of the task
+	// manager (runtime-only: --filter)
+	DefaultFilter string `json:"-"`
+	// PriorityColors overrides priority badge colors, keyed by task priority
+	// letter (A–F) or card priority number (1–6)
+	PriorityColors map[string]string `json:"priority_colors,omitempty"`
+	// FilterCardBodies makes the board filter (/) also search card bodies by
+	// default; tab toggles it while filtering
+	FilterCardBodies bool `json:"filter_card_bodies,omitempty"`
+	// HighlightFilterMatches underlines the characters of card titles that
+	// the board filter matched
+	HighlightFilterMatches bool `json:"highlight_filter_matches,omitempty"`
+	// StampCreatedDate writes today's date as the todo.txt creation date of
+	// tasks added from the TUI or CLI
+	StampCreatedDate bool `json:"stamp_created_date,omitempty"`
+	// CardEditor is the command cards open in, e.g. "code --wait {{file}}";
+	// empty uses $EDITOR
+	CardEditor string `json:"card_editor,omitempty"`
+	// WorkspaceCardEditors overrides CardEditor for the cards of a
+	// workspace, keyed by workspace directory
+	WorkspaceCardEditors map[string]string `json:"workspace_card_editors,omitempty"`
+	// SMTP sends the email notifications of cards' notify: lists
+	SMTP *SMTPConfig `json:"smtp,omitempty"`
+	// AgendaExclude lists contexts ("@waiting") and tags ("#someday") whose
+	// items are kept off the agenda and out of overdue counts
+	AgendaExclude []string `json:"agenda_exclude,omitempty"`
+	// SymlinkAttachments makes ctrl+f symlink files into attachments/ instead of
+	// copying them
+	SymlinkAttachments bool `json:"symlink_attachments,omitempty"`
+	// WeekCollapseEmptyDays starts the week view with the days that have
+	// nothing scheduled hidden; z toggles it
+	WeekCollapseEmptyDays bool `json:"week_collapse_empty_days,omitempty"`
+	// JournalDir holds the daily journal notes; empty uses journal/ in the
+	// first workspace
+	JournalDir string `json:"journal_dir,omitempty"`
+	// ProjectMatching merges spellings of project names
+	ProjectMatching *ProjectMatchingConfig `json:"project_matching,omitempty"`
+	// PriorityWeights tunes the priorities wydo task suggest-priorities
+	// proposes
+	PriorityWeights *PriorityWeightsConfig `json:"priority_weights,omitempty"`
+	// WatchFiles reloads the TUI when task, board or card files change on
+	// disk; off via "watch_files": false
+	WatchFiles bool `json:"watch_files"`
 }
 
 // Settings represents the config file structure
@@ -27,6 +113,31 @@ type Settings struct {
 	Workspaces  []string    `json:"workspaces"`
 	DefaultView string      `json:"default_view,omitempty"`
 	Jira        *JiraConfig `json:"jira,omitempty"`
+	Ignore      []string    `json:"ignore,omitempty"`
+	Hyperlinks  bool        `json:"hyperlinks,omitempty"`
+	// nil means the default (on), so it is a pointer
+	RestoreSession   *bool             `json:"restore_session,omitempty"`
+	PriorityColors   map[string]string `json:"priority_colors,omitempty"`
+	FilterCardBodies bool              `json:"filter_card_bodies,omitempty"`
+	// HighlightFilterMatches underlines matched title characters on boards
+	HighlightFilterMatches bool `json:"highlight_filter_matches,omitempty"`
+	StampCreatedDate       bool `json:"stamp_created_date,omitempty"`
+	// CardEditor is a command template; {{file}} stands for the card file
+	CardEditor           string            `json:"card_editor,omitempty"`
+	WorkspaceCardEditors map[string]string `json:"workspace_card_editors,omitempty"`
+	SMTP                 *SMTPConfig       `json:"smtp,omitempty"`
+	AgendaExclude        []string          `json:"agenda_exclude,omitempty"`
+	JournalDir           string            `json:"journal_dir,omitempty"`
+	// SymlinkAttachments links attached files instead of copying them
+	SymlinkAttachments bool `json:"symlink_attachments,omitempty"`
+	// WeekCollapseEmptyDays hides empty days in the week view at startup
+	WeekCollapseEmptyDays bool `json:"week_collapse_empty_days,omitempty"`
+	// ProjectMatching folds case and applies aliases to project names
+	ProjectMatching *ProjectMatchingConfig `json:"project_matching,omitempty"`
+	// PriorityWeights weighs due dates, age and projects in suggestions
+	PriorityWeights *PriorityWeightsConfig `json:"priority_weights,omitempty"`
+	// nil means the default (on), like RestoreSession
+	WatchFiles *bool `json:"watch_files,omitempty"`
 }
 
 // CLIFlags holds parsed CLI flags
@@ -39,7 +150,9 @@ var globalConfig *Config
 // Load loads configuration with priority: CLI flags > env vars > config file > default
 func Load(flags CLIFlags) (*Config, error) {
 	cfg := &Config{
-		DefaultView: "day",
+		DefaultView:    "day",
+		RestoreSession: true,
+		WatchFiles:     true,
 	}
 
 	// Try loading config file first for base values
@@ -55,6 +168,34 @@ func Load(flags CLIFlags) (*Config, error) {
 			if fileConfig.Jira != nil {
 				cfg.Jira = fileConfig.Jira
 			}
+			cfg.Ignore = fileConfig.Ignore
+			cfg.Hyperlinks = fileConfig.Hyperlinks
+			if fileConfig.RestoreSession != nil {
+				cfg.RestoreSession = *fileConfig.RestoreSession
+			}
+			cfg.PriorityColors = fileConfig.PriorityColors
+			cfg.FilterCardBodies = fileConfig.FilterCardBodies
+			cfg.HighlightFilterMatches = fileConfig.HighlightFilterMatches
+			cfg.StampCreatedDate = fileConfig.StampCreatedDate
+			cfg.CardEditor = fileConfig.CardEditor
+			if len(fileConfig.WorkspaceCardEditors) > 0 {
+				cfg.WorkspaceCardEditors = make(map[string]string)
+				for dir, command := range fileConfig.WorkspaceCardEditors {
+					cfg.WorkspaceCardEditors[filepath.Clean(ExpandPath(dir))] = command
+				}
+			}
+			cfg.SMTP = fileConfig.SMTP
+			cfg.AgendaExclude = fileConfig.AgendaExclude
+			cfg.WeekCollapseEmptyDays = fileConfig.WeekCollapseEmptyDays
+			cfg.SymlinkAttachments = fileConfig.SymlinkAttachments
+			if fileConfig.JournalDir != "" {
+				cfg.JournalDir = ExpandPath(fileConfig.JournalDir)
+			}
+			cfg.ProjectMatching = fileConfig.ProjectMatching
+			cfg.PriorityWeights = fileConfig.PriorityWeights
+			if fileConfig.WatchFiles != nil {
+				cfg.WatchFiles = *fileConfig.WatchFiles
+			}
 		}
 	}
 
@@ -82,6 +223,27 @@ func Load(flags CLIFlags) (*Config, error) {
 	return cfg, nil
 }
 
+// CardEditorFor returns the command cards in the given workspace open in:
+// its workspace_card_editors entry, else card_editor. "" means $EDITOR.
+func (c *Config) CardEditorFor(workspaceDir string) string {
+	if c == nil {
+		return ""
+	}
+	if command, ok := c.WorkspaceCardEditors[filepath.Clean(workspaceDir)]; ok && workspaceDir != "" {
+		return command
+	}
+	return c.CardEditor
+}
+
+// JournalDirFor returns the directory of the daily journal notes:
+// journal_dir, else journal/ in the given workspace (the first one).
+func (c *Config) JournalDirFor(workspaceDir string) string {
+	if c != nil && c.JournalDir != "" {
+		return c.JournalDir
+	}
+	return filepath.Join(workspaceDir, "journal")
+}
+
 // Get returns the loaded config
 func Get() *Config {
 	return globalConfig
@@ -98,11 +260,11 @@ func GetDefaultDir() (string, error) {
 
 // getConfigPath returns the path to the configuration file
 func getConfigPath() (string, error) {
-	homeDir, err := os.UserHomeDir()
+	configDir, err := ConfigDir()
 	if err != nil {
 		return "", err
 	}
-	return filepath.Join(homeDir, ".config", "wydo", "config.json"), nil
+	return filepath.Join(configDir, "config.json"), nil
 }
 
 // loadConfigFile loads configuration from the settings file
@@ -156,6 +318,50 @@ func SaveJiraConfig(jira *JiraConfig) error {
 	return nil
 }
 
+// SaveWorkspaces writes the workspace list into the config file, preserving other settings.
+func SaveWorkspaces(workspaces []string) error {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return err
+	}
+
+	var settings Settings
+	if data, err := os.ReadFile(configPath); err == nil {
+		_ = json.Unmarshal(data, &settings)
+	}
+
+	settings.Workspaces = workspaces
+
+	data, err := json.MarshalIndent(settings, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(configPath), 0755); err != nil {
+		return err
+	}
+
+	if err := os.WriteFile(configPath, data, 0644); err != nil {
+		return err
+	}
+
+	if globalConfig != nil {
+		globalConfig.Workspaces = workspaces
+	}
+
+	return nil
+}
+
+// ConfigFileExists reports whether the config file has been created yet.
+func ConfigFileExists() bool {
+	configPath, err := getConfigPath()
+	if err != nil {
+		return false
+	}
+	_, err = os.Stat(configPath)
+	return err == nil
+}
+
 // EnsureWorkspaces ensures all workspace directories exist (creates them if missing)
 func (c *Config) EnsureWorkspaces() error {
 	for _, dir := range c.Workspaces {
@@ -239,7 +445,8 @@ func parseColonSeparated(s string) []string {
 	return result
 }
 
-func expandPath(path string) string {
+// ExpandPath expands a leading ~/ to the home directory.
+func ExpandPath(path string) string {
 	if strings.HasPrefix(path, "~/") {
 		homeDir, err := os.UserHomeDir()
 		if err != nil {
@@ -253,7 +460,7 @@ func expandPath(path string) string {
 func expandPaths(paths []string) []string {
 	result := make([]string, len(paths))
 	for i, p := range paths {
-		result[i] = expandPath(p)
+		result[i] = ExpandPath(p)
 	}
 	return result
 }
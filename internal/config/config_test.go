@@ -111,3 +111,20 @@ func TestGetFirstWorkspace(t *testing.T) {
 		t.Errorf("expected empty string, got %q", empty.GetFirstWorkspace())
 	}
 }
+
+func TestCardEditorFor(t *testing.T) {
+	cfg := &Config{
+		CardEditor:           "code --wait {{file}}",
+		WorkspaceCardEditors: map[string]string{"/ws/notes": "obsidian {{file}}"},
+	}
+	if got := cfg.CardEditorFor("/ws/notes/"); got != "obsidian {{file}}" {
+		t.Errorf("workspace editor: got %q", got)
+	}
+	if got := cfg.CardEditorFor("/ws/work"); got != "code --wait {{file}}" {
+		t.Errorf("global editor: got %q", got)
+	}
+	var none *Config
+	if got := none.CardEditorFor("/ws/notes"); got != "" {
+		t.Errorf("nil config: got %q", got)
+	}
+}
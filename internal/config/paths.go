@@ -0,0 +1,131 @@
+package config
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// appDirName is the directory wydo uses under each XDG base directory.
+const appDirName = "wydo"
+
+// ConfigDir returns $XDG_CONFIG_HOME/wydo (default ~/.config/wydo).
+// It holds user-edited settings: config.json and the claude-status hook files.
+func ConfigDir() (string, error) {
+	return xdgDir("XDG_CONFIG_HOME", ".config")
+}
+
+// StateDir returns $XDG_STATE_HOME/wydo (default ~/.local/state/wydo).
+// It holds data wydo writes on its own: state.json and debug.log.
+func StateDir() (string, error) {
+	return xdgDir("XDG_STATE_HOME", ".local", "state")
+}
+
+// ProjectTemplatesDir returns the directory holding project templates,
+// templates/projects under ConfigDir. Each subdirectory is one template.
+func ProjectTemplatesDir() (string, error) {
+	dir, err := ConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "templates", "projects"), nil
+}
+
+// xdgDir resolves envVar (which must be absolute per the XDG spec) or falls
+// back to the given path under the home directory.
+func xdgDir(envVar string, fallback ...string) (string, error) {
+	if dir := os.Getenv(envVar); dir != "" && filepath.IsAbs(dir) {
+		return filepath.Join(dir, appDirName), nil
+	}
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	parts := append([]string{homeDir}, fallback...)
+	return filepath.Join(append(parts, appDirName)...), nil
+}
+
+// legacyConfigPath is where config.json lived before XDG_CONFIG_HOME was honored.
+func legacyConfigPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".config", appDirName, "config.json"), nil
+}
+
+// MigrateLegacyConfig copies config.json from ~/.config/wydo into
+// $XDG_CONFIG_HOME/wydo when the latter is set elsewhere and has no config yet.
+// Call it before Load.
+func MigrateLegacyConfig() error {
+	oldPath, err := legacyConfigPath()
+	if err != nil {
+		return err
+	}
+	newPath, err := getConfigPath()
+	if err != nil || oldPath == newPath {
+		return err
+	}
+	return copyIfMissing(oldPath, newPath)
+}
+
+// MigrateLegacyLog moves debug.log out of the first workspace, where older
+// versions wrote it, into the state directory.
+func MigrateLegacyLog(firstWorkspace string) error {
+	if firstWorkspace == "" {
+		return nil
+	}
+	stateDir, err := StateDir()
+	if err != nil {
+		return err
+	}
+	return moveIfMissing(filepath.Join(firstWorkspace, "debug.log"), filepath.Join(stateDir, "debug.log"))
+}
+
+// copyIfMissing copies src to dst when src exists and dst does not.
+func copyIfMissing(src, dst string) error {
+	if _, err := os.Stat(dst); err == nil {
+		return nil
+	}
+	in, err := os.Open(src)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	out, err := os.OpenFile(dst, os.O_CREATE|os.O_WRONLY|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	_, err = io.Copy(out, in)
+	return err
+}
+
+// moveIfMissing renames src to dst when src exists and dst does not,
+// falling back to copy+remove across filesystems.
+func moveIfMissing(src, dst string) error {
+	if _, err := os.Stat(src); os.IsNotExist(err) {
+		return nil
+	}
+	if _, err := os.Stat(dst); err == nil {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(dst), 0755); err != nil {
+		return err
+	}
+	if err := os.Rename(src, dst); err == nil {
+		return nil
+	}
+	if err := copyIfMissing(src, dst); err != nil {
+		return err
+	}
+	return os.Remove(src)
+}
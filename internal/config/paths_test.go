@@ -0,0 +1,77 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestXDGDirs(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "/xdg/config")
+	t.Setenv("XDG_STATE_HOME", "relative/state") // ignored: XDG paths must be absolute
+
+	cases := []struct {
+		name string
+		fn   func() (string, error)
+		want string
+	}{
+		{"config", ConfigDir, "/xdg/config/wydo"},
+		{"state", StateDir, filepath.Join(home, ".local", "state", "wydo")},
+	}
+	for _, tc := range cases {
+		got, err := tc.fn()
+		if err != nil {
+			t.Fatalf("%s: %v", tc.name, err)
+		}
+		if got != tc.want {
+			t.Errorf("%s dir = %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestMigrateLegacyConfig(t *testing.T) {
+	home := t.TempDir()
+	xdg := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", xdg)
+
+	legacy := filepath.Join(home, ".config", "wydo", "config.json")
+	if err := os.MkdirAll(filepath.Dir(legacy), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(legacy, []byte(`{"workspaces":["~/old"]}`), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := MigrateLegacyConfig(); err != nil {
+		t.Fatalf("MigrateLegacyConfig: %v", err)
+	}
+	content, err := os.ReadFile(filepath.Join(xdg, "wydo", "config.json"))
+	if err != nil {
+		t.Fatalf("expected migrated config: %v", err)
+	}
+	if string(content) != `{"workspaces":["~/old"]}` {
+		t.Errorf("unexpected migrated content: %s", content)
+	}
+}
+
+func TestMigrateLegacyLog(t *testing.T) {
+	ws := t.TempDir()
+	stateHome := t.TempDir()
+	t.Setenv("XDG_STATE_HOME", stateHome)
+
+	if err := os.WriteFile(filepath.Join(ws, "debug.log"), []byte("old log\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := MigrateLegacyLog(ws); err != nil {
+		t.Fatalf("MigrateLegacyLog: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(ws, "debug.log")); !os.IsNotExist(err) {
+		t.Error("expected debug.log to be removed from the workspace")
+	}
+	if _, err := os.Stat(filepath.Join(stateHome, "wydo", "debug.log")); err != nil {
+		t.Errorf("expected debug.log in state dir: %v", err)
+	}
+}
@@ -0,0 +1,263 @@
+// Package convert maps tasks to kanban cards and back.
+//
+// The mapping is meant to survive a round trip: card fields with no todo.txt
+// equivalent are written as task tags, and task tags with no card field are
+// kept in the card's task_tags frontmatter. Words of a card title that would
+// read as todo.txt syntax (+project, @context, key:value, a leading date or
+// priority) are escaped with a backslash in the task name. The exceptions are
+// the card body (a task is a single line), the card's actions and the time of
+// day of its completion date.
+package convert
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+// Task tags that carry card fields.
+const (
+	TagTmux       = "tmux"
+	TagJira       = "jira"
+	TagJiraStatus = "jirastatus"
+	TagGoal       = "goal"
+	TagBlocked    = "blocked"
+	TagArchived   = "archived"
+	TagPin        = "pin"
+	TagCardTags   = "cardtags" // card tags that can't be written as @contexts, comma-separated
+	tagURLLabel   = "urllabel" // urllabel, urllabel2, ... label the matching url, url2, ...
+)
+
+// taskTagCreated is the task_tags key holding the task's creation date.
+const taskTagCreated = "created"
+
+var (
+	contextRe = regexp.MustCompile(`^[A-Za-z0-9]+$`)
+	// metaWordRe matches a word todo.txt reads as a project, context or tag.
+	metaWordRe = regexp.MustCompile(`^(?:[+@][A-Za-z0-9]|[A-Za-z0-9]+:(?:"|\+?[A-Za-z0-9]))`)
+	// leadingWordRe matches a first word read as done, a priority or a date.
+	leadingWordRe = regexp.MustCompile(`^(?:x$|\([A-Za-z]\)|\d{4}-\d{2}-\d{2})`)
+)
+
+// TaskPriorityToCardPriority maps a todo.txt priority (A-F) to a card priority (1-6).
+// Returns 0 for no priority.
+func TaskPriorityToCardPriority(p data.Priority) int {
+	if p >= data.PriorityA && p <= data.PriorityF {
+		return int(p-data.PriorityA) + 1
+	}
+	return 0
+}
+
+// CardPriorityToTaskPriority maps a card priority (1-6) to a todo.txt priority (A-F).
+func CardPriorityToTaskPriority(p int) data.Priority {
+	if p >= 1 && p <= 6 {
+		return data.PriorityA + data.Priority(p-1)
+	}
+	return data.PriorityNone
+}
+
+// TaskToCard converts a task to a card. Filename is left empty.
+func TaskToCard(t data.Task) models.Card {
+	title := unescapeName(t.Name)
+	card := models.Card{
+		Title:    title,
+		Projects: append([]string{}, t.Projects...),
+		Tags:     append([]string{}, t.Contexts...),
+		Content:  "# " + title + "\n",
+		Priority: TaskPriorityToCardPriority(t.Priority),
+	}
+
+	if d, err := time.Parse("2006-01-02", t.GetDueDate()); err == nil {
+		card.DueDate = &d
+	}
+	if d, err := time.Parse("2006-01-02", t.GetScheduledDate()); err == nil {
+		card.ScheduledDate = &d
+	}
+	if t.Done {
+		completed := clock.Now()
+		if d, err := time.ParseInLocation("2006-01-02", t.CompletionDate, time.Local); err == nil {
+			completed = d
+		}
+		card.DateCompleted = &completed
+	}
+
+	urls := t.GetURLs()
+	for i, u := range urls {
+		card.URLs = append(card.URLs, models.CardURL{URL: u, Label: t.Tags[urlLabelKey(i+1)]})
+	}
+
+	if v := t.Tags[TagCardTags]; v != "" {
+		for _, tag := range strings.Split(v, ",") {
+			if tag = strings.TrimSpace(tag); tag != "" {
+				card.Tags = append(card.Tags, tag)
+			}
+		}
+	}
+	card.TmuxSession = t.Tags[TagTmux]
+	card.JiraKey = t.Tags[TagJira]
+	card.JiraStatus = t.Tags[TagJiraStatus]
+	card.Goal = t.Tags[TagGoal]
+	card.Blocked = t.Tags[TagBlocked]
+	card.Archived = t.Tags[TagArchived] == "true"
+	card.Pinned = t.Tags[TagPin] == "true"
+
+	for k, v := range t.Tags {
+		if isCardTag(k, len(urls)) {
+			continue
+		}
+		if card.TaskTags == nil {
+			card.TaskTags = make(map[string]string)
+		}
+		card.TaskTags[k] = v
+	}
+	if t.CreatedDate != "" {
+		if card.TaskTags == nil {
+			card.TaskTags = make(map[string]string)
+		}
+		card.TaskTags[taskTagCreated] = t.CreatedDate
+	}
+
+	return card
+}
+
+// CardToTask converts a card to a task. ID and File are left empty.
+func CardToTask(c models.Card) data.Task {
+	t := data.Task{
+		Name:     escapeName(c.Title),
+		Projects: append([]string{}, c.Projects...),
+		Priority: CardPriorityToTaskPriority(c.Priority),
+		Tags:     make(map[string]string),
+	}
+
+	var extraTags []string
+	for _, tag := range c.Tags {
+		if contextRe.MatchString(tag) {
+			t.Contexts = append(t.Contexts, tag)
+		} else {
+			extraTags = append(extraTags, tagValue(tag))
+		}
+	}
+	if len(extraTags) > 0 {
+		t.Tags[TagCardTags] = strings.Join(extraTags, ",")
+	}
+
+	if c.DueDate != nil {
+		t.SetDueDate(c.DueDate.Format("2006-01-02"))
+	}
+	if c.ScheduledDate != nil {
+		t.SetScheduledDate(c.ScheduledDate.Format("2006-01-02"))
+	}
+	if c.DateCompleted != nil {
+		t.Done = true
+		t.CompletionDate = c.DateCompleted.Format("2006-01-02")
+	}
+
+	urls := make([]string, 0, len(c.URLs))
+	for _, u := range c.URLs {
+		urls = append(urls, u.URL)
+	}
+	t.SetURLs(urls)
+	for i, u := range c.URLs {
+		if u.Label != "" {
+			t.Tags[urlLabelKey(i+1)] = tagValue(u.Label)
+		}
+	}
+
+	setTag := func(key, value string) {
+		if value != "" {
+			t.Tags[key] = tagValue(value)
+		}
+	}
+	setTag(TagTmux, c.TmuxSession)
+	setTag(TagJira, c.JiraKey)
+	setTag(TagJiraStatus, c.JiraStatus)
+	setTag(TagGoal, c.Goal)
+	setTag(TagBlocked, c.Blocked)
+	if c.Archived {
+		t.Tags[TagArchived] = "true"
+	}
+	if c.Pinned {
+		t.Tags[TagPin] = "true"
+	}
+
+	// Task tags parked on the card; never let them shadow the card's own fields.
+	keys := make([]string, 0, len(c.TaskTags))
+	for k := range c.TaskTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if k == taskTagCreated {
+			t.CreatedDate = data.ParseDate(c.TaskTags[k])
+			continue
+		}
+		if _, ok := t.Tags[k]; !ok {
+			t.Tags[k] = tagValue(c.TaskTags[k])
+		}
+	}
+
+	return t
+}
+
+// escapeName makes a card title safe to write as a task name: it puts a
+// backslash before each word that todo.txt would otherwise parse.
+func escapeName(title string) string {
+	words := strings.Fields(title)
+	for i, w := range words {
+		if isMetaWord(w, i == 0) {
+			words[i] = `\` + w
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+// unescapeName undoes escapeName.
+func unescapeName(name string) string {
+	words := strings.Fields(name)
+	for i, w := range words {
+		if rest, ok := strings.CutPrefix(w, `\`); ok && isMetaWord(rest, i == 0) {
+			words[i] = rest
+		}
+	}
+	return strings.Join(words, " ")
+}
+
+func isMetaWord(w string, first bool) bool {
+	return metaWordRe.MatchString(w) || (first && leadingWordRe.MatchString(w))
+}
+
+// isCardTag reports whether a task tag key is consumed by a card field.
+func isCardTag(key string, numURLs int) bool {
+	switch key {
+	case "due", "scheduled", TagTmux, TagJira, TagJiraStatus, TagGoal, TagBlocked, TagArchived, TagPin, TagCardTags:
+		return true
+	}
+	if data.IsURLTag(key) {
+		return true
+	}
+	for i := 1; i <= numURLs; i++ {
+		if key == urlLabelKey(i) {
+			return true
+		}
+	}
+	return false
+}
+
+// urlLabelKey returns the tag key labelling the n-th (1-based) URL.
+func urlLabelKey(n int) string {
+	if n == 1 {
+		return tagURLLabel
+	}
+	return tagURLLabel + strconv.Itoa(n)
+}
+
+// tagValue makes s safe to write as a quoted todo.txt tag value.
+func tagValue(s string) string {
+	return strings.ReplaceAll(s, `"`, "'")
+}
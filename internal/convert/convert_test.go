@@ -0,0 +1,98 @@
+package convert
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+func TestTaskToCardToTask(t *testing.T) {
+	lines := []string{
+		"Plain task",
+		"(A) 2026-01-02 Fix bug +wydo +cli @work @home due:2026-02-01 scheduled:2026-01-20",
+		"Read docs url:https://a.example url2:https://b.example urllabel2:\"Spec doc\"",
+		"x 2026-03-04 2026-01-02 Done thing +wydo ann:deadbeef",
+		"Pair up tmux:wydo jira:PROJ-1 jirastatus:\"In Review\" goal:q1 blocked:\"waiting on design\" effort:3",
+	}
+	for _, line := range lines {
+		task := data.ParseTask(line, "", "")
+		got := CardToTask(TaskToCard(task))
+		if got.String() != task.String() {
+			t.Errorf("round trip:\n got %q\nwant %q", got.String(), task.String())
+		}
+	}
+}
+
+func TestCardToTaskToCard(t *testing.T) {
+	due := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+	completed := time.Date(2026, 3, 4, 0, 0, 0, 0, time.Local)
+	card := models.Card{
+		Title:         "Ship release",
+		Content:       "# Ship release\n",
+		Tags:          []string{"urgent", "needs-review", "two words"},
+		Projects:      []string{"wydo"},
+		URLs:          []models.CardURL{{URL: "https://a.example"}, {URL: "https://b.example", Label: "Design doc"}},
+		DueDate:       &due,
+		DateCompleted: &completed,
+		Priority:      3,
+		Archived:      true,
+		Pinned:        true,
+		TmuxSession:   "wydo",
+		JiraKey:       "PROJ-7",
+		JiraStatus:    "In Progress",
+		Goal:          "q1",
+		Blocked:       "waiting on CI",
+		TaskTags:      map[string]string{"created": "2026-01-02", "effort": "3"},
+	}
+
+	task := CardToTask(card)
+	if task.Priority != data.PriorityC {
+		t.Errorf("priority = %c, want C", task.Priority)
+	}
+	if !reflect.DeepEqual(task.Contexts, []string{"urgent"}) {
+		t.Errorf("contexts = %v, want [urgent]", task.Contexts)
+	}
+	if task.CreatedDate != "2026-01-02" {
+		t.Errorf("created date = %q", task.CreatedDate)
+	}
+
+	// Reparse the written line, as a task read back from todo.txt would be.
+	got := TaskToCard(data.ParseTask(task.String(), "", ""))
+	if !reflect.DeepEqual(got, card) {
+		t.Errorf("round trip:\n got %+v\nwant %+v", got, card)
+	}
+}
+
+func TestPriorityMapping(t *testing.T) {
+	for p := 0; p <= 6; p++ {
+		if got := TaskPriorityToCardPriority(CardPriorityToTaskPriority(p)); got != p {
+			t.Errorf("priority %d round-tripped to %d", p, got)
+		}
+	}
+	if got := CardPriorityToTaskPriority(7); got != data.PriorityNone {
+		t.Errorf("priority 7 = %c, want none", got)
+	}
+}
+
+func TestCardToTaskEscapesTitle(t *testing.T) {
+	titles := []string{
+		"Reply to +1 thread re: meeting at 10:30 @ noon",
+		"(B) is the plan",
+		"2026-05-01 retro notes",
+		"x marks the spot",
+		"Email bob@example.com about C++",
+	}
+	for _, title := range titles {
+		line := CardToTask(models.Card{Title: title}).String()
+		task := data.ParseTask(line, "", "")
+		if len(task.Projects) > 0 || len(task.Contexts) > 0 || len(task.Tags) > 0 || task.Priority != data.PriorityNone || task.CreatedDate != "" || task.Done {
+			t.Errorf("%q written as %q parses with metadata: %+v", title, line, task)
+		}
+		if got := TaskToCard(task).Title; got != title {
+			t.Errorf("title round trip: got %q, want %q", got, title)
+		}
+	}
+}
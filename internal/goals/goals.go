@@ -0,0 +1,175 @@
+package goals
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+// GoalsFilename is the per-workspace (or per-project) goals file.
+const GoalsFilename = "goals.md"
+
+// GoalTag is the task tag / card frontmatter key that links an item to a goal.
+const GoalTag = "goal"
+
+// Goal is a monthly objective read from a goals.md file.
+//
+// goals.md groups goals under H2 month headings:
+//
+//	## 2026-10
+//	- ship-api: Ship the public API
+//	- Read four books
+//
+// The optional "key:" prefix is what tasks (goal:ship-api) and cards
+// (goal: ship-api) use to link to the goal. Without it the key is the
+// slugified title (read-four-books).
+type Goal struct {
+	Key     string
+	Title   string
+	Month   string // YYYY-MM
+	Project string // owning project, or "" for workspace-level goals
+	Path    string // goals.md the goal was read from
+}
+
+var (
+	monthHeadingRe = regexp.MustCompile(`^##\s+(\d{4}-\d{2})\s*$`)
+	keyedItemRe    = regexp.MustCompile(`^([A-Za-z0-9-]+):\s+(.+)$`)
+	nonSlugRe      = regexp.MustCompile(`[^a-z0-9]+`)
+)
+
+// ReadGoals reads goals from a goals.md file.
+// Returns nil (no error) if the file does not exist.
+func ReadGoals(path, project string) ([]Goal, error) {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParseGoals(string(content), path, project), nil
+}
+
+// ParseGoals parses goals.md content. List items outside a month heading are ignored.
+func ParseGoals(content, path, project string) []Goal {
+	var result []Goal
+	month := ""
+
+	sc := bufio.NewScanner(strings.NewReader(content))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if m := monthHeadingRe.FindStringSubmatch(line); m != nil {
+			month = m[1]
+			continue
+		}
+		if strings.HasPrefix(line, "#") {
+			month = ""
+			continue
+		}
+		if month == "" {
+			continue
+		}
+
+		var item string
+		if strings.HasPrefix(line, "- ") || strings.HasPrefix(line, "* ") {
+			item = strings.TrimSpace(line[2:])
+		}
+		if item == "" {
+			continue
+		}
+
+		g := Goal{Month: month, Project: project, Path: path}
+		if m := keyedItemRe.FindStringSubmatch(item); m != nil {
+			g.Key = strings.ToLower(m[1])
+			g.Title = strings.TrimSpace(m[2])
+		} else {
+			g.Title = item
+			g.Key = Slugify(item)
+		}
+		if g.Key == "" {
+			continue
+		}
+		result = append(result, g)
+	}
+	return result
+}
+
+// Slugify converts a goal title into a key usable as a todo.txt tag value.
+func Slugify(title string) string {
+	s := nonSlugRe.ReplaceAllString(strings.ToLower(title), "-")
+	return strings.Trim(s, "-")
+}
+
+// ForMonth returns the goals for the given YYYY-MM month, preserving order.
+func ForMonth(goals []Goal, month string) []Goal {
+	var result []Goal
+	for _, g := range goals {
+		if g.Month == month {
+			result = append(result, g)
+		}
+	}
+	return result
+}
+
+// Progress counts the tasks and cards linked to the goal.
+// Tasks are done when completed; cards are done when they sit in a Done column.
+func Progress(g Goal, tasks []data.Task, boards []kanbanmodels.Board) (done, total int) {
+	for _, t := range tasks {
+		if !strings.EqualFold(t.Tags[GoalTag], g.Key) {
+			continue
+		}
+		total++
+		if t.Done {
+			done++
+		}
+	}
+	for _, b := range boards {
+		for _, col := range b.Columns {
+			for _, c := range col.Cards {
+				if !strings.EqualFold(c.Goal, g.Key) {
+					continue
+				}
+				total++
+				if b.IsDoneColumn(col.Name) {
+					done++
+				}
+			}
+		}
+	}
+	return done, total
+}
+
+// Template returns the initial content for a new goals.md with a heading for month.
+func Template(month string) string {
+	return "# Goals\n\n## " + month + "\n\n"
+}
+
+// EnsureMonthHeading makes sure path contains a "## YYYY-MM" heading for month,
+// creating the file from Template if it does not exist.
+func EnsureMonthHeading(path, month string) error {
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return os.WriteFile(path, []byte(Template(month)), 0644)
+	}
+	if err != nil {
+		return err
+	}
+
+	sc := bufio.NewScanner(strings.NewReader(string(content)))
+	for sc.Scan() {
+		if m := monthHeadingRe.FindStringSubmatch(strings.TrimSpace(sc.Text())); m != nil && m[1] == month {
+			return nil
+		}
+	}
+
+	s := string(content)
+	if s != "" && !strings.HasSuffix(s, "\n") {
+		s += "\n"
+	}
+	s += "\n## " + month + "\n\n"
+	return os.WriteFile(path, []byte(s), 0644)
+}
@@ -0,0 +1,90 @@
+package goals
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+const sampleGoals = `# Goals
+
+- stray item outside a month
+
+## 2026-09
+- ship-api: Ship the public API
+
+## 2026-10
+- ship-api: Ship the public API
+- Read four books!
+* Fitness: run 3x a week
+
+### Notes
+- not a goal
+`
+
+func TestParseGoals(t *testing.T) {
+	gs := ParseGoals(sampleGoals, "/ws/goals.md", "")
+	if len(gs) != 4 {
+		t.Fatalf("expected 4 goals, got %d: %+v", len(gs), gs)
+	}
+
+	oct := ForMonth(gs, "2026-10")
+	if len(oct) != 3 {
+		t.Fatalf("expected 3 goals in 2026-10, got %d", len(oct))
+	}
+	if oct[0].Key != "ship-api" || oct[0].Title != "Ship the public API" {
+		t.Errorf("unexpected keyed goal: %+v", oct[0])
+	}
+	if oct[1].Key != "read-four-books" {
+		t.Errorf("expected slugified key, got %q", oct[1].Key)
+	}
+	if oct[2].Key != "fitness" || oct[2].Title != "run 3x a week" {
+		t.Errorf("unexpected goal: %+v", oct[2])
+	}
+}
+
+func TestProgress(t *testing.T) {
+	g := Goal{Key: "ship-api", Month: "2026-10"}
+	tasks := []data.Task{
+		{Name: "spec", Done: true, Tags: map[string]string{"goal": "ship-api"}},
+		{Name: "impl", Tags: map[string]string{"goal": "ship-api"}},
+		{Name: "other", Tags: map[string]string{"goal": "fitness"}},
+	}
+	boards := []kanbanmodels.Board{{
+		Columns: []kanbanmodels.Column{
+			{Name: "In Progress", Cards: []kanbanmodels.Card{{Goal: "ship-api"}}},
+			{Name: "Done", Cards: []kanbanmodels.Card{{Goal: "ship-api"}, {Goal: ""}}},
+		},
+	}}
+
+	done, total := Progress(g, tasks, boards)
+	if done != 2 || total != 4 {
+		t.Errorf("expected 2/4, got %d/%d", done, total)
+	}
+}
+
+func TestEnsureMonthHeading(t *testing.T) {
+	path := filepath.Join(t.TempDir(), GoalsFilename)
+
+	if err := EnsureMonthHeading(path, "2026-10"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := EnsureMonthHeading(path, "2026-10"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := EnsureMonthHeading(path, "2026-11"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	content, _ := os.ReadFile(path)
+	if n := strings.Count(string(content), "## 2026-10"); n != 1 {
+		t.Errorf("expected one 2026-10 heading, got %d", n)
+	}
+	if !strings.Contains(string(content), "## 2026-11") {
+		t.Error("expected 2026-11 heading to be appended")
+	}
+}
@@ -0,0 +1,83 @@
+// Package golden compares rendered TUI views with snapshots kept in the
+// test's testdata directory. Run the tests with -update to write the
+// snapshots afresh after an intended change, then review the diff.
+package golden
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/x/ansi"
+	"wydo/internal/clock"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden files of view snapshot tests")
+
+// Now is the time snapshot tests run at: a Wednesday morning.
+var Now = time.Date(2026, time.March, 4, 9, 30, 0, 0, time.Local)
+
+// FixClock fixes the clock at Now for the rest of the test.
+func FixClock(t testing.TB) {
+	t.Helper()
+	clock.Set(Now)
+	t.Cleanup(func() { clock.Set(time.Time{}) })
+}
+
+// Assert compares view with testdata/<name>.golden. Colors and trailing
+// spaces are stripped first, so only the layout and text are compared.
+func Assert(t testing.TB, name, view string) {
+	t.Helper()
+	got := Normalize(view)
+	path := filepath.Join("testdata", name+".golden")
+
+	if *update {
+		if err := os.MkdirAll("testdata", 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("%v (run the test with -update to create it)", err)
+	}
+	if got != string(want) {
+		t.Errorf("%s differs from %s (run with -update if the change is intended)\n%s", name, path, diff(string(want), got))
+	}
+}
+
+// Normalize strips ANSI escapes and trailing spaces from view.
+func Normalize(view string) string {
+	lines := strings.Split(ansi.Strip(view), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.TrimRight(strings.Join(lines, "\n"), "\n") + "\n"
+}
+
+// diff lists the lines that differ, numbered, as want / got pairs.
+func diff(want, got string) string {
+	w, g := strings.Split(want, "\n"), strings.Split(got, "\n")
+	var b strings.Builder
+	for i := 0; i < max(len(w), len(g)); i++ {
+		var wl, gl string
+		if i < len(w) {
+			wl = w[i]
+		}
+		if i < len(g) {
+			gl = g[i]
+		}
+		if wl != gl {
+			fmt.Fprintf(&b, "line %d\n  want: %s\n  got:  %s\n", i+1, wl, gl)
+		}
+	}
+	return b.String()
+}
@@ -2,10 +2,11 @@ package fs
 
 import (
 	"bytes"
-	"os"
 	"path/filepath"
 	"strings"
+	"time"
 	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
@@ -17,19 +18,30 @@ import (
 func ReadBoard(boardPath string) (models.Board, error) {
 	boardFilePath := filepath.Join(boardPath, "board.md")
 
-	content, err := os.ReadFile(boardFilePath)
+	content, err := writeq.ReadFile(boardFilePath)
 	if err != nil {
 		return models.Board{}, err
 	}
 
-	body, archived, jiraBoardID, project := stripBoardFrontmatter(content)
+	body, fm := stripBoardFrontmatter(content)
 
 	board := models.Board{
-		Path:        boardPath,
-		Columns:     []models.Column{},
-		Archived:    archived,
-		JiraBoardID: jiraBoardID,
-		Project:     project,
+		Path:             boardPath,
+		Columns:          []models.Column{},
+		Archived:         fm.Archived,
+		JiraBoardID:      fm.JiraBoardID,
+		Project:          fm.Project,
+		DefaultNewColumn: strings.TrimSpace(fm.DefaultNewColumn),
+
+		AutoArchiveDoneAfter: strings.TrimSpace(fm.AutoArchiveDoneAfter),
+		AutoArchiveCompact:   fm.AutoArchiveCompact,
+
+		Editor: strings.TrimSpace(fm.Editor),
+
+		Review: strings.TrimSpace(fm.Review),
+	}
+	if t, err := time.Parse(time.RFC3339, strings.TrimSpace(fm.LastReviewed)); err == nil {
+		board.LastReviewed = &t
 	}
 
 	reader := text.NewReader(body)
@@ -77,15 +89,48 @@ func ReadBoard(boardPath string) (models.Board, error) {
 		board.Columns = append(board.Columns, *currentColumn)
 	}
 
+	for name, color := range fm.ColumnColors {
+		for i := range board.Columns {
+			if strings.EqualFold(board.Columns[i].Name, name) {
+				board.Columns[i].Color = strings.TrimSpace(color)
+			}
+		}
+	}
+	for name, icon := range fm.ColumnIcons {
+		for i := range board.Columns {
+			if strings.EqualFold(board.Columns[i].Name, name) {
+				board.Columns[i].Icon = strings.TrimSpace(icon)
+			}
+		}
+	}
+
 	return board, nil
 }
 
+// boardFrontmatter holds the optional YAML frontmatter fields of board.md.
+type boardFrontmatter struct {
+	Archived         bool              `yaml:"archived"`
+	JiraBoardID      int               `yaml:"jira_board_id"`
+	Project          string            `yaml:"project"`
+	DefaultNewColumn string            `yaml:"default_new_column"`
+	ColumnColors     map[string]string `yaml:"column_colors"`
+	ColumnIcons      map[string]string `yaml:"column_icons"`
+
+	AutoArchiveDoneAfter string `yaml:"auto_archive_done_after"`
+	AutoArchiveCompact   bool   `yaml:"auto_archive_compact"`
+
+	Editor string `yaml:"editor"`
+
+	Review       string `yaml:"review"`
+	LastReviewed string `yaml:"last_reviewed"`
+}
+
 // stripBoardFrontmatter extracts optional YAML frontmatter from board.md content.
-// Returns the body (without frontmatter), whether archived is true, the jira_board_id, and the project path.
-func stripBoardFrontmatter(content []byte) ([]byte, bool, int, string) {
+// Returns the body (without frontmatter) and the parsed frontmatter fields.
+func stripBoardFrontmatter(content []byte) ([]byte, boardFrontmatter) {
 	lines := bytes.Split(content, []byte("\n"))
 	if len(lines) == 0 || !bytes.Equal(bytes.TrimSpace(lines[0]), []byte("---")) {
-		return content, false, 0, ""
+		return content, boardFrontmatter{}
 	}
 
 	var frontmatterEnd int
@@ -97,19 +142,15 @@ func stripBoardFrontmatter(content []byte) ([]byte, bool, int, string) {
 	}
 
 	if frontmatterEnd == 0 {
-		return content, false, 0, ""
+		return content, boardFrontmatter{}
 	}
 
 	frontmatterBytes := bytes.Join(lines[1:frontmatterEnd], []byte("\n"))
-	var fm struct {
-		Archived    bool   `yaml:"archived"`
-		JiraBoardID int    `yaml:"jira_board_id"`
-		Project     string `yaml:"project"`
-	}
+	var fm boardFrontmatter
 	if err := yaml.Unmarshal(frontmatterBytes, &fm); err != nil {
-		return content, false, 0, ""
+		return content, boardFrontmatter{}
 	}
 
 	body := bytes.TrimLeft(bytes.Join(lines[frontmatterEnd+1:], []byte("\n")), "\n")
-	return body, fm.Archived, fm.JiraBoardID, fm.Project
+	return body, fm
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 	"wydo/internal/kanban/models"
 )
 
@@ -135,6 +136,120 @@ func TestWriteBoard_ProjectFrontmatter(t *testing.T) {
 	}
 }
 
+func TestWriteBoard_DefaultNewColumnFrontmatter(t *testing.T) {
+	tmp := t.TempDir()
+	boardPath := filepath.Join(tmp, "sprint")
+	os.MkdirAll(boardPath, 0755)
+
+	board := models.Board{
+		Path:             boardPath,
+		Name:             "sprint",
+		DefaultNewColumn: "Inbox",
+		Columns:          []models.Column{{Name: "Backlog", Cards: []models.Card{}}, {Name: "Inbox", Cards: []models.Card{}}},
+	}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(boardPath, "board.md"))
+	if !strings.Contains(string(content), "default_new_column: Inbox") {
+		t.Errorf("expected default_new_column frontmatter in board.md, got:\n%s", content)
+	}
+
+	loaded, err := ReadBoard(boardPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if loaded.DefaultNewColumn != "Inbox" {
+		t.Errorf("expected default_new_column Inbox after round-trip, got %q", loaded.DefaultNewColumn)
+	}
+	if idx := loaded.NewCardColumnIndex(); idx != 1 {
+		t.Errorf("expected new cards in column 1, got %d", idx)
+	}
+}
+
+func TestWriteBoard_ColumnColorsFrontmatter(t *testing.T) {
+	tmp := t.TempDir()
+	boardPath := filepath.Join(tmp, "sprint")
+	os.MkdirAll(boardPath, 0755)
+
+	board := models.Board{
+		Path: boardPath,
+		Name: "sprint",
+		Columns: []models.Column{
+			{Name: "Backlog", Cards: []models.Card{}},
+			{Name: "In Progress", Cards: []models.Card{}, Color: "yellow"},
+			{Name: "Blocked", Cards: []models.Card{}, Color: "#ff0000"},
+		},
+	}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(boardPath, "board.md"))
+	if !strings.Contains(string(content), "column_colors:") {
+		t.Errorf("expected column_colors frontmatter in board.md, got:\n%s", content)
+	}
+
+	loaded, err := ReadBoard(boardPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	for i, want := range []string{"", "yellow", "#ff0000"} {
+		if got := loaded.Columns[i].Color; got != want {
+			t.Errorf("column %d color: got %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestWriteBoard_ColumnIconsRoundTrip(t *testing.T) {
+	tmp := t.TempDir()
+	boardPath := filepath.Join(tmp, "sprint")
+	os.MkdirAll(boardPath, 0755)
+
+	board := models.Board{
+		Path: boardPath,
+		Name: "sprint",
+		Columns: []models.Column{
+			{Name: "Backlog", Cards: []models.Card{}},
+			{Name: "In Progress", Cards: []models.Card{}, Icon: "🚧"},
+			{Name: "Done", Cards: []models.Card{}, Icon: "完"},
+		},
+	}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	content, _ := os.ReadFile(filepath.Join(boardPath, "board.md"))
+	if !strings.Contains(string(content), "column_icons:") {
+		t.Errorf("expected column_icons frontmatter in board.md, got:\n%s", content)
+	}
+
+	loaded, err := ReadBoard(boardPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	for i, want := range []string{"", "🚧", "完"} {
+		if got := loaded.Columns[i].Icon; got != want {
+			t.Errorf("column %d icon: got %q, want %q", i, got, want)
+		}
+	}
+}
+
+func TestReadBoard_ColumnColorsMatchCaseInsensitively(t *testing.T) {
+	tmp := t.TempDir()
+	content := "---\ncolumn_colors:\n  in progress: yellow\n---\n\n# b\n\n## To Do\n\n## In Progress\n"
+	os.WriteFile(filepath.Join(tmp, "board.md"), []byte(content), 0644)
+
+	board, err := ReadBoard(tmp)
+	if err != nil {
+		t.Fatalf("read error: %v", err)
+	}
+	if board.Columns[0].Color != "" || board.Columns[1].Color != "yellow" {
+		t.Errorf("unexpected colors: %+v", board.Columns)
+	}
+}
+
 func TestWriteBoard_ReadBoard_RoundTrip(t *testing.T) {
 	boardPath := filepath.Join(testdataDir(), "workspace1", "boards", "dev-work")
 	original, err := ReadBoard(boardPath)
@@ -176,3 +291,103 @@ func TestWriteBoard_ReadBoard_RoundTrip(t *testing.T) {
 		t.Fatalf("expected %d columns, got %d", len(original.Columns), len(loaded.Columns))
 	}
 }
+
+func TestWriteBoard_OneLinePerCard(t *testing.T) {
+	boardPath := filepath.Join(t.TempDir(), "sprint")
+	os.MkdirAll(filepath.Join(boardPath, "cards"), 0755)
+	for _, name := range []string{"a.md", "b.md", "c.md"} {
+		os.WriteFile(filepath.Join(boardPath, "cards", name), []byte("# "+name+"\n"), 0644)
+	}
+
+	board := models.Board{
+		Path: boardPath,
+		Name: "sprint",
+		Columns: []models.Column{
+			{Name: "To Do", Cards: []models.Card{{Title: "Fix [urgent] bug", Filename: "a.md"}, {Title: "Write docs", Filename: "b.md"}}},
+			{Name: "Doing", Cards: []models.Card{}},
+			{Name: "Done", Cards: []models.Card{{Title: "Ship", Filename: "c.md"}}},
+		},
+	}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	want := "# sprint\n\n" +
+		"## To Do\n\n" +
+		"- [Fix \\[urgent\\] bug](./cards/a.md)\n" +
+		"- [Write docs](./cards/b.md)\n" +
+		"\n## Doing\n" +
+		"\n## Done\n\n" +
+		"- [Ship](./cards/c.md)\n"
+	content, _ := os.ReadFile(filepath.Join(boardPath, "board.md"))
+	if string(content) != want {
+		t.Errorf("board.md:\n%s\nwant:\n%s", content, want)
+	}
+
+	loaded, err := ReadBoard(boardPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	var got []string
+	for _, col := range loaded.Columns {
+		for _, card := range col.Cards {
+			got = append(got, col.Name+"/"+card.Filename)
+		}
+	}
+	if strings.Join(got, " ") != "To Do/a.md To Do/b.md Done/c.md" {
+		t.Errorf("card order after round-trip: %v", got)
+	}
+}
+
+func TestWriteBoard_UnchangedLeavesFileAlone(t *testing.T) {
+	boardPath := filepath.Join(t.TempDir(), "sprint")
+	os.MkdirAll(boardPath, 0755)
+	board := models.Board{Path: boardPath, Name: "sprint", Columns: []models.Column{{Name: "To Do", Cards: []models.Card{}}}}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+
+	boardFile := filepath.Join(boardPath, "board.md")
+	old := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	os.Chtimes(boardFile, old, old)
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	info, err := os.Stat(boardFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !info.ModTime().Equal(old) {
+		t.Errorf("expected board.md untouched, modified at %v", info.ModTime())
+	}
+}
+
+func TestWriteBoard_ReviewRoundTrip(t *testing.T) {
+	tmp := t.TempDir()
+	boardPath := filepath.Join(tmp, "sprint")
+	os.MkdirAll(boardPath, 0755)
+
+	reviewed := time.Date(2026, 3, 2, 17, 45, 0, 0, time.UTC)
+	board := models.Board{
+		Path:         boardPath,
+		Name:         "Sprint",
+		Review:       "weekly",
+		LastReviewed: &reviewed,
+		Columns:      []models.Column{{Name: "To Do", Cards: []models.Card{}}},
+	}
+	if err := WriteBoard(board); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	content, _ := os.ReadFile(filepath.Join(boardPath, "board.md"))
+	if !strings.Contains(string(content), "review: weekly\nlast_reviewed: 2026-03-02T17:45:00Z\n") {
+		t.Errorf("expected review frontmatter in board.md, got:\n%s", content)
+	}
+
+	loaded, err := ReadBoard(boardPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if loaded.Review != "weekly" || loaded.LastReviewed == nil || !loaded.LastReviewed.Equal(reviewed) {
+		t.Errorf("after round-trip: review %q, last reviewed %v", loaded.Review, loaded.LastReviewed)
+	}
+}
@@ -3,21 +3,35 @@ package fs
 import (
 	"bytes"
 	"fmt"
-	"os"
 	"path/filepath"
 	"strings"
+	"time"
 	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
 
 	"gopkg.in/yaml.v3"
 )
 
-// WriteBoard writes a Board struct to board.md
+// WriteBoard writes a Board struct to board.md. The output depends only on
+// the board: frontmatter keys in a fixed order, map keys sorted, and one
+// line per card.
 func WriteBoard(board models.Board) error {
 	boardFilePath := filepath.Join(board.Path, "board.md")
 
 	var buf bytes.Buffer
 
-	if board.Archived || board.JiraBoardID != 0 || board.Project != "" {
+	columnColors := make(map[string]string)
+	columnIcons := make(map[string]string)
+	for _, column := range board.Columns {
+		if column.Color != "" {
+			columnColors[column.Name] = column.Color
+		}
+		if column.Icon != "" {
+			columnIcons[column.Name] = column.Icon
+		}
+	}
+
+	if board.Archived || board.JiraBoardID != 0 || board.Project != "" || board.DefaultNewColumn != "" || board.AutoArchiveDoneAfter != "" || board.AutoArchiveCompact || board.Editor != "" || board.Review != "" || board.LastReviewed != nil || len(columnColors) > 0 || len(columnIcons) > 0 {
 		buf.WriteString("---\n")
 		if board.Archived {
 			buf.WriteString("archived: true\n")
@@ -30,6 +44,42 @@ func WriteBoard(board models.Board) error {
 				buf.WriteString("project: " + strings.TrimRight(string(projectYAML), "\n") + "\n")
 			}
 		}
+		if board.DefaultNewColumn != "" {
+			if columnYAML, err := yaml.Marshal(board.DefaultNewColumn); err == nil {
+				buf.WriteString("default_new_column: " + strings.TrimRight(string(columnYAML), "\n") + "\n")
+			}
+		}
+		if board.AutoArchiveDoneAfter != "" {
+			if ageYAML, err := yaml.Marshal(board.AutoArchiveDoneAfter); err == nil {
+				buf.WriteString("auto_archive_done_after: " + strings.TrimRight(string(ageYAML), "\n") + "\n")
+			}
+		}
+		if board.AutoArchiveCompact {
+			buf.WriteString("auto_archive_compact: true\n")
+		}
+		if board.Editor != "" {
+			if editorYAML, err := yaml.Marshal(board.Editor); err == nil {
+				buf.WriteString("editor: " + strings.TrimRight(string(editorYAML), "\n") + "\n")
+			}
+		}
+		if board.Review != "" {
+			if reviewYAML, err := yaml.Marshal(board.Review); err == nil {
+				buf.WriteString("review: " + strings.TrimRight(string(reviewYAML), "\n") + "\n")
+			}
+		}
+		if board.LastReviewed != nil {
+			buf.WriteString("last_reviewed: " + board.LastReviewed.Format(time.RFC3339) + "\n")
+		}
+		if len(columnColors) > 0 {
+			if colorsYAML, err := yaml.Marshal(map[string]map[string]string{"column_colors": columnColors}); err == nil {
+				buf.Write(colorsYAML)
+			}
+		}
+		if len(columnIcons) > 0 {
+			if iconsYAML, err := yaml.Marshal(map[string]map[string]string{"column_icons": columnIcons}); err == nil {
+				buf.Write(iconsYAML)
+			}
+		}
 		buf.WriteString("---\n\n")
 	}
 
@@ -37,19 +87,36 @@ func WriteBoard(board models.Board) error {
 	buf.WriteString(board.Name)
 	buf.WriteString("\n\n")
 
-	for _, column := range board.Columns {
+	// Cards are one list item per line, with no blank lines between them, so
+	// moving a card changes two lines of the diff rather than rewriting a
+	// block, and git can merge edits to different columns.
+	for i, column := range board.Columns {
+		if i > 0 {
+			buf.WriteString("\n")
+		}
 		buf.WriteString("## ")
 		buf.WriteString(column.Name)
-		buf.WriteString("\n\n")
-
+		buf.WriteString("\n")
+		if len(column.Cards) > 0 {
+			buf.WriteString("\n")
+		}
 		for _, card := range column.Cards {
-			buf.WriteString("[")
-			buf.WriteString(card.Title)
+			buf.WriteString("- [")
+			buf.WriteString(linkTextEscaper.Replace(card.Title))
 			buf.WriteString("](./cards/")
 			buf.WriteString(card.Filename)
-			buf.WriteString(")\n\n")
+			buf.WriteString(")\n")
 		}
 	}
 
-	return os.WriteFile(boardFilePath, buf.Bytes(), 0644)
+	// Saving a board that didn't change leaves the file alone, so sync tools
+	// and git don't see a modification
+	if current, err := writeq.ReadFile(boardFilePath); err == nil && bytes.Equal(current, buf.Bytes()) {
+		return nil
+	}
+	return writeq.WriteFile(boardFilePath, buf.Bytes(), 0644)
 }
+
+// linkTextEscaper escapes what would end a card's link text early. The
+// reader takes titles from the card files, so this is only for display.
+var linkTextEscaper = strings.NewReplacer(`\`, `\\`, `[`, `\[`, `]`, `\]`)
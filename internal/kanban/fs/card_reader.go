@@ -2,11 +2,12 @@ package fs
 
 import (
 	"bytes"
-	"os"
+	"fmt"
 	"path/filepath"
 	"strings"
 	"time"
 	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
 
 	"github.com/yuin/goldmark"
 	"github.com/yuin/goldmark/ast"
@@ -16,7 +17,7 @@ import (
 
 // ReadCard reads a card file and parses its frontmatter and content
 func ReadCard(cardPath string) (models.Card, error) {
-	content, err := os.ReadFile(cardPath)
+	content, err := writeq.ReadFile(cardPath)
 	if err != nil {
 		return models.Card{}, err
 	}
@@ -43,9 +44,19 @@ func ReadCard(cardPath string) (models.Card, error) {
 		DateCompleted: result.DateCompleted,
 		Priority:      result.Priority,
 		Archived:      result.Archived,
+		Pinned:        result.Pinned,
 		TmuxSession:   result.TmuxSession,
 		JiraKey:       result.JiraKey,
 		JiraStatus:    result.JiraStatus,
+		Goal:          result.Goal,
+		Blocked:       result.Blocked,
+		TaskTags:      result.TaskTags,
+		Actions:       result.Actions,
+		History:       result.History,
+		DueHistory:    result.DueHistory,
+		Notify:        result.Notify,
+		Attachments:   result.Attachments,
+		Warnings:      result.Warnings,
 	}, nil
 }
 
@@ -59,10 +70,20 @@ type FrontmatterResult struct {
 	DateCompleted *time.Time
 	Priority      int
 	Archived      bool
+	Pinned        bool
 	TmuxSession   string
 	JiraKey       string
 	JiraStatus    string
+	Goal          string
+	Blocked       string
+	TaskTags      map[string]string
+	Actions       []models.CardAction
+	History       []models.ColumnEntry
+	DueHistory    []models.DueChange
+	Notify        []string
+	Attachments   []string
 	Body          string
+	Warnings      []models.ParseWarning // unreadable frontmatter or date values, which are ignored
 }
 
 // ParseFrontmatter extracts YAML frontmatter from markdown content
@@ -92,24 +113,51 @@ func ParseFrontmatter(content []byte) (FrontmatterResult, error) {
 	// Parse frontmatter
 	frontmatterBytes := bytes.Join(lines[1:frontmatterEnd], []byte("\n"))
 	var frontmatter struct {
-		Tags          []string         `yaml:"tags"`
-		Projects      []string         `yaml:"projects"`
-		URL           string           `yaml:"url"`
-		URLs          []models.CardURL `yaml:"urls"`
-		Due           string           `yaml:"due"`
-		Scheduled     string           `yaml:"scheduled"`
-		DateCompleted string           `yaml:"date_completed"`
-		Priority      int              `yaml:"priority"`
-		Archived      bool             `yaml:"archived"`
-		TmuxSession   string           `yaml:"tmux_session"`
-		JiraKey       string           `yaml:"jira_key,omitempty"`
-		JiraStatus    string           `yaml:"jira_status,omitempty"`
+		Tags          []string            `yaml:"tags"`
+		Projects      []string            `yaml:"projects"`
+		URL           string              `yaml:"url"`
+		URLs          []models.CardURL    `yaml:"urls"`
+		Due           string              `yaml:"due"`
+		Scheduled     string              `yaml:"scheduled"`
+		DateCompleted string              `yaml:"date_completed"`
+		Priority      int                 `yaml:"priority"`
+		Archived      bool                `yaml:"archived"`
+		Pin           bool                `yaml:"pin"`
+		TmuxSession   string              `yaml:"tmux_session"`
+		JiraKey       string              `yaml:"jira_key,omitempty"`
+		JiraStatus    string              `yaml:"jira_status,omitempty"`
+		Goal          string              `yaml:"goal,omitempty"`
+		Blocked       string              `yaml:"blocked,omitempty"`
+		TaskTags      map[string]string   `yaml:"task_tags,omitempty"`
+		Actions       []models.CardAction `yaml:"actions,omitempty"`
+		History       []historyEntry      `yaml:"history,omitempty"`
+		DueHistory    []dueHistoryEntry   `yaml:"due_history,omitempty"`
+		Notify        []string            `yaml:"notify,omitempty"`
+		Attachments   []string            `yaml:"attachments,omitempty"`
 	}
 
 	if err := yaml.Unmarshal(frontmatterBytes, &frontmatter); err != nil {
+		empty.Warnings = []models.ParseWarning{{Line: 1, Field: "frontmatter", Message: err.Error()}}
 		return empty, nil
 	}
 
+	var warnings []models.ParseWarning
+	parseDate := func(key, value, layout string) *time.Time {
+		if value == "" {
+			return nil
+		}
+		parsed, err := time.Parse(layout, value)
+		if err != nil {
+			warnings = append(warnings, models.ParseWarning{
+				Line:    frontmatterKeyLine(lines[:frontmatterEnd], key),
+				Field:   key,
+				Message: fmt.Sprintf("invalid date %q (want %s)", value, layout),
+			})
+			return nil
+		}
+		return &parsed
+	}
+
 	body := strings.TrimLeft(string(bytes.Join(lines[frontmatterEnd+1:], []byte("\n"))), "\n")
 
 	tags := frontmatter.Tags
@@ -122,24 +170,20 @@ func ParseFrontmatter(content []byte) (FrontmatterResult, error) {
 		projects = []string{}
 	}
 
-	var dueDate *time.Time
-	if frontmatter.Due != "" {
-		if parsed, err := time.Parse("2006-01-02", frontmatter.Due); err == nil {
-			dueDate = &parsed
-		}
-	}
+	dueDate := parseDate("due", frontmatter.Due, "2006-01-02")
+	scheduledDate := parseDate("scheduled", frontmatter.Scheduled, "2006-01-02")
+	dateCompleted := parseDate("date_completed", frontmatter.DateCompleted, time.RFC3339)
 
-	var scheduledDate *time.Time
-	if frontmatter.Scheduled != "" {
-		if parsed, err := time.Parse("2006-01-02", frontmatter.Scheduled); err == nil {
-			scheduledDate = &parsed
+	var history []models.ColumnEntry
+	for _, h := range frontmatter.History {
+		if at := parseDate("history", h.At, time.RFC3339); at != nil {
+			history = append(history, models.ColumnEntry{Column: h.Column, At: *at})
 		}
 	}
-
-	var dateCompleted *time.Time
-	if frontmatter.DateCompleted != "" {
-		if parsed, err := time.Parse(time.RFC3339, frontmatter.DateCompleted); err == nil {
-			dateCompleted = &parsed
+	var dueHistory []models.DueChange
+	for _, h := range frontmatter.DueHistory {
+		if at := parseDate("due_history", h.At, time.RFC3339); at != nil {
+			dueHistory = append(dueHistory, models.DueChange{From: h.From, To: h.To, At: *at})
 		}
 	}
 
@@ -160,13 +204,48 @@ func ParseFrontmatter(content []byte) (FrontmatterResult, error) {
 		DateCompleted: dateCompleted,
 		Priority:      frontmatter.Priority,
 		Archived:      frontmatter.Archived,
+		Pinned:        frontmatter.Pin,
 		TmuxSession:   frontmatter.TmuxSession,
 		JiraKey:       frontmatter.JiraKey,
 		JiraStatus:    frontmatter.JiraStatus,
+		Goal:          frontmatter.Goal,
+		Blocked:       strings.TrimSpace(frontmatter.Blocked),
+		TaskTags:      frontmatter.TaskTags,
+		Actions:       frontmatter.Actions,
+		History:       history,
+		DueHistory:    dueHistory,
+		Notify:        frontmatter.Notify,
+		Attachments:   frontmatter.Attachments,
 		Body:          body,
+		Warnings:      warnings,
 	}, nil
 }
 
+// historyEntry is one item of the history: frontmatter list.
+type historyEntry struct {
+	Column string `yaml:"column"`
+	At     string `yaml:"at"` // RFC3339
+}
+
+// dueHistoryEntry is one item of the due_history: frontmatter list.
+type dueHistoryEntry struct {
+	From string `yaml:"from,omitempty"`
+	To   string `yaml:"to,omitempty"`
+	At   string `yaml:"at"` // RFC3339
+}
+
+// frontmatterKeyLine returns the 1-based line of key in the frontmatter
+// lines (the opening --- is line 1), or 0 if it isn't found.
+func frontmatterKeyLine(lines [][]byte, key string) int {
+	prefix := []byte(key + ":")
+	for i, line := range lines {
+		if bytes.HasPrefix(line, prefix) {
+			return i + 1
+		}
+	}
+	return 0
+}
+
 func extractTitle(markdown string) string {
 	reader := text.NewReader([]byte(markdown))
 	parser := goldmark.DefaultParser()
@@ -3,7 +3,9 @@ package fs
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 	"wydo/internal/kanban/models"
 )
 
@@ -241,3 +243,143 @@ func TestWriteCard_ReadCard_URLRoundTrip(t *testing.T) {
 		t.Errorf("expected URL 'https://docs.example.com', got %q", loaded.URLs[1].URL)
 	}
 }
+
+func TestWriteCard_ReadCard_BlockedRoundTrip(t *testing.T) {
+	tmpPath := filepath.Join(t.TempDir(), "blocked.md")
+	card := models.Card{
+		Title:   "Blocked Card",
+		Blocked: "waiting on design review",
+		Content: "# Blocked Card\n",
+	}
+
+	if err := WriteCard(card, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	loaded, err := ReadCard(tmpPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if !loaded.IsBlocked() || loaded.Blocked != "waiting on design review" {
+		t.Errorf("expected blocked reason to round-trip, got %q", loaded.Blocked)
+	}
+
+	// Clearing the reason removes the field
+	loaded.Blocked = ""
+	if err := WriteCard(loaded, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	content, _ := os.ReadFile(tmpPath)
+	if strings.Contains(string(content), "blocked") {
+		t.Errorf("expected blocked field removed, got:\n%s", content)
+	}
+}
+
+func TestWriteCard_ReadCard_ActionsRoundTrip(t *testing.T) {
+	tmpPath := filepath.Join(t.TempDir(), "deploy.md")
+	card := models.Card{
+		Title: "Deploy",
+		Actions: []models.CardAction{
+			{Name: "Build", Command: "make build"},
+			{Name: "Open", Command: "$EDITOR {{card}}"},
+		},
+		Content: "# Deploy\n",
+	}
+
+	if err := WriteCard(card, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	loaded, err := ReadCard(tmpPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if len(loaded.Actions) != 2 || loaded.Actions[1] != card.Actions[1] {
+		t.Errorf("expected actions to round-trip, got %+v", loaded.Actions)
+	}
+}
+
+func TestWriteCard_ReadCard_PinRoundTrip(t *testing.T) {
+	tmpPath := filepath.Join(t.TempDir(), "pinned.md")
+	card := models.Card{Title: "Runbook", Pinned: true, Content: "# Runbook\n"}
+
+	if err := WriteCard(card, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	content, _ := os.ReadFile(tmpPath)
+	if !strings.Contains(string(content), "pin: true") {
+		t.Errorf("expected pin: true in frontmatter, got:\n%s", content)
+	}
+	loaded, err := ReadCard(tmpPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if !loaded.Pinned {
+		t.Error("expected the card to stay pinned")
+	}
+
+	loaded.Pinned = false
+	if err := WriteCard(loaded, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	content, _ = os.ReadFile(tmpPath)
+	if strings.Contains(string(content), "pin") {
+		t.Errorf("expected pin field removed, got:\n%s", content)
+	}
+}
+
+func TestWriteCard_ReadCard_HistoryRoundTrip(t *testing.T) {
+	tmpPath := filepath.Join(t.TempDir(), "moved.md")
+	entered := time.Date(2026, 5, 4, 9, 30, 0, 0, time.UTC)
+	card := models.Card{Title: "Moved", Content: "# Moved\n", History: []models.ColumnEntry{
+		{Column: "To Do", At: entered},
+		{Column: "In Progress", At: entered.Add(26 * time.Hour)},
+	}}
+
+	if err := WriteCard(card, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	content, _ := os.ReadFile(tmpPath)
+	if !strings.Contains(string(content), "column: In Progress") || !strings.Contains(string(content), "at: \"2026-05-05T11:30:00Z\"") {
+		t.Errorf("expected history entries in frontmatter, got:\n%s", content)
+	}
+
+	loaded, err := ReadCard(tmpPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if len(loaded.History) != 2 || loaded.History[1].Column != "In Progress" || !loaded.History[1].At.Equal(entered.Add(26*time.Hour)) {
+		t.Errorf("history not round-tripped: %+v", loaded.History)
+	}
+}
+
+func TestWriteCard_ReadCard_DueHistoryRoundTrip(t *testing.T) {
+	tmpPath := filepath.Join(t.TempDir(), "due.md")
+	at := time.Date(2026, 5, 4, 9, 30, 0, 0, time.UTC)
+	card := models.Card{Title: "Due", Content: "# Due\n", DueHistory: []models.DueChange{
+		{To: "2026-05-10", At: at},
+		{From: "2026-05-10", To: "2026-05-12", At: at.Add(time.Hour)},
+	}}
+
+	if err := WriteCard(card, tmpPath); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	loaded, err := ReadCard(tmpPath)
+	if err != nil {
+		t.Fatalf("read-back error: %v", err)
+	}
+	if len(loaded.DueHistory) != 2 || loaded.DueHistory[0].From != "" || loaded.DueHistory[1] != card.DueHistory[1] {
+		t.Errorf("due history not round-tripped: %+v", loaded.DueHistory)
+	}
+}
+
+func TestParseFrontmatter_BadHistoryDateWarns(t *testing.T) {
+	result, err := ParseFrontmatter([]byte("---\nhistory:\n  - column: To Do\n    at: yesterday\n  - column: Done\n    at: 2026-05-04T09:30:00Z\n---\n# Card\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(result.History) != 1 || result.History[0].Column != "Done" {
+		t.Errorf("expected only the valid entry, got %+v", result.History)
+	}
+	if len(result.Warnings) != 1 || result.Warnings[0].Field != "history" {
+		t.Errorf("expected a history warning, got %+v", result.Warnings)
+	}
+}
@@ -2,9 +2,9 @@ package fs
 
 import (
 	"bytes"
-	"os"
 	"time"
 	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
 
 	"gopkg.in/yaml.v3"
 )
@@ -48,9 +48,32 @@ func WriteCard(card models.Card, path string) error {
 
 	set("priority", card.Priority, card.Priority > 0)
 	set("archived", card.Archived, card.Archived)
+	set("pin", card.Pinned, card.Pinned)
 	set("tmux_session", card.TmuxSession, card.TmuxSession != "")
 	set("jira_key", card.JiraKey, card.JiraKey != "")
 	set("jira_status", card.JiraStatus, card.JiraStatus != "")
+	set("goal", card.Goal, card.Goal != "")
+	set("blocked", card.Blocked, card.Blocked != "")
+	set("task_tags", card.TaskTags, len(card.TaskTags) > 0)
+	set("actions", card.Actions, len(card.Actions) > 0)
+	history := make([]historyEntry, len(card.History))
+	for i, h := range card.History {
+		history[i] = historyEntry{Column: h.Column, At: h.At.Format(time.RFC3339)}
+	}
+	set("history", history, len(history) > 0)
+	dueHistory := make([]dueHistoryEntry, len(card.DueHistory))
+	for i, h := range card.DueHistory {
+		dueHistory[i] = dueHistoryEntry{From: h.From, To: h.To, At: h.At.Format(time.RFC3339)}
+	}
+	set("due_history", dueHistory, len(dueHistory) > 0)
+	set("notify", card.Notify, len(card.Notify) > 0)
+	set("attachments", card.Attachments, len(card.Attachments) > 0)
+
+	// The H1 is the source of truth for the title; keep a hand-written
+	// frontmatter title (if any) in step with it.
+	if _, ok := fm["title"]; ok && card.Title != "" {
+		fm["title"] = card.Title
+	}
 
 	var buf bytes.Buffer
 	if len(fm) > 0 {
@@ -65,7 +88,7 @@ func WriteCard(card models.Card, path string) error {
 
 	buf.WriteString(card.Content)
 
-	return os.WriteFile(path, buf.Bytes(), 0644)
+	return writeq.WriteFile(path, buf.Bytes(), 0644)
 }
 
 // loadRawFrontmatter reads the file at path and returns its YAML frontmatter
@@ -74,7 +97,7 @@ func WriteCard(card models.Card, path string) error {
 func loadRawFrontmatter(path string) map[string]interface{} {
 	fm := make(map[string]interface{})
 
-	data, err := os.ReadFile(path)
+	data, err := writeq.ReadFile(path)
 	if err != nil {
 		return fm
 	}
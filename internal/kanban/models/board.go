@@ -1,6 +1,12 @@
 package models
 
-import "strings"
+import (
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Board represents a kanban board with its columns and cards
 type Board struct {
@@ -10,6 +16,112 @@ type Board struct {
 	Archived    bool     // From YAML frontmatter in board.md
 	JiraBoardID int      // From YAML frontmatter in board.md (optional)
 	Project     string   // relative path from board.md to the linked project index file, or ""
+
+	DefaultNewColumn string // From YAML frontmatter in board.md: column cards created from tasks land in ("" = first column)
+
+	AutoArchiveDoneAfter string // From YAML frontmatter in board.md: age ("14d", "2w", "36h") after which done cards are archived ("" = off)
+	AutoArchiveCompact   bool   // From YAML frontmatter in board.md: move auto-archived cards to the bottom of their column
+
+	Editor string // From YAML frontmatter in board.md: command cards open in, e.g. "code --wait {{file}}" ("" = card_editor, then $EDITOR)
+
+	Review       string     // From YAML frontmatter in board.md: review cadence, "weekly", "monthly", "10d"... ("" = none)
+	LastReviewed *time.Time // From YAML frontmatter in board.md: when the board was last reviewed (RFC3339)
+}
+
+// NextReview returns the day the board's next review is due, and false when
+// it has no valid review cadence. A board never reviewed is due on the day
+// of now.
+func (b *Board) NextReview(now time.Time) (time.Time, bool) {
+	var next func(time.Time) time.Time
+	switch strings.ToLower(strings.TrimSpace(b.Review)) {
+	case "":
+		return time.Time{}, false
+	case "daily":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 1) }
+	case "weekly":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 7) }
+	case "biweekly":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 0, 14) }
+	case "monthly":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 1, 0) }
+	case "quarterly":
+		next = func(t time.Time) time.Time { return t.AddDate(0, 3, 0) }
+	default:
+		d, err := ParseAge(b.Review)
+		if err != nil {
+			return time.Time{}, false
+		}
+		next = func(t time.Time) time.Time { return t.Add(d) }
+	}
+	due := now
+	if b.LastReviewed != nil {
+		due = next(b.LastReviewed.In(now.Location()))
+	}
+	return time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, now.Location()), true
+}
+
+// ReviewDue reports whether the board has a review cadence and its next
+// review is due on or before the day of now.
+func (b *Board) ReviewDue(now time.Time) bool {
+	next, ok := b.NextReview(now)
+	return ok && !next.After(now)
+}
+
+// AutoArchiveAfter returns the parsed auto_archive_done_after age, or 0 when
+// it is unset or invalid.
+func (b *Board) AutoArchiveAfter() time.Duration {
+	if b.AutoArchiveDoneAfter == "" {
+		return 0
+	}
+	d, err := ParseAge(b.AutoArchiveDoneAfter)
+	if err != nil {
+		return 0
+	}
+	return d
+}
+
+// ParseAge parses an age like "14d" or "2w", falling back to
+// time.ParseDuration for hour and minute units ("36h").
+func ParseAge(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	day := 24 * time.Hour
+	for suffix, unit := range map[string]time.Duration{"d": day, "w": 7 * day} {
+		if n, ok := strings.CutSuffix(s, suffix); ok {
+			count, err := strconv.Atoi(n)
+			if err != nil || count <= 0 {
+				return 0, fmt.Errorf("invalid age %q", s)
+			}
+			return time.Duration(count) * unit, nil
+		}
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid age %q", s)
+	}
+	return d, nil
+}
+
+// LinksProject reports whether the board's project frontmatter points at the
+// index file of the project with the given name and directory.
+func (b *Board) LinksProject(name, dirPath string) bool {
+	if b.Project == "" || dirPath == "" {
+		return false
+	}
+	return filepath.Clean(filepath.Join(b.Path, b.Project)) == filepath.Join(dirPath, name+".md")
+}
+
+// NewCardColumnIndex returns the index of the column new cards created from
+// tasks or capture should go to: DefaultNewColumn (case-insensitive) if the
+// board has it, otherwise the first column.
+func (b *Board) NewCardColumnIndex() int {
+	if b.DefaultNewColumn != "" {
+		for i := range b.Columns {
+			if strings.EqualFold(b.Columns[i].Name, b.DefaultNewColumn) {
+				return i
+			}
+		}
+	}
+	return 0
 }
 
 // GetColumn returns a pointer to the column with the given name
@@ -59,6 +171,15 @@ func (b *Board) CanDeleteColumn(index int) (bool, string) {
 	return true, ""
 }
 
+// AdjacentColumn returns the column a deleted column's cards go to by
+// default: the one before it, or after it for the first column.
+func (b *Board) AdjacentColumn(index int) int {
+	if index > 0 {
+		return index - 1
+	}
+	return index + 1
+}
+
 // CanRenameColumn returns (bool, errorMessage)
 func (b *Board) CanRenameColumn(index int) (bool, string) {
 	if index < 0 || index >= len(b.Columns) {
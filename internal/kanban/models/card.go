@@ -8,23 +8,66 @@ type CardURL struct {
 	URL   string `yaml:"url"`
 }
 
+// CardAction is a named shell command that can be run against a card
+type CardAction struct {
+	Name    string `yaml:"name"`
+	Command string `yaml:"command"`
+}
+
+// ColumnEntry records when a card entered a column. The history of a card
+// is what cycle and lead times are measured from.
+type ColumnEntry struct {
+	Column string
+	At     time.Time
+}
+
+// DueChange records a change of a card's due date. From and To are
+// YYYY-MM-DD dates, "" for no due date.
+type DueChange struct {
+	From, To string
+	At       time.Time
+}
+
 // Card represents a kanban card with frontmatter metadata
 type Card struct {
-	Filename      string     // Filename in the cards directory
-	Title         string     // Extracted from first H1 in markdown
-	Tags          []string   // From YAML frontmatter
-	Projects      []string   // From YAML frontmatter
-	URLs          []CardURL  // From YAML frontmatter
-	Preview       string     // First few lines of content
-	Content       string     // Full markdown content (without frontmatter)
-	DueDate       *time.Time // From YAML frontmatter (ISO 8601 date)
-	ScheduledDate *time.Time // From YAML frontmatter (ISO 8601 date)
-	DateCompleted *time.Time // From YAML frontmatter (RFC3339 datetime)
-	Priority      int        // From YAML frontmatter (0 = unset)
-	Archived      bool       // From YAML frontmatter
-	TmuxSession   string     // From YAML frontmatter
-	JiraKey       string     // From YAML frontmatter (e.g. "PROJ-123")
-	JiraStatus    string     // From YAML frontmatter (cached Jira status)
+	Filename      string            // Filename in the cards directory
+	Title         string            // Extracted from first H1 in markdown
+	Tags          []string          // From YAML frontmatter
+	Projects      []string          // From YAML frontmatter
+	URLs          []CardURL         // From YAML frontmatter
+	Preview       string            // First few lines of content
+	Content       string            // Full markdown content (without frontmatter)
+	DueDate       *time.Time        // From YAML frontmatter (ISO 8601 date)
+	ScheduledDate *time.Time        // From YAML frontmatter (ISO 8601 date)
+	DateCompleted *time.Time        // From YAML frontmatter (RFC3339 datetime)
+	Priority      int               // From YAML frontmatter (0 = unset)
+	Archived      bool              // From YAML frontmatter
+	Pinned        bool              // From YAML frontmatter (pin: true keeps the card at the top of its column)
+	TmuxSession   string            // From YAML frontmatter
+	JiraKey       string            // From YAML frontmatter (e.g. "PROJ-123")
+	JiraStatus    string            // From YAML frontmatter (cached Jira status)
+	Goal          string            // From YAML frontmatter (goal key from goals.md)
+	Blocked       string            // From YAML frontmatter (reason the card is blocked; empty = not blocked)
+	TaskTags      map[string]string // From YAML frontmatter (task tags with no card field, kept for task round-trips)
+	Actions       []CardAction      // From YAML frontmatter (commands offered by the board's run picker)
+	History       []ColumnEntry     // From YAML frontmatter (columns the card entered, oldest first)
+	DueHistory    []DueChange       // From YAML frontmatter (due date changes, oldest first)
+	Notify        []string          // From YAML frontmatter (webhook URLs and email addresses told when the card moves or its due date changes)
+	Attachments   []string          // From YAML frontmatter (files under the workspace's attachments/, relative to its root)
+	Warnings      []ParseWarning    // Frontmatter values that could not be read (not written back)
+}
+
+// ParseWarning is a value in a file that could not be parsed and was
+// ignored, such as a malformed due date.
+type ParseWarning struct {
+	Line    int    // 1-based line in the file, 0 if unknown
+	Field   string // frontmatter key or tag name, e.g. "due"
+	Message string
+}
+
+// IsBlocked returns true if the card has a blocked reason
+func (c Card) IsBlocked() bool {
+	return c.Blocked != ""
 }
 
 // HasURLs returns true if the card has at least one URL
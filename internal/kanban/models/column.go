@@ -4,4 +4,6 @@ package models
 type Column struct {
 	Name  string
 	Cards []Card
+	Color string // From board.md frontmatter column_colors: a color name, ANSI number or #hex ("" = default)
+	Icon  string // From board.md frontmatter column_icons: shown before the name in the column header
 }
@@ -6,8 +6,11 @@ import (
 	"path/filepath"
 	"regexp"
 	"strings"
+	"time"
+	"wydo/internal/clock"
 	"wydo/internal/kanban/fs"
 	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
 )
 
 // CreateBoard creates a new board with the given name
@@ -45,9 +48,62 @@ func CreateBoard(rootDir, name string) (models.Board, error) {
 	return board, nil
 }
 
-// DeleteBoard removes a board directory
-func DeleteBoard(board models.Board) error {
-	return os.RemoveAll(board.Path)
+// TrashDir is the directory, beside a deleted board, that DeleteBoard moves it
+// into. Being hidden, it is skipped by workspace scans.
+const TrashDir = ".trash"
+
+// DeleteBoard moves a board directory into TrashDir next to it, suffixed with
+// a timestamp, and returns the new location. Restore by moving it back.
+func DeleteBoard(board models.Board) (string, error) {
+	trashDir := filepath.Join(filepath.Dir(board.Path), TrashDir)
+	if err := os.MkdirAll(trashDir, 0755); err != nil {
+		return "", err
+	}
+	dest := filepath.Join(trashDir, filepath.Base(board.Path)+"-"+clock.Now().Format(trashStamp))
+	if err := writeq.Rename(board.Path, dest); err != nil {
+		return "", err
+	}
+	return dest, nil
+}
+
+// trashStamp is the suffix format DeleteBoard gives boards in TrashDir.
+const trashStamp = "20060102-150405"
+
+// FindTrashedBoard returns the most recently trashed copy of the board that
+// lived at boardPath, if DeleteBoard moved one into TrashDir.
+func FindTrashedBoard(boardPath string) (string, bool) {
+	trashDir := filepath.Join(filepath.Dir(boardPath), TrashDir)
+	entries, err := os.ReadDir(trashDir)
+	if err != nil {
+		return "", false
+	}
+	prefix := filepath.Base(boardPath) + "-"
+	latest := ""
+	for _, e := range entries {
+		name := e.Name()
+		if !e.IsDir() || !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if _, err := time.Parse(trashStamp, strings.TrimPrefix(name, prefix)); err != nil {
+			continue
+		}
+		// The stamps sort in time order
+		if name > latest {
+			latest = name
+		}
+	}
+	if latest == "" {
+		return "", false
+	}
+	return filepath.Join(trashDir, latest), true
+}
+
+// RestoreBoard moves a trashed board back to boardPath, which must be free.
+func RestoreBoard(trashedPath, boardPath string) error {
+	if _, err := os.Stat(boardPath); err == nil {
+		return fmt.Errorf("%s already exists", boardPath)
+	}
+	return writeq.Rename(trashedPath, boardPath)
 }
 
 // ToggleBoardArchive flips the archived state of a board and persists to disk
@@ -68,13 +124,16 @@ func RenameBoard(board *models.Board, newName string) error {
 
 	oldPath := board.Path
 	newDirName := sanitizeName(newName)
+	if newDirName == "" {
+		return fmt.Errorf("board name needs at least one letter or digit")
+	}
 	newPath := filepath.Join(filepath.Dir(oldPath), newDirName)
 
 	if oldPath != newPath {
 		if _, err := os.Stat(newPath); err == nil {
 			return fmt.Errorf("a board already exists at %s", newDirName)
 		}
-		if err := os.Rename(oldPath, newPath); err != nil {
+		if err := writeq.Rename(oldPath, newPath); err != nil {
 			return err
 		}
 		board.Path = newPath
@@ -92,6 +151,13 @@ func SetBoardProject(board *models.Board, relPath string) error {
 	return fs.WriteBoard(*board)
 }
 
+// MarkBoardReviewed records now as the board's last review and persists it,
+// which moves its next review one cadence on.
+func MarkBoardReviewed(board *models.Board, now time.Time) error {
+	board.LastReviewed = &now
+	return fs.WriteBoard(*board)
+}
+
 func sanitizeName(name string) string {
 	name = strings.ToLower(name)
 	name = strings.ReplaceAll(name, " ", "-")
@@ -60,3 +60,87 @@ func TestSetBoardProject_SetAndClear(t *testing.T) {
 		t.Errorf("loaded.Project after clear: got %q, want empty", loaded2.Project)
 	}
 }
+
+func TestRenameBoard_MovesDirAndRewritesTitle(t *testing.T) {
+	root := t.TempDir()
+	board, err := CreateBoard(root, "Old Name")
+	if err != nil {
+		t.Fatalf("CreateBoard: %v", err)
+	}
+
+	if err := RenameBoard(&board, "New Name"); err != nil {
+		t.Fatalf("RenameBoard: %v", err)
+	}
+	if want := filepath.Join(root, "new-name"); board.Path != want {
+		t.Errorf("path: got %q, want %q", board.Path, want)
+	}
+	loaded, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatalf("ReadBoard: %v", err)
+	}
+	if loaded.Name != "New Name" {
+		t.Errorf("title: got %q", loaded.Name)
+	}
+
+	if err := RenameBoard(&board, "!!!"); err == nil {
+		t.Error("expected error for a name with no usable characters")
+	}
+}
+
+func TestDeleteBoard_MovesToTrash(t *testing.T) {
+	root := t.TempDir()
+	board, err := CreateBoard(root, "Doomed")
+	if err != nil {
+		t.Fatalf("CreateBoard: %v", err)
+	}
+
+	dest, err := DeleteBoard(board)
+	if err != nil {
+		t.Fatalf("DeleteBoard: %v", err)
+	}
+	if _, err := os.Stat(board.Path); !os.IsNotExist(err) {
+		t.Errorf("board dir still exists: %v", err)
+	}
+	if filepath.Dir(dest) != filepath.Join(root, TrashDir) {
+		t.Errorf("trash location: got %q", dest)
+	}
+	if _, err := fs.ReadBoard(dest); err != nil {
+		t.Errorf("trashed board unreadable: %v", err)
+	}
+}
+
+func TestFindTrashedBoard_RestoresLatest(t *testing.T) {
+	root := t.TempDir()
+	board, err := CreateBoard(root, "Doomed")
+	if err != nil {
+		t.Fatalf("CreateBoard: %v", err)
+	}
+	if _, ok := FindTrashedBoard(board.Path); ok {
+		t.Fatal("found a trashed copy before any delete")
+	}
+
+	trash := filepath.Join(root, TrashDir)
+	for _, name := range []string{"Doomed-20250101-090000", "Doomed-other", "Doomedish-20990101-000000"} {
+		if err := os.MkdirAll(filepath.Join(trash, name), 0755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dest, err := DeleteBoard(board)
+	if err != nil {
+		t.Fatalf("DeleteBoard: %v", err)
+	}
+
+	found, ok := FindTrashedBoard(board.Path)
+	if !ok || found != dest {
+		t.Fatalf("FindTrashedBoard = %q, %v; want %q", found, ok, dest)
+	}
+	if err := RestoreBoard(found, board.Path); err != nil {
+		t.Fatalf("RestoreBoard: %v", err)
+	}
+	if _, err := fs.ReadBoard(board.Path); err != nil {
+		t.Errorf("restored board unreadable: %v", err)
+	}
+	if err := RestoreBoard(filepath.Join(trash, "Doomed-20250101-090000"), board.Path); err == nil {
+		t.Error("RestoreBoard overwrote an existing board")
+	}
+}
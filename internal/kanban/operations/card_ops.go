@@ -6,10 +6,19 @@ import (
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"slices"
+	"sort"
 	"strings"
 	"time"
+	"wydo/internal/clock"
+	"wydo/internal/convert"
 	"wydo/internal/kanban/fs"
 	"wydo/internal/kanban/models"
+	"wydo/internal/notify"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+	"wydo/internal/webmeta"
+	"wydo/internal/writeq"
 )
 
 // CreateCard creates a new card in the specified column
@@ -32,6 +41,9 @@ func CreateCard(board *models.Board, columnName string) (models.Card, error) {
 		Tags:     []string{},
 		Content:  "# \n",
 	}
+	if col := board.GetColumn(columnName); col != nil {
+		recordColumn(&card, col.Name, clock.Now())
+	}
 
 	if err := fs.WriteCard(card, cardPath); err != nil {
 		return models.Card{}, err
@@ -77,7 +89,14 @@ func SyncCardFilename(board *models.Board, columnIndex, cardIndex int) error {
 
 	oldPath := cardPath
 	newPath := filepath.Join(cardsDir, expectedFilename)
-	if err := os.Rename(oldPath, newPath); err != nil {
+	// Never clobber another card (e.g. one created since UniqueFilename ran);
+	// a case-only rename of the same file is fine.
+	if existing, err := os.Stat(newPath); err == nil {
+		if current, err := os.Stat(oldPath); err != nil || !os.SameFile(existing, current) {
+			return fmt.Errorf("cannot rename %s: %s already exists", card.Filename, expectedFilename)
+		}
+	}
+	if err := writeq.Rename(oldPath, newPath); err != nil {
 		return err
 	}
 
@@ -86,16 +105,68 @@ func SyncCardFilename(board *models.Board, columnIndex, cardIndex int) error {
 	return fs.WriteBoard(*board)
 }
 
-// EditCard opens a card in the user's editor
-func EditCard(boardPath, filename string) error {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = "vim"
+// RenameCard sets a card's title by rewriting its H1 heading, then renames
+// the card file to match (see SyncCardFilename).
+func RenameCard(board *models.Board, columnIndex, cardIndex int, title string) error {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return fmt.Errorf("invalid column index")
 	}
 
-	cardPath := filepath.Join(boardPath, "cards", filename)
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return fmt.Errorf("invalid card index")
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return fmt.Errorf("title cannot be empty")
+	}
+
+	card := &column.Cards[cardIndex]
+	cardPath := filepath.Join(board.Path, "cards", card.Filename)
 
-	cmd := exec.Command(editor, cardPath)
+	// Re-read so edits made outside wydo since the board loaded are kept
+	current, err := fs.ReadCard(cardPath)
+	if err != nil {
+		return err
+	}
+	current.Title = title
+	current.Content = SetCardTitle(current.Content, title)
+	if err := fs.WriteCard(current, cardPath); err != nil {
+		return err
+	}
+
+	card.Title = title
+	card.Content = current.Content
+	return SyncCardFilename(board, columnIndex, cardIndex)
+}
+
+// SetCardTitle replaces the first "# " heading in a card body with title,
+// or prepends one if the body has none. Headings inside code fences are skipped.
+func SetCardTitle(content, title string) string {
+	lines := strings.Split(content, "\n")
+	inFence := false
+	for i, line := range lines {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			inFence = !inFence
+			continue
+		}
+		if !inFence && (trimmed == "#" || strings.HasPrefix(trimmed, "# ")) {
+			lines[i] = "# " + title
+			return strings.Join(lines, "\n")
+		}
+	}
+	if strings.TrimSpace(content) == "" {
+		return "# " + title + "\n"
+	}
+	return "# " + title + "\n\n" + content
+}
+
+// EditCard opens a card in the user's editor: the board's editor, else
+// fallback (the configured card_editor), else $EDITOR.
+func EditCard(board models.Board, filename, fallback string) error {
+	cmd := CardEditorCommand(board, filename, fallback)
 	cmd.Stdin = os.Stdin
 	cmd.Stdout = os.Stdout
 	cmd.Stderr = os.Stderr
@@ -117,7 +188,7 @@ func DeleteCard(board *models.Board, columnIndex, cardIndex int) error {
 	card := column.Cards[cardIndex]
 	cardPath := filepath.Join(board.Path, "cards", card.Filename)
 
-	if err := os.Remove(cardPath); err != nil && !os.IsNotExist(err) {
+	if err := writeq.Remove(cardPath); err != nil && !os.IsNotExist(err) {
 		return err
 	}
 
@@ -146,18 +217,55 @@ func MoveCard(board *models.Board, fromColIndex, cardIndex, toColIndex int) erro
 	toCol := &board.Columns[toColIndex]
 
 	// Stamp date_completed when moving to a done column
+	now := clock.Now()
 	if board.IsDoneColumn(toCol.Name) {
-		now := time.Now()
 		card.DateCompleted = &now
-		cardPath := filepath.Join(board.Path, "cards", card.Filename)
-		if err := fs.WriteCard(card, cardPath); err != nil {
-			return err
-		}
+	}
+	recordColumn(&card, toCol.Name, now)
+	cardPath := filepath.Join(board.Path, "cards", card.Filename)
+	if err := fs.WriteCard(card, cardPath); err != nil {
+		return err
 	}
 
 	toCol.Cards = append(toCol.Cards, card)
 
-	return fs.WriteBoard(*board)
+	if err := fs.WriteBoard(*board); err != nil {
+		return err
+	}
+	if fromCol.Name != toCol.Name {
+		notifyWatchers(*board, card, notify.KindMoved, fromCol.Name, toCol.Name)
+	}
+	return nil
+}
+
+// notifyWatchers queues a notification of a change to the card for the
+// watchers in its notify: list.
+func notifyWatchers(board models.Board, card models.Card, kind, from, to string) {
+	if len(card.Notify) == 0 {
+		return
+	}
+	notify.Send(card.Notify, notify.Event{
+		Kind:      kind,
+		Board:     board.Name,
+		BoardPath: board.Path,
+		Card:      card.Title,
+		File:      filepath.Join(board.Path, "cards", card.Filename),
+		From:      from,
+		To:        to,
+	})
+}
+
+func formatDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// recordColumn adds the column a card enters to its history, which cycle
+// and lead times are measured from (see stats.BoardFlow).
+func recordColumn(card *models.Card, column string, at time.Time) {
+	card.History = append(card.History, models.ColumnEntry{Column: column, At: at})
 }
 
 // ReorderCard swaps a card's position within a column
@@ -289,6 +397,28 @@ func UpdateCardURLs(board *models.Board, columnIndex, cardIndex int, urls []mode
 	return fs.WriteCard(*card, cardPath)
 }
 
+// AddCardAttachment records path (relative to the workspace root) among a
+// card's attachments and persists to disk. A path already there is kept once.
+func AddCardAttachment(board *models.Board, columnIndex, cardIndex int, path string) error {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return fmt.Errorf("invalid column index")
+	}
+
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return fmt.Errorf("invalid card index")
+	}
+
+	card := &column.Cards[cardIndex]
+	if slices.Contains(card.Attachments, path) {
+		return nil
+	}
+	card.Attachments = append(slices.Clone(card.Attachments), path)
+
+	cardPath := filepath.Join(board.Path, "cards", card.Filename)
+	return fs.WriteCard(*card, cardPath)
+}
+
 // UpdateCardDueDate updates a card's due date and persists to disk
 func UpdateCardDueDate(board *models.Board, columnIndex, cardIndex int, dueDate *time.Time) error {
 	if columnIndex < 0 || columnIndex >= len(board.Columns) {
@@ -301,10 +431,67 @@ func UpdateCardDueDate(board *models.Board, columnIndex, cardIndex int, dueDate
 	}
 
 	card := &column.Cards[cardIndex]
+	before, after := formatDate(card.DueDate), formatDate(dueDate)
 	card.DueDate = dueDate
+	if after != before {
+		// The board's activity feed lists the change
+		card.DueHistory = addDueChange(card.DueHistory, models.DueChange{From: before, To: after, At: clock.Now()})
+	}
 
 	cardPath := filepath.Join(board.Path, "cards", card.Filename)
-	return fs.WriteCard(*card, cardPath)
+	if err := fs.WriteCard(*card, cardPath); err != nil {
+		return err
+	}
+	if after != before {
+		notifyWatchers(*board, *card, notify.KindDue, before, after)
+	}
+	return nil
+}
+
+// dueChangeMerge is how soon after the last due date change a new one
+// replaces it, so that pressing > a few times records one change.
+const dueChangeMerge = 5 * time.Minute
+
+// maxDueHistory is how many due date changes a card keeps, the oldest
+// dropped first.
+const maxDueHistory = 20
+
+// addDueChange returns history with change recorded. A change soon after
+// the last one is merged into it, and dropped when it undoes it.
+func addDueChange(history []models.DueChange, change models.DueChange) []models.DueChange {
+	history = slices.Clone(history)
+	if n := len(history); n > 0 && change.At.Sub(history[n-1].At) < dueChangeMerge {
+		change.From = history[n-1].From
+		history = history[:n-1]
+	}
+	if change.From != change.To {
+		history = append(history, change)
+	}
+	if len(history) > maxDueHistory {
+		history = history[len(history)-maxDueHistory:]
+	}
+	return history
+}
+
+// BumpCardDueDate moves a card's due date by days (negative to pull it in),
+// counting from today when the card has no due date, and persists to disk.
+func BumpCardDueDate(board *models.Board, columnIndex, cardIndex, days int) (time.Time, error) {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return time.Time{}, fmt.Errorf("invalid column index")
+	}
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return time.Time{}, fmt.Errorf("invalid card index")
+	}
+
+	base := column.Cards[cardIndex].DueDate
+	if base == nil {
+		now := clock.Now()
+		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+		base = &today
+	}
+	due := base.AddDate(0, 0, days)
+	return due, UpdateCardDueDate(board, columnIndex, cardIndex, &due)
 }
 
 // UpdateCardScheduledDate updates a card's scheduled date and persists to disk
@@ -361,17 +548,10 @@ func UpdateCardTmuxSession(board *models.Board, columnIndex, cardIndex int, sess
 	return fs.WriteCard(*card, cardPath)
 }
 
-// TaskPriorityToCardPriority maps a todo.txt priority rune (A-F) to a card priority int (1-6).
-// Returns 0 for no priority.
-func TaskPriorityToCardPriority(p rune) int {
-	if p >= 'A' && p <= 'F' {
-		return int(p-'A') + 1
-	}
-	return 0
-}
-
-// CreateCardFromTask creates a new card in the first column of a board from task data.
-func CreateCardFromTask(board *models.Board, title string, projects []string, tags []string, dueDate *time.Time, scheduledDate *time.Time, priority int) (models.Card, error) {
+// CreateCardFromTask creates a new card from a task in the board's default
+// new-card column (default_new_column in board.md, else the first column).
+// Fields are mapped by convert.TaskToCard.
+func CreateCardFromTask(board *models.Board, task data.Task) (models.Card, error) {
 	if len(board.Columns) == 0 {
 		return models.Card{}, fmt.Errorf("board has no columns")
 	}
@@ -381,26 +561,17 @@ func CreateCardFromTask(board *models.Board, title string, projects []string, ta
 		return models.Card{}, err
 	}
 
-	baseFilename := ToSnakeCase(title)
-	filename := UniqueFilename(baseFilename, cardsDir, "")
+	card := convert.TaskToCard(task)
+	card.Filename = UniqueFilename(ToSnakeCase(card.Title), cardsDir, "")
+	col := &board.Columns[board.NewCardColumnIndex()]
+	recordColumn(&card, col.Name, clock.Now())
 
-	card := models.Card{
-		Filename:      filename,
-		Title:         title,
-		Tags:          tags,
-		Projects:      projects,
-		Content:       "# " + title + "\n",
-		DueDate:       dueDate,
-		ScheduledDate: scheduledDate,
-		Priority:      priority,
-	}
-
-	cardPath := filepath.Join(cardsDir, filename)
+	cardPath := filepath.Join(cardsDir, card.Filename)
 	if err := fs.WriteCard(card, cardPath); err != nil {
 		return models.Card{}, err
 	}
 
-	board.Columns[0].Cards = append(board.Columns[0].Cards, card)
+	col.Cards = append(col.Cards, card)
 	if err := fs.WriteBoard(*board); err != nil {
 		return models.Card{}, err
 	}
@@ -408,6 +579,90 @@ func CreateCardFromTask(board *models.Board, title string, projects []string, ta
 	return card, nil
 }
 
+// CreateCardFromURL creates a card for a web page in the column at colIndex:
+// titled title (the URL when empty), with the URL in its frontmatter and the
+// page's domain as a tag.
+func CreateCardFromURL(board *models.Board, colIndex int, url, title string) (models.Card, error) {
+	if colIndex < 0 || colIndex >= len(board.Columns) {
+		return models.Card{}, fmt.Errorf("invalid column index")
+	}
+
+	cardsDir := filepath.Join(board.Path, "cards")
+	if err := os.MkdirAll(cardsDir, 0755); err != nil {
+		return models.Card{}, err
+	}
+
+	title = strings.TrimSpace(title)
+	if title == "" {
+		title = url
+	}
+	card := models.Card{
+		Filename: UniqueFilename(ToSnakeCase(title), cardsDir, ""),
+		Title:    title,
+		Tags:     []string{},
+		URLs:     []models.CardURL{{URL: url}},
+		Content:  "# " + title + "\n",
+	}
+	if domain := webmeta.Domain(url); domain != "" {
+		card.Tags = append(card.Tags, domain)
+	}
+	col := &board.Columns[colIndex]
+	now := clock.Now()
+	if board.IsDoneColumn(col.Name) {
+		card.DateCompleted = &now
+	}
+	recordColumn(&card, col.Name, now)
+
+	if err := fs.WriteCard(card, filepath.Join(cardsDir, card.Filename)); err != nil {
+		return models.Card{}, err
+	}
+	col.Cards = append(col.Cards, card)
+	if err := fs.WriteBoard(*board); err != nil {
+		return models.Card{}, err
+	}
+	return card, nil
+}
+
+// CreateTaskFromCard adds a task converted from a card (see convert.CardToTask)
+// and then deletes the card. The card's body, less its title heading, is kept
+// as an annotation on the task. The task is created first so a failed delete
+// leaves a duplicate rather than losing data.
+func CreateTaskFromCard(svc service.TaskService, board *models.Board, columnIndex, cardIndex int) (*data.Task, error) {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return nil, fmt.Errorf("invalid column index")
+	}
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return nil, fmt.Errorf("invalid card index")
+	}
+
+	card := column.Cards[cardIndex]
+	task, err := svc.Add(convert.CardToTask(card).String())
+	if err != nil {
+		return nil, err
+	}
+	if body := strings.TrimSpace(cardBody(card)); body != "" {
+		if err := svc.Annotate(task.ID, body); err != nil {
+			return task, fmt.Errorf("task created but card body not kept, card not deleted: %w", err)
+		}
+	}
+	if err := DeleteCard(board, columnIndex, cardIndex); err != nil {
+		return task, fmt.Errorf("task created but card not deleted: %w", err)
+	}
+	return task, nil
+}
+
+// cardBody returns the card's content without the "# title" heading it
+// starts with.
+func cardBody(card models.Card) string {
+	body := strings.TrimLeft(card.Content, "\n")
+	first, rest, _ := strings.Cut(body, "\n")
+	if strings.TrimSpace(first) == "# "+card.Title {
+		return rest
+	}
+	return body
+}
+
 // EnsureBoardProjects ensures that a card has all the given board projects
 // in its frontmatter. Returns nil without writing if all are already present.
 func EnsureBoardProjects(board *models.Board, colIndex, cardIndex int, boardProjects []string) error {
@@ -452,6 +707,26 @@ func MoveCardToBoard(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *
 	if colIndex < 0 || colIndex >= len(srcBoard.Columns) {
 		return fmt.Errorf("invalid source column index")
 	}
+
+	// Determine target column index
+	dstColIdx := 0
+	if srcBoard.IsDoneColumn(srcBoard.Columns[colIndex].Name) {
+		for i, c := range dstBoard.Columns {
+			if dstBoard.IsDoneColumn(c.Name) {
+				dstColIdx = i
+				break
+			}
+		}
+	}
+	return MoveCardToBoardColumn(srcBoard, colIndex, cardIndex, dstBoard, dstColIdx, boardProjects)
+}
+
+// MoveCardToBoardColumn is MoveCardToBoard into a given column of the
+// target board.
+func MoveCardToBoardColumn(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *models.Board, dstColIdx int, boardProjects []string) error {
+	if colIndex < 0 || colIndex >= len(srcBoard.Columns) {
+		return fmt.Errorf("invalid source column index")
+	}
 	srcCol := &srcBoard.Columns[colIndex]
 	if cardIndex < 0 || cardIndex >= len(srcCol.Cards) {
 		return fmt.Errorf("invalid source card index")
@@ -459,6 +734,9 @@ func MoveCardToBoard(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *
 	if len(dstBoard.Columns) == 0 {
 		return fmt.Errorf("target board has no columns")
 	}
+	if dstColIdx < 0 || dstColIdx >= len(dstBoard.Columns) {
+		return fmt.Errorf("invalid destination column index")
+	}
 
 	card := srcCol.Cards[cardIndex]
 
@@ -469,15 +747,10 @@ func MoveCardToBoard(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *
 		}
 	}
 
-	// Determine target column index
-	dstColIdx := 0
-	if srcBoard.IsDoneColumn(srcCol.Name) {
-		for i, c := range dstBoard.Columns {
-			if dstBoard.IsDoneColumn(c.Name) {
-				dstColIdx = i
-				break
-			}
-		}
+	// Stamp date_completed when a card not yet finished lands in a done column
+	if dstBoard.IsDoneColumn(dstBoard.Columns[dstColIdx].Name) && card.DateCompleted == nil {
+		now := clock.Now()
+		card.DateCompleted = &now
 	}
 
 	// Write to destination (destination-first for crash safety)
@@ -486,6 +759,8 @@ func MoveCardToBoard(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *
 		return fmt.Errorf("create target cards dir: %w", err)
 	}
 
+	recordColumn(&card, dstBoard.Columns[dstColIdx].Name, clock.Now())
+
 	baseFilename := ToSnakeCase(card.Title)
 	newFilename := UniqueFilename(baseFilename, dstCardsDir, "")
 	origFilename := card.Filename
@@ -504,13 +779,15 @@ func MoveCardToBoard(srcBoard *models.Board, colIndex, cardIndex int, dstBoard *
 	// Remove from source
 	srcCardsDir := filepath.Join(srcBoard.Path, "cards")
 	srcCol.Cards = append(srcCol.Cards[:cardIndex], srcCol.Cards[cardIndex+1:]...)
-	if err := os.Remove(filepath.Join(srcCardsDir, origFilename)); err != nil && !os.IsNotExist(err) {
+	if err := writeq.Remove(filepath.Join(srcCardsDir, origFilename)); err != nil && !os.IsNotExist(err) {
 		// Non-fatal: card is already in target
 	}
 	if err := fs.WriteBoard(*srcBoard); err != nil {
 		return fmt.Errorf("write source board: %w", err)
 	}
 
+	notifyWatchers(*dstBoard, card, notify.KindMoved,
+		srcBoard.Name+" / "+srcCol.Name, dstBoard.Columns[dstColIdx].Name)
 	return nil
 }
 
@@ -541,6 +818,45 @@ func ToggleCardArchive(board *models.Board, columnIndex, cardIndex int) error {
 	return fs.WriteCard(*card, cardPath)
 }
 
+// ToggleCardPin flips whether a card is pinned to the top of its column and
+// persists it. The column order in board.md is left alone; pinned cards are
+// lifted when the board is displayed.
+func ToggleCardPin(board *models.Board, columnIndex, cardIndex int) error {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return fmt.Errorf("invalid column index")
+	}
+
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return fmt.Errorf("invalid card index")
+	}
+
+	card := &column.Cards[cardIndex]
+	card.Pinned = !card.Pinned
+
+	cardPath := filepath.Join(board.Path, "cards", card.Filename)
+	return fs.WriteCard(*card, cardPath)
+}
+
+// SetCardBlocked sets or clears a card's blocked reason. An empty reason
+// unblocks the card.
+func SetCardBlocked(board *models.Board, columnIndex, cardIndex int, reason string) error {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return fmt.Errorf("invalid column index")
+	}
+
+	column := &board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return fmt.Errorf("invalid card index")
+	}
+
+	card := &column.Cards[cardIndex]
+	card.Blocked = strings.TrimSpace(reason)
+
+	cardPath := filepath.Join(board.Path, "cards", card.Filename)
+	return fs.WriteCard(*card, cardPath)
+}
+
 // OpenURL opens a URL in the default browser
 func OpenURL(url string) error {
 	var cmd *exec.Cmd
@@ -558,3 +874,43 @@ func OpenURL(url string) error {
 
 	return cmd.Start()
 }
+
+// AutoArchiveDoneCards archives the cards in the board's done columns that
+// were completed longer ago than its auto_archive_done_after age, and
+// returns how many it archived. With auto_archive_compact, archived cards
+// are moved to the bottom of their column.
+func AutoArchiveDoneCards(board *models.Board, now time.Time) (int, error) {
+	after := board.AutoArchiveAfter()
+	if after <= 0 {
+		return 0, nil
+	}
+
+	archived := 0
+	for i := range board.Columns {
+		column := &board.Columns[i]
+		if !board.IsDoneColumn(column.Name) {
+			continue
+		}
+		for j := range column.Cards {
+			card := &column.Cards[j]
+			if card.Archived || card.DateCompleted == nil || now.Sub(*card.DateCompleted) < after {
+				continue
+			}
+			card.Archived = true
+			if err := fs.WriteCard(*card, filepath.Join(board.Path, "cards", card.Filename)); err != nil {
+				return archived, err
+			}
+			archived++
+		}
+		if archived > 0 && board.AutoArchiveCompact {
+			sort.SliceStable(column.Cards, func(a, b int) bool {
+				return !column.Cards[a].Archived && column.Cards[b].Archived
+			})
+		}
+	}
+
+	if archived > 0 && board.AutoArchiveCompact {
+		return archived, fs.WriteBoard(*board)
+	}
+	return archived, nil
+}
@@ -0,0 +1,443 @@
+package operations
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"wydo/internal/clock"
+	"wydo/internal/convert"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/notify"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+func TestSetCardTitle(t *testing.T) {
+	cases := []struct {
+		name, content, want string
+	}{
+		{"replace", "# Old\n\nbody\n", "# New\n\nbody\n"},
+		{"empty heading", "# \n", "# New\n"},
+		{"no heading", "body\n", "# New\n\nbody\n"},
+		{"empty body", "", "# New\n"},
+		{"skip fenced", "```\n# not a title\n```\n# Old\n", "```\n# not a title\n```\n# New\n"},
+	}
+	for _, tc := range cases {
+		if got := SetCardTitle(tc.content, "New"); got != tc.want {
+			t.Errorf("%s: got %q, want %q", tc.name, got, tc.want)
+		}
+	}
+}
+
+func TestRenameCard_UpdatesTitleAndAvoidsCollision(t *testing.T) {
+	dir := t.TempDir()
+	cardsDir := filepath.Join(dir, "cards")
+	if err := os.MkdirAll(cardsDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	existing := models.Card{Filename: "ship_it.md", Title: "Ship it", Content: "# Ship it\n"}
+	renamed := models.Card{Filename: "draft.md", Title: "Draft", Content: "# Draft\n\nnotes\n"}
+	for _, c := range []models.Card{existing, renamed} {
+		if err := fs.WriteCard(c, filepath.Join(cardsDir, c.Filename)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// A hand-written frontmatter title should follow the rename
+	draftPath := filepath.Join(cardsDir, "draft.md")
+	if err := os.WriteFile(draftPath, []byte("---\ntitle: Draft\n---\n\n# Draft\n\nnotes\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	board := models.Board{
+		Name:    "test-board",
+		Path:    dir,
+		Columns: []models.Column{{Name: "To Do", Cards: []models.Card{existing, renamed}}},
+	}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatalf("WriteBoard: %v", err)
+	}
+
+	if err := RenameCard(&board, 0, 1, "Ship it"); err != nil {
+		t.Fatalf("RenameCard: %v", err)
+	}
+
+	card := board.Columns[0].Cards[1]
+	if card.Filename != "ship_it_2.md" {
+		t.Errorf("filename: got %q, want ship_it_2.md", card.Filename)
+	}
+	if _, err := os.Stat(draftPath); !os.IsNotExist(err) {
+		t.Error("old card file should be gone")
+	}
+
+	content, err := os.ReadFile(filepath.Join(cardsDir, "ship_it_2.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "# Ship it\n\nnotes") || !strings.Contains(string(content), "title: Ship it") {
+		t.Errorf("unexpected card content:\n%s", content)
+	}
+
+	// The untouched card keeps its file
+	if _, err := os.Stat(filepath.Join(cardsDir, "ship_it.md")); err != nil {
+		t.Errorf("existing card file should remain: %v", err)
+	}
+
+	if err := RenameCard(&board, 0, 1, "   "); err == nil {
+		t.Error("expected error for blank title")
+	}
+}
+
+func TestCreateCardFromTask_UsesDefaultNewColumn(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{
+		Name:    "test-board",
+		Path:    dir,
+		Columns: []models.Column{{Name: "To Do"}, {Name: "Inbox"}, {Name: "Done"}},
+	}
+
+	if _, err := CreateCardFromTask(&board, data.Task{Name: "first"}); err != nil {
+		t.Fatalf("CreateCardFromTask: %v", err)
+	}
+	if len(board.Columns[0].Cards) != 1 {
+		t.Errorf("without default_new_column the card should land in the first column")
+	}
+
+	board.DefaultNewColumn = "inbox"
+	if _, err := CreateCardFromTask(&board, data.Task{Name: "second"}); err != nil {
+		t.Fatalf("CreateCardFromTask: %v", err)
+	}
+	if len(board.Columns[1].Cards) != 1 || board.Columns[1].Cards[0].Title != "second" {
+		t.Errorf("expected card in Inbox, got columns %+v", board.Columns)
+	}
+
+	// An unknown column falls back to the first one
+	board.DefaultNewColumn = "Missing"
+	if _, err := CreateCardFromTask(&board, data.Task{Name: "third"}); err != nil {
+		t.Fatalf("CreateCardFromTask: %v", err)
+	}
+	if len(board.Columns[0].Cards) != 2 {
+		t.Errorf("expected fallback to the first column")
+	}
+}
+
+func TestCreateCardFromTask_RoundTripsThroughCardFile(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{Name: "test-board", Path: dir, Columns: []models.Column{{Name: "To Do"}}}
+
+	task := data.ParseTask(`(B) 2026-01-02 Ship it +wydo @home due:2026-02-01 url:https://example.com tmux:wydo ann:abc123 effort:3`, "", "")
+	card, err := CreateCardFromTask(&board, task)
+	if err != nil {
+		t.Fatalf("CreateCardFromTask: %v", err)
+	}
+
+	read, err := fs.ReadCard(filepath.Join(dir, "cards", card.Filename))
+	if err != nil {
+		t.Fatalf("ReadCard: %v", err)
+	}
+	if read.TmuxSession != "wydo" || read.Priority != 2 || read.TaskTags["effort"] != "3" {
+		t.Errorf("card lost task fields: %+v", read)
+	}
+
+	back := convert.CardToTask(read)
+	if got, want := back.String(), task.String(); got != want {
+		t.Errorf("round trip:\n got %q\nwant %q", got, want)
+	}
+}
+
+func TestCreateCardFromURL(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{Name: "test-board", Path: dir, Columns: []models.Column{{Name: "To Do"}, {Name: "Reading"}}}
+
+	card, err := CreateCardFromURL(&board, 1, "https://www.example.com/post", "A Good Post")
+	if err != nil {
+		t.Fatalf("CreateCardFromURL: %v", err)
+	}
+	if card.Filename != "a_good_post.md" || len(board.Columns[1].Cards) != 1 {
+		t.Errorf("card %q not added to Reading: %+v", card.Filename, board.Columns)
+	}
+	read, err := fs.ReadCard(filepath.Join(dir, "cards", card.Filename))
+	if err != nil {
+		t.Fatalf("ReadCard: %v", err)
+	}
+	if read.Title != "A Good Post" || read.FirstURL() != "https://www.example.com/post" {
+		t.Errorf("card = %+v", read)
+	}
+	if len(read.Tags) != 1 || read.Tags[0] != "example.com" {
+		t.Errorf("Tags = %v, want the domain", read.Tags)
+	}
+
+	// Without a title the URL stands in until the page's is known
+	card, err = CreateCardFromURL(&board, 0, "https://example.com/other", "")
+	if err != nil {
+		t.Fatalf("CreateCardFromURL: %v", err)
+	}
+	if card.Title != "https://example.com/other" {
+		t.Errorf("Title = %q, want the URL", card.Title)
+	}
+	if _, err := CreateCardFromURL(&board, 2, "https://example.com", ""); err == nil {
+		t.Error("expected an error for a missing column")
+	}
+}
+
+func TestBumpCardDueDate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	due := time.Date(2026, 10, 31, 0, 0, 0, 0, time.UTC)
+	board := models.Board{
+		Name: "test-board",
+		Path: dir,
+		Columns: []models.Column{{Name: "To Do", Cards: []models.Card{
+			{Filename: "a.md", Title: "a", Content: "# a\n", DueDate: &due},
+			{Filename: "b.md", Title: "b", Content: "# b\n"},
+		}}},
+	}
+
+	got, err := BumpCardDueDate(&board, 0, 0, 7)
+	if err != nil {
+		t.Fatalf("BumpCardDueDate: %v", err)
+	}
+	if want := "2026-11-07"; got.Format("2006-01-02") != want {
+		t.Errorf("got %s, want %s", got.Format("2006-01-02"), want)
+	}
+	read, err := fs.ReadCard(filepath.Join(dir, "cards", "a.md"))
+	if err != nil {
+		t.Fatalf("ReadCard: %v", err)
+	}
+	if read.DueDate == nil || !read.DueDate.Equal(got) {
+		t.Errorf("due date not persisted: %v", read.DueDate)
+	}
+
+	// No due date: counts from today
+	got, err = BumpCardDueDate(&board, 0, 1, -1)
+	if err != nil {
+		t.Fatalf("BumpCardDueDate: %v", err)
+	}
+	if want := time.Now().AddDate(0, 0, -1).Format("2006-01-02"); got.Format("2006-01-02") != want {
+		t.Errorf("got %s, want %s", got.Format("2006-01-02"), want)
+	}
+}
+
+func TestAutoArchiveDoneCards(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	now := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	old := now.AddDate(0, 0, -20)
+	recent := now.AddDate(0, 0, -3)
+	board := models.Board{
+		Name: "test-board",
+		Path: dir,
+		Columns: []models.Column{
+			{Name: "To Do", Cards: []models.Card{{Filename: "todo.md", Title: "todo", DateCompleted: &old}}},
+			{Name: "Done", Cards: []models.Card{
+				{Filename: "old.md", Title: "old", DateCompleted: &old},
+				{Filename: "recent.md", Title: "recent", DateCompleted: &recent},
+				{Filename: "undated.md", Title: "undated"},
+			}},
+		},
+	}
+
+	if n, err := AutoArchiveDoneCards(&board, now); err != nil || n != 0 {
+		t.Fatalf("without a setting: got %d, %v", n, err)
+	}
+
+	board.AutoArchiveDoneAfter = "2w"
+	board.AutoArchiveCompact = true
+	n, err := AutoArchiveDoneCards(&board, now)
+	if err != nil {
+		t.Fatalf("AutoArchiveDoneCards: %v", err)
+	}
+	if n != 1 {
+		t.Fatalf("expected 1 archived card, got %d", n)
+	}
+	if board.Columns[0].Cards[0].Archived {
+		t.Error("cards outside the done column must not be archived")
+	}
+	done := board.Columns[1].Cards
+	if last := done[len(done)-1]; last.Filename != "old.md" || !last.Archived {
+		t.Errorf("expected old.md archived at the bottom, got %+v", done)
+	}
+	read, err := fs.ReadCard(filepath.Join(dir, "cards", "old.md"))
+	if err != nil || !read.Archived {
+		t.Errorf("archived flag not persisted: %+v, %v", read, err)
+	}
+	reread, err := fs.ReadBoard(dir)
+	if err != nil {
+		t.Fatalf("ReadBoard: %v", err)
+	}
+	if reread.AutoArchiveDoneAfter != "2w" || !reread.AutoArchiveCompact {
+		t.Errorf("board settings not round-tripped: %+v", reread)
+	}
+
+	// Already archived cards are not counted again
+	if n, _ := AutoArchiveDoneCards(&board, now); n != 0 {
+		t.Errorf("second run archived %d cards", n)
+	}
+}
+
+func TestMoveCard_RecordsColumnHistory(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{Name: "b", Path: dir, Columns: []models.Column{{Name: "To Do"}, {Name: "Doing"}, {Name: "Done"}}}
+
+	card, err := CreateCard(&board, "To Do")
+	if err != nil {
+		t.Fatalf("CreateCard: %v", err)
+	}
+	if err := MoveCard(&board, 0, 0, 1); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	if err := MoveCard(&board, 1, 0, 2); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+
+	read, err := fs.ReadCard(filepath.Join(dir, "cards", card.Filename))
+	if err != nil {
+		t.Fatalf("ReadCard: %v", err)
+	}
+	var columns []string
+	for _, h := range read.History {
+		columns = append(columns, h.Column)
+	}
+	if got := strings.Join(columns, ","); got != "To Do,Doing,Done" {
+		t.Errorf("history = %s, want To Do,Doing,Done", got)
+	}
+	if read.DateCompleted == nil || !read.History[2].At.Equal(read.DateCompleted.Truncate(time.Second)) {
+		t.Errorf("expected the Done entry at date_completed, got %+v / %v", read.History, read.DateCompleted)
+	}
+}
+
+func TestMoveCard_NotifiesWatchers(t *testing.T) {
+	var mu sync.Mutex
+	var events []notify.Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e notify.Event
+		_ = json.NewDecoder(r.Body).Decode(&e)
+		mu.Lock()
+		events = append(events, e)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	board := models.Board{Name: "b", Path: dir, Columns: []models.Column{{Name: "To Do"}, {Name: "Done"}}}
+	if _, err := CreateCard(&board, "To Do"); err != nil {
+		t.Fatalf("CreateCard: %v", err)
+	}
+	board.Columns[0].Cards[0].Notify = []string{srv.URL}
+
+	if err := MoveCard(&board, 0, 0, 1); err != nil {
+		t.Fatalf("MoveCard: %v", err)
+	}
+	due := time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC)
+	if err := UpdateCardDueDate(&board, 1, 0, &due); err != nil {
+		t.Fatalf("UpdateCardDueDate: %v", err)
+	}
+	// Setting the same date again is not a change
+	if err := UpdateCardDueDate(&board, 1, 0, &due); err != nil {
+		t.Fatalf("UpdateCardDueDate: %v", err)
+	}
+	if !notify.Wait(5 * time.Second) {
+		t.Fatal("notifications not sent")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(events) != 2 {
+		t.Fatalf("got %d notifications, want 2: %+v", len(events), events)
+	}
+	if e := events[0]; e.Kind != notify.KindMoved || e.From != "To Do" || e.To != "Done" {
+		t.Errorf("unexpected move event %+v", e)
+	}
+	if e := events[1]; e.Kind != notify.KindDue || e.From != "" || e.To != "2026-05-01" {
+		t.Errorf("unexpected due event %+v", e)
+	}
+}
+
+func TestUpdateCardDueDate_MergesQuickChangesAndCapsHistory(t *testing.T) {
+	defer clock.Set(time.Time{})
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	clock.Set(now)
+
+	dir := t.TempDir()
+	board := models.Board{Name: "b", Path: dir, Columns: []models.Column{{Name: "To Do"}}}
+	if _, err := CreateCard(&board, "To Do"); err != nil {
+		t.Fatalf("CreateCard: %v", err)
+	}
+	// Three quick bumps are one change
+	for _, days := range []int{1, 1, 1} {
+		if _, err := BumpCardDueDate(&board, 0, 0, days); err != nil {
+			t.Fatalf("BumpCardDueDate: %v", err)
+		}
+	}
+	history := board.Columns[0].Cards[0].DueHistory
+	if len(history) != 1 || history[0].From != "" || history[0].To != "2026-03-05" {
+		t.Fatalf("history = %+v, want one change to 2026-03-05", history)
+	}
+	// Clearing the date soon after undoes the change
+	clock.Set(now.Add(time.Minute))
+	if err := UpdateCardDueDate(&board, 0, 0, nil); err != nil {
+		t.Fatalf("UpdateCardDueDate: %v", err)
+	}
+	if history := board.Columns[0].Cards[0].DueHistory; len(history) != 0 {
+		t.Fatalf("history = %+v, want none", history)
+	}
+
+	for i := range 2 * maxDueHistory {
+		clock.Set(now.Add(time.Duration(i+1) * time.Hour))
+		if _, err := BumpCardDueDate(&board, 0, 0, 1); err != nil {
+			t.Fatalf("BumpCardDueDate: %v", err)
+		}
+	}
+	if n := len(board.Columns[0].Cards[0].DueHistory); n != maxDueHistory {
+		t.Errorf("kept %d changes, want %d", n, maxDueHistory)
+	}
+}
+
+func TestCreateTaskFromCard_KeepsBody(t *testing.T) {
+	taskDir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(taskDir, "todo.txt"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: taskDir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	board := models.Board{Name: "b", Path: t.TempDir(), Columns: []models.Column{{Name: "To Do"}}}
+	card, err := CreateCardFromTask(&board, data.Task{Name: "Call +1 support"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	board.Columns[0].Cards[0].Content = "# " + card.Title + "\n\nTicket 4411, ask for Sam.\n"
+
+	task, err := CreateTaskFromCard(svc, &board, 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(board.Columns[0].Cards) != 0 {
+		t.Errorf("expected the card removed, got %+v", board.Columns[0].Cards)
+	}
+	task, err = svc.Get(task.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(task.Projects) != 0 {
+		t.Errorf("title words became projects: %q", task.String())
+	}
+	anns := data.TaskAnnotations(*task)
+	if len(anns) != 1 || anns[0].Text != "Ticket 4411, ask for Sam." {
+		t.Errorf("annotations = %+v, want the card body", anns)
+	}
+}
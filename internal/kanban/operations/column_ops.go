@@ -69,32 +69,22 @@ func AddColumn(board *models.Board, name string, position int) error {
 
 // DeleteColumn removes a column and auto-migrates cards to adjacent column
 func DeleteColumn(board *models.Board, columnIndex int) error {
-	if columnIndex < 0 || columnIndex >= len(board.Columns) {
-		return fmt.Errorf("invalid column index")
-	}
-
-	column := board.Columns[columnIndex]
+	return DeleteColumnTo(board, columnIndex, board.AdjacentColumn(columnIndex))
+}
 
-	if board.IsDoneColumn(column.Name) {
-		return fmt.Errorf("cannot delete the Done column")
+// DeleteColumnTo removes a column and moves its cards to the end of the
+// column at targetIndex (an index before the deletion).
+func DeleteColumnTo(board *models.Board, columnIndex, targetIndex int) error {
+	if ok, msg := board.CanDeleteColumn(columnIndex); !ok {
+		return fmt.Errorf("%s", msg)
 	}
-
-	if len(board.Columns) <= 1 {
-		return fmt.Errorf("cannot delete the last column")
+	column := board.Columns[columnIndex]
+	if len(column.Cards) > 0 && (targetIndex < 0 || targetIndex >= len(board.Columns) || targetIndex == columnIndex) {
+		return fmt.Errorf("invalid target column")
 	}
 
 	if len(column.Cards) > 0 {
-		targetIndex := columnIndex - 1
-		if targetIndex < 0 {
-			targetIndex = columnIndex + 1
-		}
-
-		if targetIndex >= 0 && targetIndex < len(board.Columns) {
-			board.Columns[targetIndex].Cards = append(
-				board.Columns[targetIndex].Cards,
-				column.Cards...,
-			)
-		}
+		board.Columns[targetIndex].Cards = append(board.Columns[targetIndex].Cards, column.Cards...)
 	}
 
 	board.Columns = append(board.Columns[:columnIndex], board.Columns[columnIndex+1:]...)
@@ -0,0 +1,68 @@
+package operations
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+func newColumnTestBoard(t *testing.T) models.Board {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	board := models.Board{Name: "b", Path: dir, Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{Filename: "a.md", Title: "A", Content: "# A\n"}}},
+		{Name: "Review", Cards: []models.Card{
+			{Filename: "b.md", Title: "B", Content: "# B\n"},
+			{Filename: "c.md", Title: "C", Content: "# C\n"},
+		}},
+		{Name: "Done"},
+	}}
+	for _, col := range board.Columns {
+		for _, c := range col.Cards {
+			if err := fs.WriteCard(c, filepath.Join(dir, "cards", c.Filename)); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+	return board
+}
+
+func TestDeleteColumnTo_MovesCardsToChosenColumn(t *testing.T) {
+	board := newColumnTestBoard(t)
+	if err := DeleteColumnTo(&board, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(loaded.Columns) != 2 || loaded.Columns[1].Name != "Done" {
+		t.Fatalf("unexpected columns: %+v", loaded.Columns)
+	}
+	if n := len(loaded.Columns[1].Cards); n != 2 {
+		t.Errorf("expected 2 cards in Done, got %d", n)
+	}
+	if n := len(loaded.Columns[0].Cards); n != 1 {
+		t.Errorf("expected To Do untouched, got %d cards", n)
+	}
+}
+
+func TestDeleteColumnTo_RejectsDoneAndBadTarget(t *testing.T) {
+	board := newColumnTestBoard(t)
+	if err := DeleteColumnTo(&board, 2, 0); err == nil {
+		t.Error("expected an error deleting Done")
+	}
+	if err := DeleteColumnTo(&board, 1, 1); err == nil {
+		t.Error("expected an error moving cards into the deleted column")
+	}
+}
@@ -0,0 +1,85 @@
+package operations
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"wydo/internal/kanban/models"
+)
+
+// FilePlaceholder marks where an editor command takes the file to open.
+const FilePlaceholder = "{{file}}"
+
+// EditorCommand builds the command that opens file in an editor. command is
+// a template such as "code --wait {{file}}"; without {{file}} the file is
+// added as the last argument. Arguments split on spaces, and quotes keep one
+// together. An empty command falls back to $EDITOR, then vim.
+func EditorCommand(command, file string) *exec.Cmd {
+	args := splitCommand(command)
+	if len(args) == 0 {
+		args = splitCommand(os.Getenv("EDITOR"))
+	}
+	if len(args) == 0 {
+		args = []string{"vim"}
+	}
+
+	placed := false
+	for i, arg := range args {
+		if strings.Contains(arg, FilePlaceholder) {
+			args[i] = strings.ReplaceAll(arg, FilePlaceholder, file)
+			placed = true
+		}
+	}
+	if !placed {
+		args = append(args, file)
+	}
+	return exec.Command(args[0], args[1:]...)
+}
+
+// CardEditorCommand builds the command that opens a card of the board: with
+// the board's own editor if board.md sets one, else with fallback (the
+// workspace or global card_editor).
+func CardEditorCommand(board models.Board, filename, fallback string) *exec.Cmd {
+	command := board.Editor
+	if command == "" {
+		command = fallback
+	}
+	return EditorCommand(command, filepath.Join(board.Path, "cards", filename))
+}
+
+// splitCommand splits a command line into arguments on whitespace. Single or
+// double quotes group words into one argument.
+func splitCommand(command string) []string {
+	var args []string
+	var cur strings.Builder
+	inArg := false
+	var quote rune
+	for _, r := range command {
+		switch {
+		case quote != 0:
+			if r == quote {
+				quote = 0
+			} else {
+				cur.WriteRune(r)
+			}
+		case r == '"' || r == '\'':
+			quote = r
+			inArg = true
+		case r == ' ' || r == '\t' || r == '\n':
+			if inArg {
+				args = append(args, cur.String())
+				cur.Reset()
+				inArg = false
+			}
+		default:
+			cur.WriteRune(r)
+			inArg = true
+		}
+	}
+	if inArg {
+		args = append(args, cur.String())
+	}
+	return args
+}
@@ -0,0 +1,60 @@
+package operations
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+func TestEditorCommand(t *testing.T) {
+	t.Setenv("EDITOR", "nano -w")
+
+	tests := []struct {
+		command string
+		want    []string
+	}{
+		{"code --wait {{file}}", []string{"code", "--wait", "/b/c.md"}},
+		{"hx", []string{"hx", "/b/c.md"}},
+		{"emacsclient -c --eval '(find-file \"{{file}}\")'", []string{"emacsclient", "-c", "--eval", `(find-file "/b/c.md")`}},
+		{"  ", []string{"nano", "-w", "/b/c.md"}},
+		{"", []string{"nano", "-w", "/b/c.md"}},
+	}
+	for _, tt := range tests {
+		if got := EditorCommand(tt.command, "/b/c.md").Args; !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("EditorCommand(%q) = %q, want %q", tt.command, got, tt.want)
+		}
+	}
+
+	t.Setenv("EDITOR", "")
+	if got := EditorCommand("", "/b/c.md").Args; !reflect.DeepEqual(got, []string{"vim", "/b/c.md"}) {
+		t.Errorf("without $EDITOR got %q, want vim", got)
+	}
+}
+
+func TestCardEditorCommand_BoardOverridesFallback(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{Name: "B", Path: dir, Columns: []models.Column{{Name: "Todo"}}}
+	card := filepath.Join(dir, "cards", "c.md")
+
+	if got := CardEditorCommand(board, "c.md", "code --wait").Args; !reflect.DeepEqual(got, []string{"code", "--wait", card}) {
+		t.Errorf("fallback: got %q", got)
+	}
+
+	board.Editor = "subl -w {{file}}"
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatalf("WriteBoard: %v", err)
+	}
+	reread, err := fs.ReadBoard(dir)
+	if err != nil {
+		t.Fatalf("ReadBoard: %v", err)
+	}
+	if reread.Editor != board.Editor {
+		t.Fatalf("editor not round-tripped: %q", reread.Editor)
+	}
+	if got := CardEditorCommand(reread, "c.md", "code --wait").Args; !reflect.DeepEqual(got, []string{"subl", "-w", card}) {
+		t.Errorf("board editor: got %q", got)
+	}
+}
@@ -0,0 +1,150 @@
+package operations
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+
+	"wydo/internal/checklist"
+	"wydo/internal/clock"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+// ImportedCard is a card parsed from a markdown file by ParseMarkdownCards.
+type ImportedCard struct {
+	Title   string
+	Body    string // nested items as a checklist, "" if there were none
+	Section string // text of the nearest heading above the item
+	Done    bool   // the item was a checked [x] checklist item
+}
+
+var mdHeadingRe = regexp.MustCompile(`^#{1,6}\s+(.*?)\s*#*\s*$`)
+
+// ParseMarkdownCards turns the top-level list items of a markdown file into
+// cards. Items nested under one become its body as checklist items (plain
+// bullets become unchecked ones) and indented text under it is kept as is.
+// Headings name the section of the items below them. Everything else is
+// skipped.
+func ParseMarkdownCards(content string) []ImportedCard {
+	var cards []ImportedCard
+	var cur *ImportedCard
+	var body []string
+	baseIndent := -1
+	section := ""
+
+	flush := func() {
+		if cur != nil {
+			cur.Body = strings.Join(body, "\n")
+			cards = append(cards, *cur)
+		}
+		cur, body, baseIndent = nil, nil, -1
+	}
+
+	for _, line := range strings.Split(content, "\n") {
+		line = strings.ReplaceAll(strings.TrimRight(line, " \t\r"), "\t", "    ")
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+		if m := mdHeadingRe.FindStringSubmatch(line); m != nil {
+			flush()
+			section = m[1]
+			continue
+		}
+
+		indent := len(line) - len(strings.TrimLeft(line, " "))
+		item, isItem := checklist.ParseItem(line)
+		if isItem && indent < 2 {
+			flush()
+			if item.Text != "" {
+				cur = &ImportedCard{Title: item.Text, Section: section, Done: item.Checked}
+			}
+			continue
+		}
+		if cur == nil || indent < 2 {
+			// Paragraphs between lists end the current card
+			flush()
+			continue
+		}
+
+		if baseIndent < 0 || indent < baseIndent {
+			baseIndent = indent
+		}
+		pad := strings.Repeat(" ", indent-baseIndent)
+		if isItem {
+			item.Indent = indent - baseIndent
+			body = append(body, item.String())
+		} else {
+			body = append(body, pad+strings.TrimSpace(line))
+		}
+	}
+	flush()
+	return cards
+}
+
+// ImportColumn returns the index of the column an imported card goes to:
+// the Done column for checked items, else the column named like its section
+// (case-insensitive), else the board's new-card column.
+func ImportColumn(board *models.Board, card ImportedCard) int {
+	for i, col := range board.Columns {
+		if card.Done && board.IsDoneColumn(col.Name) {
+			return i
+		}
+	}
+	if card.Section != "" {
+		for i, col := range board.Columns {
+			if strings.EqualFold(col.Name, card.Section) {
+				return i
+			}
+		}
+	}
+	return board.NewCardColumnIndex()
+}
+
+// ImportMarkdownCards writes the imported cards to the board, each in the
+// column chosen by ImportColumn and with the given projects and tags, and
+// returns how many were added. Cards in the Done column are marked
+// completed now.
+func ImportMarkdownCards(board *models.Board, cards []ImportedCard, projects, tags []string) (int, error) {
+	if len(board.Columns) == 0 {
+		return 0, fmt.Errorf("board has no columns")
+	}
+
+	cardsDir := filepath.Join(board.Path, "cards")
+	if err := os.MkdirAll(cardsDir, 0755); err != nil {
+		return 0, err
+	}
+
+	now := clock.Now()
+	added := 0
+	for _, imported := range cards {
+		colIdx := ImportColumn(board, imported)
+		content := "# " + imported.Title + "\n"
+		if imported.Body != "" {
+			content += "\n" + imported.Body + "\n"
+		}
+		card := models.Card{
+			Filename: UniqueFilename(ToSnakeCase(imported.Title), cardsDir, ""),
+			Title:    imported.Title,
+			Tags:     append([]string{}, tags...),
+			Projects: append([]string(nil), projects...),
+			Content:  content,
+		}
+		if board.IsDoneColumn(board.Columns[colIdx].Name) {
+			card.DateCompleted = &now
+		}
+		recordColumn(&card, board.Columns[colIdx].Name, now)
+		if err := fs.WriteCard(card, filepath.Join(cardsDir, card.Filename)); err != nil {
+			return added, err
+		}
+		board.Columns[colIdx].Cards = append(board.Columns[colIdx].Cards, card)
+		added++
+	}
+
+	if added == 0 {
+		return 0, nil
+	}
+	return added, fs.WriteBoard(*board)
+}
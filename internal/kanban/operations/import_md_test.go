@@ -0,0 +1,90 @@
+package operations
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+const meetingNotes = `# Weekly sync
+
+Attendees: Ana, Bo
+
+## To Do
+- Draft the RFC
+  - outline
+  - [x] gather feedback
+    - from Bo
+  see the thread in #eng
+- [ ] Book the room
+
+## Notes
+* [x] Ship 1.2
+1. Follow up with legal
+`
+
+func TestParseMarkdownCards(t *testing.T) {
+	got := ParseMarkdownCards(meetingNotes)
+	want := []ImportedCard{
+		{Title: "Draft the RFC", Section: "To Do", Body: "- [ ] outline\n- [x] gather feedback\n  - [ ] from Bo\nsee the thread in #eng"},
+		{Title: "Book the room", Section: "To Do"},
+		{Title: "Ship 1.2", Section: "Notes", Done: true},
+		{Title: "Follow up with legal", Section: "Notes"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("ParseMarkdownCards:\n got  %+v\n want %+v", got, want)
+	}
+}
+
+func TestImportMarkdownCards_PlacesCardsByColumn(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{
+		Name: "sync",
+		Path: dir,
+		Columns: []models.Column{
+			{Name: "Backlog"},
+			{Name: "To Do"},
+			{Name: "Done"},
+		},
+	}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+
+	n, err := ImportMarkdownCards(&board, ParseMarkdownCards(meetingNotes), []string{"alpha"}, nil)
+	if err != nil {
+		t.Fatalf("ImportMarkdownCards: %v", err)
+	}
+	if n != 4 {
+		t.Fatalf("expected 4 cards, got %d", n)
+	}
+
+	counts := []int{len(board.Columns[0].Cards), len(board.Columns[1].Cards), len(board.Columns[2].Cards)}
+	if !reflect.DeepEqual(counts, []int{1, 2, 1}) {
+		t.Errorf("expected Backlog/To Do/Done counts [1 2 1], got %v", counts)
+	}
+	if board.Columns[2].Cards[0].DateCompleted == nil {
+		t.Error("expected the card imported into Done to be completed")
+	}
+
+	reread, err := fs.ReadBoard(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	card := reread.Columns[1].Cards[0]
+	if card.Title != "Draft the RFC" || card.Filename != "draft_the_rfc.md" {
+		t.Errorf("unexpected card %q (%s)", card.Title, card.Filename)
+	}
+	if !reflect.DeepEqual(card.Projects, []string{"alpha"}) {
+		t.Errorf("expected the given projects, got %v", card.Projects)
+	}
+	raw, _ := os.ReadFile(filepath.Join(dir, "cards", card.Filename))
+	if want := "- [x] gather feedback"; !strings.Contains(string(raw), want) {
+		t.Errorf("expected card body to contain %q:\n%s", want, raw)
+	}
+}
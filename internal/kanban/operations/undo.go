@@ -0,0 +1,309 @@
+package operations
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/writeq"
+)
+
+// historyLimit caps how many changes a History can undo.
+const historyLimit = 100
+
+// Change is a board operation that can be undone and redone. Changes find
+// cards by filename and columns by name, not by index, so they still apply
+// to the board after it has been reloaded from disk.
+type Change interface {
+	Undo(board *models.Board) error
+	Redo(board *models.Board) error
+	// Label says what the change did, e.g. "move Deploy API".
+	Label() string
+}
+
+// History is a board's undo and redo stacks.
+type History struct {
+	undo, redo []Change
+}
+
+// NewHistory returns an empty History.
+func NewHistory() *History {
+	return &History{}
+}
+
+// Record adds a change that was just made. It clears the redo stack, as the
+// changes there no longer follow on from the board.
+func (h *History) Record(c Change) {
+	if c == nil {
+		return
+	}
+	h.undo = append(h.undo, c)
+	if len(h.undo) > historyLimit {
+		h.undo = h.undo[len(h.undo)-historyLimit:]
+	}
+	h.redo = nil
+}
+
+// Undo reverts the last change and moves it to the redo stack. It returns
+// nil when there is nothing to undo. A change that fails to revert (its
+// card was deleted outside wydo, say) is dropped.
+func (h *History) Undo(board *models.Board) (Change, error) {
+	if len(h.undo) == 0 {
+		return nil, nil
+	}
+	c := h.undo[len(h.undo)-1]
+	h.undo = h.undo[:len(h.undo)-1]
+	if err := c.Undo(board); err != nil {
+		return nil, fmt.Errorf("cannot undo %s: %w", c.Label(), err)
+	}
+	h.redo = append(h.redo, c)
+	return c, nil
+}
+
+// Redo reapplies the last undone change, the same way Undo reverts one.
+func (h *History) Redo(board *models.Board) (Change, error) {
+	if len(h.redo) == 0 {
+		return nil, nil
+	}
+	c := h.redo[len(h.redo)-1]
+	h.redo = h.redo[:len(h.redo)-1]
+	if err := c.Redo(board); err != nil {
+		return nil, fmt.Errorf("cannot redo %s: %w", c.Label(), err)
+	}
+	h.undo = append(h.undo, c)
+	return c, nil
+}
+
+// CardState is a card as a change found or left it: the column it was in,
+// its position there and its file.
+type CardState struct {
+	Column   string
+	Index    int
+	Filename string
+	File     []byte
+}
+
+// CardChange is a change to one card: a move, a delete, an archive toggle
+// or an edit of its metadata. Undoing it puts the card back in its column
+// and position and reverts what the change did to its file, keeping later
+// edits elsewhere in the file; a nil After is a deleted card.
+type CardChange struct {
+	Name          string
+	Before, After *CardState
+}
+
+func (c CardChange) Label() string { return c.Name }
+
+func (c CardChange) Undo(board *models.Board) error {
+	return applyCardState(board, c.After, c.Before)
+}
+
+func (c CardChange) Redo(board *models.Board) error {
+	return applyCardState(board, c.Before, c.After)
+}
+
+// TrackCard runs op, a change to the card at columnIndex/cardIndex, and
+// returns it as a CardChange named label. The card is found afterwards by
+// its filename, or at the same position when op renamed it. When the card's
+// file can't be read op still runs, but there is no change to undo (nil).
+func TrackCard(board *models.Board, columnIndex, cardIndex int, label string, op func() error) (*CardChange, error) {
+	before, err := captureCard(board, columnIndex, cardIndex)
+	if err != nil {
+		return nil, op()
+	}
+	known := cardFilenames(board)
+	if err := op(); err != nil {
+		return nil, err
+	}
+
+	change := &CardChange{Name: label, Before: before}
+	col, idx := findCard(board, before.Filename)
+	if col < 0 && columnIndex < len(board.Columns) && cardIndex < len(board.Columns[columnIndex].Cards) {
+		if name := board.Columns[columnIndex].Cards[cardIndex].Filename; !known[name] {
+			col, idx = columnIndex, cardIndex
+		}
+	}
+	if col >= 0 {
+		if change.After, err = captureCard(board, col, idx); err != nil {
+			return nil, nil
+		}
+	}
+	return change, nil
+}
+
+// CardSwap is a reorder within a column: two cards trading places.
+// Swapping them again undoes it.
+type CardSwap struct {
+	Column, First, Second string
+}
+
+func (s CardSwap) Label() string { return "reorder" }
+
+func (s CardSwap) Undo(board *models.Board) error { return s.swap(board) }
+
+func (s CardSwap) Redo(board *models.Board) error { return s.swap(board) }
+
+func (s CardSwap) swap(board *models.Board) error {
+	colIndex := board.GetColumnIndex(s.Column)
+	if colIndex < 0 {
+		return fmt.Errorf("column %q no longer exists", s.Column)
+	}
+	cards := board.Columns[colIndex].Cards
+	first := slices.IndexFunc(cards, func(c models.Card) bool { return c.Filename == s.First })
+	second := slices.IndexFunc(cards, func(c models.Card) bool { return c.Filename == s.Second })
+	if first < 0 || second < 0 {
+		return fmt.Errorf("the cards are no longer in %s", s.Column)
+	}
+	return ReorderCard(board, colIndex, first, second)
+}
+
+// SwapCards is ReorderCard recorded as a CardSwap.
+func SwapCards(board *models.Board, colIndex, fromIndex, toIndex int) (*CardSwap, error) {
+	if err := ReorderCard(board, colIndex, fromIndex, toIndex); err != nil {
+		return nil, err
+	}
+	col := board.Columns[colIndex]
+	return &CardSwap{Column: col.Name, First: col.Cards[fromIndex].Filename, Second: col.Cards[toIndex].Filename}, nil
+}
+
+// captureCard reads the state of the card at columnIndex/cardIndex.
+func captureCard(board *models.Board, columnIndex, cardIndex int) (*CardState, error) {
+	if columnIndex < 0 || columnIndex >= len(board.Columns) {
+		return nil, fmt.Errorf("invalid column index")
+	}
+	column := board.Columns[columnIndex]
+	if cardIndex < 0 || cardIndex >= len(column.Cards) {
+		return nil, fmt.Errorf("invalid card index")
+	}
+	filename := column.Cards[cardIndex].Filename
+	file, err := writeq.ReadFile(filepath.Join(board.Path, "cards", filename))
+	if err != nil {
+		return nil, err
+	}
+	return &CardState{Column: column.Name, Index: cardIndex, Filename: filename, File: file}, nil
+}
+
+// applyCardState takes a card from state from to state to: it removes the
+// card from, writes to's file and puts the card in to's column and position.
+// When the card's file was edited after the change, only the part of it the
+// change rewrote is taken back, and when the edit touched that part too the
+// card is left alone.
+func applyCardState(board *models.Board, from, to *CardState) error {
+	var toCol *models.Column
+	if to != nil {
+		if toCol = board.GetColumn(to.Column); toCol == nil {
+			return fmt.Errorf("column %q no longer exists", to.Column)
+		}
+		if from == nil || from.Filename != to.Filename {
+			// A file whose first write is still queued exists all the same
+			toPath := filepath.Join(board.Path, "cards", to.Filename)
+			if _, err := os.Stat(toPath); err == nil || writeq.IsPending(toPath) {
+				return fmt.Errorf("%s already exists", to.Filename)
+			}
+		}
+	}
+
+	var file []byte
+	if to != nil {
+		file = to.File
+	}
+	if from != nil {
+		col, idx := findCard(board, from.Filename)
+		if col < 0 {
+			return fmt.Errorf("%s is no longer on the board", from.Filename)
+		}
+		current, err := writeq.ReadFile(filepath.Join(board.Path, "cards", from.Filename))
+		if err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		if err == nil && !bytes.Equal(current, from.File) {
+			var ok bool
+			if to != nil {
+				file, ok = rebaseEdit(current, from.File, to.File)
+			}
+			if !ok {
+				return fmt.Errorf("%s has been edited since", from.Filename)
+			}
+		}
+
+		column := &board.Columns[col]
+		column.Cards = slices.Delete(column.Cards, idx, idx+1)
+		if to == nil || to.Filename != from.Filename {
+			if err := writeq.Remove(filepath.Join(board.Path, "cards", from.Filename)); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+
+	if to != nil {
+		cardPath := filepath.Join(board.Path, "cards", to.Filename)
+		if err := writeq.WriteFile(cardPath, file, 0644); err != nil {
+			return err
+		}
+		card, err := fs.ReadCard(cardPath)
+		if err != nil {
+			return err
+		}
+		toCol.Cards = slices.Insert(toCol.Cards, min(to.Index, len(toCol.Cards)), card)
+	}
+	return fs.WriteBoard(*board)
+}
+
+// rebaseEdit applies the edit that turned from into to to current, a later
+// version of from. Both edits are taken as the one span of from they
+// rewrote; the result is ok when the spans are apart, with unchanged text
+// between them.
+func rebaseEdit(current, from, to []byte) (result []byte, ok bool) {
+	start, end, _ := editSpan(from, to)
+	laterStart, laterEnd, _ := editSpan(from, current)
+	switch {
+	case laterStart > end:
+		// the later edit is after the change's
+		return append(slices.Clip(to[:len(to)-(len(from)-end)]), current[end:]...), true
+	case laterEnd < start:
+		// the later edit is before it
+		return append(slices.Clip(current[:len(current)-(len(from)-start)]), to[start:]...), true
+	}
+	return nil, false
+}
+
+// editSpan returns the span of a that b rewrote: a[start:endA] became
+// b[start:endB], and the text around it is the same in both.
+func editSpan(a, b []byte) (start, endA, endB int) {
+	for start < len(a) && start < len(b) && a[start] == b[start] {
+		start++
+	}
+	endA, endB = len(a), len(b)
+	for endA > start && endB > start && a[endA-1] == b[endB-1] {
+		endA--
+		endB--
+	}
+	return start, endA, endB
+}
+
+// findCard returns the column and index of the card named filename, or -1
+// for both when it isn't on the board.
+func findCard(board *models.Board, filename string) (int, int) {
+	for i, col := range board.Columns {
+		for j, card := range col.Cards {
+			if card.Filename == filename {
+				return i, j
+			}
+		}
+	}
+	return -1, -1
+}
+
+func cardFilenames(board *models.Board) map[string]bool {
+	names := make(map[string]bool)
+	for _, col := range board.Columns {
+		for _, card := range col.Cards {
+			names[card.Filename] = true
+		}
+	}
+	return names
+}
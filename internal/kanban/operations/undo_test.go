@@ -0,0 +1,203 @@
+package operations
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+// undoBoard writes a board with cards a, b and c in To Do and an empty Done.
+func undoBoard(t *testing.T) models.Board {
+	t.Helper()
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	board := models.Board{Name: "b", Path: dir, Columns: []models.Column{{Name: "To Do"}, {Name: "Done"}}}
+	for _, name := range []string{"a", "b", "c"} {
+		card := models.Card{Filename: name + ".md", Title: name, Content: "# " + name + "\n"}
+		if err := fs.WriteCard(card, filepath.Join(dir, "cards", card.Filename)); err != nil {
+			t.Fatal(err)
+		}
+		board.Columns[0].Cards = append(board.Columns[0].Cards, card)
+	}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+	return board
+}
+
+// columnFiles lists the card filenames of each column, e.g. "a,b|c".
+func columnFiles(board models.Board) string {
+	var cols []string
+	for _, col := range board.Columns {
+		var names []string
+		for _, card := range col.Cards {
+			names = append(names, strings.TrimSuffix(card.Filename, ".md"))
+		}
+		cols = append(cols, strings.Join(names, ","))
+	}
+	return strings.Join(cols, "|")
+}
+
+func TestHistory_MoveUndoRedo(t *testing.T) {
+	board := undoBoard(t)
+	h := NewHistory()
+
+	change, err := TrackCard(&board, 0, 0, "move a", func() error { return MoveCard(&board, 0, 0, 1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Record(change)
+	if got := columnFiles(board); got != "b,c|a" {
+		t.Fatalf("after move %s", got)
+	}
+
+	if c, err := h.Undo(&board); err != nil || c.Label() != "move a" {
+		t.Fatalf("Undo = %v, %v", c, err)
+	}
+	if got := columnFiles(board); got != "a,b,c|" {
+		t.Errorf("after undo %s, want a back at the top of To Do", got)
+	}
+	card, _ := fs.ReadCard(filepath.Join(board.Path, "cards", "a.md"))
+	if len(card.History) != 0 || card.DateCompleted != nil {
+		t.Errorf("expected the move's history and date_completed gone, got %+v", card)
+	}
+
+	// Redo works on the board as read back from disk
+	reloaded, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Redo(&reloaded); err != nil {
+		t.Fatal(err)
+	}
+	if got := columnFiles(reloaded); got != "b,c|a" {
+		t.Errorf("after redo %s", got)
+	}
+	if c, _ := h.Redo(&reloaded); c != nil {
+		t.Errorf("expected nothing left to redo, got %s", c.Label())
+	}
+}
+
+func TestHistory_DeleteAndRenameUndo(t *testing.T) {
+	board := undoBoard(t)
+	h := NewHistory()
+
+	change, err := TrackCard(&board, 0, 1, "delete b", func() error { return DeleteCard(&board, 0, 1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if change.After != nil {
+		t.Fatalf("expected a delete to leave no After state, got %+v", change.After)
+	}
+	h.Record(change)
+	change, err = TrackCard(&board, 0, 0, "rename a", func() error { return RenameCard(&board, 0, 0, "Alpha") })
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Record(change)
+	if got := columnFiles(board); got != "alpha,c|" {
+		t.Fatalf("after delete and rename %s", got)
+	}
+
+	for range 2 {
+		if _, err := h.Undo(&board); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := columnFiles(board); got != "a,b,c|" {
+		t.Errorf("after undo %s, want a and b back", got)
+	}
+	if _, err := os.Stat(filepath.Join(board.Path, "cards", "alpha.md")); !os.IsNotExist(err) {
+		t.Errorf("expected alpha.md removed by the undo, got %v", err)
+	}
+	if card, err := fs.ReadCard(filepath.Join(board.Path, "cards", "b.md")); err != nil || card.Title != "b" {
+		t.Errorf("restored b = %+v, %v", card, err)
+	}
+}
+
+func TestHistory_SwapAndRecordClearsRedo(t *testing.T) {
+	board := undoBoard(t)
+	h := NewHistory()
+
+	swap, err := SwapCards(&board, 0, 0, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Record(swap)
+	if got := columnFiles(board); got != "c,b,a|" {
+		t.Fatalf("after swap %s", got)
+	}
+	if _, err := h.Undo(&board); err != nil {
+		t.Fatal(err)
+	}
+	if got := columnFiles(board); got != "a,b,c|" {
+		t.Errorf("after undo %s", got)
+	}
+
+	change, err := TrackCard(&board, 0, 0, "archive a", func() error { return ToggleCardArchive(&board, 0, 0) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Record(change)
+	if c, _ := h.Redo(&board); c != nil {
+		t.Errorf("expected a new change to clear the redo stack, redid %s", c.Label())
+	}
+}
+
+func TestHistory_UndoKeepsLaterEdits(t *testing.T) {
+	board := undoBoard(t)
+	h := NewHistory()
+	path := filepath.Join(board.Path, "cards", "a.md")
+
+	change, err := TrackCard(&board, 0, 0, "move a", func() error { return MoveCard(&board, 0, 0, 1) })
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.Record(change)
+	moved, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, append(moved, "\nnotes from the meeting\n"...), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := h.Undo(&board); err != nil {
+		t.Fatal(err)
+	}
+	if got := columnFiles(board); got != "a,b,c|" {
+		t.Errorf("after undo %s", got)
+	}
+	card, err := fs.ReadCard(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(card.Content, "notes from the meeting") {
+		t.Errorf("undo lost the text added after the move: %q", card.Content)
+	}
+	if len(card.History) != 0 || card.DateCompleted != nil {
+		t.Errorf("expected the move's history and date_completed gone, got %+v", card)
+	}
+
+	// An edit to the part the change rewrote can't be merged; redo refuses
+	// and leaves the file as it is
+	edited := []byte("---\ntitle: rewritten\n---\n# a\n")
+	if err := os.WriteFile(path, edited, 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := h.Redo(&board); err == nil {
+		t.Error("expected redo over a conflicting edit to fail")
+	}
+	if got, _ := os.ReadFile(path); string(got) != string(edited) {
+		t.Errorf("redo overwrote the edit: %q", got)
+	}
+	if got := columnFiles(board); got != "a,b,c|" {
+		t.Errorf("after the refused redo %s", got)
+	}
+}
@@ -1,52 +1,206 @@
 package logs
 
 import (
+	"context"
+	"fmt"
 	"io"
 	"log"
+	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime"
+	"strings"
 	"sync"
+	"time"
 )
 
 var (
-	Logger  *log.Logger
-	logFile *os.File
+	// Logger is the printf-style logger most of wydo logs through. Its lines
+	// go to the same leveled log as For: at error level when they start with
+	// "Error", warn with "Warning", info otherwise.
+	Logger *log.Logger
+
+	logFile *rotatingFile
+	level   = new(slog.LevelVar)
+	handler slog.Handler
 	mu      sync.Mutex
 )
 
 // Logger is off (discards output) by default.
 func init() {
-	Logger = log.New(io.Discard, "[wydo] ", log.LstdFlags|log.Lshortfile)
+	setOutput(io.Discard)
 }
 
 // Initialize enables logging to /tmp/wydo-debug.log, or to a file inside
-// logDir if provided.
+// logDir (normally the XDG state directory) if provided. The file is rotated
+// once it grows past maxLogSize.
 func Initialize(logDir string) error {
-	mu.Lock()
-	defer mu.Unlock()
-
 	logPath := filepath.Join("/tmp", "wydo-debug.log")
 	if logDir != "" && logDir != "." {
+		if err := os.MkdirAll(logDir, 0755); err != nil {
+			return err
+		}
 		logPath = filepath.Join(logDir, "debug.log")
 	}
 
-	f, err := os.OpenFile(logPath, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
+	f, err := openRotatingFile(logPath)
 	if err != nil {
 		return err
 	}
 
+	mu.Lock()
 	if logFile != nil {
 		logFile.Close()
 	}
-
 	logFile = f
-	Logger = log.New(f, "[wydo] ", log.LstdFlags|log.Lshortfile)
+	setOutput(f)
+	mu.Unlock()
 
-	Logger.Printf("Logger initialized: %s", logPath)
+	For("logs").Info("logger initialized", "path", logPath, "level", level.Level())
 
 	return nil
 }
 
+// SetDebug logs debug lines as well when on; by default the log starts at
+// info.
+func SetDebug(on bool) {
+	if on {
+		level.Set(slog.LevelDebug)
+	} else {
+		level.Set(slog.LevelInfo)
+	}
+}
+
+// For returns a logger whose lines are tagged with module, such as "writeq"
+// or "tasks". It can be kept in a package variable: it follows Initialize.
+func For(module string) *slog.Logger {
+	return slog.New(currentHandler{}).With("module", module)
+}
+
+// setOutput points Logger and For at w, plus the recent lines kept for the
+// debug overlay. Callers hold mu, or run before anything logs.
+func setOutput(w io.Writer) {
+	handler = teeHandler{
+		slog.NewTextHandler(w, &slog.HandlerOptions{Level: level, AddSource: true, ReplaceAttr: shortSource}),
+		recentHandler{},
+	}
+	if Logger == nil {
+		Logger = log.New(printfWriter{currentHandler{}.WithAttrs([]slog.Attr{slog.String("module", "wydo")})}, "", 0)
+	}
+}
+
+// currentHandler hands records to the handler set by the latest setOutput.
+type currentHandler struct {
+	attrs []slog.Attr
+}
+
+func (c currentHandler) get() slog.Handler {
+	mu.Lock()
+	h := handler
+	mu.Unlock()
+	if len(c.attrs) > 0 {
+		h = h.WithAttrs(c.attrs)
+	}
+	return h
+}
+
+func (c currentHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= level.Level()
+}
+
+func (c currentHandler) Handle(ctx context.Context, r slog.Record) error {
+	return c.get().Handle(ctx, r)
+}
+
+func (c currentHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return currentHandler{attrs: append(append([]slog.Attr(nil), c.attrs...), attrs...)}
+}
+
+func (c currentHandler) WithGroup(string) slog.Handler {
+	return c
+}
+
+// shortSource logs the source of a line as file.go:12, like log.Lshortfile.
+func shortSource(_ []string, a slog.Attr) slog.Attr {
+	if src, ok := a.Value.Any().(*slog.Source); ok && a.Key == slog.SourceKey {
+		return slog.String(slog.SourceKey, fmt.Sprintf("%s:%d", filepath.Base(src.File), src.Line))
+	}
+	return a
+}
+
+// printfWriter turns the lines written through Logger into leveled records.
+type printfWriter struct {
+	handler slog.Handler
+}
+
+func (w printfWriter) Write(p []byte) (int, error) {
+	msg := strings.TrimRight(string(p), "\n")
+	lvl := slog.LevelInfo
+	switch {
+	case strings.HasPrefix(msg, "Error"):
+		lvl = slog.LevelError
+	case strings.HasPrefix(msg, "Warning"):
+		lvl = slog.LevelWarn
+	}
+	ctx := context.Background()
+	if !w.handler.Enabled(ctx, lvl) {
+		return len(p), nil
+	}
+	return len(p), w.handler.Handle(ctx, slog.NewRecord(time.Now(), lvl, msg, printfCaller()))
+}
+
+// printfCaller returns the pc of the code that called Logger.Printf or
+// Println, past the frames of the log package.
+func printfCaller() uintptr {
+	var pcs [8]uintptr
+	n := runtime.Callers(3, pcs[:])
+	for _, pc := range pcs[:n] {
+		if fn := runtime.FuncForPC(pc - 1); fn != nil && !strings.HasPrefix(fn.Name(), "log.") {
+			return pc
+		}
+	}
+	return 0
+}
+
+// teeHandler passes records to every handler that wants them.
+type teeHandler []slog.Handler
+
+func (t teeHandler) Enabled(ctx context.Context, l slog.Level) bool {
+	for _, h := range t {
+		if h.Enabled(ctx, l) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t teeHandler) Handle(ctx context.Context, r slog.Record) error {
+	for _, h := range t {
+		if h.Enabled(ctx, r.Level) {
+			if err := h.Handle(ctx, r.Clone()); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (t teeHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	result := make(teeHandler, len(t))
+	for i, h := range t {
+		result[i] = h.WithAttrs(attrs)
+	}
+	return result
+}
+
+func (t teeHandler) WithGroup(name string) slog.Handler {
+	result := make(teeHandler, len(t))
+	for i, h := range t {
+		result[i] = h.WithGroup(name)
+	}
+	return result
+}
+
 // Close closes the log file.
 func Close() error {
 	mu.Lock()
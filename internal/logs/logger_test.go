@@ -0,0 +1,70 @@
+package logs
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLevelsAndRecent(t *testing.T) {
+	dir := t.TempDir()
+	if err := Initialize(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer Close()
+	defer SetDebug(false)
+
+	SetDebug(false)
+	For("test").Debug("hidden detail")
+	Logger.Printf("Error saving state: %v", os.ErrPermission)
+	SetDebug(true)
+	For("test").Debug("shown detail", "n", 3)
+
+	recent := strings.Join(Recent(3), "\n")
+	if strings.Contains(recent, "hidden detail") {
+		t.Errorf("debug line logged at info level:\n%s", recent)
+	}
+	if !strings.Contains(recent, "ERROR wydo: Error saving state") {
+		t.Errorf("expected the printf Error line at error level:\n%s", recent)
+	}
+	if !strings.Contains(recent, "DEBUG test: shown detail n=3") {
+		t.Errorf("expected the debug line with its module:\n%s", recent)
+	}
+
+	content, err := os.ReadFile(filepath.Join(dir, "debug.log"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "source=logger_test.go:") {
+		t.Errorf("expected the caller's file in the log:\n%s", content)
+	}
+}
+
+func TestRotatingFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "debug.log")
+	r, err := openRotatingFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close()
+
+	line := []byte(strings.Repeat("x", 1023) + "\n")
+	for i := 0; i < (keepLogs+2)*maxLogSize/len(line); i++ {
+		if _, err := r.Write(line); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for i := 1; i <= keepLogs; i++ {
+		info, err := os.Stat(path + "." + string(rune('0'+i)))
+		if err != nil {
+			t.Fatalf("expected rotated file %d: %v", i, err)
+		}
+		if info.Size() > maxLogSize {
+			t.Errorf("rotated file %d is %d bytes, over %d", i, info.Size(), maxLogSize)
+		}
+	}
+	if _, err := os.Stat(path + "." + string(rune('0'+keepLogs+1))); !os.IsNotExist(err) {
+		t.Errorf("expected at most %d rotated files", keepLogs)
+	}
+}
@@ -0,0 +1,93 @@
+package logs
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"sync"
+)
+
+// recentCap is how many lines Recent keeps.
+const recentCap = 500
+
+var (
+	recentMu    sync.Mutex
+	recentLines []string // ring buffer, oldest at recentStart once full
+	recentStart int
+)
+
+// Recent returns up to the last n log lines, oldest first, formatted as
+// "15:04:05 WARN module: message key=value". It backs the TUI's debug
+// overlay, and keeps lines even while the log file is off.
+func Recent(n int) []string {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	total := len(recentLines)
+	if n > total {
+		n = total
+	}
+	result := make([]string, 0, n)
+	for i := total - n; i < total; i++ {
+		result = append(result, recentLines[(recentStart+i)%total])
+	}
+	return result
+}
+
+func addRecent(line string) {
+	recentMu.Lock()
+	defer recentMu.Unlock()
+
+	if len(recentLines) < recentCap {
+		recentLines = append(recentLines, line)
+		return
+	}
+	recentLines[recentStart] = line
+	recentStart = (recentStart + 1) % recentCap
+}
+
+// recentHandler formats records into the Recent ring buffer.
+type recentHandler struct {
+	module string
+	attrs  []slog.Attr
+}
+
+func (h recentHandler) Enabled(_ context.Context, l slog.Level) bool {
+	return l >= level.Level()
+}
+
+func (h recentHandler) Handle(_ context.Context, r slog.Record) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s %-5s ", r.Time.Format("15:04:05"), r.Level.String())
+	if h.module != "" {
+		b.WriteString(h.module + ": ")
+	}
+	b.WriteString(r.Message)
+	write := func(a slog.Attr) bool {
+		fmt.Fprintf(&b, " %s=%v", a.Key, a.Value)
+		return true
+	}
+	for _, a := range h.attrs {
+		write(a)
+	}
+	r.Attrs(write)
+	addRecent(b.String())
+	return nil
+}
+
+func (h recentHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	result := recentHandler{module: h.module, attrs: append([]slog.Attr(nil), h.attrs...)}
+	for _, a := range attrs {
+		if a.Key == "module" {
+			result.module = a.Value.String()
+			continue
+		}
+		result.attrs = append(result.attrs, a)
+	}
+	return result
+}
+
+func (h recentHandler) WithGroup(string) slog.Handler {
+	return h
+}
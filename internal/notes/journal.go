@@ -0,0 +1,121 @@
+package notes
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"wydo/internal/checklist"
+	"wydo/internal/writeq"
+)
+
+// journalAgendaHeading heads the agenda section of a journal note. Its items
+// are not carried forward: the agenda of the next day lists whatever is
+// still open.
+const journalAgendaHeading = "## Agenda"
+
+// JournalPath returns the path of day's journal note in dir, e.g.
+// dir/2026-10-16.md.
+func JournalPath(dir string, day time.Time) string {
+	return filepath.Join(dir, day.Format("2006-01-02")+".md")
+}
+
+// OpenJournal returns the path of day's journal note in dir, creating the
+// note if it doesn't exist yet. A new note lists agenda (checklist lines of
+// the day's agenda) at the top, then the unchecked checklist items of the
+// latest earlier journal note, then an empty Notes section. created reports
+// whether the note was written.
+func OpenJournal(dir string, day time.Time, agenda []string) (path string, created bool, err error) {
+	path = JournalPath(dir, day)
+	if _, err := os.Stat(path); err == nil || writeq.IsPending(path) {
+		return path, false, nil
+	}
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return "", false, err
+	}
+
+	var sb strings.Builder
+	sb.WriteString("---\n")
+	sb.WriteString("date: " + day.Format("2006-01-02") + "\n")
+	sb.WriteString("title: " + day.Format("Monday, January 2") + "\n")
+	sb.WriteString("tags: [journal]\n")
+	sb.WriteString("---\n\n")
+	sb.WriteString(journalAgendaHeading + "\n\n")
+	if len(agenda) == 0 {
+		sb.WriteString("Nothing due.\n")
+	}
+	for _, line := range agenda {
+		sb.WriteString(line + "\n")
+	}
+
+	if prevPath, prevDay, ok := previousJournal(dir, day); ok {
+		if body, err := ReadBody(prevPath); err == nil {
+			if items := checklist.Unchecked(withoutSection(body, journalAgendaHeading)); len(items) > 0 {
+				sb.WriteString("\n## Carried forward from " + prevDay.Format("Mon Jan 2") + "\n\n")
+				for _, it := range items {
+					sb.WriteString(it.String() + "\n")
+				}
+			}
+		}
+	}
+	sb.WriteString("\n## Notes\n\n")
+
+	if err := writeq.WriteFile(path, []byte(sb.String()), 0644); err != nil {
+		return "", false, err
+	}
+	return path, true, nil
+}
+
+// previousJournal finds the latest journal note in dir dated before day.
+func previousJournal(dir string, day time.Time) (string, time.Time, bool) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return "", time.Time{}, false
+	}
+	cutoff := day.Format("2006-01-02")
+	var names []string
+	for _, e := range entries {
+		name := e.Name()
+		if e.IsDir() || !strings.HasSuffix(name, ".md") {
+			continue
+		}
+		date := strings.TrimSuffix(name, ".md")
+		if _, err := time.Parse("2006-01-02", date); err == nil && date < cutoff {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "", time.Time{}, false
+	}
+	sort.Strings(names)
+	latest := names[len(names)-1]
+	prevDay, _ := time.Parse("2006-01-02", strings.TrimSuffix(latest, ".md"))
+	return filepath.Join(dir, latest), prevDay, true
+}
+
+// withoutSection drops the section under heading, up to the next heading of
+// the same or a higher level, from markdown.
+func withoutSection(markdown, heading string) string {
+	level := len(heading) - len(strings.TrimLeft(heading, "#"))
+	var kept []string
+	skipping := false
+	for _, line := range strings.Split(markdown, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if strings.HasPrefix(trimmed, "#") {
+			hashes := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+			if trimmed == heading {
+				skipping = true
+				continue
+			}
+			if skipping && hashes <= level {
+				skipping = false
+			}
+		}
+		if !skipping {
+			kept = append(kept, line)
+		}
+	}
+	return strings.Join(kept, "\n")
+}
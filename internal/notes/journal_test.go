@@ -0,0 +1,74 @@
+package notes
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestOpenJournal_CarriesForwardUncheckedItems(t *testing.T) {
+	dir := t.TempDir()
+	prev := `---
+date: 2026-10-14
+---
+
+## Agenda
+
+- [ ] Overdue task from the agenda
+
+## Notes
+
+- [ ] Email Dana
+  - [ ] attach the slides
+- [x] Book flights
+  - [ ] left behind with its done parent
+`
+	if err := os.WriteFile(filepath.Join(dir, "2026-10-14.md"), []byte(prev), 0644); err != nil {
+		t.Fatal(err)
+	}
+	// An older note is not the latest before the day
+	if err := os.WriteFile(filepath.Join(dir, "2026-10-01.md"), []byte("- [ ] ancient\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2026, 10, 16, 0, 0, 0, 0, time.Local)
+	path, created, err := OpenJournal(dir, day, []string{"- [ ] Call Bob"})
+	if err != nil || !created {
+		t.Fatalf("OpenJournal = %q, %v, %v", path, created, err)
+	}
+	if path != filepath.Join(dir, "2026-10-16.md") {
+		t.Errorf("path = %q", path)
+	}
+	raw, _ := os.ReadFile(path)
+	content := string(raw)
+	for _, want := range []string{
+		"date: 2026-10-16",
+		"## Agenda\n\n- [ ] Call Bob\n",
+		"## Carried forward from Wed Oct 14\n\n- [ ] Email Dana\n  - [ ] attach the slides\n\n## Notes",
+	} {
+		if !strings.Contains(content, want) {
+			t.Errorf("journal missing %q:\n%s", want, content)
+		}
+	}
+	for _, unwanted := range []string{"Overdue task from the agenda", "left behind", "ancient"} {
+		if strings.Contains(content, unwanted) {
+			t.Errorf("journal should not carry %q:\n%s", unwanted, content)
+		}
+	}
+	if note, ok := ParseNoteFile(path, dir); !ok || len(note.Tags) != 1 || note.Tags[0] != "journal" {
+		t.Errorf("ParseNoteFile = %+v, %v", note, ok)
+	}
+
+	// Opening it again leaves the note as it is
+	if err := os.WriteFile(path, []byte("edited"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, created, err := OpenJournal(dir, day, nil); err != nil || created {
+		t.Errorf("second OpenJournal: created %v, %v", created, err)
+	}
+	if raw, _ := os.ReadFile(path); string(raw) != "edited" {
+		t.Errorf("existing journal rewritten: %q", raw)
+	}
+}
@@ -1,6 +1,9 @@
 package notes
 
-import "time"
+import (
+	"strings"
+	"time"
+)
 
 // Note represents a markdown note with a date
 type Note struct {
@@ -8,4 +11,24 @@ type Note struct {
 	FilePath string    // Absolute path to file
 	RelPath  string    // Path relative to scanned dir root (for display)
 	Date     time.Time // From frontmatter `date`, or parsed from filename
+	Projects []string  // From frontmatter `projects` (links the note to projects outside their directory)
+	Tags     []string  // From frontmatter `tags`
+}
+
+// TagLabel returns the note's tags as "#a #b", or "" when it has none
+func (n Note) TagLabel() string {
+	if len(n.Tags) == 0 {
+		return ""
+	}
+	return "#" + strings.Join(n.Tags, " #")
+}
+
+// HasProject returns true if the note's frontmatter lists the project
+func (n Note) HasProject(project string) bool {
+	for _, p := range n.Projects {
+		if p == project {
+			return true
+		}
+	}
+	return false
 }
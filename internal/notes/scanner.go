@@ -32,13 +32,13 @@ func ParseNoteFile(absPath, rootDir string) (Note, bool) {
 	hasDate := false
 
 	// Try frontmatter first
-	fmDate, fmTitle := parseFrontmatter(content)
-	if !fmDate.IsZero() {
-		noteDate = fmDate
+	fm := parseFrontmatter(content)
+	if !fm.date.IsZero() {
+		noteDate = fm.date
 		hasDate = true
 	}
-	if fmTitle != "" {
-		title = fmTitle
+	if fm.Title != "" {
+		title = fm.Title
 	}
 
 	// Try filename date (used if frontmatter has no date)
@@ -65,19 +65,59 @@ func ParseNoteFile(absPath, rootDir string) (Note, bool) {
 		FilePath: absPath,
 		RelPath:  relPath,
 		Date:     noteDate,
+		Projects: cleanNames(fm.Projects, "+"),
+		Tags:     cleanNames(fm.Tags, "#"),
 	}, true
 }
 
+// ReadBody returns a note file's markdown without its frontmatter.
+func ReadBody(absPath string) (string, error) {
+	content, err := os.ReadFile(absPath)
+	if err != nil {
+		return "", err
+	}
+	lines := strings.Split(string(content), "\n")
+	if len(lines) > 0 && strings.TrimSpace(lines[0]) == "---" {
+		for i := 1; i < len(lines); i++ {
+			if strings.TrimSpace(lines[i]) == "---" {
+				return strings.Join(lines[i+1:], "\n"), nil
+			}
+		}
+	}
+	return string(content), nil
+}
+
 type noteFrontmatter struct {
-	Date  string `yaml:"date"`
-	Title string `yaml:"title"`
+	Date     string   `yaml:"date"`
+	Title    string   `yaml:"title"`
+	Projects nameList `yaml:"projects"`
+	Tags     nameList `yaml:"tags"`
+
+	date time.Time // parsed Date
 }
 
-func parseFrontmatter(content []byte) (time.Time, string) {
+// nameList is a frontmatter list of names, written as a YAML list or as one
+// string such as "tags: foo" or "tags: foo, bar".
+type nameList []string
+
+func (l *nameList) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		*l = strings.Split(value.Value, ",")
+		return nil
+	}
+	var names []string
+	if err := value.Decode(&names); err != nil {
+		return err
+	}
+	*l = names
+	return nil
+}
+
+func parseFrontmatter(content []byte) noteFrontmatter {
 	lines := bytes.Split(content, []byte("\n"))
 
 	if len(lines) == 0 || !bytes.Equal(bytes.TrimSpace(lines[0]), []byte("---")) {
-		return time.Time{}, ""
+		return noteFrontmatter{}
 	}
 
 	var fmEnd int
@@ -89,23 +129,35 @@ func parseFrontmatter(content []byte) (time.Time, string) {
 	}
 
 	if fmEnd == 0 {
-		return time.Time{}, ""
+		return noteFrontmatter{}
 	}
 
 	fmBytes := bytes.Join(lines[1:fmEnd], []byte("\n"))
 	var fm noteFrontmatter
 	if err := yaml.Unmarshal(fmBytes, &fm); err != nil {
-		return time.Time{}, ""
+		return noteFrontmatter{}
 	}
 
-	var date time.Time
 	if fm.Date != "" {
 		if parsed, err := time.Parse("2006-01-02", fm.Date); err == nil {
-			date = parsed
+			fm.date = parsed
 		}
 	}
 
-	return date, fm.Title
+	return fm
+}
+
+// cleanNames trims whitespace and an optional sigil (+project, #tag) from
+// frontmatter names, dropping empty entries.
+func cleanNames(names []string, sigil string) []string {
+	var result []string
+	for _, n := range names {
+		n = strings.TrimPrefix(strings.TrimSpace(n), sigil)
+		if n != "" {
+			result = append(result, n)
+		}
+	}
+	return result
 }
 
 func titleFromFilename(filename string) string {
@@ -0,0 +1,41 @@
+package notes
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestParseNoteFile_ScalarOrListNames(t *testing.T) {
+	dir := t.TempDir()
+	cases := map[string]struct {
+		frontmatter    string
+		projects, tags []string
+	}{
+		"scalar": {"projects: +alpha\ntags: foo", []string{"alpha"}, []string{"foo"}},
+		"comma":  {"tags: foo, bar", nil, []string{"foo", "bar"}},
+		"list":   {"projects: [alpha, beta]\ntags:\n  - foo", []string{"alpha", "beta"}, []string{"foo"}},
+	}
+	for name, tc := range cases {
+		path := filepath.Join(dir, name+".md")
+		content := "---\ndate: 2026-03-02\n" + tc.frontmatter + "\n---\nbody\n"
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		note, ok := ParseNoteFile(path, dir)
+		if !ok {
+			t.Errorf("%s: note dropped", name)
+			continue
+		}
+		if got := note.Date.Format("2006-01-02"); got != "2026-03-02" {
+			t.Errorf("%s: date = %s", name, got)
+		}
+		if !reflect.DeepEqual(note.Projects, tc.projects) {
+			t.Errorf("%s: projects = %v, want %v", name, note.Projects, tc.projects)
+		}
+		if !reflect.DeepEqual(note.Tags, tc.tags) {
+			t.Errorf("%s: tags = %v, want %v", name, note.Tags, tc.tags)
+		}
+	}
+}
@@ -0,0 +1,230 @@
+// Package notify tells the watchers of a card about changes to it. A card
+// lists its watchers under notify: in its frontmatter, each a webhook URL or
+// an email address. Notifications are queued and sent in the background, so
+// a slow or unreachable watcher never holds up the board.
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"wydo/internal/clock"
+	"wydo/internal/logs"
+)
+
+var log = logs.For("notify")
+
+// Kinds of change a watcher is told about
+const (
+	KindMoved = "moved" // the card entered another column
+	KindDue   = "due"   // the card's due date changed
+)
+
+// Event is a change to a watched card.
+type Event struct {
+	Kind      string    `json:"kind"`
+	Board     string    `json:"board"`
+	BoardPath string    `json:"board_path"`
+	Card      string    `json:"card"`
+	File      string    `json:"file"`
+	From      string    `json:"from"` // column or due date before; "" for none
+	To        string    `json:"to"`   // column or due date after; "" for none
+	At        time.Time `json:"at"`
+}
+
+// Summary is a one-line description of the change, used as email subject.
+func (e Event) Summary() string {
+	switch e.Kind {
+	case KindMoved:
+		return fmt.Sprintf("%q moved from %s to %s on %s", e.Card, orNone(e.From), orNone(e.To), e.Board)
+	case KindDue:
+		return fmt.Sprintf("%q due date changed from %s to %s on %s", e.Card, orNone(e.From), orNone(e.To), e.Board)
+	}
+	return fmt.Sprintf("%q changed on %s", e.Card, e.Board)
+}
+
+func orNone(s string) string {
+	if s == "" {
+		return "none"
+	}
+	return s
+}
+
+// SMTP is the mail server email watchers are notified through.
+type SMTP struct {
+	Host     string
+	Port     int // 587 if unset
+	Username string
+	Password string
+	From     string // sender address; Username if unset
+}
+
+func (s SMTP) addr() string {
+	port := s.Port
+	if port == 0 {
+		port = 587
+	}
+	return s.Host + ":" + strconv.Itoa(port)
+}
+
+// queueSize is how many notifications may wait to be sent. Beyond it new
+// ones are dropped (and logged) rather than blocking the caller.
+const queueSize = 64
+
+type job struct {
+	target string
+	event  Event
+}
+
+var (
+	mu       sync.Mutex
+	smtpConf *SMTP
+	queue    chan job
+	start    sync.Once
+	pending  sync.WaitGroup
+	client   = &http.Client{Timeout: 10 * time.Second}
+)
+
+// Configure sets the mail server for email watchers, once at startup.
+// Without one, email watchers are skipped.
+func Configure(s *SMTP) {
+	mu.Lock()
+	defer mu.Unlock()
+	smtpConf = s
+}
+
+// Send queues the event for every target. Targets that are neither a
+// webhook URL nor an email address are skipped.
+func Send(targets []string, e Event) {
+	if len(targets) == 0 {
+		return
+	}
+	if e.At.IsZero() {
+		e.At = clock.Now()
+	}
+	start.Do(func() {
+		queue = make(chan job, queueSize)
+		go work()
+	})
+	for _, t := range targets {
+		t = strings.TrimSpace(t)
+		if t == "" {
+			continue
+		}
+		pending.Add(1)
+		select {
+		case queue <- job{target: t, event: e}:
+		default:
+			pending.Done()
+			log.Warn("queue full, dropped notification", "kind", e.Kind, "target", t)
+		}
+	}
+}
+
+// Wait blocks until the queue is empty or timeout passes, so a command that
+// exits right after a change still sends its notifications. It returns
+// false on timeout.
+func Wait(timeout time.Duration) bool {
+	done := make(chan struct{})
+	go func() {
+		pending.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func work() {
+	for j := range queue {
+		if err := deliver(j.target, j.event); err != nil {
+			log.Error("delivery failed", "target", j.target, "err", err)
+		}
+		pending.Done()
+	}
+}
+
+func deliver(target string, e Event) error {
+	if IsWebhook(target) {
+		return postWebhook(target, e)
+	}
+	if addr, ok := EmailAddress(target); ok {
+		mu.Lock()
+		s := smtpConf
+		mu.Unlock()
+		if s == nil || s.Host == "" {
+			return fmt.Errorf("no smtp server configured for email notifications")
+		}
+		return sendEmail(*s, addr, e)
+	}
+	return fmt.Errorf("not a webhook URL or email address")
+}
+
+// IsWebhook reports whether a notify: target is a webhook URL.
+func IsWebhook(target string) bool {
+	return strings.HasPrefix(target, "http://") || strings.HasPrefix(target, "https://")
+}
+
+// EmailAddress returns the address of an email notify: target, written
+// plainly or as a mailto: link.
+func EmailAddress(target string) (string, bool) {
+	addr := strings.TrimPrefix(target, "mailto:")
+	at := strings.Index(addr, "@")
+	if at <= 0 || at == len(addr)-1 || strings.ContainsAny(addr, " \t<>,") {
+		return "", false
+	}
+	return addr, true
+}
+
+// postWebhook posts the event as JSON.
+func postWebhook(url string, e Event) error {
+	body, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+	resp, err := client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
+
+func sendEmail(s SMTP, to string, e Event) error {
+	from := s.From
+	if from == "" {
+		from = s.Username
+	}
+	var auth smtp.Auth
+	if s.Username != "" {
+		auth = smtp.PlainAuth("", s.Username, s.Password, s.Host)
+	}
+	return smtp.SendMail(s.addr(), auth, from, []string{to}, emailMessage(from, to, e))
+}
+
+// emailMessage renders the event as a plain text email.
+func emailMessage(from, to string, e Event) []byte {
+	var b strings.Builder
+	fmt.Fprintf(&b, "From: %s\r\n", from)
+	fmt.Fprintf(&b, "To: %s\r\n", to)
+	fmt.Fprintf(&b, "Subject: [wydo] %s\r\n", e.Summary())
+	b.WriteString("Content-Type: text/plain; charset=utf-8\r\n\r\n")
+	fmt.Fprintf(&b, "%s\r\n\r\n", e.Summary())
+	fmt.Fprintf(&b, "Card: %s\r\n", e.File)
+	fmt.Fprintf(&b, "Board: %s\r\n", e.BoardPath)
+	fmt.Fprintf(&b, "At: %s\r\n", e.At.Format(time.RFC1123Z))
+	return []byte(b.String())
+}
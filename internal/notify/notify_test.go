@@ -0,0 +1,69 @@
+package notify
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestSend_PostsWebhook(t *testing.T) {
+	var mu sync.Mutex
+	var got []Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Event
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			t.Errorf("decode: %v", err)
+		}
+		mu.Lock()
+		got = append(got, e)
+		mu.Unlock()
+	}))
+	defer srv.Close()
+
+	Send([]string{srv.URL, "  "}, Event{Kind: KindMoved, Board: "Work", Card: "Ship it", From: "Todo", To: "Done"})
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(got) != 1 {
+		t.Fatalf("got %d posts, want 1", len(got))
+	}
+	if got[0].Kind != KindMoved || got[0].To != "Done" || got[0].At.IsZero() {
+		t.Errorf("unexpected event %+v", got[0])
+	}
+}
+
+func TestEmailAddress(t *testing.T) {
+	tests := []struct {
+		target string
+		want   string
+		ok     bool
+	}{
+		{"bob@example.com", "bob@example.com", true},
+		{"mailto:bob@example.com", "bob@example.com", true},
+		{"https://hooks.example.com/x", "", false},
+		{"bob", "", false},
+		{"@example.com", "", false},
+		{"bob@", "", false},
+	}
+	for _, tt := range tests {
+		got, ok := EmailAddress(tt.target)
+		if got != tt.want || ok != tt.ok {
+			t.Errorf("EmailAddress(%q) = %q, %v; want %q, %v", tt.target, got, ok, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestEmailMessage(t *testing.T) {
+	e := Event{Kind: KindDue, Board: "Work", Card: "Ship it", To: "2026-05-01"}
+	msg := string(emailMessage("me@example.com", "bob@example.com", e))
+	if !strings.Contains(msg, `Subject: [wydo] "Ship it" due date changed from none to 2026-05-01 on Work`) {
+		t.Errorf("unexpected message:\n%s", msg)
+	}
+}
@@ -0,0 +1,378 @@
+// Package refs indexes what refers to a card: tasks tagged with its
+// card:<board>/<file> reference, notes that link to it or mention its title,
+// and other cards that link to it. The index is built when a workspace loads
+// and updated a board or note at a time after that; tasks can be read from
+// the task service each time, see SetTaskSource.
+package refs
+
+import (
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+// Kind is what a Ref is: a task, note or card.
+type Kind int
+
+const (
+	KindTask Kind = iota
+	KindNote
+	KindCard
+)
+
+func (k Kind) String() string {
+	switch k {
+	case KindTask:
+		return "task"
+	case KindNote:
+		return "note"
+	case KindCard:
+		return "card"
+	}
+	return ""
+}
+
+// Ref is a task, note or card that refers to a card.
+type Ref struct {
+	Kind  Kind
+	Title string // task text, note path or card title
+	Path  string // file the reference is in
+	Via   string // how it refers: "card:", "[[link]]" or "title"
+
+	TaskID    string // for tasks
+	BoardPath string // for cards; the card is found on it by Path's filename
+}
+
+// minMentionLength is the shortest card title that counts as mentioned when
+// a note contains it; shorter titles match too much by accident.
+const minMentionLength = 4
+
+var (
+	// wikiLinkRe matches [[target]] and [[target|label]]
+	wikiLinkRe = regexp.MustCompile(`\[\[([^\]|]+)(?:\|[^\]]*)?\]\]`)
+	// cardRefRe matches card:board/file.md, quoted or not
+	cardRefRe = regexp.MustCompile(`card:(?:"([^"]+)"|(\S+\.md))`)
+)
+
+// CardKey returns the card's reference as tasks carry it in their card: tag,
+// <board dir>/<card file>.
+func CardKey(boardPath, filename string) string {
+	return filepath.Base(boardPath) + "/" + filename
+}
+
+// links are the references found in a note or card's text.
+type links struct {
+	wiki  []string // normalized [[link]] targets
+	cards []string // card:<board>/<file> values
+}
+
+func parseLinks(text string) links {
+	var l links
+	for _, m := range wikiLinkRe.FindAllStringSubmatch(text, -1) {
+		l.wiki = append(l.wiki, normalizeLink(m[1]))
+	}
+	for _, m := range cardRefRe.FindAllStringSubmatch(text, -1) {
+		l.cards = append(l.cards, m[1]+m[2])
+	}
+	return l
+}
+
+func normalizeLink(target string) string {
+	return strings.TrimSuffix(strings.ToLower(strings.TrimSpace(target)), ".md")
+}
+
+// via returns how l links to the card with key and title, or "".
+func (l links) via(key, title string) string {
+	for _, c := range l.cards {
+		if c == key {
+			return "card:"
+		}
+	}
+	names := []string{
+		normalizeLink(title),
+		normalizeLink(strings.TrimSuffix(filepath.Base(key), ".md")),
+		normalizeLink(key),
+	}
+	for _, w := range l.wiki {
+		for _, name := range names {
+			if name != "" && w == name {
+				return "[[link]]"
+			}
+		}
+	}
+	return ""
+}
+
+type noteEntry struct {
+	path     string
+	rel      string
+	modTime  time.Time
+	links    links
+	mentions []string // the card titles (lowercased) the note contains
+	titles   uint64   // the set of titles mentions was checked against
+}
+
+type cardEntry struct {
+	ref   Ref
+	key   string
+	links links
+}
+
+// Index records the references to cards in one workspace.
+type Index struct {
+	root       string
+	tasks      map[string][]Ref // card key → tasks tagged with it
+	taskSource func() ([]data.Task, error)
+	notes      map[string]*noteEntry // note path → entry
+	cards      map[string]*cardEntry // card path → entry
+
+	// titles are the card titles long enough to count as mentioned, and
+	// titlesHash identifies the set
+	titles     []string
+	titlesHash uint64
+}
+
+// Build indexes the tasks, cards and notes of the workspace at root. Notes
+// unchanged since an earlier Build are not read again.
+func Build(root string, tasks []data.Task, boards []kanbanmodels.Board, notePaths []string) *Index {
+	ix := &Index{
+		root:  root,
+		notes: make(map[string]*noteEntry),
+		cards: make(map[string]*cardEntry),
+	}
+	ix.SetTasks(tasks)
+	for _, board := range boards {
+		for _, col := range board.Columns {
+			for _, card := range col.Cards {
+				ix.setCard(board.Path, card)
+			}
+		}
+	}
+	ix.updateTitles()
+	for _, path := range notePaths {
+		ix.UpdateNote(path)
+	}
+	return ix
+}
+
+// SetTasks reindexes the card: tags of tasks.
+func (ix *Index) SetTasks(tasks []data.Task) {
+	ix.tasks = taskRefs(tasks)
+}
+
+// SetTaskSource makes Backlinks read the tasks from list each time, keeping
+// the ones in the workspace, so that tasks edited since the index was built
+// are seen as they are now. The tasks given to Build are used when list
+// fails.
+func (ix *Index) SetTaskSource(list func() ([]data.Task, error)) {
+	ix.taskSource = list
+}
+
+func taskRefs(tasks []data.Task) map[string][]Ref {
+	refs := make(map[string][]Ref)
+	for _, task := range tasks {
+		key := task.Tags["card"]
+		if key == "" {
+			continue
+		}
+		refs[key] = append(refs[key], Ref{Kind: KindTask, Title: task.Name, Path: task.File, Via: "card:", TaskID: task.ID})
+	}
+	return refs
+}
+
+// currentTasks returns the task references by card key, from the task
+// source when there is one.
+func (ix *Index) currentTasks() map[string][]Ref {
+	if ix.taskSource == nil {
+		return ix.tasks
+	}
+	all, err := ix.taskSource()
+	if err != nil {
+		return ix.tasks
+	}
+	prefix := ix.root + string(filepath.Separator)
+	var tasks []data.Task
+	for _, task := range all {
+		if strings.HasPrefix(task.File, prefix) {
+			tasks = append(tasks, task)
+		}
+	}
+	return taskRefs(tasks)
+}
+
+// UpdateBoard reindexes the cards of a board, after it was edited. When card
+// titles changed, the notes are checked again for mentions of them.
+func (ix *Index) UpdateBoard(board kanbanmodels.Board) {
+	dir := filepath.Join(board.Path, "cards") + string(filepath.Separator)
+	for path := range ix.cards {
+		if strings.HasPrefix(path, dir) {
+			delete(ix.cards, path)
+		}
+	}
+	for _, col := range board.Columns {
+		for _, card := range col.Cards {
+			ix.setCard(board.Path, card)
+		}
+	}
+	if ix.updateTitles() {
+		for path := range ix.notes {
+			ix.UpdateNote(path)
+		}
+	}
+}
+
+func (ix *Index) setCard(boardPath string, card kanbanmodels.Card) {
+	path := filepath.Join(boardPath, "cards", card.Filename)
+	ix.cards[path] = &cardEntry{
+		ref:   Ref{Kind: KindCard, Title: card.Title, Path: path, Via: "[[link]]", BoardPath: boardPath},
+		key:   CardKey(boardPath, card.Filename),
+		links: parseLinks(card.Content),
+	}
+}
+
+// updateTitles collects the card titles notes are checked for and reports
+// whether they changed.
+func (ix *Index) updateTitles() bool {
+	seen := make(map[string]bool)
+	var titles []string
+	for _, c := range ix.cards {
+		title := mentionTitle(c.ref.Title)
+		if title != "" && !seen[title] {
+			seen[title] = true
+			titles = append(titles, title)
+		}
+	}
+	sort.Strings(titles)
+	h := fnv.New64a()
+	for _, t := range titles {
+		h.Write([]byte(t))
+		h.Write([]byte{0})
+	}
+	hash := h.Sum64()
+	changed := hash != ix.titlesHash
+	ix.titles, ix.titlesHash = titles, hash
+	return changed
+}
+
+// mentionTitle returns the form of a card title that notes are searched
+// for, or "" when the title is too short to count.
+func mentionTitle(title string) string {
+	title = strings.ToLower(strings.TrimSpace(title))
+	if len([]rune(title)) < minMentionLength {
+		return ""
+	}
+	return title
+}
+
+// UpdateNote reindexes the note at path, or drops it when it is gone. A note
+// unchanged since it was last checked for the same card titles is taken from
+// the cache.
+func (ix *Index) UpdateNote(path string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		delete(ix.notes, path)
+		return
+	}
+	if entry, ok := cachedNote(path, info.ModTime(), ix.titlesHash); ok {
+		ix.notes[path] = entry
+		return
+	}
+	content, err := os.ReadFile(path)
+	if err != nil {
+		delete(ix.notes, path)
+		return
+	}
+	rel, err := filepath.Rel(ix.root, path)
+	if err != nil {
+		rel = filepath.Base(path)
+	}
+	entry := &noteEntry{
+		path:    path,
+		rel:     rel,
+		modTime: info.ModTime(),
+		links:   parseLinks(string(content)),
+		titles:  ix.titlesHash,
+	}
+	text := strings.ToLower(string(content))
+	for _, title := range ix.titles {
+		if strings.Contains(text, title) {
+			entry.mentions = append(entry.mentions, title)
+		}
+	}
+	cacheNote(entry)
+	ix.notes[path] = entry
+}
+
+// Backlinks returns what refers to the card on the board at boardPath:
+// tasks first, then notes and other cards, each sorted by title.
+func (ix *Index) Backlinks(boardPath string, card kanbanmodels.Card) []Ref {
+	if ix == nil {
+		return nil
+	}
+	key := CardKey(boardPath, card.Filename)
+	self := filepath.Join(boardPath, "cards", card.Filename)
+	title := mentionTitle(card.Title)
+
+	tasks := append([]Ref(nil), ix.currentTasks()[key]...)
+
+	var notes []Ref
+	for _, n := range ix.notes {
+		via := n.links.via(key, card.Title)
+		if via == "" && title != "" && slices.Contains(n.mentions, title) {
+			via = "title"
+		}
+		if via != "" {
+			notes = append(notes, Ref{Kind: KindNote, Title: n.rel, Path: n.path, Via: via})
+		}
+	}
+
+	var cards []Ref
+	for path, c := range ix.cards {
+		if path == self {
+			continue
+		}
+		if via := c.links.via(key, card.Title); via != "" {
+			ref := c.ref
+			ref.Via = via
+			cards = append(cards, ref)
+		}
+	}
+
+	for _, list := range [][]Ref{tasks, notes, cards} {
+		sort.SliceStable(list, func(i, j int) bool { return list[i].Title < list[j].Title })
+	}
+	return append(append(tasks, notes...), cards...)
+}
+
+// noteCache keeps the links and title mentions of the notes read by any
+// index, so rebuilding an index when the workspace reloads only reads the
+// notes that changed.
+var (
+	noteCacheMu sync.Mutex
+	noteCache   = make(map[string]*noteEntry)
+)
+
+func cachedNote(path string, modTime time.Time, titles uint64) (*noteEntry, bool) {
+	noteCacheMu.Lock()
+	defer noteCacheMu.Unlock()
+	entry, ok := noteCache[path]
+	if !ok || !entry.modTime.Equal(modTime) || entry.titles != titles {
+		return nil, false
+	}
+	return entry, true
+}
+
+func cacheNote(entry *noteEntry) {
+	noteCacheMu.Lock()
+	defer noteCacheMu.Unlock()
+	noteCache[entry.path] = entry
+}
@@ -0,0 +1,107 @@
+package refs
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+func TestBacklinks(t *testing.T) {
+	root := t.TempDir()
+	boardPath := filepath.Join(root, "boards", "platform")
+	card := kanbanmodels.Card{Filename: "deploy-api.md", Title: "Deploy API"}
+	boards := []kanbanmodels.Board{{Name: "Platform", Path: boardPath, Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{
+			card,
+			{Filename: "smoke-tests.md", Title: "Smoke tests", Content: "Run after [[Deploy API]]"},
+			{Filename: "unrelated.md", Title: "Unrelated", Content: "nothing here"},
+		}},
+	}}}
+	tasks := []data.Task{
+		data.ParseTask(`Follow up with ops card:"platform/deploy-api.md"`, "t1", "todo.txt"),
+		data.ParseTask(`Other task`, "t2", "todo.txt"),
+	}
+	notes := map[string]string{
+		"meetings/2026-03-04.md": "We agreed to deploy api on Friday.",
+		"ideas.md":               "See [[deploy-api]] and card:platform/deploy-api.md",
+		"unrelated.md":           "Nothing to do with it.",
+	}
+	var notePaths []string
+	for rel, content := range notes {
+		path := filepath.Join(root, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		notePaths = append(notePaths, path)
+	}
+
+	ix := Build(root, tasks, boards, notePaths)
+	got := ix.Backlinks(boardPath, card)
+
+	want := []struct {
+		kind  Kind
+		title string
+		via   string
+	}{
+		{KindTask, "Follow up with ops", "card:"},
+		{KindNote, "ideas.md", "card:"},
+		{KindNote, filepath.Join("meetings", "2026-03-04.md"), "title"},
+		{KindCard, "Smoke tests", "[[link]]"},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %+v", got)
+	}
+	for i, w := range want {
+		if got[i].Kind != w.kind || got[i].Title != w.title || got[i].Via != w.via {
+			t.Errorf("ref %d: got %v %q via %q, want %v %q via %q", i, got[i].Kind, got[i].Title, got[i].Via, w.kind, w.title, w.via)
+		}
+	}
+
+	// Editing a card updates the index without a rebuild
+	boards[0].Columns[0].Cards[2].Content = "blocked by [[Deploy API|the deploy]]"
+	ix.UpdateBoard(boards[0])
+	if got := ix.Backlinks(boardPath, card); len(got) != len(want)+1 {
+		t.Errorf("expected the edited card to link, got %+v", got)
+	}
+}
+
+func TestBacklinksFollowLaterChanges(t *testing.T) {
+	root := t.TempDir()
+	boardPath := filepath.Join(root, "boards", "platform")
+	board := kanbanmodels.Board{Name: "Platform", Path: boardPath, Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{{Filename: "deploy-api.md", Title: "Deploy API"}}},
+	}}
+	note := filepath.Join(root, "notes.md")
+	if err := os.WriteFile(note, []byte("Rotate the keys before the release."), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	ix := Build(root, nil, []kanbanmodels.Board{board}, []string{note})
+	tasks := []data.Task{
+		data.ParseTask(`Follow up card:"platform/deploy-api.md"`, "t1", filepath.Join(root, "todo.txt")),
+		data.ParseTask(`Elsewhere card:"platform/deploy-api.md"`, "t2", "/other/todo.txt"),
+	}
+	ix.SetTaskSource(func() ([]data.Task, error) { return tasks, nil })
+
+	// Tasks tagged after the index was built are found, the other
+	// workspace's are not
+	card := board.Columns[0].Cards[0]
+	got := ix.Backlinks(boardPath, card)
+	if len(got) != 1 || got[0].Title != "Follow up" {
+		t.Fatalf("got %+v", got)
+	}
+
+	// A card renamed to a title the note mentions is found by title
+	board.Columns[0].Cards[0].Title = "Rotate the keys"
+	ix.UpdateBoard(board)
+	got = ix.Backlinks(boardPath, board.Columns[0].Cards[0])
+	if len(got) != 2 || got[1].Kind != KindNote || got[1].Via != "title" {
+		t.Errorf("after rename got %+v", got)
+	}
+}
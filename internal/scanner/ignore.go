@@ -0,0 +1,156 @@
+package scanner
+
+import (
+	"bufio"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// IgnoreFilename is the per-workspace ignore file, read from the workspace root.
+const IgnoreFilename = ".wydoignore"
+
+// IgnoreRules matches workspace-relative paths against gitignore-style patterns.
+//
+// Supported syntax: blank lines and # comments, ! negation, a trailing / to
+// match directories only, a leading or inner / to anchor the pattern to the
+// workspace root, and the *, ?, [...] and ** wildcards. As with .gitignore,
+// the last matching pattern wins.
+type IgnoreRules struct {
+	patterns []ignorePattern
+}
+
+type ignorePattern struct {
+	re      *regexp.Regexp
+	negate  bool
+	dirOnly bool
+}
+
+// NewIgnoreRules builds rules from a list of patterns.
+func NewIgnoreRules(patterns []string) *IgnoreRules {
+	r := &IgnoreRules{}
+	for _, p := range patterns {
+		r.Add(p)
+	}
+	return r
+}
+
+// LoadIgnoreFile reads patterns from an ignore file. A missing file yields
+// empty rules.
+func LoadIgnoreFile(path string) (*IgnoreRules, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return &IgnoreRules{}, nil
+		}
+		return nil, err
+	}
+	defer f.Close()
+
+	r := &IgnoreRules{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		r.Add(sc.Text())
+	}
+	return r, sc.Err()
+}
+
+// Add appends one pattern. Blank lines and comments are ignored.
+func (r *IgnoreRules) Add(pattern string) {
+	pattern = strings.TrimRight(pattern, " \t\r")
+	if pattern == "" || strings.HasPrefix(pattern, "#") {
+		return
+	}
+
+	p := ignorePattern{}
+	if strings.HasPrefix(pattern, "!") {
+		p.negate = true
+		pattern = pattern[1:]
+	} else if strings.HasPrefix(pattern, `\!`) || strings.HasPrefix(pattern, `\#`) {
+		pattern = pattern[1:]
+	}
+	if strings.HasSuffix(pattern, "/") {
+		p.dirOnly = true
+		pattern = strings.TrimRight(pattern, "/")
+	}
+	if pattern == "" {
+		return
+	}
+
+	// A slash anywhere but the end anchors the pattern to the root;
+	// otherwise it matches the name at any depth.
+	prefix := "(?:.*/)?"
+	if strings.Contains(pattern, "/") {
+		prefix = ""
+		pattern = strings.TrimPrefix(pattern, "/")
+	}
+
+	re, err := regexp.Compile("^" + prefix + globToRegexp(pattern) + "$")
+	if err != nil {
+		return
+	}
+	p.re = re
+	r.patterns = append(r.patterns, p)
+}
+
+// Match reports whether the slash-separated workspace-relative path is ignored.
+func (r *IgnoreRules) Match(relPath string, isDir bool) bool {
+	if r == nil {
+		return false
+	}
+	ignored := false
+	for _, p := range r.patterns {
+		if p.dirOnly && !isDir {
+			continue
+		}
+		if p.re.MatchString(relPath) {
+			ignored = !p.negate
+		}
+	}
+	return ignored
+}
+
+// globToRegexp translates a gitignore glob into a regular expression body.
+func globToRegexp(glob string) string {
+	var sb strings.Builder
+	for i := 0; i < len(glob); i++ {
+		c := glob[i]
+		switch c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				if i+2 < len(glob) && glob[i+2] == '/' {
+					// "**/" matches zero or more directories
+					sb.WriteString("(?:.*/)?")
+					i += 2
+				} else {
+					sb.WriteString(".*")
+					i++
+				}
+			} else {
+				sb.WriteString("[^/]*")
+			}
+		case '?':
+			sb.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i+1:], ']')
+			if end < 0 {
+				sb.WriteString(`\[`)
+				continue
+			}
+			class := glob[i+1 : i+1+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			sb.WriteString("[" + class + "]")
+			i += end + 1
+		case '\\':
+			if i+1 < len(glob) {
+				i++
+				sb.WriteString(regexp.QuoteMeta(string(glob[i])))
+			}
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,51 @@
+package scanner
+
+import "testing"
+
+func TestIgnoreRules_Match(t *testing.T) {
+	rules := NewIgnoreRules([]string{
+		"# comment",
+		"",
+		"*.log",
+		"archive/",
+		"/scratch",
+		"docs/**/drafts",
+		"notes/tmp-?.md",
+		"build-[0-9]",
+		"!keep.log",
+	})
+
+	cases := []struct {
+		path  string
+		isDir bool
+		want  bool
+	}{
+		{"debug.log", false, true},
+		{"notes/deep/debug.log", false, true},
+		{"keep.log", false, false},
+		{"archive", true, true},
+		{"projects/alpha/archive", true, true},
+		{"archive", false, false}, // dir-only pattern
+		{"scratch", true, true},
+		{"notes/scratch", true, false}, // anchored to root
+		{"docs/drafts", true, true},
+		{"docs/a/b/drafts", true, true},
+		{"notes/tmp-1.md", false, true},
+		{"notes/tmp-12.md", false, false},
+		{"build-3", true, true},
+		{"build-x", true, false},
+		{"notes/meeting.md", false, false},
+	}
+	for _, tc := range cases {
+		if got := rules.Match(tc.path, tc.isDir); got != tc.want {
+			t.Errorf("Match(%q, dir=%v) = %v, want %v", tc.path, tc.isDir, got, tc.want)
+		}
+	}
+}
+
+func TestIgnoreRules_NilMatchesNothing(t *testing.T) {
+	var rules *IgnoreRules
+	if rules.Match("anything", true) {
+		t.Error("nil rules should not ignore anything")
+	}
+}
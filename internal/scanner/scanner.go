@@ -24,6 +24,16 @@ type BoardInfo struct {
 type TaskDirInfo struct {
 	DirPath string   // absolute path to the tasks/ directory
 	Files   []string // .txt filenames found within
+
+	// The workspace root and ignore rules it was scanned with, for ListFiles
+	rootDir string
+	ignore  *IgnoreRules
+}
+
+// ListFiles lists the directory's .txt files again, such as a done file
+// created since the scan, skipping those the workspace ignores.
+func (td TaskDirInfo) ListFiles() ([]string, error) {
+	return listTaskFiles(td.DirPath, td.rootDir, td.ignore)
 }
 
 // ProjectInfo describes a discovered project directory
@@ -33,8 +43,11 @@ type ProjectInfo struct {
 	Parent string // parent project name if nested
 }
 
-// ScanWorkspace recursively scans a single workspace directory
-func ScanWorkspace(rootDir string) (*WorkspaceScan, error) {
+// ScanWorkspace recursively scans a single workspace directory.
+// Paths matched by the extra ignore patterns (e.g. from config) or by the
+// workspace's .wydoignore are skipped; .wydoignore is applied last so it can
+// re-include paths with "!".
+func ScanWorkspace(rootDir string, ignore ...string) (*WorkspaceScan, error) {
 	absRoot, err := filepath.Abs(rootDir)
 	if err != nil {
 		return nil, err
@@ -44,7 +57,14 @@ func ScanWorkspace(rootDir string) (*WorkspaceScan, error) {
 		RootDir: absRoot,
 	}
 
-	err = walkWorkspace(absRoot, absRoot, "", scan)
+	rules := NewIgnoreRules(ignore)
+	fileRules, err := LoadIgnoreFile(filepath.Join(absRoot, IgnoreFilename))
+	if err != nil {
+		return nil, err
+	}
+	rules.patterns = append(rules.patterns, fileRules.patterns...)
+
+	err = walkWorkspace(absRoot, absRoot, "", rules, scan)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +73,7 @@ func ScanWorkspace(rootDir string) (*WorkspaceScan, error) {
 }
 
 // walkWorkspace recursively walks a directory, tracking project context
-func walkWorkspace(dir, rootDir, projectContext string, scan *WorkspaceScan) error {
+func walkWorkspace(dir, rootDir, projectContext string, ignore *IgnoreRules, scan *WorkspaceScan) error {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -70,23 +90,26 @@ func walkWorkspace(dir, rootDir, projectContext string, scan *WorkspaceScan) err
 		if entry.IsDir() && shouldSkipDir(name) {
 			continue
 		}
+		if isIgnored(ignore, rootDir, absPath, entry.IsDir()) {
+			continue
+		}
 
 		if entry.IsDir() {
 			switch name {
 			case "boards":
 				if dir == rootDir {
-					if err := scanBoardsDir(absPath, scan); err != nil {
+					if err := scanBoardsDir(absPath, rootDir, ignore, scan); err != nil {
 						return err
 					}
 				}
 			case "tasks":
 				if dir == rootDir {
-					if err := scanTasksDir(absPath, scan); err != nil {
+					if err := scanTasksDir(absPath, rootDir, ignore, scan); err != nil {
 						return err
 					}
 				}
 			case "projects":
-				if err := scanProjectsDir(absPath, rootDir, projectContext, scan); err != nil {
+				if err := scanProjectsDir(absPath, rootDir, projectContext, ignore, scan); err != nil {
 					return err
 				}
 			case "cards":
@@ -94,7 +117,7 @@ func walkWorkspace(dir, rootDir, projectContext string, scan *WorkspaceScan) err
 				continue
 			default:
 				// Recurse into other directories (e.g. notes/)
-				if err := walkWorkspace(absPath, rootDir, projectContext, scan); err != nil {
+				if err := walkWorkspace(absPath, rootDir, projectContext, ignore, scan); err != nil {
 					return err
 				}
 			}
@@ -107,7 +130,7 @@ func walkWorkspace(dir, rootDir, projectContext string, scan *WorkspaceScan) err
 }
 
 // scanBoardsDir scans a boards/ directory for board subdirectories
-func scanBoardsDir(boardsDir string, scan *WorkspaceScan) error {
+func scanBoardsDir(boardsDir, rootDir string, ignore *IgnoreRules, scan *WorkspaceScan) error {
 	entries, err := os.ReadDir(boardsDir)
 	if err != nil {
 		return err
@@ -119,6 +142,9 @@ func scanBoardsDir(boardsDir string, scan *WorkspaceScan) error {
 		}
 
 		boardPath := filepath.Join(boardsDir, entry.Name())
+		if isIgnored(ignore, rootDir, boardPath, true) {
+			continue
+		}
 		boardFile := filepath.Join(boardPath, "board.md")
 
 		if _, err := os.Stat(boardFile); err == nil {
@@ -132,34 +158,49 @@ func scanBoardsDir(boardsDir string, scan *WorkspaceScan) error {
 }
 
 // scanTasksDir scans a tasks/ directory for .txt files
-func scanTasksDir(tasksDir string, scan *WorkspaceScan) error {
-	entries, err := os.ReadDir(tasksDir)
+func scanTasksDir(tasksDir, rootDir string, ignore *IgnoreRules, scan *WorkspaceScan) error {
+	files, err := listTaskFiles(tasksDir, rootDir, ignore)
 	if err != nil {
 		return err
 	}
 
+	if len(files) > 0 {
+		scan.TaskDirs = append(scan.TaskDirs, TaskDirInfo{
+			DirPath: tasksDir,
+			Files:   files,
+			rootDir: rootDir,
+			ignore:  ignore,
+		})
+	}
+
+	return nil
+}
+
+// listTaskFiles returns the names of the .txt files in tasksDir that the
+// ignore rules keep.
+func listTaskFiles(tasksDir, rootDir string, ignore *IgnoreRules) ([]string, error) {
+	entries, err := os.ReadDir(tasksDir)
+	if err != nil {
+		return nil, err
+	}
+
 	var files []string
 	for _, entry := range entries {
 		if entry.IsDir() {
 			continue
 		}
+		if isIgnored(ignore, rootDir, filepath.Join(tasksDir, entry.Name()), false) {
+			continue
+		}
 		if strings.HasSuffix(strings.ToLower(entry.Name()), ".txt") {
 			files = append(files, entry.Name())
 		}
 	}
-
-	if len(files) > 0 {
-		scan.TaskDirs = append(scan.TaskDirs, TaskDirInfo{
-			DirPath: tasksDir,
-			Files:   files,
-		})
-	}
-
-	return nil
+	return files, nil
 }
 
 // scanProjectsDir scans a projects/ directory, where each subdirectory is a project
-func scanProjectsDir(projectsDir, rootDir, parentProject string, scan *WorkspaceScan) error {
+func scanProjectsDir(projectsDir, rootDir, parentProject string, ignore *IgnoreRules, scan *WorkspaceScan) error {
 	entries, err := os.ReadDir(projectsDir)
 	if err != nil {
 		return err
@@ -175,6 +216,9 @@ func scanProjectsDir(projectsDir, rootDir, parentProject string, scan *Workspace
 
 		projectName := entry.Name()
 		projectPath := filepath.Join(projectsDir, projectName)
+		if isIgnored(ignore, rootDir, projectPath, true) {
+			continue
+		}
 
 		scan.Projects = append(scan.Projects, ProjectInfo{
 			Name:   projectName,
@@ -183,7 +227,7 @@ func scanProjectsDir(projectsDir, rootDir, parentProject string, scan *Workspace
 		})
 
 		// Recurse into the project directory with this project as context
-		if err := walkWorkspace(projectPath, rootDir, projectName, scan); err != nil {
+		if err := walkWorkspace(projectPath, rootDir, projectName, ignore, scan); err != nil {
 			return err
 		}
 	}
@@ -206,6 +250,15 @@ func isNoteFile(name, dir string) bool {
 	return true
 }
 
+// isIgnored reports whether absPath is excluded by the workspace ignore rules
+func isIgnored(ignore *IgnoreRules, rootDir, absPath string, isDir bool) bool {
+	rel, err := filepath.Rel(rootDir, absPath)
+	if err != nil {
+		return false
+	}
+	return ignore.Match(filepath.ToSlash(rel), isDir)
+}
+
 // shouldSkipDir returns true for directories that should be skipped during scanning
 func shouldSkipDir(name string) bool {
 	if strings.HasPrefix(name, ".") {
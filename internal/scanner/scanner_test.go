@@ -222,3 +222,40 @@ func TestScanWorkspace_TasksOnlyAtRoot(t *testing.T) {
 		t.Fatalf("expected exactly 1 task dir (root only), got %d", len(scan.TaskDirs))
 	}
 }
+
+func TestScanWorkspace_IgnoreRules(t *testing.T) {
+	tmp := t.TempDir()
+
+	os.MkdirAll(filepath.Join(tmp, "notes", "generated"), 0755)
+	os.WriteFile(filepath.Join(tmp, "notes", "keep.md"), []byte("# keep\n"), 0644)
+	os.WriteFile(filepath.Join(tmp, "notes", "generated", "out.md"), []byte("# out\n"), 0644)
+	os.MkdirAll(filepath.Join(tmp, "vendored-docs"), 0755)
+	os.WriteFile(filepath.Join(tmp, "vendored-docs", "readme.md"), []byte("# readme\n"), 0644)
+	os.MkdirAll(filepath.Join(tmp, "boards", "old"), 0755)
+	os.WriteFile(filepath.Join(tmp, "boards", "old", "board.md"), []byte("# old\n"), 0644)
+	os.MkdirAll(filepath.Join(tmp, "tasks"), 0755)
+	os.WriteFile(filepath.Join(tmp, "tasks", "todo.txt"), []byte("task\n"), 0644)
+	os.WriteFile(filepath.Join(tmp, "tasks", "scratch.txt"), []byte("scratch\n"), 0644)
+
+	os.WriteFile(filepath.Join(tmp, IgnoreFilename), []byte("generated/\nboards/old\ntasks/scratch.txt\n!vendored-docs/\n"), 0644)
+
+	scan, err := ScanWorkspace(tmp, "vendored-*/")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(scan.NotePaths) != 2 {
+		t.Fatalf("expected keep.md and readme.md (re-included by .wydoignore), got %v", scan.NotePaths)
+	}
+	for _, n := range scan.NotePaths {
+		if filepath.Base(n) == "out.md" {
+			t.Errorf("expected generated/ to be ignored, found %s", n)
+		}
+	}
+	if len(scan.Boards) != 0 {
+		t.Errorf("expected ignored board to be skipped, got %d boards", len(scan.Boards))
+	}
+	if len(scan.TaskDirs) != 1 || len(scan.TaskDirs[0].Files) != 1 || scan.TaskDirs[0].Files[0] != "todo.txt" {
+		t.Errorf("expected only todo.txt, got %+v", scan.TaskDirs)
+	}
+}
@@ -0,0 +1,176 @@
+package state
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"wydo/internal/config"
+)
+
+const (
+	stateFilename    = "state.json"
+	maxRecentBoards  = 10
+	maxSearchHistory = 50
+)
+
+// State is data wydo records on its own between runs, stored in
+// $XDG_STATE_HOME/wydo/state.json. Unlike config.json it is never hand-edited.
+type State struct {
+	RecentBoards  []string `json:"recent_boards,omitempty"`  // board paths, most recent first
+	SearchHistory []string `json:"search_history,omitempty"` // task search queries, most recent first
+	TourCompleted bool     `json:"tour_completed,omitempty"` // onboarding tour finished or skipped
+	Session       *Session `json:"session,omitempty"`        // where the TUI was when it last quit
+	MyDay         *MyDay   `json:"my_day,omitempty"`         // items picked for the day's My Day list
+
+	BoardVisits map[string]BoardVisit `json:"board_visits,omitempty"` // last visit of each board, by board path
+
+	path string
+}
+
+// Session is the TUI position saved on quit and restored on the next start.
+type Session struct {
+	View       string          `json:"view,omitempty"`        // view name as accepted by --view, or "board"
+	BoardPath  string          `json:"board_path,omitempty"`  // board open in the board view
+	ColIndex   int             `json:"col_index,omitempty"`   // selected column on that board
+	CardIndex  int             `json:"card_index,omitempty"`  // selected card in that column
+	AgendaDate string          `json:"agenda_date,omitempty"` // date shown by the agenda views (2006-01-02)
+	TaskFilter json.RawMessage `json:"task_filter,omitempty"` // task manager filters, owned by the tasks view
+}
+
+// MyDay is the hand-picked list of tasks and cards to work on one day. It
+// belongs to that day: at midnight the list is dropped, and anything still
+// wanted has to be picked again.
+type MyDay struct {
+	Date  string   `json:"date"`            // day the list is for (2006-01-02)
+	Items []string `json:"items,omitempty"` // item keys, in the order picked
+}
+
+// BoardVisit is what a board held when it was last looked at, so that cards
+// which arrived since, from a sync or another tool, can be pointed out.
+type BoardVisit struct {
+	At    time.Time           `json:"at"`
+	Cards map[string][]string `json:"cards,omitempty"` // card files by column name
+}
+
+// Load reads the state file, returning an empty State if it does not exist.
+func Load() (*State, error) {
+	dir, err := config.StateDir()
+	if err != nil {
+		return &State{}, err
+	}
+	return LoadFrom(filepath.Join(dir, stateFilename))
+}
+
+// LoadFrom reads state from path, returning an empty State if it does not exist.
+func LoadFrom(path string) (*State, error) {
+	s := &State{path: path}
+	content, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return s, nil
+	}
+	if err != nil {
+		return s, err
+	}
+	if err := json.Unmarshal(content, s); err != nil {
+		return s, err
+	}
+	return s, nil
+}
+
+// Save writes the state file, creating the state directory if needed.
+func (s *State) Save() error {
+	if s.path == "" {
+		return nil
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0755); err != nil {
+		return err
+	}
+	content, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, content, 0644)
+}
+
+// AddRecentBoard moves path to the front of the recent boards list.
+func (s *State) AddRecentBoard(path string) {
+	s.RecentBoards = pushFront(s.RecentBoards, path, maxRecentBoards)
+}
+
+// RenameRecentBoard replaces oldPath with newPath in the recent boards list
+// and the board visits, or drops it when newPath is empty (the board was
+// deleted).
+func (s *State) RenameRecentBoard(oldPath, newPath string) {
+	if visit, ok := s.BoardVisits[oldPath]; ok {
+		delete(s.BoardVisits, oldPath)
+		if newPath != "" {
+			s.BoardVisits[newPath] = visit
+		}
+	}
+
+	var result []string
+	for _, p := range s.RecentBoards {
+		if p == oldPath {
+			if newPath == "" {
+				continue
+			}
+			p = newPath
+		}
+		result = append(result, p)
+	}
+	s.RecentBoards = result
+}
+
+// RecordBoardVisit notes that the board at path held cards, by column, at.
+func (s *State) RecordBoardVisit(path string, cards map[string][]string, at time.Time) {
+	if s.BoardVisits == nil {
+		s.BoardVisits = make(map[string]BoardVisit)
+	}
+	s.BoardVisits[path] = BoardVisit{At: at, Cards: cards}
+}
+
+// AddSearch moves query to the front of the search history.
+func (s *State) AddSearch(query string) {
+	s.SearchHistory = pushFront(s.SearchHistory, query, maxSearchHistory)
+}
+
+// MyDayItems returns the keys of the items picked for My Day on today, or nil
+// when the list was made on another day.
+func (s *State) MyDayItems(today time.Time) []string {
+	if s.MyDay == nil || s.MyDay.Date != today.Format("2006-01-02") {
+		return nil
+	}
+	return s.MyDay.Items
+}
+
+// ToggleMyDay adds key to today's My Day list, or removes it when it is
+// already there, and reports whether it was added. A list left from an
+// earlier day is dropped first.
+func (s *State) ToggleMyDay(key string, today time.Time) bool {
+	items := s.MyDayItems(today)
+	added := !slices.Contains(items, key)
+	if added {
+		items = append(slices.Clone(items), key)
+	} else {
+		items = slices.DeleteFunc(slices.Clone(items), func(k string) bool { return k == key })
+	}
+	s.MyDay = &MyDay{Date: today.Format("2006-01-02"), Items: items}
+	return added
+}
+
+// pushFront prepends v to list, dropping any earlier copy and capping the length.
+func pushFront(list []string, v string, limit int) []string {
+	if v == "" {
+		return list
+	}
+	result := []string{v}
+	for _, item := range list {
+		if item != v && len(result) < limit {
+			result = append(result, item)
+		}
+	}
+	return result
+}
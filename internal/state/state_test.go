@@ -0,0 +1,127 @@
+package state
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestAddRecentBoard_DedupesAndCaps(t *testing.T) {
+	s := &State{}
+	for i := 0; i < maxRecentBoards+3; i++ {
+		s.AddRecentBoard(filepath.Join("/boards", string(rune('a'+i))))
+	}
+	s.AddRecentBoard("/boards/c")
+
+	if len(s.RecentBoards) != maxRecentBoards {
+		t.Fatalf("expected %d recent boards, got %d", maxRecentBoards, len(s.RecentBoards))
+	}
+	if s.RecentBoards[0] != "/boards/c" {
+		t.Errorf("expected /boards/c first, got %q", s.RecentBoards[0])
+	}
+	seen := map[string]bool{}
+	for _, b := range s.RecentBoards {
+		if seen[b] {
+			t.Errorf("duplicate entry %q", b)
+		}
+		seen[b] = true
+	}
+}
+
+func TestRenameRecentBoard(t *testing.T) {
+	s := &State{RecentBoards: []string{"/boards/a", "/boards/b", "/boards/c"}}
+
+	s.RenameRecentBoard("/boards/b", "/boards/renamed")
+	if got := s.RecentBoards; len(got) != 3 || got[1] != "/boards/renamed" {
+		t.Errorf("rename: got %v", got)
+	}
+
+	s.RenameRecentBoard("/boards/a", "")
+	if got := s.RecentBoards; len(got) != 2 || got[0] != "/boards/renamed" {
+		t.Errorf("delete: got %v", got)
+	}
+}
+
+func TestSaveLoadRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wydo", stateFilename)
+
+	s, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom missing file: %v", err)
+	}
+	s.AddRecentBoard("/ws/boards/a/board.md")
+	s.AddSearch("release")
+	s.Session = &Session{View: "board", BoardPath: "/ws/boards/a", ColIndex: 2, CardIndex: 1, AgendaDate: "2026-10-15", TaskFilter: []byte(`{"status":1}`)}
+	if err := s.Save(); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded, err := LoadFrom(path)
+	if err != nil {
+		t.Fatalf("LoadFrom: %v", err)
+	}
+	if len(loaded.RecentBoards) != 1 || loaded.RecentBoards[0] != "/ws/boards/a/board.md" {
+		t.Errorf("unexpected recent boards: %v", loaded.RecentBoards)
+	}
+	if len(loaded.SearchHistory) != 1 || loaded.SearchHistory[0] != "release" {
+		t.Errorf("unexpected search history: %v", loaded.SearchHistory)
+	}
+	if got := loaded.Session; got == nil || got.View != "board" || got.BoardPath != "/ws/boards/a" ||
+		got.ColIndex != 2 || got.CardIndex != 1 || got.AgendaDate != "2026-10-15" || compactJSON(t, got.TaskFilter) != `{"status":1}` {
+		t.Errorf("unexpected session: %+v", got)
+	}
+}
+
+func compactJSON(t *testing.T, raw []byte) string {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, raw); err != nil {
+		t.Fatalf("Compact: %v", err)
+	}
+	return buf.String()
+}
+
+func TestToggleMyDay_FallsOffNextDay(t *testing.T) {
+	s := &State{}
+	today := time.Date(2026, 3, 4, 9, 0, 0, 0, time.Local)
+
+	if !s.ToggleMyDay("task:Write report", today) || !s.ToggleMyDay("card:/b/cards/a.md", today) {
+		t.Fatal("expected the items to be added")
+	}
+	if s.ToggleMyDay("task:Write report", today) {
+		t.Error("expected the second toggle to remove the item")
+	}
+	if got := s.MyDayItems(today); len(got) != 1 || got[0] != "card:/b/cards/a.md" {
+		t.Errorf("today: got %v", got)
+	}
+
+	tomorrow := today.AddDate(0, 0, 1)
+	if got := s.MyDayItems(tomorrow); got != nil {
+		t.Errorf("expected yesterday's list to have fallen off, got %v", got)
+	}
+	s.ToggleMyDay("task:Call Sam", tomorrow)
+	if got := s.MyDayItems(tomorrow); len(got) != 1 || got[0] != "task:Call Sam" {
+		t.Errorf("tomorrow: got %v", got)
+	}
+}
+
+func TestRenameRecentBoard_MovesVisit(t *testing.T) {
+	s := &State{}
+	at := time.Date(2026, 10, 15, 9, 0, 0, 0, time.UTC)
+	s.RecordBoardVisit("/boards/a", map[string][]string{"To Do": {"x.md"}}, at)
+
+	s.RenameRecentBoard("/boards/a", "/boards/b")
+	if _, ok := s.BoardVisits["/boards/a"]; ok {
+		t.Error("expected the visit moved off the old path")
+	}
+	if got := s.BoardVisits["/boards/b"]; !got.At.Equal(at) || len(got.Cards["To Do"]) != 1 {
+		t.Errorf("visit under the new path = %+v", got)
+	}
+
+	s.RenameRecentBoard("/boards/b", "")
+	if len(s.BoardVisits) != 0 {
+		t.Errorf("expected the visit dropped with the board, got %v", s.BoardVisits)
+	}
+}
@@ -0,0 +1,89 @@
+package stats
+
+import (
+	"sort"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+// ActivityKind is what happened to a card in a board's activity feed.
+type ActivityKind int
+
+const (
+	ActivityCreated ActivityKind = iota
+	ActivityMoved
+	ActivityCompleted
+	ActivityDueChanged
+)
+
+// Activity is one change to a card, read from its frontmatter history.
+type Activity struct {
+	At     time.Time
+	Kind   ActivityKind
+	Card   kanbanmodels.Card
+	Column string // the column the card is in now
+	// From and To are columns for moves and completions (From is empty for
+	// a created card) and YYYY-MM-DD dates for due changes ("" for none).
+	From, To string
+}
+
+// BoardActivity lists the changes recorded on the board's cards, newest
+// first: each card's creation and moves from its column history (a move
+// into a done column counts as completing it) and its due date changes.
+// Archived cards are included; cards without history have no activity.
+func BoardActivity(board kanbanmodels.Board) []Activity {
+	var feed []Activity
+	for _, col := range board.Columns {
+		for _, card := range col.Cards {
+			for i, h := range card.History {
+				a := Activity{At: h.At, Kind: ActivityCreated, Card: card, Column: col.Name, To: h.Column}
+				if i > 0 {
+					a.From = card.History[i-1].Column
+					a.Kind = ActivityMoved
+					if board.IsDoneColumn(h.Column) {
+						a.Kind = ActivityCompleted
+					}
+				}
+				feed = append(feed, a)
+			}
+			for _, d := range card.DueHistory {
+				feed = append(feed, Activity{At: d.At, Kind: ActivityDueChanged, Card: card, Column: col.Name, From: d.From, To: d.To})
+			}
+		}
+	}
+	sort.SliceStable(feed, func(i, j int) bool { return feed[i].At.After(feed[j].At) })
+	return feed
+}
+
+// String describes the change, e.g. "moved To Do → Doing" or "due Mar 4 →
+// Mar 9".
+func (a Activity) String() string {
+	switch a.Kind {
+	case ActivityCreated:
+		return "created in " + a.To
+	case ActivityMoved:
+		return "moved " + a.From + " → " + a.To
+	case ActivityCompleted:
+		return "completed (" + a.From + " → " + a.To + ")"
+	case ActivityDueChanged:
+		switch {
+		case a.From == "":
+			return "due set to " + shortDate(a.To)
+		case a.To == "":
+			return "due cleared (was " + shortDate(a.From) + ")"
+		default:
+			return "due " + shortDate(a.From) + " → " + shortDate(a.To)
+		}
+	}
+	return ""
+}
+
+// shortDate formats a YYYY-MM-DD date as "Mar 4", leaving anything else as
+// it is.
+func shortDate(date string) string {
+	if t, err := time.Parse("2006-01-02", date); err == nil {
+		return t.Format("Jan 2")
+	}
+	return date
+}
@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+func TestBoardActivity(t *testing.T) {
+	start := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time { return start.Add(time.Duration(hours) * time.Hour) }
+
+	board := kanbanmodels.Board{Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{
+			{Title: "Write docs", History: []kanbanmodels.ColumnEntry{{Column: "To Do", At: at(1)}},
+				DueHistory: []kanbanmodels.DueChange{{To: "2026-05-08", At: at(2)}, {From: "2026-05-08", To: "2026-05-11", At: at(5)}, {From: "2026-05-11", At: at(6)}}},
+			{Title: "No history"},
+		}},
+		{Name: "Done", Cards: []kanbanmodels.Card{
+			{Title: "Ship", History: []kanbanmodels.ColumnEntry{
+				{Column: "To Do", At: at(0)},
+				{Column: "Doing", At: at(3)},
+				{Column: "Done", At: at(4)},
+			}},
+		}},
+	}}
+
+	var got []string
+	for _, a := range BoardActivity(board) {
+		got = append(got, a.Card.Title+": "+a.String())
+	}
+	want := []string{
+		"Write docs: due cleared (was May 11)",
+		"Write docs: due May 8 → May 11",
+		"Ship: completed (Doing → Done)",
+		"Ship: moved To Do → Doing",
+		"Write docs: due set to May 8",
+		"Write docs: created in To Do",
+		"Ship: created in To Do",
+	}
+	if strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("feed:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
@@ -0,0 +1,109 @@
+package stats
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"wydo/internal/agenda"
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+// BoardSummary is the at-a-glance state of a board the board picker shows
+// for the highlighted board. Cards parked by agenda_exclude are not counted
+// as due or overdue.
+type BoardSummary struct {
+	Columns     int
+	Cards       int       // cards not archived
+	DueThisWeek int       // open cards due from today to Sunday
+	Overdue     int       // open cards due or scheduled before today, as in the day agenda
+	Modified    time.Time // latest change to board.md or a card file; zero if unknown
+}
+
+// CollectBoardSummary summarizes board as of now. Modified is read from the
+// files on disk.
+func CollectBoardSummary(board kanbanmodels.Board, now time.Time) BoardSummary {
+	s := BoardSummary{Columns: len(board.Columns), Modified: boardModified(board.Path)}
+	for _, col := range board.Columns {
+		for _, card := range col.Cards {
+			if !card.Archived {
+				s.Cards++
+			}
+		}
+	}
+
+	// The agenda leaves archived boards out; an archived board is still
+	// summarized for itself
+	board.Archived = false
+	boards := []kanbanmodels.Board{board}
+	rest := agenda.DateRange{Start: startOfDay(now), End: agenda.WeekRange(now).End}
+	for _, bucket := range agenda.ExcludeBuckets(agenda.QueryAgenda(nil, boards, nil, nil, rest)) {
+		for _, item := range bucket.AllItems() {
+			if item.Reason == agenda.ReasonDue && !item.Completed {
+				s.DueThisWeek++
+			}
+		}
+	}
+	s.Overdue = len(agenda.ExcludeItems(agenda.QueryOverdueSources([]agenda.AgendaSource{agenda.CardSource{Boards: boards}}, startOfDay(now))))
+	return s
+}
+
+// boardModified returns the latest modification time of board.md and the
+// files in cards/.
+func boardModified(boardPath string) time.Time {
+	var latest time.Time
+	if info, err := os.Stat(filepath.Join(boardPath, "board.md")); err == nil {
+		latest = info.ModTime()
+	}
+	entries, err := os.ReadDir(filepath.Join(boardPath, "cards"))
+	if err != nil {
+		return latest
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		if info, err := e.Info(); err == nil && info.ModTime().After(latest) {
+			latest = info.ModTime()
+		}
+	}
+	return latest
+}
+
+// String formats the summary for one line, e.g. "4 columns · 23 cards ·
+// 3 due this week · 1 overdue · changed 2h ago".
+func (s BoardSummary) String(now time.Time) string {
+	parts := []string{
+		plural(s.Columns, "column"),
+		plural(s.Cards, "card"),
+	}
+	if s.DueThisWeek > 0 {
+		parts = append(parts, fmt.Sprintf("%d due this week", s.DueThisWeek))
+	}
+	if s.Overdue > 0 {
+		parts = append(parts, fmt.Sprintf("%d overdue", s.Overdue))
+	}
+	if !s.Modified.IsZero() {
+		parts = append(parts, "changed "+FormatAge(now.Sub(s.Modified)))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// FormatAge formats how long ago something happened, e.g. "just now",
+// "5m ago", "3h ago", "2d ago" or "6w ago".
+func FormatAge(d time.Duration) string {
+	switch {
+	case d < time.Minute:
+		return "just now"
+	case d < time.Hour:
+		return fmt.Sprintf("%dm ago", int(d/time.Minute))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh ago", int(d/time.Hour))
+	case d < 14*24*time.Hour:
+		return fmt.Sprintf("%dd ago", int(d/(24*time.Hour)))
+	default:
+		return fmt.Sprintf("%dw ago", int(d/(7*24*time.Hour)))
+	}
+}
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+func TestCollectBoardSummary(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	modified := time.Date(2026, 5, 1, 12, 0, 0, 0, time.Local)
+	for _, name := range []string{"board.md", "cards/a.md"} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, nil, 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modified, modified.Add(-time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := os.Chtimes(filepath.Join(dir, "cards/a.md"), modified, modified); err != nil {
+		t.Fatal(err)
+	}
+
+	// Wednesday; the week runs to Sunday the 10th
+	now := time.Date(2026, 5, 6, 9, 0, 0, 0, time.Local)
+	day := func(d int) *time.Time {
+		t := time.Date(2026, 5, d, 0, 0, 0, 0, time.UTC)
+		return &t
+	}
+	board := kanbanmodels.Board{
+		Path:     dir,
+		Archived: true,
+		Columns: []kanbanmodels.Column{
+			{Name: "To Do", Cards: []kanbanmodels.Card{
+				{Title: "due friday", DueDate: day(8)},
+				{Title: "due today", DueDate: day(6)},
+				{Title: "due monday", DueDate: day(4)},
+				{Title: "next week", DueDate: day(12)},
+				{Title: "archived", DueDate: day(7), Archived: true},
+			}},
+			{Name: "Done", Cards: []kanbanmodels.Card{
+				{Title: "done late", DueDate: day(1)},
+			}},
+		},
+	}
+
+	s := CollectBoardSummary(board, now)
+	want := BoardSummary{Columns: 2, Cards: 5, DueThisWeek: 2, Overdue: 1, Modified: modified}
+	if !s.Modified.Equal(want.Modified) {
+		t.Errorf("Modified = %v, want %v", s.Modified, want.Modified)
+	}
+	s.Modified = want.Modified
+	if s != want {
+		t.Errorf("got %+v, want %+v", s, want)
+	}
+	if got := s.String(modified.Add(3 * time.Hour)); got != "2 columns · 5 cards · 2 due this week · 1 overdue · changed 3h ago" {
+		t.Errorf("String() = %q", got)
+	}
+}
@@ -0,0 +1,46 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"wydo/internal/agenda"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/service"
+)
+
+// DueSoon counts the open tasks and cards that need attention now. The TUI
+// keeps it on the status bar in every view.
+type DueSoon struct {
+	Today   int // due today
+	Overdue int // due or scheduled before today, as in the day agenda
+}
+
+// CollectDueSoon counts the pending tasks and cards due on now's day and
+// those overdue before it.
+func CollectDueSoon(taskSvc service.TaskService, boards []kanbanmodels.Board, now time.Time) DueSoon {
+	var d DueSoon
+	for _, bucket := range agenda.ExcludeBuckets(agenda.QueryAgenda(taskSvc, boards, nil, nil, agenda.DayRange(now))) {
+		for _, item := range bucket.AllItems() {
+			if item.Reason == agenda.ReasonDue && !item.Completed {
+				d.Today++
+			}
+		}
+	}
+	d.Overdue = len(agenda.ExcludeItems(agenda.QueryOverdueItems(taskSvc, boards, startOfDay(now))))
+	return d
+}
+
+// String formats the non-zero counts, e.g. "3 due today, 2 overdue", or
+// returns "" when nothing is due.
+func (d DueSoon) String() string {
+	var parts []string
+	if d.Today > 0 {
+		parts = append(parts, fmt.Sprintf("%d due today", d.Today))
+	}
+	if d.Overdue > 0 {
+		parts = append(parts, fmt.Sprintf("%d overdue", d.Overdue))
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,146 @@
+package stats
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+// CardFlow is how long a finished card took, measured from its column
+// history.
+type CardFlow struct {
+	Card     kanbanmodels.Card
+	Column   string        // the done column the card is in
+	Lead     time.Duration // from its first history entry to completion
+	Cycle    time.Duration // from when work started to completion
+	Started  bool          // Cycle is known: the card entered a column past the new-card one
+	Finished time.Time
+}
+
+// Flow is the cycle and lead times of a board's finished cards.
+type Flow struct {
+	Cards       []CardFlow // oldest completion first
+	AvgLead     time.Duration
+	MedianLead  time.Duration
+	AvgCycle    time.Duration
+	MedianCycle time.Duration
+	Cycled      int // cards with a cycle time
+}
+
+// CardFlowTimes returns the lead and cycle time of a card in one of the
+// board's done columns. Lead time starts when the card first entered the
+// board. Cycle time starts when it first entered a column other than the
+// new-card column (default_new_column, else the first), i.e. when work on
+// it began. A card finishes at its date_completed, or else when it last
+// entered a done column. ok is false for unfinished cards and cards without
+// history.
+func CardFlowTimes(board kanbanmodels.Board, column string, card kanbanmodels.Card) (flow CardFlow, ok bool) {
+	if !board.IsDoneColumn(column) || len(card.History) == 0 {
+		return CardFlow{}, false
+	}
+
+	var finished time.Time
+	if card.DateCompleted != nil {
+		finished = *card.DateCompleted
+	} else {
+		for _, h := range card.History {
+			if board.IsDoneColumn(h.Column) {
+				finished = h.At
+			}
+		}
+		if finished.IsZero() {
+			return CardFlow{}, false
+		}
+	}
+
+	flow = CardFlow{Card: card, Column: column, Finished: finished}
+	flow.Lead = nonNegative(finished.Sub(card.History[0].At))
+
+	newCol := ""
+	if i := board.NewCardColumnIndex(); i < len(board.Columns) {
+		newCol = board.Columns[i].Name
+	}
+	for _, h := range card.History {
+		if h.Column != newCol {
+			flow.Cycle = nonNegative(finished.Sub(h.At))
+			flow.Started = true
+			break
+		}
+	}
+	return flow, true
+}
+
+// BoardFlow collects the flow of every finished card with history on the
+// board, archived ones included, and their average and median times.
+func BoardFlow(board kanbanmodels.Board) Flow {
+	var f Flow
+	for _, col := range board.Columns {
+		for _, card := range col.Cards {
+			if cf, ok := CardFlowTimes(board, col.Name, card); ok {
+				f.Cards = append(f.Cards, cf)
+			}
+		}
+	}
+	sort.SliceStable(f.Cards, func(i, j int) bool {
+		return f.Cards[i].Finished.Before(f.Cards[j].Finished)
+	})
+
+	var leads, cycles []time.Duration
+	for _, cf := range f.Cards {
+		leads = append(leads, cf.Lead)
+		if cf.Started {
+			cycles = append(cycles, cf.Cycle)
+		}
+	}
+	f.AvgLead, f.MedianLead = avgMedian(leads)
+	f.AvgCycle, f.MedianCycle = avgMedian(cycles)
+	f.Cycled = len(cycles)
+	return f
+}
+
+func avgMedian(ds []time.Duration) (avg, median time.Duration) {
+	if len(ds) == 0 {
+		return 0, 0
+	}
+	sorted := append([]time.Duration(nil), ds...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, d := range sorted {
+		total += d
+	}
+	avg = total / time.Duration(len(sorted))
+
+	mid := len(sorted) / 2
+	median = sorted[mid]
+	if len(sorted)%2 == 0 {
+		median = (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return avg, median
+}
+
+func nonNegative(d time.Duration) time.Duration {
+	if d < 0 {
+		return 0
+	}
+	return d
+}
+
+// FormatFlowDuration formats a lead or cycle time in days and hours, e.g.
+// "3d 4h", "5h" or "<1h".
+func FormatFlowDuration(d time.Duration) string {
+	days := int(d / (24 * time.Hour))
+	hours := int(d % (24 * time.Hour) / time.Hour)
+	switch {
+	case days > 0 && hours > 0:
+		return fmt.Sprintf("%dd %dh", days, hours)
+	case days > 0:
+		return fmt.Sprintf("%dd", days)
+	case hours > 0:
+		return fmt.Sprintf("%dh", hours)
+	default:
+		return "<1h"
+	}
+}
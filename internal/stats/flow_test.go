@@ -0,0 +1,83 @@
+package stats
+
+import (
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+)
+
+func TestBoardFlow(t *testing.T) {
+	start := time.Date(2026, 5, 4, 9, 0, 0, 0, time.UTC)
+	at := func(hours int) time.Time { return start.Add(time.Duration(hours) * time.Hour) }
+	completed := at(100)
+
+	board := kanbanmodels.Board{
+		DefaultNewColumn: "Backlog",
+		Columns: []kanbanmodels.Column{
+			{Name: "To Do"},
+			{Name: "Backlog", Cards: []kanbanmodels.Card{
+				{Title: "unfinished", History: []kanbanmodels.ColumnEntry{{Column: "Backlog", At: at(0)}}},
+			}},
+			{Name: "Done", Cards: []kanbanmodels.Card{
+				// Waited a day in the backlog, then two days of work
+				{Title: "slow", History: []kanbanmodels.ColumnEntry{
+					{Column: "Backlog", At: at(0)},
+					{Column: "To Do", At: at(24)},
+					{Column: "Done", At: at(72)},
+				}},
+				// date_completed wins over the history
+				{Title: "stamped", DateCompleted: &completed, History: []kanbanmodels.ColumnEntry{
+					{Column: "To Do", At: at(40)},
+					{Column: "Done", At: at(90)},
+				}},
+				// Never left the backlog before Done: done is when work started
+				{Title: "straight", History: []kanbanmodels.ColumnEntry{
+					{Column: "Backlog", At: at(10)},
+					{Column: "Done", At: at(12)},
+				}},
+				{Title: "no history"},
+			}},
+		},
+	}
+
+	f := BoardFlow(board)
+	if len(f.Cards) != 3 {
+		t.Fatalf("expected 3 finished cards with history, got %+v", f.Cards)
+	}
+	if f.Cards[0].Card.Title != "straight" || f.Cards[2].Card.Title != "stamped" {
+		t.Errorf("expected cards by completion, got %s, %s, %s",
+			f.Cards[0].Card.Title, f.Cards[1].Card.Title, f.Cards[2].Card.Title)
+	}
+
+	slow := f.Cards[1]
+	if slow.Lead != 72*time.Hour || slow.Cycle != 48*time.Hour || !slow.Started {
+		t.Errorf("slow: lead %v cycle %v", slow.Lead, slow.Cycle)
+	}
+	if stamped := f.Cards[2]; stamped.Lead != 60*time.Hour || stamped.Cycle != 60*time.Hour {
+		t.Errorf("stamped: lead %v cycle %v", stamped.Lead, stamped.Cycle)
+	}
+	if straight := f.Cards[0]; straight.Lead != 2*time.Hour || straight.Cycle != 0 {
+		t.Errorf("straight: lead %v cycle %v", straight.Lead, straight.Cycle)
+	}
+
+	if f.MedianLead != 60*time.Hour || f.AvgLead != (2+72+60)*time.Hour/3 {
+		t.Errorf("lead avg %v median %v", f.AvgLead, f.MedianLead)
+	}
+	if f.Cycled != 3 || f.MedianCycle != 48*time.Hour {
+		t.Errorf("cycle median %v over %d cards", f.MedianCycle, f.Cycled)
+	}
+}
+
+func TestFormatFlowDuration(t *testing.T) {
+	for d, want := range map[time.Duration]string{
+		0:                             "<1h",
+		5 * time.Hour:                 "5h",
+		48 * time.Hour:                "2d",
+		76*time.Hour + 59*time.Minute: "3d 4h",
+	} {
+		if got := FormatFlowDuration(d); got != want {
+			t.Errorf("FormatFlowDuration(%v) = %q, want %q", d, got, want)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package stats
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"wydo/internal/agenda"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/service"
+	"wydo/internal/workspace"
+)
+
+// Health summarizes what was loaded: shown on the status bar at startup and
+// printed by wydo status.
+type Health struct {
+	Workspaces int
+	Boards     int
+	OpenTasks  int
+	Overdue    int // pending tasks and cards due or scheduled before today
+	ScanErrors []workspace.ScanError
+}
+
+// CollectHealth counts the workspaces, boards, open tasks and overdue items.
+// scanErrs are the workspaces that failed to load; board errors of the
+// loaded workspaces are added to them.
+func CollectHealth(workspaces []*workspace.Workspace, taskSvc service.TaskService, scanErrs []workspace.ScanError, now time.Time) Health {
+	h := Health{
+		Workspaces: len(workspaces),
+		ScanErrors: workspace.AllScanErrors(workspaces, scanErrs),
+	}
+	var boards []kanbanmodels.Board
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+	}
+	h.Boards = len(boards)
+	if taskSvc != nil {
+		if pending, err := taskSvc.ListPending(); err == nil {
+			h.OpenTasks = len(pending)
+		}
+	}
+	h.Overdue = len(agenda.ExcludeItems(agenda.QueryOverdueItems(taskSvc, boards, startOfDay(now))))
+	return h
+}
+
+// String formats the counts on one line, e.g.
+// "2 workspaces, 5 boards, 31 open tasks, 3 overdue, 1 scan error".
+func (h Health) String() string {
+	parts := []string{
+		plural(h.Workspaces, "workspace"),
+		plural(h.Boards, "board"),
+		plural(h.OpenTasks, "open task"),
+		fmt.Sprintf("%d overdue", h.Overdue),
+	}
+	if len(h.ScanErrors) > 0 {
+		parts = append(parts, plural(len(h.ScanErrors), "scan error"))
+	}
+	return strings.Join(parts, ", ")
+}
+
+func plural(n int, noun string) string {
+	if n == 1 {
+		return fmt.Sprintf("%d %s", n, noun)
+	}
+	return fmt.Sprintf("%d %ss", n, noun)
+}
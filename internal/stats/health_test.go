@@ -0,0 +1,64 @@
+package stats
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/workspace"
+)
+
+func TestCollectHealth(t *testing.T) {
+	past := day("2026-03-01")
+	board := kanbanmodels.Board{
+		Name: "Work",
+		Columns: []kanbanmodels.Column{
+			{Name: "Todo", Cards: []kanbanmodels.Card{{Title: "late", DueDate: &past}, {Title: "undated"}}},
+			{Name: "Done", Cards: []kanbanmodels.Card{{Title: "finished", DueDate: &past}}},
+		},
+	}
+	workspaces := []*workspace.Workspace{
+		{Boards: []kanbanmodels.Board{board}},
+		{ScanErrors: []workspace.ScanError{{Path: "/ws/boards/broken", Err: errors.New("bad frontmatter")}}},
+	}
+	skipped := []workspace.ScanError{{Path: "/missing", Err: errors.New("no such directory")}}
+
+	h := CollectHealth(workspaces, nil, skipped, day("2026-03-10"))
+	if h.Workspaces != 2 || h.Boards != 1 || h.OpenTasks != 0 || h.Overdue != 1 {
+		t.Errorf("got %+v, want 2 workspaces, 1 board, 0 open tasks, 1 overdue", h)
+	}
+	if len(h.ScanErrors) != 2 || h.ScanErrors[0].Path != "/missing" {
+		t.Errorf("scan errors = %v, want the skipped workspace then the broken board", h.ScanErrors)
+	}
+	if got, want := h.String(), "2 workspaces, 1 board, 0 open tasks, 1 overdue, 2 scan errors"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
+
+func TestCollectDueSoon(t *testing.T) {
+	past, today := day("2026-03-01"), day("2026-03-10")
+	board := kanbanmodels.Board{
+		Name: "Work",
+		Columns: []kanbanmodels.Column{
+			{Name: "Todo", Cards: []kanbanmodels.Card{
+				{Title: "late", DueDate: &past},
+				{Title: "due now", DueDate: &today},
+				{Title: "blocked", DueDate: &today, Blocked: "waiting"},
+				{Title: "planned", ScheduledDate: &today},
+			}},
+			{Name: "Done", Cards: []kanbanmodels.Card{{Title: "finished", DueDate: &today}}},
+		},
+	}
+
+	d := CollectDueSoon(nil, []kanbanmodels.Board{board}, today.Add(15*time.Hour))
+	if d.Today != 2 || d.Overdue != 1 {
+		t.Errorf("got %+v, want 2 due today (scheduled and done cards left out), 1 overdue", d)
+	}
+	if got, want := d.String(), "2 due today, 1 overdue"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if got := (DueSoon{}).String(); got != "" {
+		t.Errorf("expected nothing when nothing is due, got %q", got)
+	}
+}
@@ -0,0 +1,184 @@
+package stats
+
+import (
+	"strings"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+const dayKeyFormat = "2006-01-02"
+
+// Completions counts completed tasks and cards per day, keyed by yyyy-MM-dd.
+type Completions map[string]int
+
+// Count returns the number of completions on the given day.
+func (c Completions) Count(day time.Time) int {
+	return c[day.Format(dayKeyFormat)]
+}
+
+// Total returns the number of completions between start and end, inclusive.
+func (c Completions) Total(start, end time.Time) int {
+	total := 0
+	for d := startOfDay(start); !d.After(end); d = d.AddDate(0, 0, 1) {
+		total += c.Count(d)
+	}
+	return total
+}
+
+// ParseDoneDate parses a todo.txt completion date (yyyy-MM-dd).
+// It returns false for empty or malformed dates.
+func ParseDoneDate(s string) (time.Time, bool) {
+	if data.ParseDate(s) == "" {
+		return time.Time{}, false
+	}
+	t, err := time.ParseInLocation(dayKeyFormat, s, time.Local)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// CollectCompletions builds daily completion counts from done tasks (their
+// todo.txt completion date) and cards with a date_completed. Archived boards
+// are skipped; archived cards still count since they were finished.
+func CollectCompletions(tasks []data.Task, boards []kanbanmodels.Board) Completions {
+	c := make(Completions)
+	for _, t := range tasks {
+		if !t.Done {
+			continue
+		}
+		if day, ok := ParseDoneDate(t.CompletionDate); ok {
+			c[day.Format(dayKeyFormat)]++
+		}
+	}
+	for _, b := range boards {
+		if b.Archived {
+			continue
+		}
+		for _, col := range b.Columns {
+			for _, card := range col.Cards {
+				if card.DateCompleted != nil {
+					c[card.DateCompleted.Local().Format(dayKeyFormat)]++
+				}
+			}
+		}
+	}
+	return c
+}
+
+// Streaks returns the current streak (consecutive days with completions
+// ending today, or yesterday if nothing is done yet today) and the longest
+// streak within [start, today].
+func Streaks(c Completions, start, today time.Time) (current, longest int) {
+	run := 0
+	for d := startOfDay(start); !d.After(today); d = d.AddDate(0, 0, 1) {
+		if c.Count(d) > 0 {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+
+	d := startOfDay(today)
+	if c.Count(d) == 0 {
+		d = d.AddDate(0, 0, -1)
+	}
+	for c.Count(d) > 0 {
+		current++
+		d = d.AddDate(0, 0, -1)
+	}
+	return current, longest
+}
+
+// heatmapGlyphs are the cell glyphs from no completions to the busiest days.
+var heatmapGlyphs = []string{"·", "░", "▒", "▓", "█"}
+
+// HeatmapLevels is the number of intensity levels a cell can have.
+const HeatmapLevels = 5
+
+// Level maps a day's count to an intensity level in [0, HeatmapLevels) relative
+// to the busiest day.
+func Level(count, busiest int) int {
+	if count <= 0 || busiest <= 0 {
+		return 0
+	}
+	return min(HeatmapLevels-1, (count*(HeatmapLevels-1)+busiest-1)/busiest)
+}
+
+// CellFunc styles one heatmap cell. It receives the cell's level and glyph.
+type CellFunc func(level int, glyph string) string
+
+// RenderHeatmap draws a GitHub-style grid: one column per week (Monday first),
+// one row per weekday, with month labels above. Days outside [start, end] are
+// left blank. A nil cell renders plain glyphs, suitable for stdout.
+func RenderHeatmap(c Completions, start, end time.Time, cell CellFunc) string {
+	if cell == nil {
+		cell = func(_ int, glyph string) string { return glyph }
+	}
+	start = startOfDay(start)
+	end = startOfDay(end)
+	first := mondayOf(start)
+
+	busiest := 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		busiest = max(busiest, c.Count(d))
+	}
+
+	weeks := int(end.Sub(first).Hours()/24)/7 + 1
+
+	var sb strings.Builder
+
+	// Month labels: print the month name over the week it starts in
+	labels := []rune(strings.Repeat(" ", weeks*2))
+	for w := 0; w < weeks; w++ {
+		weekStart := first.AddDate(0, 0, w*7)
+		for i := 0; i < 7; i++ {
+			d := weekStart.AddDate(0, 0, i)
+			if d.Day() == 1 && !d.Before(start) && !d.After(end) {
+				copy(labels[w*2:], []rune(d.Format("Jan")))
+			}
+		}
+	}
+	sb.WriteString("    ")
+	sb.WriteString(strings.TrimRight(string(labels[:weeks*2]), " "))
+	sb.WriteString("\n")
+
+	dayNames := []string{"Mon", "   ", "Wed", "   ", "Fri", "   ", "Sun"}
+	for row := 0; row < 7; row++ {
+		sb.WriteString(dayNames[row])
+		sb.WriteString(" ")
+		for w := 0; w < weeks; w++ {
+			d := first.AddDate(0, 0, w*7+row)
+			if d.Before(start) || d.After(end) {
+				sb.WriteString("  ")
+				continue
+			}
+			level := Level(c.Count(d), busiest)
+			sb.WriteString(cell(level, heatmapGlyphs[level]))
+			sb.WriteString(" ")
+		}
+		sb.WriteString("\n")
+	}
+
+	// Legend
+	sb.WriteString("    less ")
+	for level, glyph := range heatmapGlyphs {
+		sb.WriteString(cell(level, glyph))
+		sb.WriteString(" ")
+	}
+	sb.WriteString("more")
+
+	return sb.String()
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func mondayOf(t time.Time) time.Time {
+	offset := (int(t.Weekday()) + 6) % 7
+	return startOfDay(t).AddDate(0, 0, -offset)
+}
@@ -0,0 +1,121 @@
+package stats
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+)
+
+func day(s string) time.Time {
+	t, _ := time.ParseInLocation("2006-01-02", s, time.Local)
+	return t
+}
+
+func TestParseDoneDate(t *testing.T) {
+	if d, ok := ParseDoneDate("2026-03-04"); !ok || !d.Equal(day("2026-03-04")) {
+		t.Errorf("expected 2026-03-04, got %v %v", d, ok)
+	}
+	for _, bad := range []string{"", "2026-3-4", "2026-13-01", "yesterday"} {
+		if _, ok := ParseDoneDate(bad); ok {
+			t.Errorf("expected %q to be rejected", bad)
+		}
+	}
+}
+
+func TestCollectCompletions(t *testing.T) {
+	completed := time.Date(2026, 3, 4, 15, 30, 0, 0, time.Local)
+	tasks := []data.Task{
+		data.ParseTask("x 2026-03-04 2026-03-01 write report", "1", "done.txt"),
+		data.ParseTask("x 2026-03-05 ship it", "2", "done.txt"),
+		data.ParseTask("x no date", "3", "done.txt"),
+		data.ParseTask("2026-03-04 still pending", "4", "todo.txt"),
+	}
+	boards := []kanbanmodels.Board{
+		{Columns: []kanbanmodels.Column{{Cards: []kanbanmodels.Card{
+			{Title: "done card", DateCompleted: &completed},
+			{Title: "open card"},
+		}}}},
+		{Archived: true, Columns: []kanbanmodels.Column{{Cards: []kanbanmodels.Card{
+			{Title: "archived board card", DateCompleted: &completed},
+		}}}},
+	}
+
+	c := CollectCompletions(tasks, boards)
+	if got := c.Count(day("2026-03-04")); got != 2 {
+		t.Errorf("expected 2 completions on 03-04, got %d", got)
+	}
+	if got := c.Count(day("2026-03-05")); got != 1 {
+		t.Errorf("expected 1 completion on 03-05, got %d", got)
+	}
+	if got := c.Total(day("2026-03-01"), day("2026-03-31")); got != 3 {
+		t.Errorf("expected 3 completions in March, got %d", got)
+	}
+}
+
+func TestStreaks(t *testing.T) {
+	c := Completions{
+		"2026-03-01": 1,
+		"2026-03-02": 2,
+		"2026-03-03": 1,
+		"2026-03-05": 1,
+		"2026-03-06": 1,
+	}
+
+	current, longest := Streaks(c, day("2026-03-01"), day("2026-03-06"))
+	if current != 2 || longest != 3 {
+		t.Errorf("expected current=2 longest=3, got %d %d", current, longest)
+	}
+
+	// Nothing done yet today still counts yesterday's run
+	current, _ = Streaks(c, day("2026-03-01"), day("2026-03-07"))
+	if current != 2 {
+		t.Errorf("expected current streak to carry from yesterday, got %d", current)
+	}
+
+	current, _ = Streaks(c, day("2026-03-01"), day("2026-03-08"))
+	if current != 0 {
+		t.Errorf("expected broken streak, got %d", current)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	cases := []struct{ count, busiest, want int }{
+		{0, 10, 0},
+		{1, 10, 1},
+		{5, 10, 2},
+		{10, 10, 4},
+		{1, 1, 4},
+		{3, 0, 0},
+	}
+	for _, tc := range cases {
+		if got := Level(tc.count, tc.busiest); got != tc.want {
+			t.Errorf("Level(%d, %d) = %d, want %d", tc.count, tc.busiest, got, tc.want)
+		}
+	}
+}
+
+func TestRenderHeatmap(t *testing.T) {
+	c := Completions{"2026-03-02": 4, "2026-03-03": 1}
+	out := RenderHeatmap(c, day("2026-02-23"), day("2026-03-08"), nil)
+	lines := strings.Split(out, "\n")
+
+	// Month labels, 7 weekday rows, legend
+	if len(lines) != 9 {
+		t.Fatalf("expected 9 lines, got %d:\n%s", len(lines), out)
+	}
+	if !strings.Contains(lines[0], "Mar") {
+		t.Errorf("expected March label, got %q", lines[0])
+	}
+	if lines[1] != "Mon · █ " {
+		t.Errorf("unexpected Monday row %q", lines[1])
+	}
+	if lines[2] != "    · ░ " {
+		t.Errorf("unexpected Tuesday row %q", lines[2])
+	}
+	if !strings.HasPrefix(lines[8], "    less") {
+		t.Errorf("expected legend, got %q", lines[8])
+	}
+}
@@ -0,0 +1,122 @@
+package data
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+)
+
+// AnnotationsFile is the sidecar file (next to todo.txt) that stores task annotations.
+// It deliberately does not use the .txt extension so it is never loaded as a task file.
+const AnnotationsFile = "annotations.tsv"
+
+// AnnotationTag is the task tag linking a task line to its sidecar annotations.
+const AnnotationTag = "ann"
+
+// Annotation is a timestamped note attached to a task
+type Annotation struct {
+	Time time.Time
+	Text string
+}
+
+// GetAnnotationKey returns the key linking the task to its annotations, or "" if none.
+func (t *Task) GetAnnotationKey() string {
+	return t.Tags[AnnotationTag]
+}
+
+// EnsureAnnotationKey assigns an annotation key to the task if it has none.
+// Returns true if the task was modified.
+func (t *Task) EnsureAnnotationKey() bool {
+	if t.GetAnnotationKey() != "" {
+		return false
+	}
+	if t.Tags == nil {
+		t.Tags = make(map[string]string)
+	}
+	t.Tags[AnnotationTag] = clock.NewID(t.String())
+	return true
+}
+
+// AnnotationsPath returns the sidecar path for annotations of tasks in the given file.
+func AnnotationsPath(taskFile string) string {
+	return filepath.Join(filepath.Dir(taskFile), AnnotationsFile)
+}
+
+// AppendAnnotation appends an annotation for key to the sidecar file in dirPath.
+func AppendAnnotation(dirPath, key string, ann Annotation) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	text := strings.TrimSpace(CollapseWhitespace(ann.Text))
+	if text == "" {
+		return fmt.Errorf("empty annotation")
+	}
+
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		return fmt.Errorf("error creating directory: %v", err)
+	}
+
+	path := filepath.Join(dirPath, AnnotationsFile)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return fmt.Errorf("error opening %s for append: %v", path, err)
+	}
+	defer f.Close()
+
+	if _, err := fmt.Fprintf(f, "%s\t%s\t%s\n", key, ann.Time.Format(time.RFC3339), text); err != nil {
+		return fmt.Errorf("error writing to %s: %v", path, err)
+	}
+	return nil
+}
+
+// LoadAnnotations reads the sidecar file in dirPath, grouped by annotation key.
+// A missing file yields an empty map.
+func LoadAnnotations(dirPath string) (map[string][]Annotation, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+
+	result := make(map[string][]Annotation)
+	file, err := os.Open(filepath.Join(dirPath, AnnotationsFile))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return result, nil
+		}
+		return nil, err
+	}
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "\t", 3)
+		if len(parts) != 3 {
+			continue
+		}
+		ts, err := time.Parse(time.RFC3339, parts[1])
+		if err != nil {
+			continue
+		}
+		result[parts[0]] = append(result[parts[0]], Annotation{Time: ts, Text: parts[2]})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// TaskAnnotations returns the annotations recorded for the given task, oldest first.
+func TaskAnnotations(t Task) []Annotation {
+	key := t.GetAnnotationKey()
+	if key == "" || t.File == "" {
+		return nil
+	}
+	all, err := LoadAnnotations(filepath.Dir(t.File))
+	if err != nil {
+		return nil
+	}
+	return all[key]
+}
@@ -2,27 +2,58 @@ package data
 
 import (
 	"bufio"
+	"bytes"
 	"crypto/sha1"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"sync"
 
+	"wydo/internal/clock"
 	"wydo/internal/logs"
+	"wydo/internal/writeq"
 )
 
+var log = logs.For("tasks")
+
 var (
 	mu sync.RWMutex
+
+	// stampCreated is set once at startup from config.StampCreatedDate
+	stampCreated bool
 )
 
+// SetStampCreated makes AppendTaskToFile give new tasks without a creation
+// date today's date.
+func SetStampCreated(enabled bool) {
+	stampCreated = enabled
+}
+
 func HashTaskLine(line string) string {
 	h := sha1.New()
 	h.Write([]byte(line))
 	return hex.EncodeToString(h.Sum(nil))[:10]
 }
 
+// ContentIDs returns an ID for each of tasks, keyed by task ID, made from
+// its file and text rather than its line number (as Task.ID is). It stays
+// the same while lines are added, removed or reordered around the task, and
+// changes when the task itself is edited. Identical lines in one file are
+// told apart by their order.
+func ContentIDs(tasks []Task) map[string]string {
+	ids := make(map[string]string, len(tasks))
+	seen := make(map[string]int)
+	for _, t := range tasks {
+		key := t.File + "\x00" + t.String()
+		ids[t.ID] = HashTaskLine(fmt.Sprintf("%s\x00%d", key, seen[key]))
+		seen[key]++
+	}
+	return ids
+}
+
 type ParseTaskMismatchError struct {
 	Msg string
 }
@@ -32,18 +63,17 @@ func (e *ParseTaskMismatchError) Error() string {
 }
 
 func UpdateTask(tasks []Task, updatedTask Task) []Task {
-	logs.Logger.Printf("Update Task: %s\n", updatedTask)
+	log.Debug("update task", "task", updatedTask.String())
 	found := false
 	for i, t := range tasks {
 		if t.ID == updatedTask.ID {
-			logs.Logger.Println("task found. updating...")
 			tasks[i] = updatedTask
 			found = true
 			break
 		}
 	}
 	if !found {
-		logs.Logger.Println("task not found. adding new task...")
+		log.Debug("task not found, adding it", "id", updatedTask.ID)
 		tasks = append(tasks, updatedTask)
 	}
 	return tasks
@@ -78,19 +108,15 @@ func WriteTasksToFile(tasks []Task, filePath string) error {
 		return fmt.Errorf("error creating directory: %v", err)
 	}
 
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("error writing %s: %v", filePath, err)
-	}
-	defer file.Close()
-
+	var buf bytes.Buffer
 	for _, task := range tasks {
 		if task.File != filePath {
 			continue
 		}
-		if _, err := fmt.Fprintln(file, task.String()); err != nil {
-			return fmt.Errorf("error writing to %s: %v", filePath, err)
-		}
+		fmt.Fprintln(&buf, task.String())
+	}
+	if err := writeq.WriteFile(filePath, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %v", filePath, err)
 	}
 	return nil
 }
@@ -119,16 +145,12 @@ func writeTaskSliceToFile(tasks []Task, filePath string) error {
 		return fmt.Errorf("error creating directory: %v", err)
 	}
 
-	file, err := os.Create(filePath)
-	if err != nil {
-		return fmt.Errorf("error writing %s: %v", filePath, err)
-	}
-	defer file.Close()
-
+	var buf bytes.Buffer
 	for _, task := range tasks {
-		if _, err := fmt.Fprintln(file, task.String()); err != nil {
-			return fmt.Errorf("error writing to %s: %v", filePath, err)
-		}
+		fmt.Fprintln(&buf, task.String())
+	}
+	if err := writeq.WriteFile(filePath, buf.Bytes(), 0644); err != nil {
+		return fmt.Errorf("error writing %s: %v", filePath, err)
 	}
 	return nil
 }
@@ -148,19 +170,47 @@ func TaskCount(tasks []Task, project string) (int, int) {
 	return todoCount, doneCount
 }
 
+// DoneFileName returns the name of the file that archives tasks completed in year.
+func DoneFileName(year int) string {
+	return fmt.Sprintf("done-%d.txt", year)
+}
+
+// DoneFileYear returns the year of a per-year done file (done-2025.txt).
+func DoneFileYear(path string) (int, bool) {
+	name := filepath.Base(path)
+	if !strings.HasPrefix(name, "done-") || !strings.HasSuffix(name, ".txt") {
+		return 0, false
+	}
+	digits := strings.TrimSuffix(strings.TrimPrefix(name, "done-"), ".txt")
+	year, err := strconv.Atoi(digits)
+	if err != nil || len(digits) != 4 {
+		return 0, false
+	}
+	return year, true
+}
+
+// IsDoneFile reports whether path is a done file: the original done.txt or a
+// per-year done-YYYY.txt.
+func IsDoneFile(path string) bool {
+	if filepath.Base(path) == "done.txt" {
+		return true
+	}
+	_, ok := DoneFileYear(path)
+	return ok
+}
+
 func loadTaskFile(filePath string, allowMismatch bool, projects map[string]Project) ([]Task, error) {
 	mu.Lock()
 	defer mu.Unlock()
 
-	file, err := os.Open(filePath)
+	content, err := writeq.ReadFile(filePath)
 	if err != nil {
 		return nil, err
 	}
-	defer file.Close()
 
 	taskList := []Task{}
 
-	scanner := bufio.NewScanner(file)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
 	lineNum := 0
 	for scanner.Scan() {
 		line := scanner.Text()
@@ -170,6 +220,7 @@ func loadTaskFile(filePath string, allowMismatch bool, projects map[string]Proje
 		}
 		hashId := HashTaskLine(fmt.Sprintf("%d:%s", lineNum, filePath))
 		task := ParseTask(line, hashId, filePath)
+		task.Line = lineNum
 		for _, project := range task.Projects {
 			if _, exists := projects[project]; !exists {
 				projects[project] = Project{Name: project}
@@ -177,7 +228,7 @@ func loadTaskFile(filePath string, allowMismatch bool, projects map[string]Proje
 		}
 		if task.String() != line && !allowMismatch {
 			msg := fmt.Sprintf("malformed task\nparsed: %s\noriginal: %s", task.String(), line)
-			logs.Logger.Println(msg)
+			log.Warn("malformed task", "file", filePath, "line", lineNum, "parsed", task.String(), "original", line)
 			return nil, &ParseTaskMismatchError{Msg: msg}
 		}
 		taskList = append(taskList, task)
@@ -189,6 +240,25 @@ func loadTaskFile(filePath string, allowMismatch bool, projects map[string]Proje
 	return taskList, nil
 }
 
+// ReadTaskByID reads filePath afresh and returns the task whose ID is id, or
+// nil if no line has that ID any more. It is used to spot tasks changed on
+// disk since they were loaded.
+func ReadTaskByID(filePath, id string) (*Task, error) {
+	tasks, err := loadTaskFile(filePath, true, make(map[string]Project))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	for i := range tasks {
+		if tasks[i].ID == id {
+			return &tasks[i], nil
+		}
+	}
+	return nil, nil
+}
+
 // DeleteTask removes a task by ID from the task slice and returns the updated slice.
 func DeleteTask(tasks []Task, id string) []Task {
 	for i, t := range tasks {
@@ -214,31 +284,24 @@ func AppendTaskToFile(rawLine, todoFilePath string) (*Task, error) {
 	}
 
 	lineCount := 0
-	file, err := os.Open(todoFilePath)
+	content, err := writeq.ReadFile(todoFilePath)
 	if err != nil && !os.IsNotExist(err) {
 		return nil, fmt.Errorf("error opening %s: %v", todoFilePath, err)
 	}
-	if file != nil {
-		scanner := bufio.NewScanner(file)
-		for scanner.Scan() {
-			if strings.TrimSpace(scanner.Text()) != "" {
-				lineCount++
-			}
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		if strings.TrimSpace(scanner.Text()) != "" {
+			lineCount++
 		}
-		file.Close()
 	}
 
 	hashId := HashTaskLine(fmt.Sprintf("%d:%s", lineCount+1, todoFilePath))
 	task := ParseTask(rawLine, hashId, todoFilePath)
-
-	f, err := os.OpenFile(todoFilePath, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
-	if err != nil {
-		return nil, fmt.Errorf("error opening %s for append: %v", todoFilePath, err)
+	if stampCreated && !task.Done && task.CreatedDate == "" {
+		task.CreatedDate = clock.Now().Format("2006-01-02")
 	}
-	defer f.Close()
 
-	_, err = fmt.Fprintln(f, task.String())
-	if err != nil {
+	if err := writeq.AppendFile(todoFilePath, []byte(task.String()+"\n"), 0644); err != nil {
 		return nil, fmt.Errorf("error writing to %s: %v", todoFilePath, err)
 	}
 
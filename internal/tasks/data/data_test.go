@@ -1,9 +1,11 @@
 package data
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"testing"
+	"time"
 )
 
 func TestLoadTasksFromDir(t *testing.T) {
@@ -129,6 +131,63 @@ func TestTaskFileTracking(t *testing.T) {
 	}
 }
 
+func TestReadTaskByID(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "todo.txt")
+	if err := os.WriteFile(filePath, []byte("First task\nSecond task\n"), 0644); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	loaded, err := LoadTasksFromDir(filepath.Dir(filePath), []string{"todo.txt"}, true)
+	if err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+
+	if err := os.WriteFile(filePath, []byte("First task\nSecond task edited elsewhere\n"), 0644); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	disk, err := ReadTaskByID(filePath, loaded[1].ID)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if disk == nil || disk.Name != "Second task edited elsewhere" {
+		t.Errorf("expected the edited line, got %+v", disk)
+	}
+
+	if err := os.WriteFile(filePath, []byte("First task\n"), 0644); err != nil {
+		t.Fatalf("write error: %v", err)
+	}
+	if disk, err := ReadTaskByID(filePath, loaded[1].ID); err != nil || disk != nil {
+		t.Errorf("expected no task for a removed line, got %+v, %v", disk, err)
+	}
+}
+
+func TestDoneFiles(t *testing.T) {
+	cases := []struct {
+		path string
+		year int
+		done bool
+	}{
+		{"/w/tasks/done.txt", 0, true},
+		{"/w/tasks/done-2025.txt", 2025, true},
+		{"done-1999.txt", 1999, true},
+		{"/w/tasks/todo.txt", 0, false},
+		{"/w/tasks/done-25.txt", 0, false},
+		{"/w/tasks/done-2025.txt.bak", 0, false},
+		{"/w/tasks/done-2025-old.txt", 0, false},
+	}
+	for _, c := range cases {
+		year, ok := DoneFileYear(c.path)
+		if year != c.year || ok != (c.year != 0) {
+			t.Errorf("DoneFileYear(%q) = %d, %v; want %d", c.path, year, ok, c.year)
+		}
+		if got := IsDoneFile(c.path); got != c.done {
+			t.Errorf("IsDoneFile(%q) = %v, want %v", c.path, got, c.done)
+		}
+	}
+	if got := DoneFileName(2026); got != "done-2026.txt" {
+		t.Errorf("DoneFileName(2026) = %q", got)
+	}
+}
+
 func TestLoadTasksFromDir_MultipleProjects(t *testing.T) {
 	// Verify that tasks with project tags are loaded correctly from any directory.
 	tmpDir := t.TempDir()
@@ -153,3 +212,112 @@ func TestLoadTasksFromDir_MultipleProjects(t *testing.T) {
 		}
 	}
 }
+
+func TestAnnotationsRoundTrip(t *testing.T) {
+	tmpDir := t.TempDir()
+	task := Task{ID: "1", Name: "Call vendor", File: filepath.Join(tmpDir, "todo.txt")}
+
+	if !task.EnsureAnnotationKey() {
+		t.Fatal("expected annotation key to be assigned")
+	}
+	if task.EnsureAnnotationKey() {
+		t.Error("expected existing annotation key to be kept")
+	}
+	key := task.GetAnnotationKey()
+
+	// Round-trip through the task line
+	parsed := ParseTask(task.String(), "1", task.File)
+	if parsed.GetAnnotationKey() != key {
+		t.Errorf("ann tag lost in round-trip: got %q, want %q", parsed.GetAnnotationKey(), key)
+	}
+
+	first := time.Date(2026, 3, 1, 9, 30, 0, 0, time.UTC)
+	if err := AppendAnnotation(tmpDir, key, Annotation{Time: first, Text: "waiting on Bob"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := AppendAnnotation(tmpDir, key, Annotation{Time: first.Add(time.Hour), Text: "Bob  replied"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := AppendAnnotation(tmpDir, key, Annotation{Time: first, Text: "   "}); err == nil {
+		t.Error("expected error for empty annotation")
+	}
+
+	anns := TaskAnnotations(parsed)
+	if len(anns) != 2 {
+		t.Fatalf("expected 2 annotations, got %d", len(anns))
+	}
+	if anns[0].Text != "waiting on Bob" || !anns[0].Time.Equal(first) {
+		t.Errorf("unexpected first annotation: %+v", anns[0])
+	}
+	if anns[1].Text != "Bob replied" {
+		t.Errorf("expected whitespace to be collapsed, got %q", anns[1].Text)
+	}
+
+	// The sidecar must not be picked up as a task file
+	if _, err := os.Stat(filepath.Join(tmpDir, AnnotationsFile)); err != nil {
+		t.Fatalf("sidecar not written: %v", err)
+	}
+	if filepath.Ext(AnnotationsFile) == ".txt" {
+		t.Error("annotations sidecar must not use the .txt extension")
+	}
+}
+
+func TestAppendTaskToFile_StampsCreatedDate(t *testing.T) {
+	SetStampCreated(true)
+	defer SetStampCreated(false)
+
+	file := filepath.Join(t.TempDir(), "todo.txt")
+	task, err := AppendTaskToFile("(A) write report +alpha", file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	today := time.Now().Format("2006-01-02")
+	if task.CreatedDate != today {
+		t.Errorf("expected created date %s, got %q", today, task.CreatedDate)
+	}
+	content, _ := os.ReadFile(file)
+	if want := "(A) " + today + " write report +alpha\n"; string(content) != want {
+		t.Errorf("expected line %q, got %q", want, string(content))
+	}
+
+	// An explicit creation date is kept
+	task, err = AppendTaskToFile("2020-01-02 old idea", file)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if task.CreatedDate != "2020-01-02" {
+		t.Errorf("expected the given created date to be kept, got %q", task.CreatedDate)
+	}
+
+	SetStampCreated(false)
+	task, _ = AppendTaskToFile("unstamped", file)
+	if task.CreatedDate != "" {
+		t.Errorf("expected no created date with stamping off, got %q", task.CreatedDate)
+	}
+}
+
+func TestContentIDs_SurviveLineChanges(t *testing.T) {
+	parse := func(lines ...string) []Task {
+		var tasks []Task
+		for i, line := range lines {
+			tasks = append(tasks, ParseTask(line, HashTaskLine(fmt.Sprintf("%d:todo.txt", i+1)), "todo.txt"))
+		}
+		return tasks
+	}
+	before := parse("Buy milk +errands", "Post parcel +errands", "Post parcel +errands")
+	after := parse("Post parcel +errands", "Post parcel +errands")
+
+	ids, later := ContentIDs(before), ContentIDs(after)
+	// The line-based ID of line 1 now names another task; the content IDs follow the tasks
+	if later[after[0].ID] != ids[before[1].ID] || later[after[1].ID] != ids[before[2].ID] {
+		t.Errorf("content IDs moved with the lines: %v then %v", ids, later)
+	}
+	if ids[before[1].ID] == ids[before[2].ID] {
+		t.Error("expected identical lines told apart")
+	}
+	for _, id := range later {
+		if id == ids[before[0].ID] {
+			t.Error("expected the removed task's content ID gone")
+		}
+	}
+}
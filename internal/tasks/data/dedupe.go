@@ -0,0 +1,129 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"wydo/internal/writeq"
+)
+
+// DuplicateGroup is a set of task lines with the same normalized content.
+// Keep is the line that stays; Remove are the copies to drop.
+type DuplicateGroup struct {
+	Keep   Task
+	Remove []Task
+}
+
+// NormalizedContent is the task's line without its completion marker,
+// completion date and priority, which sync tools and completing a task
+// change. Two lines with the same normalized content are the same task.
+func (t Task) NormalizedContent() string {
+	t.Done = false
+	t.CompletionDate = ""
+	t.Priority = PriorityNone
+	return t.String()
+}
+
+// FindDuplicates groups tasks whose normalized content repeats, within a file
+// or across files. Each group keeps the completed copy with the earliest
+// completion date, or the first copy when none is completed. Groups are in
+// the order their first copy appears in tasks.
+func FindDuplicates(tasks []Task) []DuplicateGroup {
+	byContent := make(map[string][]int)
+	var order []string
+	for i, t := range tasks {
+		key := t.NormalizedContent()
+		if _, seen := byContent[key]; !seen {
+			order = append(order, key)
+		}
+		byContent[key] = append(byContent[key], i)
+	}
+
+	var groups []DuplicateGroup
+	for _, key := range order {
+		indices := byContent[key]
+		if len(indices) < 2 {
+			continue
+		}
+		keep := indices[0]
+		for _, i := range indices[1:] {
+			if keepsOver(tasks[i], tasks[keep]) {
+				keep = i
+			}
+		}
+		group := DuplicateGroup{Keep: tasks[keep]}
+		for _, i := range indices {
+			if i != keep {
+				group.Remove = append(group.Remove, tasks[i])
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+// keepsOver reports whether a is a better record to keep than b: completed
+// beats pending, and a dated completion beats a later or undated one.
+func keepsOver(a, b Task) bool {
+	if a.Done != b.Done {
+		return a.Done
+	}
+	if !a.Done || a.CompletionDate == b.CompletionDate {
+		return false
+	}
+	if b.CompletionDate == "" {
+		return true
+	}
+	return a.CompletionDate != "" && a.CompletionDate < b.CompletionDate
+}
+
+// RemoveLines rewrites filePath without the given 1-based lines. Every other
+// line is kept byte for byte.
+func RemoveLines(filePath string, lines []int) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	drop := make(map[int]bool, len(lines))
+	for _, n := range lines {
+		drop[n] = true
+	}
+
+	data, err := writeq.ReadFile(filePath)
+	if err != nil {
+		return fmt.Errorf("error reading %s: %v", filePath, err)
+	}
+	// Split after each "\n" so the kept lines keep their own endings, be
+	// they "\n", "\r\n" or none on the last line
+	var content []byte
+	for i, line := range bytes.SplitAfter(data, []byte("\n")) {
+		if len(line) > 0 && !drop[i+1] {
+			content = append(content, line...)
+		}
+	}
+	if err := writeq.WriteFile(filePath, content, 0644); err != nil {
+		return fmt.Errorf("error writing %s: %v", filePath, err)
+	}
+	return nil
+}
+
+// RemoveDuplicates deletes the Remove lines of groups from their files.
+func RemoveDuplicates(groups []DuplicateGroup) error {
+	byFile := make(map[string][]int)
+	for _, g := range groups {
+		for _, t := range g.Remove {
+			byFile[t.File] = append(byFile[t.File], t.Line)
+		}
+	}
+	files := make([]string, 0, len(byFile))
+	for f := range byFile {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	for _, f := range files {
+		if err := RemoveLines(f, byFile[f]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
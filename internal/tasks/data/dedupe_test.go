@@ -0,0 +1,62 @@
+package data
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestFindDuplicates_KeepsEarliestCompletion(t *testing.T) {
+	dir := t.TempDir()
+	todo := filepath.Join(dir, "todo.txt")
+	done := filepath.Join(dir, "done.txt")
+	os.WriteFile(todo, []byte("(A) 2026-01-01 Buy milk +home\nCall Bob\n\nCall Bob\n"), 0644)
+	os.WriteFile(done, []byte("x 2026-01-05 2026-01-01 Buy milk +home\nx 2026-01-03 2026-01-01 Buy milk +home\n"), 0644)
+
+	tasks, err := LoadTasksFromDir(dir, []string{"todo.txt", "done.txt"}, true)
+	if err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+	groups := FindDuplicates(tasks)
+	if len(groups) != 2 {
+		t.Fatalf("got %d groups, want 2: %+v", len(groups), groups)
+	}
+
+	milk := groups[0]
+	if milk.Keep.File != done || milk.Keep.CompletionDate != "2026-01-03" {
+		t.Errorf("milk keeps %s:%d (%s), want the 2026-01-03 completion", milk.Keep.File, milk.Keep.Line, milk.Keep.CompletionDate)
+	}
+	if len(milk.Remove) != 2 {
+		t.Errorf("milk removes %d lines, want 2", len(milk.Remove))
+	}
+
+	bob := groups[1]
+	if bob.Keep.Line != 2 || len(bob.Remove) != 1 || bob.Remove[0].Line != 4 {
+		t.Errorf("Call Bob keeps line %d and removes %+v, want keep 2, remove 4", bob.Keep.Line, bob.Remove)
+	}
+
+	if err := RemoveDuplicates(groups); err != nil {
+		t.Fatalf("remove error: %v", err)
+	}
+	gotTodo, _ := os.ReadFile(todo)
+	if want := "Call Bob\n\n"; string(gotTodo) != want {
+		t.Errorf("todo.txt = %q, want %q", gotTodo, want)
+	}
+	gotDone, _ := os.ReadFile(done)
+	if want := "x 2026-01-03 2026-01-01 Buy milk +home\n"; string(gotDone) != want {
+		t.Errorf("done.txt = %q, want %q", gotDone, want)
+	}
+}
+
+func TestRemoveLines_KeepsLineEndings(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "todo.txt")
+	os.WriteFile(file, []byte("Call Bob\r\nBuy milk\r\nCall Bob\r\nWater plants"), 0644)
+
+	if err := RemoveLines(file, []int{3}); err != nil {
+		t.Fatalf("remove error: %v", err)
+	}
+	got, _ := os.ReadFile(file)
+	if want := "Call Bob\r\nBuy milk\r\nWater plants"; string(got) != want {
+		t.Errorf("todo.txt = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,216 @@
+package data
+
+import "strings"
+
+// MergeResult is two diverged copies of a todo.txt file merged into one, see
+// Merge. Conflicts are the tasks that could not be merged on their own; each
+// holds a default choice in the result until it is resolved otherwise.
+type MergeResult struct {
+	slots     [][]string // output lines per merged task, in order
+	Conflicts []MergeConflict
+
+	AddedA, AddedB int // tasks found in only one copy and kept
+	Deleted        int // tasks dropped because one copy deleted them
+	Completed      int // tasks completed in one copy and kept completed
+}
+
+// MergeConflict is a task the two copies changed in different ways, or one
+// changed and the other deleted.
+type MergeConflict struct {
+	A, B   string // the task's line in each copy, "" where it was deleted
+	Reason string
+	slot   int
+}
+
+// Lines returns the merged file's lines.
+func (r *MergeResult) Lines() []string {
+	var lines []string
+	for _, s := range r.slots {
+		lines = append(lines, s...)
+	}
+	return lines
+}
+
+// Resolve replaces conflict i's default choice with lines: one of its two
+// versions, both, or none to drop the task.
+func (r *MergeResult) Resolve(i int, lines ...string) {
+	var kept []string
+	for _, l := range lines {
+		if l != "" {
+			kept = append(kept, l)
+		}
+	}
+	r.slots[r.Conflicts[i].slot] = kept
+}
+
+type mergeLine struct {
+	raw  string
+	task Task
+}
+
+// Merge merges a and b, two copies of a todo.txt file that diverged, e.g.
+// on two machines between syncs. base is the copy they both started from
+// when it is known, or nil.
+//
+// Tasks are matched by their annotation key when they have one, else by
+// their text. Tasks found in one copy only are kept (with base, a task one
+// copy left unchanged and the other deleted is dropped). Where the copies
+// disagree, a change made in one copy only wins, and a completed task wins
+// over its pending copy; anything else is a conflict, which defaults to the
+// completed version, or a's. Tasks are in a's order, followed by those only
+// in b.
+func Merge(base, a, b []string) *MergeResult {
+	r := &MergeResult{}
+	baseLines, aLines, bLines := parseMergeLines(base), parseMergeLines(a), parseMergeLines(b)
+	baseKeys, bKeys := indexMergeLines(baseLines), indexMergeLines(bLines)
+	hasBase := base != nil
+
+	// pick returns the nth line with key, if any
+	pick := func(lines []mergeLine, index map[string][]int, key string, n int) *mergeLine {
+		if n < len(index[key]) {
+			return &lines[index[key][n]]
+		}
+		return nil
+	}
+
+	seen := make(map[string]int)
+	for i := range aLines {
+		key := mergeKey(aLines[i].task)
+		n := seen[key]
+		seen[key]++
+		r.merge(hasBase, pick(baseLines, baseKeys, key, n), &aLines[i], pick(bLines, bKeys, key, n))
+	}
+	seenB := make(map[string]int)
+	for i := range bLines {
+		key := mergeKey(bLines[i].task)
+		n := seenB[key]
+		seenB[key]++
+		if n >= seen[key] {
+			r.merge(hasBase, pick(baseLines, baseKeys, key, n), nil, &bLines[i])
+		}
+	}
+	return r
+}
+
+// merge adds the merged version of one task, given its line in each copy
+// (nil where it is missing).
+func (r *MergeResult) merge(hasBase bool, base, a, b *mergeLine) {
+	switch {
+	case a != nil && b != nil:
+		r.mergeBoth(base, a, b)
+	case hasBase && base != nil:
+		// One copy deleted the task: drop it unless the other changed it
+		kept, side := a, "A"
+		if kept == nil {
+			kept, side = b, "B"
+		}
+		if kept.raw == base.raw {
+			r.Deleted++
+			return
+		}
+		conflict := MergeConflict{Reason: "deleted in " + other(side) + ", changed in " + side}
+		if side == "A" {
+			conflict.A = kept.raw
+		} else {
+			conflict.B = kept.raw
+		}
+		r.conflict(conflict, kept.raw)
+	case a != nil:
+		r.AddedA++
+		r.add(a.raw)
+	default:
+		r.AddedB++
+		r.add(b.raw)
+	}
+}
+
+func (r *MergeResult) mergeBoth(base, a, b *mergeLine) {
+	if a.raw == b.raw {
+		r.add(a.raw)
+		return
+	}
+	if base != nil {
+		// A change made in one copy only
+		if a.raw == base.raw {
+			r.add(b.raw)
+			return
+		}
+		if b.raw == base.raw {
+			r.add(a.raw)
+			return
+		}
+	}
+
+	aContent, bContent := a.task.NormalizedContent(), b.task.NormalizedContent()
+	if a.task.Done != b.task.Done {
+		done, pending := a, b
+		if b.task.Done {
+			done, pending = b, a
+		}
+		// The completed copy wins unless the pending one was edited as well
+		if aContent == bContent || (base != nil && pending.task.NormalizedContent() == base.task.NormalizedContent()) {
+			r.Completed++
+			r.add(done.raw)
+			return
+		}
+		r.conflict(MergeConflict{A: a.raw, B: b.raw, Reason: "completed in one copy, edited in the other"}, done.raw)
+		return
+	}
+	if aContent == bContent && a.task.Priority == b.task.Priority {
+		// Both completed on different dates: the earlier one is kept
+		if keepsOver(b.task, a.task) {
+			r.add(b.raw)
+		} else {
+			r.add(a.raw)
+		}
+		return
+	}
+	r.conflict(MergeConflict{A: a.raw, B: b.raw, Reason: "changed in both copies"}, a.raw)
+}
+
+func (r *MergeResult) add(line string) {
+	r.slots = append(r.slots, []string{line})
+}
+
+func (r *MergeResult) conflict(c MergeConflict, choice string) {
+	c.slot = len(r.slots)
+	r.slots = append(r.slots, []string{choice})
+	r.Conflicts = append(r.Conflicts, c)
+}
+
+func other(side string) string {
+	if side == "A" {
+		return "B"
+	}
+	return "A"
+}
+
+// mergeKey is what matches a task across copies: its annotation key, which
+// survives edits, or else its text.
+func mergeKey(t Task) string {
+	if key := t.GetAnnotationKey(); key != "" {
+		return AnnotationTag + ":" + key
+	}
+	return strings.ToLower(t.Name)
+}
+
+func parseMergeLines(lines []string) []mergeLine {
+	var result []mergeLine
+	for _, l := range lines {
+		l = strings.TrimRight(l, "\r")
+		if strings.TrimSpace(l) == "" {
+			continue
+		}
+		result = append(result, mergeLine{raw: l, task: ParseTask(l, "", "")})
+	}
+	return result
+}
+
+func indexMergeLines(lines []mergeLine) map[string][]int {
+	index := make(map[string][]int)
+	for i, l := range lines {
+		key := mergeKey(l.task)
+		index[key] = append(index[key], i)
+	}
+	return index
+}
@@ -0,0 +1,94 @@
+package data
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestMerge_TwoWay(t *testing.T) {
+	a := []string{
+		"Call Bob",
+		"(A) Buy milk +home",
+		"Renew passport due:2026-05-01",
+		"Only in A",
+	}
+	b := []string{
+		"x 2026-03-02 Call Bob",
+		"(A) Buy milk +home",
+		"Renew passport due:2026-06-01",
+		"",
+		"Only in B",
+	}
+	r := Merge(nil, a, b)
+
+	want := []string{
+		"x 2026-03-02 Call Bob",
+		"(A) Buy milk +home",
+		"Renew passport due:2026-05-01",
+		"Only in A",
+		"Only in B",
+	}
+	if got := r.Lines(); !slices.Equal(got, want) {
+		t.Errorf("Lines = %q, want %q", got, want)
+	}
+	if r.AddedA != 1 || r.AddedB != 1 || r.Completed != 1 {
+		t.Errorf("AddedA=%d AddedB=%d Completed=%d, want 1 each", r.AddedA, r.AddedB, r.Completed)
+	}
+	if len(r.Conflicts) != 1 || r.Conflicts[0].B != "Renew passport due:2026-06-01" {
+		t.Fatalf("Conflicts = %+v, want the passport", r.Conflicts)
+	}
+
+	r.Resolve(0, r.Conflicts[0].B)
+	if got := r.Lines()[2]; got != "Renew passport due:2026-06-01" {
+		t.Errorf("resolved to %q", got)
+	}
+}
+
+func TestMerge_ThreeWay(t *testing.T) {
+	base := []string{
+		"Call Bob",
+		"Buy milk",
+		"Water plants",
+		"Pay rent ann:k1",
+		"Book flights",
+	}
+	a := []string{
+		"Call Bob @phone",                // edited in A only
+		"Buy milk",                       // deleted in B
+		"Pay rent due:2026-04-01 ann:k1", // edited in A, renamed in B
+		"Book flights +trip",             // edited in A, deleted in B
+	}
+	b := []string{
+		"Call Bob",
+		"Water plants",
+		"Pay the rent ann:k1",
+		"New in B",
+	}
+	r := Merge(base, a, b)
+
+	want := []string{
+		"Call Bob @phone",
+		"Pay rent due:2026-04-01 ann:k1",
+		"Book flights +trip",
+		"New in B",
+	}
+	if got := r.Lines(); !slices.Equal(got, want) {
+		t.Errorf("Lines = %q, want %q", got, want)
+	}
+	// Buy milk went in B and Water plants in A
+	if r.Deleted != 2 || r.AddedB != 1 {
+		t.Errorf("Deleted=%d AddedB=%d, want 2 and 1", r.Deleted, r.AddedB)
+	}
+	if len(r.Conflicts) != 2 {
+		t.Fatalf("Conflicts = %+v, want the rent and the flights", r.Conflicts)
+	}
+	if c := r.Conflicts[1]; c.B != "" || c.Reason != "deleted in B, changed in A" {
+		t.Errorf("flights conflict = %+v", c)
+	}
+
+	// Dropping a conflicted task
+	r.Resolve(1)
+	if got := len(r.Lines()); got != 3 {
+		t.Errorf("expected the flights dropped, got %q", r.Lines())
+	}
+}
@@ -0,0 +1,145 @@
+package data
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+)
+
+// PriorityWeights tunes SuggestPriorities: how much a near due date, the age
+// of a task and each project count towards its priority.
+type PriorityWeights struct {
+	Due float64
+	Age float64
+	// Projects maps a project (matched case-insensitively) to its
+	// importance, added to the score of its tasks; negative ones lower it
+	Projects map[string]float64
+}
+
+// DefaultPriorityWeights counts due dates and age fully and no project more
+// than another.
+var DefaultPriorityWeights = PriorityWeights{Due: 1, Age: 1}
+
+// PrioritySuggestion is a priority SuggestPriorities proposes for a task.
+type PrioritySuggestion struct {
+	Task     Task
+	Priority Priority
+	Score    float64
+	Reasons  []string // what made up the score, e.g. "due in 2 days"
+}
+
+// scorePriorities maps a score to the priority it earns, highest first.
+var scorePriorities = []struct {
+	min      float64
+	priority Priority
+}{
+	{4, PriorityA},
+	{3, PriorityB},
+	{2, PriorityC},
+	{1, PriorityD},
+}
+
+// SuggestPriorities proposes priorities for the pending tasks that have none
+// or a low one (D to F). A task scores by how soon it is due (4 when due
+// today or overdue, down to 1 two weeks out), how long it has been open (1
+// after 30 days, 2 after 90) and the importance of its projects, each times
+// its weight. Only tasks whose score earns a higher priority than they have
+// are suggested, highest score first.
+func SuggestPriorities(tasks []Task, w PriorityWeights, now time.Time) []PrioritySuggestion {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	projects := make(map[string]float64, len(w.Projects))
+	for p, weight := range w.Projects {
+		projects[strings.ToLower(p)] = weight
+	}
+
+	var suggestions []PrioritySuggestion
+	for _, t := range tasks {
+		if t.Done || (t.Priority != PriorityNone && t.Priority < PriorityD) {
+			continue
+		}
+		s := PrioritySuggestion{Task: t}
+		if due, err := time.ParseInLocation("2006-01-02", t.GetDueDate(), now.Location()); err == nil {
+			days := int(due.Sub(today).Hours() / 24)
+			if points := dueScore(days); points > 0 && w.Due != 0 {
+				s.Score += points * w.Due
+				s.Reasons = append(s.Reasons, dueReason(days))
+			}
+		}
+		if created, err := time.ParseInLocation("2006-01-02", t.CreatedDate, now.Location()); err == nil {
+			days := int(today.Sub(created).Hours() / 24)
+			if points := ageScore(days); points > 0 && w.Age != 0 {
+				s.Score += points * w.Age
+				s.Reasons = append(s.Reasons, fmt.Sprintf("open %d days", days))
+			}
+		}
+		for _, p := range t.Projects {
+			if weight := projects[strings.ToLower(p)]; weight != 0 {
+				s.Score += weight
+				s.Reasons = append(s.Reasons, fmt.Sprintf("+%s %+g", p, weight))
+			}
+		}
+
+		s.Priority = priorityForScore(s.Score)
+		if s.Priority == PriorityNone || (t.Priority != PriorityNone && s.Priority >= t.Priority) {
+			continue
+		}
+		suggestions = append(suggestions, s)
+	}
+
+	slices.SortStableFunc(suggestions, func(a, b PrioritySuggestion) int {
+		switch {
+		case a.Score > b.Score:
+			return -1
+		case a.Score < b.Score:
+			return 1
+		}
+		return 0
+	})
+	return suggestions
+}
+
+func dueScore(days int) float64 {
+	switch {
+	case days <= 0:
+		return 4
+	case days <= 2:
+		return 3
+	case days <= 7:
+		return 2
+	case days <= 14:
+		return 1
+	}
+	return 0
+}
+
+func dueReason(days int) string {
+	switch {
+	case days < 0:
+		return fmt.Sprintf("overdue %d day(s)", -days)
+	case days == 0:
+		return "due today"
+	case days == 1:
+		return "due tomorrow"
+	}
+	return fmt.Sprintf("due in %d days", days)
+}
+
+func ageScore(days int) float64 {
+	switch {
+	case days >= 90:
+		return 2
+	case days >= 30:
+		return 1
+	}
+	return 0
+}
+
+func priorityForScore(score float64) Priority {
+	for _, sp := range scorePriorities {
+		if score >= sp.min {
+			return sp.priority
+		}
+	}
+	return PriorityNone
+}
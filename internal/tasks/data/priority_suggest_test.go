@@ -0,0 +1,55 @@
+package data
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestSuggestPriorities(t *testing.T) {
+	now := time.Date(2026, time.March, 4, 9, 30, 0, 0, time.Local)
+	var tasks []Task
+	for _, line := range []string{
+		"Renew passport due:2026-03-03",            // overdue: A
+		"Send invoice +acme due:2026-03-06",        // due in 2 days (3) + acme (2): A
+		"(E) Book dentist due:2026-03-10",          // in 6 days: C, up from E
+		"2025-12-01 Sort photos",                   // open 93 days: C
+		"Water plants +chores due:2026-03-05",      // tomorrow (3) + chores (-2): D
+		"(A) Already urgent due:2026-03-04",        // high priority: left alone
+		"(D) Read book due:2026-04-30",             // nothing earns more than D
+		"x 2026-03-01 Done already due:2026-03-01", // completed
+		"Plain task", // no score
+	} {
+		tasks = append(tasks, ParseTask(line, "", ""))
+	}
+	w := PriorityWeights{Due: 1, Age: 1, Projects: map[string]float64{"ACME": 2, "chores": -2}}
+
+	got := SuggestPriorities(tasks, w, now)
+	want := []struct {
+		name     string
+		priority Priority
+	}{
+		{"Send invoice", PriorityA},
+		{"Renew passport", PriorityA},
+		{"Book dentist", PriorityC},
+		{"Sort photos", PriorityC},
+		{"Water plants", PriorityD},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d suggestions, want %d: %+v", len(got), len(want), got)
+	}
+	for i, w := range want {
+		if got[i].Task.Name != w.name || got[i].Priority != w.priority {
+			t.Errorf("suggestion %d = %q (%c), want %q (%c)", i, got[i].Task.Name, got[i].Priority, w.name, w.priority)
+		}
+	}
+	if reasons := strings.Join(got[0].Reasons, ", "); reasons != "due in 2 days, +acme +2" {
+		t.Errorf("reasons = %q", reasons)
+	}
+
+	// Weighting due dates out leaves only age and projects
+	got = SuggestPriorities(tasks, PriorityWeights{Age: 1}, now)
+	if len(got) != 1 || got[0].Task.Name != "Sort photos" {
+		t.Errorf("without the due weight got %+v", got)
+	}
+}
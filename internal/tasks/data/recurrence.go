@@ -0,0 +1,183 @@
+package data
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RecurrenceTag is the tag that makes a task repeat, e.g. rec:1w.
+const RecurrenceTag = "rec"
+
+// Recurrence is how often a task repeats, parsed from its rec: tag.
+type Recurrence struct {
+	Every int
+	Unit  byte // 'd', 'w', 'm' or 'y'
+	// Strict ("+" prefix, rec:+1w) counts the next occurrence from the due
+	// date rather than from the day the task was completed.
+	Strict bool
+}
+
+// ParseRecurrence parses a rec: value: a count and a unit (3d, 1w, 2m, 1y)
+// or one of daily, weekly, monthly and yearly, optionally prefixed with "+".
+func ParseRecurrence(s string) (Recurrence, error) {
+	var r Recurrence
+	value, strict := strings.CutPrefix(strings.ToLower(s), "+")
+	r.Strict = strict
+	switch value {
+	case "daily":
+		return Recurrence{1, 'd', strict}, nil
+	case "weekly":
+		return Recurrence{1, 'w', strict}, nil
+	case "monthly":
+		return Recurrence{1, 'm', strict}, nil
+	case "yearly":
+		return Recurrence{1, 'y', strict}, nil
+	}
+	if len(value) < 2 {
+		return r, fmt.Errorf("invalid recurrence %q (want e.g. 3d, 1w, 2m, 1y or weekly)", s)
+	}
+	n, err := strconv.Atoi(value[:len(value)-1])
+	unit := value[len(value)-1]
+	if err != nil || n < 1 || !strings.ContainsRune("dwmy", rune(unit)) {
+		return r, fmt.Errorf("invalid recurrence %q (want e.g. 3d, 1w, 2m, 1y or weekly)", s)
+	}
+	r.Every, r.Unit = n, unit
+	return r, nil
+}
+
+// Next returns the date one interval after from. Months and years keep the
+// day of the month, or take the last day of a shorter month: monthly from
+// Jan 31 is Feb 28, and yearly from Feb 29 is Feb 28.
+func (r Recurrence) Next(from time.Time) time.Time {
+	switch r.Unit {
+	case 'w':
+		return from.AddDate(0, 0, 7*r.Every)
+	case 'm':
+		return addMonths(from, r.Every)
+	case 'y':
+		return addMonths(from, 12*r.Every)
+	}
+	return from.AddDate(0, 0, r.Every)
+}
+
+// addMonths returns from n months on, clamped to the end of the month.
+// time.AddDate would roll Jan 31 over into March.
+func addMonths(from time.Time, n int) time.Time {
+	first := time.Date(from.Year(), from.Month()+time.Month(n), 1,
+		from.Hour(), from.Minute(), from.Second(), from.Nanosecond(), from.Location())
+	last := first.AddDate(0, 1, -1).Day()
+	return first.AddDate(0, 0, min(from.Day(), last)-1)
+}
+
+// GetRecurrence returns the task's parsed rec: tag, if it has a valid one.
+func (t *Task) GetRecurrence() (Recurrence, bool) {
+	value, ok := t.Tags[RecurrenceTag]
+	if !ok {
+		return Recurrence{}, false
+	}
+	r, err := ParseRecurrence(value)
+	return r, err == nil
+}
+
+// recurrenceAnchor returns the date a recurring task repeats by: its due
+// date, or else its scheduled date.
+func (t *Task) recurrenceAnchor() (time.Time, bool) {
+	for _, value := range []string{t.GetDueDate(), t.GetScheduledDate()} {
+		if d, err := time.Parse("2006-01-02", value); err == nil {
+			return d, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// NextOccurrence returns the pending task to add when t, a recurring task,
+// is completed on the day completed. Its due date (or scheduled date, when
+// it has no due date) is one interval after the completion day, or after
+// the old date for a strict recurrence, and its other dates move with it. A
+// task with neither date gets a due date. Returns false when t does not
+// recur.
+func (t Task) NextOccurrence(completed time.Time) (Task, bool) {
+	r, ok := t.GetRecurrence()
+	if !ok {
+		return Task{}, false
+	}
+	day := time.Date(completed.Year(), completed.Month(), completed.Day(), 0, 0, 0, 0, time.UTC)
+	from := day
+	if anchor, ok := t.recurrenceAnchor(); ok && r.Strict {
+		from = anchor
+	}
+	return t.occurrence(day, r.Next(from)), true
+}
+
+// occurrence returns the pending copy of t added on the day created whose
+// due (or scheduled) date is date, with its other dates moved along.
+func (t Task) occurrence(created, date time.Time) Task {
+	next := t
+	next.ID = ""
+	next.Line = 0
+	next.Done = false
+	next.CompletionDate = ""
+	if next.CreatedDate != "" {
+		next.CreatedDate = created.Format("2006-01-02")
+	}
+	next.Projects = slices.Clone(t.Projects)
+	next.Contexts = slices.Clone(t.Contexts)
+	next.Tags = maps.Clone(t.Tags)
+	// Annotations belong to the occurrence they were written on
+	delete(next.Tags, AnnotationTag)
+
+	anchor, ok := t.recurrenceAnchor()
+	if !ok {
+		next.SetDueDate(date.Format("2006-01-02"))
+		return next
+	}
+	days := int(date.Sub(anchor).Hours() / 24)
+	for _, key := range []string{"due", "scheduled", "start"} {
+		if d, err := time.Parse("2006-01-02", next.Tags[key]); err == nil {
+			next.Tags[key] = d.AddDate(0, 0, days).Format("2006-01-02")
+		}
+	}
+	return next
+}
+
+// ProjectOccurrences returns the occurrences of t, a pending recurring task,
+// that would follow it up to and including the day through, assuming each
+// is completed on its due (or scheduled) date. A task without either date,
+// whose next date depends on when it is done, has none.
+func (t Task) ProjectOccurrences(through time.Time) []Task {
+	through = time.Date(through.Year(), through.Month(), through.Day(), 0, 0, 0, 0, time.UTC)
+	r, ok := t.GetRecurrence()
+	if !ok {
+		return nil
+	}
+	anchor, ok := t.recurrenceAnchor()
+	if !ok {
+		return nil
+	}
+	// Each date is counted from the task's own, not from the one before, so
+	// a monthly task on the 31st comes back to the 31st after a short month
+	var occurrences []Task
+	previous := anchor
+	for k := 1; k <= maxProjectedOccurrences; k++ {
+		step := r
+		step.Every = r.Every * k
+		date := step.Next(anchor)
+		if date.After(through) {
+			break
+		}
+		next := t.occurrence(previous, date)
+		next.ID = t.ID
+		next.File = t.File
+		next.Line = t.Line
+		occurrences = append(occurrences, next)
+		previous = date
+	}
+	return occurrences
+}
+
+// maxProjectedOccurrences caps ProjectOccurrences, a year of a daily task.
+const maxProjectedOccurrences = 366
@@ -0,0 +1,138 @@
+package data
+
+import (
+	"slices"
+	"testing"
+	"time"
+)
+
+func TestParseRecurrence(t *testing.T) {
+	tests := []struct {
+		in   string
+		want Recurrence
+	}{
+		{"3d", Recurrence{3, 'd', false}},
+		{"1w", Recurrence{1, 'w', false}},
+		{"+2m", Recurrence{2, 'm', true}},
+		{"1y", Recurrence{1, 'y', false}},
+		{"monthly", Recurrence{1, 'm', false}},
+		{"+Weekly", Recurrence{1, 'w', true}},
+	}
+	for _, tt := range tests {
+		got, err := ParseRecurrence(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseRecurrence(%q) = %+v, %v; want %+v", tt.in, got, err, tt.want)
+		}
+	}
+	for _, bad := range []string{"", "w", "0d", "2x", "fortnightly", "+"} {
+		if _, err := ParseRecurrence(bad); err == nil {
+			t.Errorf("ParseRecurrence(%q): expected an error", bad)
+		}
+	}
+}
+
+func TestParseTask_StrictRecurrenceTag(t *testing.T) {
+	task := ParseTask("Pay rent +home rec:+1m due:2026-03-01", "id", "todo.txt")
+	if task.Name != "Pay rent" || task.Tags["rec"] != "+1m" {
+		t.Fatalf("parsed %+v", task)
+	}
+	if got := task.String(); got != "Pay rent +home due:2026-03-01 rec:+1m" {
+		t.Errorf("String() = %q, want the + kept unquoted", got)
+	}
+}
+
+func TestNextOccurrence(t *testing.T) {
+	completed := time.Date(2026, 3, 4, 18, 0, 0, 0, time.Local)
+	tests := []struct {
+		name, line, want string
+	}{
+		{
+			"from the completion day",
+			"x 2026-03-04 2026-02-20 Water plants @home rec:1w due:2026-03-02 ann:k1",
+			"2026-03-04 Water plants @home due:2026-03-11 rec:1w",
+		},
+		{
+			"strict keeps to the schedule",
+			"Pay rent rec:+1m due:2026-03-01 start:2026-02-25",
+			"Pay rent due:2026-04-01 rec:+1m start:2026-03-28",
+		},
+		{
+			"scheduled date when there is no due date",
+			"Review budget rec:monthly scheduled:2026-02-27",
+			"Review budget rec:monthly scheduled:2026-04-04",
+		},
+		{
+			"undated task gets a due date",
+			"Stretch rec:2d",
+			"Stretch due:2026-03-06 rec:2d",
+		},
+	}
+	for _, tt := range tests {
+		task := ParseTask(tt.line, "id", "todo.txt")
+		next, ok := task.NextOccurrence(completed)
+		if !ok {
+			t.Fatalf("%s: expected a next occurrence", tt.name)
+		}
+		if got := next.String(); got != tt.want {
+			t.Errorf("%s: next = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+
+	if _, ok := ParseTask("One-off due:2026-03-01", "id", "todo.txt").NextOccurrence(completed); ok {
+		t.Error("expected no next occurrence without a rec: tag")
+	}
+}
+
+func TestProjectOccurrences(t *testing.T) {
+	task := ParseTask("Standup rec:1w due:2026-03-04", "id", "todo.txt")
+	occurrences := task.ProjectOccurrences(time.Date(2026, 3, 25, 12, 0, 0, 0, time.Local))
+	var dues []string
+	for _, o := range occurrences {
+		dues = append(dues, o.GetDueDate())
+		if o.ID != "id" || o.Done {
+			t.Errorf("expected occurrences pending and keeping the task's ID, got %+v", o)
+		}
+	}
+	if len(dues) != 3 || dues[0] != "2026-03-11" || dues[2] != "2026-03-25" {
+		t.Errorf("projected dues %v, want Mar 11, 18 and 25", dues)
+	}
+	if got := ParseTask("Stretch rec:2d", "id", "todo.txt").ProjectOccurrences(time.Date(2026, 12, 31, 0, 0, 0, 0, time.Local)); len(got) != 0 {
+		t.Errorf("expected no projections for an undated task, got %d", len(got))
+	}
+}
+
+func TestRecurrenceNext_MonthEnd(t *testing.T) {
+	tests := []struct {
+		rec, from, want string
+	}{
+		{"1m", "2026-01-31", "2026-02-28"},
+		{"1m", "2028-01-31", "2028-02-29"},
+		{"2m", "2026-12-31", "2027-02-28"},
+		{"1m", "2026-03-31", "2026-04-30"},
+		{"1y", "2028-02-29", "2029-02-28"},
+		{"4y", "2028-02-29", "2032-02-29"},
+		{"1m", "2026-02-28", "2026-03-28"},
+	}
+	for _, tt := range tests {
+		r, err := ParseRecurrence(tt.rec)
+		if err != nil {
+			t.Fatal(err)
+		}
+		from, _ := time.Parse("2006-01-02", tt.from)
+		if got := r.Next(from).Format("2006-01-02"); got != tt.want {
+			t.Errorf("%s after %s = %s, want %s", tt.rec, tt.from, got, tt.want)
+		}
+	}
+}
+
+func TestProjectOccurrences_KeepsMonthEnd(t *testing.T) {
+	task := ParseTask("Close the books rec:+1m due:2026-01-31", "id", "todo.txt")
+	var dues []string
+	for _, o := range task.ProjectOccurrences(time.Date(2026, 4, 30, 0, 0, 0, 0, time.Local)) {
+		dues = append(dues, o.GetDueDate())
+	}
+	want := []string{"2026-02-28", "2026-03-31", "2026-04-30"}
+	if !slices.Equal(dues, want) {
+		t.Errorf("projected dues %v, want %v", dues, want)
+	}
+}
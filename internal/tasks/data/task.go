@@ -5,10 +5,12 @@ import (
 	"regexp"
 	"slices"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 )
 
-var simpleTagValueRe = regexp.MustCompile(`^[A-Za-z0-9-]+$`)
+var simpleTagValueRe = regexp.MustCompile(`^\+?[A-Za-z0-9-]+$`)
 
 func FormatTagValue(v string) string {
 	if simpleTagValueRe.MatchString(v) {
@@ -40,6 +42,7 @@ type Task struct {
 	CompletionDate string
 	Priority       Priority
 	File           string
+	Line           int // 1-based line in File when loaded, 0 for new tasks
 }
 
 func (t *Task) HasProject(project string) bool {
@@ -95,6 +98,18 @@ func (t *Task) SetDueDate(date string) {
 	}
 }
 
+// BumpDueDate moves the due date by days (negative to pull it in), counting
+// from today when the task has no due date. Returns the new date.
+func (t *Task) BumpDueDate(days int, today time.Time) string {
+	base := today
+	if d, err := time.ParseInLocation("2006-01-02", t.GetDueDate(), today.Location()); err == nil {
+		base = d
+	}
+	due := base.AddDate(0, 0, days).Format("2006-01-02")
+	t.SetDueDate(due)
+	return due
+}
+
 func (t *Task) GetURL() string {
 	return t.Tags["url"]
 }
@@ -110,6 +125,119 @@ func (t *Task) SetURL(url string) {
 	}
 }
 
+// GetURLs returns the task's URLs in order: url:, then url2:, url3:, ...
+func (t *Task) GetURLs() []string {
+	var nums []int
+	for k := range t.Tags {
+		if n, ok := urlTagIndex(k); ok {
+			nums = append(nums, n)
+		}
+	}
+	sort.Ints(nums)
+	urls := make([]string, 0, len(nums))
+	for _, n := range nums {
+		urls = append(urls, t.Tags[urlTagKey(n)])
+	}
+	return urls
+}
+
+// SetURLs replaces all of the task's URLs, writing them as url:, url2:, url3:, ...
+func (t *Task) SetURLs(urls []string) {
+	if t.Tags == nil {
+		t.Tags = make(map[string]string)
+	}
+	for k := range t.Tags {
+		if _, ok := urlTagIndex(k); ok {
+			delete(t.Tags, k)
+		}
+	}
+	n := 1
+	for _, url := range urls {
+		if url == "" {
+			continue
+		}
+		t.Tags[urlTagKey(n)] = url
+		n++
+	}
+}
+
+// urlTagKey returns the tag key for the n-th (1-based) URL.
+func urlTagKey(n int) string {
+	return numberedTagKey("url", n)
+}
+
+// IsURLTag reports whether key is one of the URL tags (url, url2, url3, ...).
+func IsURLTag(key string) bool {
+	_, ok := urlTagIndex(key)
+	return ok
+}
+
+// urlTagIndex reports whether key is a URL tag (url, url2, ...) and its 1-based index.
+func urlTagIndex(key string) (int, bool) {
+	return numberedTagIndex("url", key)
+}
+
+// GetAttachments returns the paths of the task's attachments in order:
+// attach:, then attach2:, attach3:, ...
+func (t *Task) GetAttachments() []string {
+	var nums []int
+	for k := range t.Tags {
+		if n, ok := numberedTagIndex("attach", k); ok {
+			nums = append(nums, n)
+		}
+	}
+	sort.Ints(nums)
+	paths := make([]string, 0, len(nums))
+	for _, n := range nums {
+		paths = append(paths, t.Tags[numberedTagKey("attach", n)])
+	}
+	return paths
+}
+
+// AddAttachment records path as the task's next attach: tag, unless it is
+// already attached.
+func (t *Task) AddAttachment(path string) {
+	last := 0
+	for k, v := range t.Tags {
+		if n, ok := numberedTagIndex("attach", k); ok {
+			if v == path {
+				return
+			}
+			last = max(last, n)
+		}
+	}
+	if t.Tags == nil {
+		t.Tags = make(map[string]string)
+	}
+	t.Tags[numberedTagKey("attach", last+1)] = path
+}
+
+// numberedTagKey returns the tag key for the n-th (1-based) value of a tag
+// that can repeat: base, base2, base3, ...
+func numberedTagKey(base string, n int) string {
+	if n == 1 {
+		return base
+	}
+	return base + strconv.Itoa(n)
+}
+
+// numberedTagIndex reports whether key is one of the numbered keys of base
+// and its 1-based index.
+func numberedTagIndex(base, key string) (int, bool) {
+	if key == base {
+		return 1, true
+	}
+	suffix, ok := strings.CutPrefix(key, base)
+	if !ok {
+		return 0, false
+	}
+	n, err := strconv.Atoi(suffix)
+	if err != nil || n < 2 || numberedTagKey(base, n) != key {
+		return 0, false
+	}
+	return n, true
+}
+
 func (t *Task) GetScheduledDate() string {
 	return t.Tags["scheduled"]
 }
@@ -125,6 +253,33 @@ func (t *Task) SetScheduledDate(date string) {
 	}
 }
 
+// GetStartDate returns the start: date, from which the task is actionable.
+func (t *Task) GetStartDate() string {
+	return t.Tags["start"]
+}
+
+func (t *Task) SetStartDate(date string) {
+	if t.Tags == nil {
+		t.Tags = make(map[string]string)
+	}
+	if date == "" {
+		delete(t.Tags, "start")
+	} else {
+		t.Tags["start"] = date
+	}
+}
+
+// NotStarted reports whether the task has a start: date after the day of
+// now. Until then it is hidden from task lists and the agenda.
+func (t *Task) NotStarted(now time.Time) bool {
+	start, err := time.ParseInLocation("2006-01-02", t.GetStartDate(), now.Location())
+	if err != nil {
+		return false
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	return start.After(today)
+}
+
 func (t Task) String() string {
 	var parts []string
 
@@ -313,7 +468,7 @@ func FirstContextIndex(s string) int {
 }
 
 func FirstTagIndex(s string) int {
-	re := regexp.MustCompile(`[ \t][A-Za-z0-9]+:(?:"[^"]*"|[A-Za-z0-9]+)`)
+	re := regexp.MustCompile(`[ \t][A-Za-z0-9]+:(?:"[^"]*"|\+?[A-Za-z0-9]+)`)
 	loc := re.FindStringIndex(s)
 	if loc != nil {
 		return loc[0] + 1
@@ -340,7 +495,7 @@ func ParseContexts(s string) []string {
 }
 
 func ParseTags(s string) map[string]string {
-	re := regexp.MustCompile(`[ \t]([A-Za-z0-9]+):(?:"([^"]*)"|([A-Za-z0-9-]+))`)
+	re := regexp.MustCompile(`[ \t]([A-Za-z0-9]+):(?:"([^"]*)"|(\+?[A-Za-z0-9-]+))`)
 	matches := re.FindAllStringSubmatch(s, -1)
 	tags := make(map[string]string)
 	for _, m := range matches {
@@ -386,6 +541,23 @@ func FirstMetaIndex(i1 int, i2 int, i3 int) int {
 	return min
 }
 
+// DateTagWarnings returns a message for each date tag (due:, scheduled:, start:)
+// whose value is not a yyyy-mm-dd date. Such tags are kept on the task but
+// it is left out of the agenda.
+func (t *Task) DateTagWarnings() map[string]string {
+	warnings := make(map[string]string)
+	for _, key := range []string{"due", "scheduled", "start"} {
+		value, ok := t.Tags[key]
+		if !ok || value == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", value); err != nil {
+			warnings[key] = fmt.Sprintf("invalid date %q (want 2006-01-02)", value)
+		}
+	}
+	return warnings
+}
+
 func ParseDate(s string) string {
 	if len(s) == 10 && s[4] == '-' && s[7] == '-' {
 		return s
@@ -1,7 +1,9 @@
 package data
 
 import (
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseTask_Basic(t *testing.T) {
@@ -157,6 +159,31 @@ func TestTask_ClearScheduledDate(t *testing.T) {
 	}
 }
 
+func TestTask_NotStarted(t *testing.T) {
+	now := time.Date(2026, 3, 10, 15, 0, 0, 0, time.Local)
+	tests := []struct {
+		line string
+		want bool
+	}{
+		{"Renew passport start:2026-03-11", true},
+		{"Renew passport start:2026-03-10", false},
+		{"Renew passport start:2026-03-01", false},
+		{"Renew passport start:soon", false},
+		{"Renew passport", false},
+	}
+	for _, tt := range tests {
+		task := ParseTask(tt.line, "", "")
+		if got := task.NotStarted(now); got != tt.want {
+			t.Errorf("%q: NotStarted = %v, want %v", tt.line, got, tt.want)
+		}
+	}
+
+	task := ParseTask("Renew passport start:soon", "", "")
+	if _, ok := task.DateTagWarnings()["start"]; !ok {
+		t.Error("expected a warning for an invalid start date")
+	}
+}
+
 func TestParseTask_QuotedTagURL(t *testing.T) {
 	task := ParseTask(`Buy domain url:"https://example.com/path?q=1"`, "id1", "todo.txt")
 	if task.Name != "Buy domain" {
@@ -238,3 +265,66 @@ func TestParseTask_QuotedTagNormalization(t *testing.T) {
 		t.Errorf("normalization mismatch: got %q, want %q", result, expected)
 	}
 }
+
+func TestGetURLs_Ordered(t *testing.T) {
+	task := ParseTask(`Review url10:"https://ten" url:"https://one" url2:"https://two" urlx:skip`, "id1", "todo.txt")
+	got := task.GetURLs()
+	want := []string{"https://one", "https://two", "https://ten"}
+	if len(got) != len(want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("url %d: expected %q, got %q", i, want[i], got[i])
+		}
+	}
+}
+
+func TestSetURLs_RenumbersAndClears(t *testing.T) {
+	task := ParseTask(`Review url:"https://one" url3:"https://three"`, "id1", "todo.txt")
+	task.SetURLs([]string{"https://a", "", "https://b"})
+	if task.Tags["url"] != "https://a" || task.Tags["url2"] != "https://b" {
+		t.Errorf("unexpected tags after SetURLs: %v", task.Tags)
+	}
+	if _, ok := task.Tags["url3"]; ok {
+		t.Error("stale url3 should be removed")
+	}
+	task.SetURLs(nil)
+	if len(task.GetURLs()) != 0 {
+		t.Errorf("expected no URLs, got %v", task.GetURLs())
+	}
+}
+
+func TestAddAttachment(t *testing.T) {
+	task := ParseTask(`Review spec attach:"attachments/a.pdf" attach3:"attachments/c.png"`, "id1", "todo.txt")
+	task.AddAttachment("attachments/d.txt")
+	task.AddAttachment("attachments/a.pdf") // already attached
+	want := []string{"attachments/a.pdf", "attachments/c.png", "attachments/d.txt"}
+	got := task.GetAttachments()
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+	if task.Tags["attach4"] != "attachments/d.txt" {
+		t.Errorf("expected the new attachment after the last one, got %v", task.Tags)
+	}
+}
+
+func TestBumpDueDate(t *testing.T) {
+	today := time.Date(2026, 10, 15, 9, 30, 0, 0, time.Local)
+
+	task := ParseTask("Write report due:2026-10-31", "id1", "todo.txt")
+	if got := task.BumpDueDate(1, today); got != "2026-11-01" {
+		t.Errorf("+1 day: got %q", got)
+	}
+	if got := task.BumpDueDate(-7, today); got != "2026-10-25" {
+		t.Errorf("-7 days: got %q", got)
+	}
+
+	undated := ParseTask("Call Bob", "id2", "todo.txt")
+	if got := undated.BumpDueDate(1, today); got != "2026-10-16" {
+		t.Errorf("undated task should count from today, got %q", got)
+	}
+	if undated.GetDueDate() != "2026-10-16" {
+		t.Errorf("due tag not set: %v", undated.Tags)
+	}
+}
@@ -0,0 +1,156 @@
+package filter
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+)
+
+// ParseDate parses a due date filter expression relative to now:
+//
+//	2026-11-03, today, tomorrow, yesterday, +3d, -1w, +1m   due that day
+//	<DAY, >DAY, <=DAY, >=DAY                               due before / after it
+//	DAY..DAY                                               due in the range, inclusive
+//	overdue                                                due before today
+//	this-week, next-week, this-month, next-month           due in that week (Mon-Sun) or month
+//	no-date                                                no due date
+//
+// A leading "due:" is ignored. The result keeps expr, so that a saved filter
+// such as "today" is resolved again on the day it is used.
+func ParseDate(expr string, now time.Time) (*DateFilter, error) {
+	e := strings.ToLower(strings.TrimSpace(expr))
+	e = strings.TrimSpace(strings.TrimPrefix(e, "due:"))
+	if e == "" {
+		return nil, fmt.Errorf("empty date filter")
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	f := &DateFilter{Expr: e}
+
+	switch e {
+	case "no-date", "none", "missing":
+		f.Mode = DateMissing
+		return f, nil
+	case "overdue":
+		f.Mode, f.Date = DateBefore, today
+		return f, nil
+	case "this-week", "next-week":
+		monday := today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		if e == "next-week" {
+			monday = monday.AddDate(0, 0, 7)
+		}
+		f.Mode, f.Date, f.End = DateBetween, monday, monday.AddDate(0, 0, 6)
+		return f, nil
+	case "this-month", "next-month":
+		first := time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.UTC)
+		if e == "next-month" {
+			first = first.AddDate(0, 1, 0)
+		}
+		f.Mode, f.Date, f.End = DateBetween, first, first.AddDate(0, 1, -1)
+		return f, nil
+	}
+
+	if from, to, ok := strings.Cut(e, ".."); ok {
+		start, err := parseFilterDay(from, today)
+		if err != nil {
+			return nil, err
+		}
+		end, err := parseFilterDay(to, today)
+		if err != nil {
+			return nil, err
+		}
+		if end.Before(start) {
+			return nil, fmt.Errorf("range ends before it starts: %s", expr)
+		}
+		f.Mode, f.Date, f.End = DateBetween, start, end
+		return f, nil
+	}
+
+	for _, op := range []string{"<=", ">=", "<", ">"} {
+		rest, ok := strings.CutPrefix(e, op)
+		if !ok {
+			continue
+		}
+		day, err := parseFilterDay(rest, today)
+		if err != nil {
+			return nil, err
+		}
+		switch op {
+		case "<=":
+			f.Mode, f.Date = DateBefore, day.AddDate(0, 0, 1)
+		case ">=":
+			f.Mode, f.Date = DateAfter, day.AddDate(0, 0, -1)
+		case "<":
+			f.Mode, f.Date = DateBefore, day
+		case ">":
+			f.Mode, f.Date = DateAfter, day
+		}
+		return f, nil
+	}
+
+	day, err := parseFilterDay(e, today)
+	if err != nil {
+		return nil, err
+	}
+	f.Mode, f.Date = DateOn, day
+	return f, nil
+}
+
+// parseFilterDay parses one day of a date filter: yyyy-MM-dd, today,
+// tomorrow, yesterday or an offset from today such as +3d, -2w or +1m.
+func parseFilterDay(s string, today time.Time) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	switch s {
+	case "today":
+		return today, nil
+	case "tomorrow":
+		return today.AddDate(0, 0, 1), nil
+	case "yesterday":
+		return today.AddDate(0, 0, -1), nil
+	}
+	if len(s) >= 3 && (s[0] == '+' || s[0] == '-') {
+		n, err := strconv.Atoi(s[1 : len(s)-1])
+		if err == nil {
+			if s[0] == '-' {
+				n = -n
+			}
+			switch s[len(s)-1] {
+			case 'd':
+				return today.AddDate(0, 0, n), nil
+			case 'w':
+				return today.AddDate(0, 0, 7*n), nil
+			case 'm':
+				return today.AddDate(0, n, 0), nil
+			}
+		}
+	}
+	if d, err := time.Parse("2006-01-02", s); err == nil {
+		return d, nil
+	}
+	return time.Time{}, fmt.Errorf("invalid date %q, use yyyy-MM-dd, today or +3d", s)
+}
+
+// ValidateDate validates a date filter expression for the text input;
+// empty clears the filter.
+func ValidateDate(s string) error {
+	if strings.TrimSpace(s) == "" {
+		return nil
+	}
+	_, err := ParseDate(s, clock.Now())
+	return err
+}
+
+// resolved returns the filter with its expression applied as of now; a
+// filter without one is returned as is.
+func (f *DateFilter) resolved(now time.Time) *DateFilter {
+	if f == nil || f.Expr == "" {
+		return f
+	}
+	r, err := ParseDate(f.Expr, now)
+	if err != nil {
+		return f
+	}
+	return r
+}
@@ -0,0 +1,408 @@
+// Package filter parses task filters and applies them to task lists. The
+// task manager and the todo.sh commands share it.
+package filter
+
+import (
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+	"wydo/internal/tasks/data"
+)
+
+// StatusFilter represents filtering by task completion status
+type StatusFilter int
+
+const (
+	StatusAll StatusFilter = iota
+	StatusPending
+	StatusDone
+)
+
+// DateMode represents how to compare dates
+type DateMode int
+
+const (
+	DateNone DateMode = iota
+	DateBefore
+	DateOn
+	DateAfter
+	DateMissing
+	DateBetween // from Date to End, inclusive
+)
+
+// DateFilter holds date filtering configuration
+type DateFilter struct {
+	Mode DateMode  `json:"mode"`
+	Date time.Time `json:"date"`
+	End  time.Time `json:"end,omitempty"`
+	// Expr is the expression the filter was parsed from ("this-week",
+	// "<+7d"). Relative ones are resolved again whenever the filter is
+	// applied, see ParseDate.
+	Expr string `json:"expr,omitempty"`
+}
+
+// State holds all active filters. It is saved with the session, hence the json tags.
+type State struct {
+	SearchQuery     string          `json:"search,omitempty"`
+	StatusFilter    StatusFilter    `json:"status"`
+	DateFilter      *DateFilter     `json:"date,omitempty"`
+	ProjectFilter   []string        `json:"projects,omitempty"`
+	ContextFilter   []string        `json:"contexts,omitempty"`
+	PriorityFilter  []data.Priority `json:"priorities,omitempty"`
+	FileFilter      []string        `json:"files,omitempty"`
+	WorkspaceFilter []string        `json:"workspaces,omitempty"`      // workspace basenames
+	AddedThisWeek   bool            `json:"added_this_week,omitempty"` // created on or after this week's Monday
+	// ShowUnstarted lists pending tasks whose start: date is still ahead,
+	// which are hidden otherwise
+	ShowUnstarted bool `json:"show_unstarted,omitempty"`
+}
+
+// NewState creates a new empty filter state
+func NewState() State {
+	return State{
+		StatusFilter: StatusPending,
+	}
+}
+
+// Parse parses a filter given on the command line, such as
+// "+alpha @home (A) due:this-week report". +project, @context, (X) and
+// due:EXPR (see ParseDate) filter as the pickers do; the other words
+// are the search query.
+func Parse(expr string, now time.Time) (State, error) {
+	f := NewState()
+	var words []string
+	for _, field := range strings.Fields(expr) {
+		switch {
+		case len(field) > 1 && field[0] == '+':
+			f.ProjectFilter = append(f.ProjectFilter, field[1:])
+		case len(field) > 1 && field[0] == '@':
+			f.ContextFilter = append(f.ContextFilter, field[1:])
+		case len(field) == 3 && data.ParsePriority(field) != data.PriorityNone:
+			f.PriorityFilter = append(f.PriorityFilter, data.ParsePriority(field))
+		case strings.HasPrefix(strings.ToLower(field), "due:"):
+			date, err := ParseDate(field, now)
+			if err != nil {
+				return State{}, err
+			}
+			f.DateFilter = date
+		default:
+			words = append(words, field)
+		}
+	}
+	f.SearchQuery = strings.Join(words, " ")
+	return f, nil
+}
+
+// IsEmpty returns true if no filters are active
+func (f *State) IsEmpty() bool {
+	return f.SearchQuery == "" &&
+		f.StatusFilter == StatusAll &&
+		f.DateFilter == nil &&
+		len(f.ProjectFilter) == 0 &&
+		len(f.ContextFilter) == 0 &&
+		len(f.PriorityFilter) == 0 &&
+		len(f.FileFilter) == 0 &&
+		len(f.WorkspaceFilter) == 0 &&
+		!f.AddedThisWeek &&
+		!f.ShowUnstarted
+}
+
+// Reset clears all filters
+func (f *State) Reset() {
+	f.SearchQuery = ""
+	f.StatusFilter = StatusAll
+	f.DateFilter = nil
+	f.ProjectFilter = nil
+	f.ContextFilter = nil
+	f.PriorityFilter = nil
+	f.FileFilter = nil
+	f.WorkspaceFilter = nil
+	f.AddedThisWeek = false
+	f.ShowUnstarted = false
+}
+
+// CycleStatusFilter cycles through status filter options
+func (f *State) CycleStatusFilter() {
+	switch f.StatusFilter {
+	case StatusAll:
+		f.StatusFilter = StatusPending
+	case StatusPending:
+		f.StatusFilter = StatusDone
+	case StatusDone:
+		f.StatusFilter = StatusAll
+	}
+}
+
+// Apply applies all active filters to a task list
+func Apply(tasks []data.Task, state State) []data.Task {
+	// With nothing else set, showing unstarted tasks keeps them all
+	rest := state
+	rest.ShowUnstarted = false
+	if state.ShowUnstarted && rest.IsEmpty() {
+		return tasks
+	}
+
+	state.DateFilter = state.DateFilter.resolved(clock.Now())
+	var result []data.Task
+	for _, task := range tasks {
+		if matchesFilters(task, state) {
+			result = append(result, task)
+		}
+	}
+	return result
+}
+
+func matchesFilters(task data.Task, state State) bool {
+	// Search filter (fuzzy match on name)
+	if state.SearchQuery != "" {
+		if !fuzzyMatch(task.Name, state.SearchQuery) {
+			return false
+		}
+	}
+
+	// Status filter
+	switch state.StatusFilter {
+	case StatusPending:
+		if task.Done {
+			return false
+		}
+	case StatusDone:
+		if !task.Done {
+			return false
+		}
+	}
+
+	// Date filter
+	if state.DateFilter != nil {
+		if !matchesDateFilter(task, state.DateFilter) {
+			return false
+		}
+	}
+
+	// Project filter (task must have at least one matching project)
+	if len(state.ProjectFilter) > 0 {
+		if !matchesAnyProject(task, state.ProjectFilter) {
+			return false
+		}
+	}
+
+	// Context filter (task must have at least one matching context)
+	if len(state.ContextFilter) > 0 {
+		if !matchesAnyContext(task, state.ContextFilter) {
+			return false
+		}
+	}
+
+	// Priority filter
+	if len(state.PriorityFilter) > 0 {
+		if !matchesPriority(task, state.PriorityFilter) {
+			return false
+		}
+	}
+
+	// File filter
+	if len(state.FileFilter) > 0 {
+		if !matchesFile(task, state.FileFilter) {
+			return false
+		}
+	}
+
+	// Added this week filter
+	if state.AddedThisWeek {
+		if !addedSince(task, WeekStart(clock.Now())) {
+			return false
+		}
+	}
+
+	// Tasks that have not started yet
+	if !state.ShowUnstarted && !task.Done && task.NotStarted(clock.Now()) {
+		return false
+	}
+
+	return true
+}
+
+// WeekStart returns midnight of the Monday of now's week
+func WeekStart(now time.Time) time.Time {
+	offset := (int(now.Weekday()) + 6) % 7
+	return time.Date(now.Year(), now.Month(), now.Day()-offset, 0, 0, 0, 0, now.Location())
+}
+
+func fuzzyMatch(s, pattern string) bool {
+	s = strings.ToLower(s)
+	pattern = strings.ToLower(pattern)
+	if pattern == "" {
+		return true
+	}
+	// fzf-style sequential character matching:
+	// characters must appear in order but not necessarily adjacent
+	// e.g., "bgr" matches "buy groceries"
+	pIdx := 0
+	for i := 0; i < len(s) && pIdx < len(pattern); i++ {
+		if s[i] == pattern[pIdx] {
+			pIdx++
+		}
+	}
+	return pIdx == len(pattern)
+}
+
+func matchesDateFilter(task data.Task, filter *DateFilter) bool {
+	dueDate := task.GetDueDate()
+
+	if filter.Mode == DateMissing {
+		return dueDate == ""
+	}
+
+	if dueDate == "" {
+		return false
+	}
+
+	taskDate, err := time.Parse("2006-01-02", dueDate)
+	if err != nil {
+		return false
+	}
+
+	switch filter.Mode {
+	case DateBefore:
+		return taskDate.Before(filter.Date)
+	case DateOn:
+		return taskDate.Year() == filter.Date.Year() &&
+			taskDate.Month() == filter.Date.Month() &&
+			taskDate.Day() == filter.Date.Day()
+	case DateAfter:
+		return taskDate.After(filter.Date)
+	case DateBetween:
+		return !taskDate.Before(filter.Date) && !taskDate.After(filter.End)
+	}
+
+	return true
+}
+
+// addedSince reports whether the task's creation date is on or after since.
+// Tasks without a creation date never match.
+func addedSince(task data.Task, since time.Time) bool {
+	created, err := time.ParseInLocation("2006-01-02", task.CreatedDate, since.Location())
+	if err != nil {
+		return false
+	}
+	return !created.Before(since)
+}
+
+func matchesAnyProject(task data.Task, projects []string) bool {
+	for _, p := range projects {
+		if task.HasProject(p) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesAnyContext(task data.Task, contexts []string) bool {
+	for _, c := range contexts {
+		if task.HasContext(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesPriority(task data.Task, priorities []data.Priority) bool {
+	for _, p := range priorities {
+		if task.Priority == p {
+			return true
+		}
+	}
+	return false
+}
+
+func matchesFile(task data.Task, files []string) bool {
+	for _, f := range files {
+		// Strip workspace prefix ("name: ") if present so suffix matching
+		// works with both prefixed display names and raw paths.
+		clean := f
+		if idx := strings.Index(f, ": "); idx >= 0 {
+			clean = f[idx+2:]
+		}
+		if strings.HasSuffix(task.File, clean) {
+			return true
+		}
+	}
+	return false
+}
+
+// StatusFilterString returns a display string for the status filter
+func (f *State) StatusFilterString() string {
+	switch f.StatusFilter {
+	case StatusPending:
+		return "pending"
+	case StatusDone:
+		return "done"
+	default:
+		return ""
+	}
+}
+
+// Summary returns a human-readable summary of active filters
+func (f *State) Summary() string {
+	var parts []string
+
+	if f.StatusFilter != StatusAll {
+		parts = append(parts, "status="+f.StatusFilterString())
+	}
+
+	if len(f.ProjectFilter) > 0 {
+		parts = append(parts, "project="+strings.Join(f.ProjectFilter, ","))
+	}
+
+	if len(f.ContextFilter) > 0 {
+		parts = append(parts, "context="+strings.Join(f.ContextFilter, ","))
+	}
+
+	if len(f.PriorityFilter) > 0 {
+		var ps []string
+		for _, p := range f.PriorityFilter {
+			ps = append(ps, string(p))
+		}
+		parts = append(parts, "priority="+strings.Join(ps, ","))
+	}
+
+	if f.DateFilter != nil {
+		var mode string
+		switch f.DateFilter.Mode {
+		case DateBefore:
+			mode = "before"
+		case DateOn:
+			mode = "on"
+		case DateAfter:
+			mode = "after"
+		case DateMissing:
+			mode = "missing"
+		}
+		if f.DateFilter.Expr != "" {
+			parts = append(parts, "due:"+f.DateFilter.Expr)
+		} else if f.DateFilter.Mode == DateMissing {
+			parts = append(parts, "due:"+mode)
+		} else {
+			parts = append(parts, "due:"+mode+" "+f.DateFilter.Date.Format("2006-01-02"))
+		}
+	}
+
+	if len(f.FileFilter) > 0 {
+		parts = append(parts, "file="+strings.Join(f.FileFilter, ","))
+	}
+
+	if len(f.WorkspaceFilter) > 0 {
+		parts = append(parts, "workspace="+strings.Join(f.WorkspaceFilter, ","))
+	}
+
+	if f.AddedThisWeek {
+		parts = append(parts, "added:this week")
+	}
+
+	if f.ShowUnstarted {
+		parts = append(parts, "start:later shown")
+	}
+
+	return strings.Join(parts, " | ")
+}
@@ -0,0 +1,159 @@
+package filter
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"wydo/internal/tasks/data"
+)
+
+func TestFilters_HideUnstartedTasks(t *testing.T) {
+	later := time.Now().AddDate(0, 0, 3).Format("2006-01-02")
+	tasks := []data.Task{
+		{ID: "1", Name: "now"},
+		{ID: "2", Name: "later", Tags: map[string]string{"start": later}},
+		{ID: "3", Name: "done early", Done: true, Tags: map[string]string{"start": later}},
+	}
+
+	result := Apply(tasks, State{})
+	if len(result) != 2 || result[0].Name != "now" || result[1].Name != "done early" {
+		t.Errorf("expected the unstarted task hidden, got %+v", result)
+	}
+	if result := Apply(tasks, State{ShowUnstarted: true}); len(result) != 3 {
+		t.Errorf("ShowUnstarted: expected 3 tasks, got %d", len(result))
+	}
+	if f := (State{ShowUnstarted: true}); f.IsEmpty() {
+		t.Error("expected ShowUnstarted to count as an active filter")
+	}
+}
+
+func TestFilterStateJSONRoundTrip(t *testing.T) {
+	state := State{
+		SearchQuery:     "release",
+		StatusFilter:    StatusAll,
+		DateFilter:      &DateFilter{Mode: DateBefore, Date: time.Date(2026, 10, 15, 0, 0, 0, 0, time.UTC)},
+		ProjectFilter:   []string{"wydo"},
+		PriorityFilter:  []data.Priority{data.PriorityA, data.PriorityB},
+		WorkspaceFilter: []string{"work"},
+	}
+	raw, err := json.Marshal(state)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var got State
+	if err := json.Unmarshal(raw, &got); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	if !reflect.DeepEqual(got, state) {
+		t.Errorf("round trip:\n got %+v\nwant %+v", got, state)
+	}
+}
+
+func TestParseDateFilter(t *testing.T) {
+	// Thursday 2026-10-15
+	now := time.Date(2026, 10, 15, 18, 0, 0, 0, time.Local)
+	day := func(d string) time.Time {
+		t, _ := time.Parse("2006-01-02", d)
+		return t
+	}
+	cases := []struct {
+		expr      string
+		mode      DateMode
+		date, end string
+	}{
+		{"today", DateOn, "2026-10-15", ""},
+		{"due:tomorrow", DateOn, "2026-10-16", ""},
+		{"2026-11-03", DateOn, "2026-11-03", ""},
+		{"overdue", DateBefore, "2026-10-15", ""},
+		{"<+7d", DateBefore, "2026-10-22", ""},
+		{"<=+1w", DateBefore, "2026-10-23", ""},
+		{">=2026-11-01", DateAfter, "2026-10-31", ""},
+		{"this-week", DateBetween, "2026-10-12", "2026-10-18"},
+		{"next-week", DateBetween, "2026-10-19", "2026-10-25"},
+		{"this-month", DateBetween, "2026-10-01", "2026-10-31"},
+		{"today..+1m", DateBetween, "2026-10-15", "2026-11-15"},
+		{"no-date", DateMissing, "", ""},
+	}
+	for _, c := range cases {
+		f, err := ParseDate(c.expr, now)
+		if err != nil {
+			t.Errorf("%s: %v", c.expr, err)
+			continue
+		}
+		if f.Mode != c.mode || (c.date != "" && !f.Date.Equal(day(c.date))) || (c.end != "" && !f.End.Equal(day(c.end))) {
+			t.Errorf("%s: got mode %d %s..%s", c.expr, f.Mode, f.Date.Format("2006-01-02"), f.End.Format("2006-01-02"))
+		}
+	}
+	for _, bad := range []string{"", "someday", "+3x", "2026-11-05..2026-11-01"} {
+		if _, err := ParseDate(bad, now); err == nil {
+			t.Errorf("%q: expected an error", bad)
+		}
+	}
+}
+
+func TestApplyFilters_RelativeDateRange(t *testing.T) {
+	today := time.Now()
+	due := func(offset int) string {
+		return today.AddDate(0, 0, offset).Format("2006-01-02")
+	}
+	tasks := []data.Task{
+		{Name: "late", Tags: map[string]string{"due": due(-2)}},
+		{Name: "soon", Tags: map[string]string{"due": due(3)}},
+		{Name: "later", Tags: map[string]string{"due": due(10)}},
+		{Name: "undated"},
+	}
+	f, err := ParseDate("today..+7d", today)
+	if err != nil {
+		t.Fatal(err)
+	}
+	result := Apply(tasks, State{DateFilter: f})
+	if len(result) != 1 || result[0].Name != "soon" {
+		t.Errorf("today..+7d: got %+v", result)
+	}
+	result = Apply(tasks, State{DateFilter: &DateFilter{Expr: "no-date"}})
+	if len(result) != 1 || result[0].Name != "undated" {
+		t.Errorf("no-date: got %+v", result)
+	}
+	if got := (&State{DateFilter: f}).Summary(); !strings.Contains(got, "due:today..+7d") {
+		t.Errorf("Summary = %q", got)
+	}
+}
+
+func TestParseFilter(t *testing.T) {
+	now := time.Date(2026, 3, 11, 9, 0, 0, 0, time.UTC)
+	f, err := Parse("+alpha @home (A) due:today write report", now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(f.ProjectFilter, []string{"alpha"}) || !reflect.DeepEqual(f.ContextFilter, []string{"home"}) {
+		t.Errorf("projects %v, contexts %v", f.ProjectFilter, f.ContextFilter)
+	}
+	if !reflect.DeepEqual(f.PriorityFilter, []data.Priority{data.PriorityA}) {
+		t.Errorf("priorities %v", f.PriorityFilter)
+	}
+	if f.DateFilter == nil || f.DateFilter.Expr != "today" {
+		t.Errorf("date filter %+v", f.DateFilter)
+	}
+	if f.SearchQuery != "write report" || f.StatusFilter != StatusPending {
+		t.Errorf("search %q, status %v", f.SearchQuery, f.StatusFilter)
+	}
+
+	if _, err := Parse("due:someday", now); err == nil {
+		t.Error("an unknown due: expression should be an error")
+	}
+}
+
+func TestAddedSince(t *testing.T) {
+	// Thursday 2026-07-16; the week started Monday 2026-07-13
+	now := time.Date(2026, 7, 16, 15, 0, 0, 0, time.Local)
+	since := WeekStart(now)
+	if !addedSince(data.Task{CreatedDate: "2026-07-13"}, since) {
+		t.Error("expected a task created on Monday to count as added this week")
+	}
+	if addedSince(data.Task{CreatedDate: "2026-07-12"}, since) || addedSince(data.Task{}, since) {
+		t.Error("expected older and undated tasks not to count as added this week")
+	}
+}
@@ -2,16 +2,18 @@ package service
 
 import (
 	"fmt"
-	"os"
 	"path/filepath"
-	"strings"
 	"time"
 
+	"wydo/internal/clock"
 	"wydo/internal/logs"
 	"wydo/internal/scanner"
 	"wydo/internal/tasks/data"
+	"wydo/internal/writeq"
 )
 
+var log = logs.For("tasks")
+
 // TaskService defines the interface for task operations.
 type TaskService interface {
 	List() ([]data.Task, error)
@@ -19,20 +21,34 @@ type TaskService interface {
 	ListByContext(context string) ([]data.Task, error)
 	ListPending() ([]data.Task, error)
 	ListDone() ([]data.Task, error)
+	// ListAll is List plus the done files of earlier years, which are only
+	// read the first time something asks for them.
+	ListAll() ([]data.Task, error)
 	Get(id string) (*data.Task, error)
 	Add(rawLine string) (*data.Task, error)
 	Update(task data.Task) error
+	// UpdateAll is Update for several tasks, written in one pass over their
+	// files.
+	UpdateAll(tasks []data.Task) error
 	Complete(id string) error
 	Delete(id string) error
 	Archive() error
+	Annotate(id, text string) error
 	GetProjects() map[string]data.Project
+	// TodoFile is the file Add appends to: the first todo.txt found.
+	TodoFile() string
 	Reload() error
 }
 
 type taskServiceImpl struct {
-	tasks    []data.Task
+	tasks    []data.Task // todo files, done.txt and this year's done file
 	projects map[string]data.Project
 	taskDirs []scanner.TaskDirInfo
+
+	// Done files of earlier years (done-2024.txt, ...), loaded by ListAll.
+	// Once loaded they are re-read on every Reload.
+	history       []data.Task
+	historyLoaded bool
 }
 
 // NewTaskService creates a new TaskService from discovered task directories
@@ -51,20 +67,24 @@ func (s *taskServiceImpl) Reload() error {
 	projects := make(map[string]data.Project)
 
 	for i, td := range s.taskDirs {
-		// Re-discover .txt files in the directory (handles newly created done.txt)
-		files := discoverTxtFiles(td.DirPath)
-		if len(files) > 0 {
+		// Re-discover .txt files in the directory (handles newly created done files)
+		if files, _ := td.ListFiles(); len(files) > 0 {
 			s.taskDirs[i].Files = files
 		}
 
-		tasks, err := data.LoadTasksFromDir(td.DirPath, s.taskDirs[i].Files, true)
+		current, _ := splitHistoryFiles(s.taskDirs[i].Files)
+		tasks, err := data.LoadTasksFromDir(td.DirPath, current, true)
 		if err != nil {
-			logs.Logger.Printf("Warning: error loading tasks from %s: %v", td.DirPath, err)
+			log.Warn("could not load tasks", "dir", td.DirPath, "err", err)
 			continue
 		}
 		allTasks = append(allTasks, tasks...)
 	}
 
+	if s.historyLoaded {
+		s.loadHistory()
+	}
+
 	// Build project map from task tags
 	for _, t := range allTasks {
 		for _, p := range t.Projects {
@@ -79,18 +99,47 @@ func (s *taskServiceImpl) Reload() error {
 	return nil
 }
 
-func discoverTxtFiles(dirPath string) []string {
-	entries, err := os.ReadDir(dirPath)
-	if err != nil {
-		return nil
+// loadHistory reads the done files of earlier years in every task directory.
+func (s *taskServiceImpl) loadHistory() {
+	var history []data.Task
+	for _, td := range s.taskDirs {
+		_, past := splitHistoryFiles(td.Files)
+		tasks, err := data.LoadTasksFromDir(td.DirPath, past, true)
+		if err != nil {
+			log.Warn("could not load done files", "dir", td.DirPath, "err", err)
+			continue
+		}
+		history = append(history, tasks...)
 	}
-	var files []string
-	for _, e := range entries {
-		if !e.IsDir() && strings.HasSuffix(strings.ToLower(e.Name()), ".txt") {
-			files = append(files, e.Name())
+	s.history = history
+	s.historyLoaded = true
+}
+
+// splitHistoryFiles separates the done files of earlier years from the files
+// read on every load.
+func splitHistoryFiles(files []string) (current, past []string) {
+	thisYear := clock.Now().Year()
+	for _, f := range files {
+		if year, ok := data.DoneFileYear(f); ok && year != thisYear {
+			past = append(past, f)
+		} else {
+			current = append(current, f)
 		}
 	}
-	return files
+	return current, past
+}
+
+// loaded returns every task read so far. Writes go through it so that a
+// loaded history file is written back whole.
+func (s *taskServiceImpl) loaded() []data.Task {
+	tasks := make([]data.Task, 0, len(s.tasks)+len(s.history))
+	tasks = append(tasks, s.tasks...)
+	return append(tasks, s.history...)
+}
+
+// doneFile returns the done file that tasks completed now are moved to.
+func doneFile(taskFile string) string {
+	return filepath.Join(filepath.Dir(taskFile), data.DoneFileName(clock.Now().Year()))
 }
 
 func (s *taskServiceImpl) List() ([]data.Task, error) {
@@ -137,8 +186,15 @@ func (s *taskServiceImpl) ListDone() ([]data.Task, error) {
 	return done, nil
 }
 
+func (s *taskServiceImpl) ListAll() ([]data.Task, error) {
+	if !s.historyLoaded {
+		s.loadHistory()
+	}
+	return s.loaded(), nil
+}
+
 func (s *taskServiceImpl) Get(id string) (*data.Task, error) {
-	for _, t := range s.tasks {
+	for _, t := range s.loaded() {
 		if t.ID == id {
 			return &t, nil
 		}
@@ -163,56 +219,147 @@ func (s *taskServiceImpl) Add(rawLine string) (*data.Task, error) {
 	return task, nil
 }
 
+// Update rewrites a task. Marking a recurring task done adds its next
+// occurrence, as Complete does, and marking it pending again takes that
+// occurrence back out.
 func (s *taskServiceImpl) Update(task data.Task) error {
-	logs.Logger.Printf("Service: Update Task: %s\n", task.ID)
-	s.tasks = data.UpdateTask(s.tasks, task)
-	if err := data.WriteAllTasks(s.tasks); err != nil {
+	log.Debug("update task", "id", task.ID)
+	prev, _ := s.Get(task.ID)
+	all := data.UpdateTask(s.loaded(), task)
+	if prev != nil && prev.Done && !task.Done {
+		all = withoutOccurrence(all, *prev)
+	}
+	if err := data.WriteAllTasks(all); err != nil {
+		return err
+	}
+	if prev != nil && !prev.Done && task.Done {
+		if err := s.addNextOccurrence(task, prev.File); err != nil {
+			return err
+		}
+	}
+	return s.Reload()
+}
+
+func (s *taskServiceImpl) UpdateAll(tasks []data.Task) error {
+	log.Debug("update tasks", "count", len(tasks))
+	all := s.loaded()
+	var completed []data.Task
+	for _, task := range tasks {
+		if prev, err := s.Get(task.ID); err == nil {
+			if !prev.Done && task.Done {
+				completed = append(completed, task)
+			} else if prev.Done && !task.Done {
+				all = withoutOccurrence(all, *prev)
+			}
+		}
+		all = data.UpdateTask(all, task)
+	}
+	if err := data.WriteAllTasks(all); err != nil {
 		return err
 	}
+	for _, task := range completed {
+		if err := s.addNextOccurrence(task, task.File); err != nil {
+			return err
+		}
+	}
 	return s.Reload()
 }
 
-// Complete marks a task as done and moves it to done.txt in the same tasks/ directory
+// Complete marks a task as done and moves it to this year's done file in the
+// same tasks/ directory. A recurring task's next occurrence is added to the
+// file it was in.
 func (s *taskServiceImpl) Complete(id string) error {
 	task, err := s.Get(id)
 	if err != nil {
 		return err
 	}
+	todoFile := task.File
 
 	task.Done = true
-	task.CompletionDate = time.Now().Format("2006-01-02")
+	task.CompletionDate = clock.Now().Format("2006-01-02")
 
-	// Find done.txt in the same tasks/ directory
-	taskDir := filepath.Dir(task.File)
-	doneFile := filepath.Join(taskDir, "done.txt")
-	task.File = doneFile
+	task.File = doneFile(task.File)
 
-	s.tasks = data.UpdateTask(s.tasks, *task)
-	if err := data.WriteAllTasks(s.tasks); err != nil {
+	if err := data.WriteAllTasks(data.UpdateTask(s.loaded(), *task)); err != nil {
+		return err
+	}
+	if err := s.addNextOccurrence(*task, todoFile); err != nil {
 		return err
 	}
 	return s.Reload()
 }
 
+// addNextOccurrence appends the next occurrence of task, if it recurs, to
+// file. It is dated from today, the day the task was completed. Nothing is
+// added when file already has it, say from completing the task before.
+func (s *taskServiceImpl) addNextOccurrence(task data.Task, file string) error {
+	next, ok := task.NextOccurrence(clock.Now())
+	if !ok {
+		return nil
+	}
+	if findOccurrence(s.loaded(), next, file) != nil {
+		log.Debug("next occurrence exists", "task", task.Name, "file", file)
+		return nil
+	}
+	log.Debug("add next occurrence", "task", task.Name, "file", file)
+	_, err := data.AppendTaskToFile(next.String(), file)
+	return err
+}
+
+// withoutOccurrence returns tasks without the occurrence that completing
+// done, a recurring task, added to its file.
+func withoutOccurrence(tasks []data.Task, done data.Task) []data.Task {
+	completed := clock.Now()
+	if d, err := time.Parse("2006-01-02", done.CompletionDate); err == nil {
+		completed = d
+	}
+	next, ok := done.NextOccurrence(completed)
+	if !ok {
+		return tasks
+	}
+	if occ := findOccurrence(tasks, next, done.File); occ != nil {
+		log.Debug("remove next occurrence", "task", done.Name, "file", done.File)
+		return data.DeleteTask(tasks, occ.ID)
+	}
+	return tasks
+}
+
+// findOccurrence returns the pending task in file that is next, ignoring
+// the creation date that adding it may have stamped.
+func findOccurrence(tasks []data.Task, next data.Task, file string) *data.Task {
+	next.CreatedDate = ""
+	line := next.String()
+	for _, t := range tasks {
+		if t.File != file || t.Done {
+			continue
+		}
+		t.CreatedDate = ""
+		if t.String() == line {
+			return &t
+		}
+	}
+	return nil
+}
+
 func (s *taskServiceImpl) Delete(id string) error {
 	// Remember which file the task was in so we can rewrite it even if empty
 	var affectedFile string
-	for _, t := range s.tasks {
+	for _, t := range s.loaded() {
 		if t.ID == id {
 			affectedFile = t.File
 			break
 		}
 	}
 
-	s.tasks = data.DeleteTask(s.tasks, id)
-	if err := data.WriteAllTasks(s.tasks); err != nil {
+	remaining := data.DeleteTask(s.loaded(), id)
+	if err := data.WriteAllTasks(remaining); err != nil {
 		return err
 	}
 
 	// If the affected file has no remaining tasks, rewrite it as empty
 	if affectedFile != "" {
 		hasTasksInFile := false
-		for _, t := range s.tasks {
+		for _, t := range remaining {
 			if t.File == affectedFile {
 				hasTasksInFile = true
 				break
@@ -220,7 +367,7 @@ func (s *taskServiceImpl) Delete(id string) error {
 		}
 		if !hasTasksInFile {
 			// Rewrite as empty file
-			if err := os.WriteFile(affectedFile, []byte{}, 0644); err != nil {
+			if err := writeq.WriteFile(affectedFile, []byte{}, 0644); err != nil {
 				return err
 			}
 		}
@@ -229,16 +376,52 @@ func (s *taskServiceImpl) Delete(id string) error {
 	return s.Reload()
 }
 
-// Archive moves done tasks to done.txt within each tasks/ directory
+// Archive moves done tasks out of the todo files into this year's done file
+// within each tasks/ directory
 func (s *taskServiceImpl) Archive() error {
-	for i := range s.tasks {
-		if s.tasks[i].Done {
-			taskDir := filepath.Dir(s.tasks[i].File)
-			doneFile := filepath.Join(taskDir, "done.txt")
-			s.tasks[i].File = doneFile
+	tasks := s.loaded()
+	emptied := make(map[string]bool)
+	for i := range tasks {
+		if tasks[i].Done && !data.IsDoneFile(tasks[i].File) {
+			emptied[tasks[i].File] = true
+			tasks[i].File = doneFile(tasks[i].File)
 		}
 	}
-	if err := data.WriteAllTasks(s.tasks); err != nil {
+	for _, t := range tasks {
+		delete(emptied, t.File)
+	}
+	if err := data.WriteAllTasks(tasks); err != nil {
+		return err
+	}
+
+	// A todo file that held only done tasks is not in tasks any more
+	for file := range emptied {
+		if err := writeq.WriteFile(file, []byte{}, 0644); err != nil {
+			return err
+		}
+	}
+	return s.Reload()
+}
+
+// Annotate appends a timestamped annotation to a task, tagging the task with an
+// annotation key on first use
+func (s *taskServiceImpl) Annotate(id, text string) error {
+	task, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+	if task.File == "" {
+		return fmt.Errorf("task has no file: %s", id)
+	}
+
+	if task.EnsureAnnotationKey() {
+		if err := data.WriteAllTasks(data.UpdateTask(s.loaded(), *task)); err != nil {
+			return err
+		}
+	}
+
+	ann := data.Annotation{Time: clock.Now(), Text: text}
+	if err := data.AppendAnnotation(filepath.Dir(task.File), task.GetAnnotationKey(), ann); err != nil {
 		return err
 	}
 	return s.Reload()
@@ -248,6 +431,10 @@ func (s *taskServiceImpl) GetProjects() map[string]data.Project {
 	return s.projects
 }
 
+func (s *taskServiceImpl) TodoFile() string {
+	return s.firstTodoFile()
+}
+
 // firstTodoFile returns the path to the first todo.txt found
 func (s *taskServiceImpl) firstTodoFile() string {
 	for _, td := range s.taskDirs {
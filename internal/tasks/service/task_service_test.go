@@ -3,8 +3,11 @@ package service
 import (
 	"os"
 	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"wydo/internal/golden"
 	"wydo/internal/scanner"
 	"wydo/internal/tasks/data"
 )
@@ -91,6 +94,9 @@ func TestAddDefaultTarget(t *testing.T) {
 	if filepath.Base(task.File) != "todo.txt" {
 		t.Errorf("expected task in todo.txt, got %q", task.File)
 	}
+	if svc.TodoFile() != task.File {
+		t.Errorf("expected TodoFile %q to be the file Add wrote, got %q", task.File, svc.TodoFile())
+	}
 }
 
 func TestCompleteMovesToDone(t *testing.T) {
@@ -130,12 +136,12 @@ func TestCompleteMovesToDone(t *testing.T) {
 		t.Errorf("expected %d done, got %d", doneCountBefore+1, len(doneAfter))
 	}
 
-	// The completed task should be in done.txt in the same directory
+	// The completed task should be in this year's done file in the same directory
 	found := false
 	for _, dt := range doneAfter {
 		if dt.Name == taskName {
 			found = true
-			expectedDone := filepath.Join(taskDir, "done.txt")
+			expectedDone := filepath.Join(taskDir, data.DoneFileName(time.Now().Year()))
 			if dt.File != expectedDone {
 				t.Errorf("expected file %q, got %q", expectedDone, dt.File)
 			}
@@ -317,10 +323,10 @@ func TestArchivePerDirectory(t *testing.T) {
 		t.Fatalf("archive error: %v", err)
 	}
 
-	// done.txt should now exist in dir1
-	doneFile := filepath.Join(dir1, "done.txt")
+	// This year's done file should now exist in dir1
+	doneFile := filepath.Join(dir1, data.DoneFileName(time.Now().Year()))
 	if _, err := os.Stat(doneFile); err != nil {
-		t.Error("expected done.txt to be created after archive")
+		t.Errorf("expected %s to be created after archive", doneFile)
 	}
 
 	// Reload and verify
@@ -334,3 +340,166 @@ func TestArchivePerDirectory(t *testing.T) {
 		t.Errorf("expected 1 done task, got %d", len(done))
 	}
 }
+
+func TestArchiveEmptiesTodoOfOnlyDoneTasks(t *testing.T) {
+	tmpDir := t.TempDir()
+	todo := filepath.Join(tmpDir, "todo.txt")
+	os.WriteFile(todo, []byte("x 2026-02-01 Completed task\n"), 0644)
+
+	svc, err := NewTaskService([]scanner.TaskDirInfo{{DirPath: tmpDir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.Archive(); err != nil {
+		t.Fatalf("archive error: %v", err)
+	}
+
+	content, _ := os.ReadFile(todo)
+	if len(content) != 0 {
+		t.Errorf("expected todo.txt to be emptied, got %q", content)
+	}
+	done, _ := svc.ListDone()
+	if len(done) != 1 {
+		t.Errorf("expected 1 done task, got %d", len(done))
+	}
+}
+
+func TestDoneHistoryLoadedLazily(t *testing.T) {
+	tmpDir := t.TempDir()
+	dir := filepath.Join(tmpDir, "tasks")
+	os.MkdirAll(dir, 0755)
+
+	thisYear := time.Now().Year()
+	lastYear := data.DoneFileName(thisYear - 1)
+	os.WriteFile(filepath.Join(dir, "todo.txt"), []byte("Pending task\nx 2026-02-01 Finished task\n"), 0644)
+	os.WriteFile(filepath.Join(dir, data.DoneFileName(thisYear)), []byte("x 2026-01-05 This year's task\n"), 0644)
+	os.WriteFile(filepath.Join(dir, lastYear), []byte("x 2025-06-01 Old task one\nx 2025-07-01 Old task two\n"), 0644)
+
+	svc, err := NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tasks, _ := svc.List()
+	if len(tasks) != 3 {
+		t.Fatalf("expected 3 tasks without last year's done file, got %d", len(tasks))
+	}
+
+	all, _ := svc.ListAll()
+	if len(all) != 5 {
+		t.Fatalf("expected 5 tasks with every done file, got %d", len(all))
+	}
+
+	// Archiving writes this year's file and leaves last year's intact
+	if err := svc.Archive(); err != nil {
+		t.Fatalf("archive error: %v", err)
+	}
+	old, _ := data.LoadTasksFromDir(dir, []string{lastYear}, true)
+	if len(old) != 2 {
+		t.Errorf("expected last year's done file untouched, got %d tasks", len(old))
+	}
+	current, _ := data.LoadTasksFromDir(dir, []string{data.DoneFileName(thisYear)}, true)
+	if len(current) != 2 {
+		t.Errorf("expected 2 tasks in this year's done file, got %d", len(current))
+	}
+
+	// An old task can be edited once loaded
+	for _, task := range all {
+		if task.Name == "Old task two" {
+			task.Name = "Old task edited"
+			if err := svc.Update(task); err != nil {
+				t.Fatalf("update error: %v", err)
+			}
+		}
+	}
+	old, _ = data.LoadTasksFromDir(dir, []string{lastYear}, true)
+	if len(old) != 2 || old[1].Name != "Old task edited" {
+		t.Errorf("expected the edit in last year's done file, got %+v", old)
+	}
+}
+
+func TestCompleteRecurringAddsNextOccurrence(t *testing.T) {
+	golden.FixClock(t)
+	dir := t.TempDir()
+	todo := filepath.Join(dir, "todo.txt")
+	os.WriteFile(todo, []byte("Water plants @home rec:1w due:2026-03-02\nPay rent rec:+1m due:2026-03-01\n"), 0644)
+
+	svc, err := NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	pending, _ := svc.ListPending()
+	if err := svc.Complete(pending[0].ID); err != nil {
+		t.Fatalf("complete error: %v", err)
+	}
+
+	// Toggling done through Update, as the task manager does, recurs too
+	pending, _ = svc.ListPending()
+	rent := pending[0]
+	rent.Done = true
+	if err := svc.Update(rent); err != nil {
+		t.Fatalf("update error: %v", err)
+	}
+
+	content, _ := os.ReadFile(todo)
+	for _, want := range []string{
+		"Water plants @home due:2026-03-11 rec:1w",
+		"Pay rent due:2026-04-01 rec:+1m",
+	} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("todo.txt missing next occurrence %q:\n%s", want, content)
+		}
+	}
+	if pending, _ := svc.ListPending(); len(pending) != 2 {
+		t.Errorf("expected the two next occurrences pending, got %d", len(pending))
+	}
+
+	// Undoing and redoing the completion leaves one next occurrence
+	for _, done := range []bool{false, true, false, true} {
+		all, _ := svc.List()
+		for _, task := range all {
+			if task.Name == "Pay rent" && task.GetDueDate() == "2026-03-01" {
+				task.Done = done
+				if err := svc.Update(task); err != nil {
+					t.Fatalf("update error: %v", err)
+				}
+				break
+			}
+		}
+		content, _ := os.ReadFile(todo)
+		want := 0
+		if done {
+			want = 1
+		}
+		if n := strings.Count(string(content), "due:2026-04-01"); n != want {
+			t.Errorf("done=%v: %d next occurrences in todo.txt, want %d:\n%s", done, n, want, content)
+		}
+	}
+}
+
+func TestReloadKeepsIgnoredFilesOut(t *testing.T) {
+	root := t.TempDir()
+	tasksDir := filepath.Join(root, "tasks")
+	os.MkdirAll(tasksDir, 0755)
+	os.WriteFile(filepath.Join(tasksDir, "todo.txt"), []byte("Kept task\n"), 0644)
+	os.WriteFile(filepath.Join(tasksDir, "scratch.txt"), []byte("Ignored by the file\n"), 0644)
+	os.WriteFile(filepath.Join(tasksDir, "old.txt"), []byte("Ignored by the config\n"), 0644)
+	os.WriteFile(filepath.Join(root, scanner.IgnoreFilename), []byte("tasks/scratch.txt\n"), 0644)
+
+	scan, err := scanner.ScanWorkspace(root, "tasks/old.txt")
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	svc, err := NewTaskService(scan.TaskDirs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := svc.Reload(); err != nil {
+		t.Fatalf("reload error: %v", err)
+	}
+
+	tasks, _ := svc.List()
+	if len(tasks) != 1 || tasks[0].Name != "Kept task" {
+		t.Errorf("expected only the task of todo.txt after reload, got %+v", tasks)
+	}
+}
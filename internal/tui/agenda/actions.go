@@ -0,0 +1,297 @@
+package agenda
+
+import (
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	agendapkg "wydo/internal/agenda"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/refs"
+	"wydo/internal/tasks/service"
+	kanbanview "wydo/internal/tui/kanban"
+	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
+	"wydo/internal/tui/theme"
+)
+
+// itemAction is an entry of the actions menu.
+type itemAction int
+
+const (
+	actionReschedule itemAction = iota
+	actionComplete
+	actionOpen
+	actionMoveToBoard
+	actionPeek
+	actionCopyLink
+)
+
+func (a itemAction) String() string {
+	switch a {
+	case actionReschedule:
+		return "Reschedule"
+	case actionComplete:
+		return "Complete"
+	case actionOpen:
+		return "Open"
+	case actionMoveToBoard:
+		return "Move to board"
+	case actionPeek:
+		return "Peek"
+	case actionCopyLink:
+		return "Copy link"
+	}
+	return ""
+}
+
+// itemActions lists what can be done with an item of its source: tasks and
+// cards can be rescheduled, completed, opened and moved to a board, notes
+// opened in $EDITOR and board reviews on their board. Every item can be peeked at and have its link copied.
+// A projected occurrence of a recurring task can't be changed; opening it
+// opens the task it follows.
+func itemActions(item agendapkg.AgendaItem) []itemAction {
+	var actions []itemAction
+	switch {
+	case item.Projected:
+		actions = append(actions, actionOpen)
+	case item.Source == agendapkg.SourceTask || item.Source == agendapkg.SourceCard:
+		actions = append(actions, actionReschedule)
+		if !item.Completed {
+			actions = append(actions, actionComplete)
+		}
+		actions = append(actions, actionOpen, actionMoveToBoard)
+	case item.Source == agendapkg.SourceNote || item.Source == agendapkg.SourceBoardReview:
+		actions = append(actions, actionOpen)
+	}
+	return append(actions, actionPeek, actionCopyLink)
+}
+
+type actionsStage int
+
+const (
+	actionsMenu      actionsStage = iota
+	actionsPickDate               // reschedule: picking the new date
+	actionsPickBoard              // move to board: picking the board
+)
+
+// actionsModel is the popup a opens: the operations that apply to the
+// selected agenda item, so it can be rescheduled, completed or moved without
+// switching to the task manager or its board.
+type actionsModel struct {
+	item    agendapkg.AgendaItem
+	actions []itemAction
+	cursor  int
+	stage   actionsStage
+	peek    bool // Peek was chosen; the view opens its quick-look popup
+
+	taskSvc    service.TaskService
+	boards     []kanbanmodels.Board
+	datePicker shared.DatePickerModel
+	selector   kanbanview.BoardSelectorModel
+
+	width  int
+	height int
+}
+
+func newActionsModel(item agendapkg.AgendaItem, taskSvc service.TaskService, boards []kanbanmodels.Board, width, height int) actionsModel {
+	actions := itemActions(item)
+	if kanbanview.NewBoardSelectorModel(boards, item.BoardPath, "").Empty() {
+		actions = slices.DeleteFunc(actions, func(a itemAction) bool { return a == actionMoveToBoard })
+	}
+	return actionsModel{
+		item:    item,
+		actions: actions,
+		taskSvc: taskSvc,
+		boards:  boards,
+		width:   width,
+		height:  height,
+	}
+}
+
+// SetSize sets the width and height for centered modal rendering.
+func (m *actionsModel) SetSize(width, height int) {
+	m.width, m.height = width, height
+	m.datePicker.SetSize(width, height)
+	m.selector.SetSize(width, height)
+}
+
+// Update handles key events. Returns (model, command carrying out the chosen
+// action, done).
+func (m actionsModel) Update(msg tea.KeyMsg) (actionsModel, tea.Cmd, bool) {
+	switch m.stage {
+	case actionsPickDate:
+		inTextInput := m.datePicker.IsTextInputActive()
+		dp, cmd := m.datePicker.Update(msg)
+		m.datePicker = dp
+		if inTextInput {
+			return m, cmd, false
+		}
+		switch msg.String() {
+		case "esc":
+			m.stage = actionsMenu
+		case "enter":
+			return m, rescheduleItem(m.taskSvc, m.item, m.datePicker.GetDate()), true
+		}
+		return m, cmd, false
+
+	case actionsPickBoard:
+		selector, path, done := m.selector.Update(msg)
+		m.selector = selector
+		if !done {
+			return m, nil, false
+		}
+		if path == "" {
+			m.stage = actionsMenu
+			return m, nil, false
+		}
+		return m, moveItemToBoard(m.item, path), true
+	}
+
+	switch msg.String() {
+	case "j", "down":
+		if m.cursor < len(m.actions)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		return m.run(m.actions[m.cursor])
+	case "esc", "q", "a":
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// run carries out action, or moves on to picking its date or board.
+func (m actionsModel) run(action itemAction) (actionsModel, tea.Cmd, bool) {
+	switch action {
+	case actionReschedule:
+		current := m.item.Date
+		m.datePicker = shared.NewDatePickerModel(&current, "Reschedule")
+		m.datePicker.SetSize(m.width, m.height)
+		m.stage = actionsPickDate
+		return m, m.datePicker.Init(), false
+	case actionComplete:
+		return m, completeItem(m.taskSvc, m.item), true
+	case actionOpen:
+		return m, openItem(m.item), true
+	case actionMoveToBoard:
+		m.selector = kanbanview.NewBoardSelectorModel(m.boards, m.item.BoardPath, "Move to Board")
+		m.selector.EnableFilter()
+		m.selector.SetSize(m.width, m.height)
+		m.stage = actionsPickBoard
+		return m, nil, false
+	case actionPeek:
+		m.peek = true
+		return m, nil, true
+	case actionCopyLink:
+		return m, shared.CopyToClipboard(itemLink(m.item)), true
+	}
+	return m, nil, true
+}
+
+// openItem focuses a task in the task manager, opens a card or a board
+// review on its board, or opens a note in $EDITOR (fallback: vim).
+func openItem(item agendapkg.AgendaItem) tea.Cmd {
+	switch item.Source {
+	case agendapkg.SourceTask:
+		if item.Task != nil {
+			return func() tea.Msg { return messages.FocusTaskMsg{TaskID: item.Task.ID} }
+		}
+	case agendapkg.SourceCard:
+		return func() tea.Msg {
+			return messages.OpenBoardMsg{BoardPath: item.BoardPath, HasTarget: true, ColIndex: item.ColIndex, CardIndex: item.CardIndex}
+		}
+	case agendapkg.SourceBoardReview:
+		return func() tea.Msg { return messages.OpenBoardMsg{BoardPath: item.BoardPath} }
+	case agendapkg.SourceNote:
+		if item.Note != nil {
+			return shared.OpenInEditor(item.Note.FilePath)
+		}
+	}
+	return nil
+}
+
+// moveItemToBoard asks the app to turn a task into a card on the board at
+// path, or to move a card there.
+func moveItemToBoard(item agendapkg.AgendaItem, path string) tea.Cmd {
+	switch item.Source {
+	case agendapkg.SourceTask:
+		if item.Task != nil {
+			return func() tea.Msg { return messages.MoveTaskToBoardMsg{Task: *item.Task, BoardPath: path} }
+		}
+	case agendapkg.SourceCard:
+		return func() tea.Msg {
+			return messages.MoveCardToBoardMsg{
+				BoardPath:  item.BoardPath,
+				ColIndex:   item.ColIndex,
+				CardIndex:  item.CardIndex,
+				TargetPath: path,
+			}
+		}
+	}
+	return nil
+}
+
+// itemLink returns how other items refer to item: a task's todo.txt line, a
+// card's card:<board>/<file> reference, a note's [[link]], or a project's
+// +name.
+func itemLink(item agendapkg.AgendaItem) string {
+	switch {
+	case item.Source == agendapkg.SourceTask && item.Task != nil:
+		return item.Task.String()
+	case item.Source == agendapkg.SourceCard && item.Card != nil:
+		key := refs.CardKey(item.BoardPath, item.Card.Filename)
+		if strings.ContainsAny(key, " \t") {
+			return `card:"` + key + `"`
+		}
+		return "card:" + key
+	case item.Source == agendapkg.SourceNote && item.Note != nil:
+		return "[[" + strings.TrimSuffix(filepath.Base(item.Note.RelPath), ".md") + "]]"
+	case item.Source == agendapkg.SourceProjectDate:
+		return "+" + item.ProjectName
+	}
+	return itemTitleNoPrefix(item)
+}
+
+// View renders the menu, or the date picker or board list of the action
+// being carried out, as a centered modal.
+func (m actionsModel) View() string {
+	switch m.stage {
+	case actionsPickDate:
+		return m.datePicker.View()
+	case actionsPickBoard:
+		return m.selector.View()
+	}
+
+	var lines []string
+	lines = append(lines, theme.ModalTitle.Render(m.item.Source.String()+": "+itemTitleNoPrefix(m.item)))
+	if m.item.Source == agendapkg.SourceTask || m.item.Source == agendapkg.SourceCard {
+		lines = append(lines, theme.ModalHelp.Render(m.item.Reason.String()+" "+m.item.Date.Format(time.DateOnly)))
+	}
+	lines = append(lines, "")
+
+	listStart := len(lines)
+	for i, action := range m.actions {
+		if i == m.cursor {
+			lines = append(lines, cursorStyle.Render(">")+" "+selectedStyle.Render(action.String()))
+		} else {
+			lines = append(lines, "  "+normalStyle.Render(action.String()))
+		}
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, theme.ModalHelp.Render("j/k: navigate • enter: choose • esc: close"))
+
+	box := theme.ModalBox.Width(shared.ModalWidth(50, m.width))
+	lines = shared.FitModalList(lines, listStart, listStart+len(m.actions), m.cursor, box, m.height)
+
+	boxed := box.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
+}
@@ -0,0 +1,156 @@
+package agenda
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/golden"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/messages"
+)
+
+func TestItemActions(t *testing.T) {
+	task := agendapkg.AgendaItem{Source: agendapkg.SourceTask, Task: &data.Task{Name: "Call"}}
+	done := task
+	done.Completed = true
+	note := agendapkg.AgendaItem{Source: agendapkg.SourceNote, Note: &notes.Note{Title: "Standup"}}
+	project := agendapkg.AgendaItem{Source: agendapkg.SourceProjectDate, ProjectName: "alpha"}
+
+	tests := []struct {
+		name string
+		item agendapkg.AgendaItem
+		want []itemAction
+	}{
+		{"task", task, []itemAction{actionReschedule, actionComplete, actionOpen, actionMoveToBoard, actionPeek, actionCopyLink}},
+		{"done task", done, []itemAction{actionReschedule, actionOpen, actionMoveToBoard, actionPeek, actionCopyLink}},
+		{"note", note, []itemAction{actionOpen, actionPeek, actionCopyLink}},
+		{"project date", project, []itemAction{actionPeek, actionCopyLink}},
+	}
+	for _, tt := range tests {
+		if got := itemActions(tt.item); !slices.Equal(got, tt.want) {
+			t.Errorf("%s: itemActions = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestItemLink(t *testing.T) {
+	tests := []struct {
+		item agendapkg.AgendaItem
+		want string
+	}{
+		{agendapkg.AgendaItem{Source: agendapkg.SourceCard, BoardPath: "/ws/boards/platform", Card: &kanbanmodels.Card{Filename: "deploy.md"}}, "card:platform/deploy.md"},
+		{agendapkg.AgendaItem{Source: agendapkg.SourceCard, BoardPath: "/ws/boards/side work", Card: &kanbanmodels.Card{Filename: "a.md"}}, `card:"side work/a.md"`},
+		{agendapkg.AgendaItem{Source: agendapkg.SourceNote, Note: &notes.Note{RelPath: "meetings/standup.md"}}, "[[standup]]"},
+		{agendapkg.AgendaItem{Source: agendapkg.SourceProjectDate, ProjectName: "alpha"}, "+alpha"},
+	}
+	for _, tt := range tests {
+		if got := itemLink(tt.item); got != tt.want {
+			t.Errorf("itemLink = %q, want %q", got, tt.want)
+		}
+	}
+}
+
+func TestOpenItem_CardAtFirstPosition(t *testing.T) {
+	item := agendapkg.AgendaItem{Source: agendapkg.SourceCard, BoardPath: "/ws/boards/platform", Card: &kanbanmodels.Card{Filename: "deploy.md"}}
+	want := messages.OpenBoardMsg{BoardPath: "/ws/boards/platform", HasTarget: true}
+	if got := openItem(item)(); got != want {
+		t.Errorf("openItem on the first card = %#v, want %#v", got, want)
+	}
+
+	review := agendapkg.AgendaItem{Source: agendapkg.SourceBoardReview, BoardPath: "/ws/boards/platform"}
+	if got := openItem(review)(); got != (messages.OpenBoardMsg{BoardPath: "/ws/boards/platform"}) {
+		t.Errorf("openItem on a board review = %#v, want no target", got)
+	}
+}
+
+func TestDayActions_Reschedule(t *testing.T) {
+	golden.FixClock(t)
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte("Call the landlord due:2026-03-04\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := NewDayModel(svc, nil, nil, nil)
+	day.SetSize(80, 24)
+
+	// a opens the menu on Reschedule; enter picks a date, l moves it a day on
+	var cmd tea.Cmd
+	for _, key := range []tea.KeyMsg{
+		{Type: tea.KeyRunes, Runes: []rune("a")},
+		{Type: tea.KeyEnter},
+		{Type: tea.KeyRunes, Runes: []rune("l")},
+		{Type: tea.KeyEnter},
+	} {
+		day, cmd = day.Update(key)
+	}
+	if day.ShowingActions() {
+		t.Fatal("expected the menu closed after rescheduling")
+	}
+	if cmd == nil {
+		t.Fatal("expected a refresh command")
+	}
+	if _, ok := cmd().(messages.DataRefreshMsg); !ok {
+		t.Errorf("expected DataRefreshMsg, got %T", cmd())
+	}
+	tasks, err := svc.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := tasks[0].GetDueDate(); got != "2026-03-05" {
+		t.Errorf("due = %q, want 2026-03-05", got)
+	}
+}
+
+func TestDayActions_Peek(t *testing.T) {
+	golden.FixClock(t)
+	due := golden.Now
+	boards := []kanbanmodels.Board{{Name: "Platform", Path: "/ws/boards/platform", Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{{Filename: "deploy.md", Title: "Deploy API", DueDate: &due}}},
+	}}}
+	day := NewDayModel(nil, boards, nil, nil)
+	day.SetSize(80, 24)
+
+	day, _ = day.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")})
+	for day.actions.actions[day.actions.cursor] != actionPeek {
+		day, _ = day.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	}
+	day, _ = day.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if day.ShowingActions() || !day.IsPeeking() {
+		t.Errorf("expected Peek to close the menu and open the quick-look popup")
+	}
+}
+
+func TestWeekNotes_OpenAndCreate(t *testing.T) {
+	golden.FixClock(t)
+	friday := golden.Now.AddDate(0, 0, 2)
+	week := NewWeekModel(nil, nil, []notes.Note{{Title: "Retro", FilePath: "/ws/notes/retro.md", Date: friday}}, nil)
+	week.SetSize(80, 24)
+
+	_, cmd := week.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("expected enter on a note to open it in the editor")
+	}
+
+	_, cmd = week.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	msg, ok := cmd().(messages.OpenJournalMsg)
+	if !ok || !isSameDay(msg.Day, friday) {
+		t.Errorf("n sent %+v, want the journal of %v", msg, friday)
+	}
+
+	empty := NewWeekModel(nil, nil, nil, nil)
+	_, cmd = empty.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if msg := cmd().(messages.OpenJournalMsg); !isSameDay(msg.Day, golden.Now) {
+		t.Errorf("n on an empty week opened %v, want today", msg.Day)
+	}
+}
@@ -0,0 +1,96 @@
+package agenda
+
+import (
+	"maps"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/logs"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/messages"
+)
+
+// bumpDueDate moves the due date of a task or card item by days, writes it
+// to disk and asks the app to reload. Other item sources and projected
+// occurrences of recurring tasks, which aren't in a file yet, are ignored.
+func bumpDueDate(svc service.TaskService, item agendapkg.AgendaItem, days int) tea.Cmd {
+	if item.Projected {
+		return nil
+	}
+	switch item.Source {
+	case agendapkg.SourceTask:
+		if svc == nil || item.Task == nil {
+			return nil
+		}
+		task := *item.Task
+		task.Tags = maps.Clone(task.Tags)
+		task.BumpDueDate(days, clock.Now())
+		if err := svc.Update(task); err != nil {
+			logs.Logger.Printf("Error bumping task due date: %v", err)
+			return nil
+		}
+	case agendapkg.SourceCard:
+		board, err := fs.ReadBoard(item.BoardPath)
+		if err != nil {
+			logs.Logger.Printf("Error loading board: %v", err)
+			return nil
+		}
+		if _, err := operations.BumpCardDueDate(&board, item.ColIndex, item.CardIndex, days); err != nil {
+			logs.Logger.Printf("Error bumping card due date: %v", err)
+			return nil
+		}
+	default:
+		return nil
+	}
+	return func() tea.Msg { return messages.DataRefreshMsg{} }
+}
+
+// rescheduleItem sets the date a task or card item is listed under (its
+// scheduled date when listed as scheduled, else its due date) to date, or
+// clears it when date is nil, and asks the app to reload.
+func rescheduleItem(svc service.TaskService, item agendapkg.AgendaItem, date *time.Time) tea.Cmd {
+	scheduled := item.Reason == agendapkg.ReasonScheduled
+	switch item.Source {
+	case agendapkg.SourceTask:
+		if svc == nil || item.Task == nil {
+			return nil
+		}
+		value := ""
+		if date != nil {
+			value = date.Format("2006-01-02")
+		}
+		task := *item.Task
+		task.Tags = maps.Clone(task.Tags)
+		if scheduled {
+			task.SetScheduledDate(value)
+		} else {
+			task.SetDueDate(value)
+		}
+		if err := svc.Update(task); err != nil {
+			logs.Logger.Printf("Error rescheduling task: %v", err)
+			return nil
+		}
+	case agendapkg.SourceCard:
+		board, err := fs.ReadBoard(item.BoardPath)
+		if err != nil {
+			logs.Logger.Printf("Error loading board: %v", err)
+			return nil
+		}
+		if scheduled {
+			err = operations.UpdateCardScheduledDate(&board, item.ColIndex, item.CardIndex, date)
+		} else {
+			err = operations.UpdateCardDueDate(&board, item.ColIndex, item.CardIndex, date)
+		}
+		if err != nil {
+			logs.Logger.Printf("Error rescheduling card: %v", err)
+			return nil
+		}
+	default:
+		return nil
+	}
+	return func() tea.Msg { return messages.DataRefreshMsg{} }
+}
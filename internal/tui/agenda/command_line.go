@@ -0,0 +1,174 @@
+package agenda
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sahilm/fuzzy"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
+)
+
+// CommandLineModel is the ':' command line at the bottom of the agenda views.
+//
+//	:open <board>   open the best fuzzy match among boards
+//	:task <text>    focus the best fuzzy match among pending tasks
+//	:goto <date>    move the agenda to a date (yyyy-MM-dd, MM-dd, today, +3, ...)
+type CommandLineModel struct {
+	input   textinput.Model
+	active  bool
+	err     string
+	taskSvc service.TaskService
+	boards  []kanbanmodels.Board
+}
+
+func NewCommandLineModel(taskSvc service.TaskService, boards []kanbanmodels.Board) CommandLineModel {
+	ti := textinput.New()
+	ti.Prompt = ":"
+	ti.Placeholder = "open <board> | task <text> | goto <date>"
+	ti.CharLimit = 200
+	ti.Width = 60
+	return CommandLineModel{input: ti, taskSvc: taskSvc, boards: boards}
+}
+
+// SetData updates the boards and tasks commands search.
+func (m *CommandLineModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Board) {
+	m.taskSvc = taskSvc
+	m.boards = boards
+}
+
+// Open activates the command line with an empty input.
+func (m *CommandLineModel) Open() tea.Cmd {
+	m.active = true
+	m.err = ""
+	m.input.SetValue("")
+	return m.input.Focus()
+}
+
+// IsActive returns true while the command line is taking input.
+func (m CommandLineModel) IsActive() bool {
+	return m.active
+}
+
+// Error returns the message from the last failed command, if any.
+func (m CommandLineModel) Error() string {
+	return m.err
+}
+
+// ClearError drops the last error message.
+func (m *CommandLineModel) ClearError() {
+	m.err = ""
+}
+
+func (m CommandLineModel) Update(msg tea.Msg) (CommandLineModel, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		var cmd tea.Cmd
+		m.input, cmd = m.input.Update(msg)
+		return m, cmd
+	}
+
+	switch keyMsg.String() {
+	case "esc":
+		m.close()
+		return m, nil
+	case "enter":
+		line := m.input.Value()
+		m.close()
+		result, err := m.execute(line)
+		if err != nil {
+			m.err = err.Error()
+			return m, nil
+		}
+		if result == nil {
+			return m, nil
+		}
+		return m, func() tea.Msg { return result }
+	case "backspace":
+		// Backspace on an empty line closes it, like vim
+		if m.input.Value() == "" {
+			m.close()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd
+}
+
+func (m *CommandLineModel) close() {
+	m.active = false
+	m.input.Blur()
+}
+
+// execute parses a command line and returns the message it dispatches.
+func (m CommandLineModel) execute(line string) (tea.Msg, error) {
+	name, arg, _ := strings.Cut(strings.TrimSpace(line), " ")
+	arg = strings.TrimSpace(arg)
+	if name == "" {
+		return nil, nil
+	}
+
+	switch name {
+	case "open", "o", "board", "b":
+		if arg == "" {
+			return messages.SwitchViewMsg{View: messages.ViewKanbanPicker}, nil
+		}
+		targets := make([]string, len(m.boards))
+		for i, b := range m.boards {
+			targets[i] = b.Name + " " + filepath.Base(filepath.Dir(b.Path))
+		}
+		matches := fuzzy.Find(arg, targets)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no board matches %q", arg)
+		}
+		return messages.OpenBoardMsg{BoardPath: m.boards[matches[0].Index].Path}, nil
+
+	case "task", "t":
+		if arg == "" {
+			return messages.SwitchViewMsg{View: messages.ViewTaskManager}, nil
+		}
+		if m.taskSvc == nil {
+			return nil, fmt.Errorf("no tasks loaded")
+		}
+		tasks, err := m.taskSvc.ListPending()
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(tasks))
+		for i, t := range tasks {
+			names[i] = t.Name
+		}
+		matches := fuzzy.Find(arg, names)
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no task matches %q", arg)
+		}
+		return messages.FocusTaskMsg{TaskID: tasks[matches[0].Index].ID}, nil
+
+	case "goto", "g", "date", "d":
+		date, err := shared.ParseDateInput(arg)
+		if err != nil {
+			return nil, fmt.Errorf("goto: %v", err)
+		}
+		return messages.GotoDateMsg{Date: date}, nil
+	}
+
+	return nil, fmt.Errorf("unknown command %q", name)
+}
+
+// View renders the input line, or the last error when inactive.
+func (m CommandLineModel) View() string {
+	if m.active {
+		return m.input.View()
+	}
+	if m.err != "" {
+		return overdueHeaderStyle.Render(m.err)
+	}
+	return ""
+}
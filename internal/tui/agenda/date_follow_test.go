@@ -0,0 +1,43 @@
+package agenda
+
+import (
+	"testing"
+
+	"wydo/internal/clock"
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestSetData_KeepsNavigatedDate(t *testing.T) {
+	golden.FixClock(t)
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: t.TempDir(), Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	day := NewDayModel(svc, nil, nil, nil)
+	month := NewMonthModel(svc, nil, nil, nil)
+	picked := golden.Now.AddDate(0, 0, 9)
+	day.date = picked
+	month.cursorDate = picked
+
+	// Coming back to the view the same day keeps the day the user moved to
+	day.SetData(svc, nil, nil, nil)
+	month.SetData(svc, nil, nil, nil)
+	if !isSameDay(day.date, picked) || !isSameDay(month.cursorDate, picked) {
+		t.Errorf("day %v, month %v: want both kept on %v", day.date, month.cursorDate, picked)
+	}
+
+	// A view left on today follows the clock past midnight
+	week := NewWeekModel(svc, nil, nil, nil)
+	tomorrow := golden.Now.AddDate(0, 0, 1)
+	clock.Set(tomorrow)
+	week.SetData(svc, nil, nil, nil)
+	if !isSameDay(week.date, tomorrow) {
+		t.Errorf("week date = %v, want it to follow today to %v", week.date, tomorrow)
+	}
+	day.SetData(svc, nil, nil, nil)
+	if !isSameDay(day.date, picked) {
+		t.Errorf("day date = %v, want the picked day kept", day.date)
+	}
+}
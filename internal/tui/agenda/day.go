@@ -2,6 +2,7 @@ package agenda
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -10,17 +11,25 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sahilm/fuzzy"
 	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/notes"
 	"wydo/internal/tasks/service"
-	"wydo/internal/tui/messages"
 	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
+// MinWidth and MinHeight are the smallest area the day and week views lay
+// out in; longer agendas are cut at the bottom.
+const (
+	MinWidth  = 70
+	MinHeight = 10
+)
+
 // DayModel is the day agenda view
 type DayModel struct {
 	date         time.Time
+	refreshedOn  time.Time // today as of the last SetData, see followsToday
 	buckets      []agendapkg.DateBucket
 	overdueItems []agendapkg.AgendaItem
 	taskSvc      service.TaskService
@@ -33,6 +42,13 @@ type DayModel struct {
 	width        int
 	height       int
 
+	peek    *shared.PeekModel // quick-look popup for the selected item
+	actions *actionsModel     // a: actions menu for the selected item
+	sources sourceFilter      // s cycles which kind of item is shown, x shows parked items
+
+	myDay     bool     // m: show only the items picked for My Day
+	myDayKeys []string // My Day keys of today's picks, in the order picked
+
 	// Search state
 	searchActive     bool
 	searchFilterMode bool
@@ -48,7 +64,8 @@ func NewDayModel(taskSvc service.TaskService, boards []kanbanmodels.Board, allNo
 	si.Width = 40
 
 	m := DayModel{
-		date:         time.Now(),
+		date:         clock.Now(),
+		refreshedOn:  clock.Now(),
 		taskSvc:      taskSvc,
 		boards:       boards,
 		notes:        allNotes,
@@ -60,9 +77,16 @@ func NewDayModel(taskSvc service.TaskService, boards []kanbanmodels.Board, allNo
 }
 
 func (m *DayModel) refreshData() {
+	if m.myDay {
+		m.buckets, m.overdueItems = nil, nil
+		m.allItems = agendapkg.QueryMyDay(m.taskSvc, m.boards, m.myDayKeys)
+		m.applySearchFilter()
+		return
+	}
+
 	dateRange := agendapkg.DayRange(m.date)
-	m.buckets = agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange)
-	m.overdueItems = agendapkg.QueryOverdueItems(m.taskSvc, m.boards, dateRange.Start)
+	m.buckets = m.sources.buckets(agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange))
+	m.overdueItems = m.sources.items(agendapkg.QueryOverdueItems(m.taskSvc, m.boards, dateRange.Start))
 
 	// Flatten all items: overdue first, then regular, then completed
 	m.allItems = nil
@@ -104,6 +128,16 @@ func (m DayModel) IsSearching() bool {
 	return m.searchActive
 }
 
+// IsPeeking returns true while the quick-look popup is open
+func (m DayModel) IsPeeking() bool {
+	return m.peek != nil
+}
+
+// ShowingActions reports whether the actions menu is open
+func (m DayModel) ShowingActions() bool {
+	return m.actions != nil
+}
+
 // HintText returns hint text for the current state
 func (m DayModel) HintText() string {
 	if m.searchActive {
@@ -119,11 +153,21 @@ func (m DayModel) HintText() string {
 func (m *DayModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
+	if m.peek != nil {
+		m.peek.SetSize(width, height)
+	}
+	if m.actions != nil {
+		m.actions.SetSize(width, height)
+	}
 }
 
 // SetData updates the data sources and refreshes
 func (m *DayModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Board, allNotes []notes.Note, projectDates []agendapkg.ProjectDateSource) {
-	m.date = time.Now()
+	now := clock.Now()
+	if followsToday(m.date, m.refreshedOn) {
+		m.date = now
+	}
+	m.refreshedOn = now
 	m.taskSvc = taskSvc
 	m.boards = boards
 	m.notes = allNotes
@@ -131,6 +175,52 @@ func (m *DayModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Bo
 	m.refreshData()
 }
 
+// followsToday reports whether a view showing date moves to today when its
+// data is refreshed: it does when date was today at the last refresh, so a
+// view left on today keeps up with the clock, while a day the user moved to
+// stays put across view switches.
+func followsToday(date, refreshedOn time.Time) bool {
+	return isSameDay(date, refreshedOn)
+}
+
+// SetMyDay sets the My Day keys of today's picks.
+func (m *DayModel) SetMyDay(keys []string) {
+	m.myDayKeys = keys
+	if m.myDay {
+		m.refreshData()
+	}
+}
+
+// ShowingMyDay reports whether the view shows the My Day list rather than
+// the full agenda.
+func (m DayModel) ShowingMyDay() bool {
+	return m.myDay
+}
+
+// SetDate moves the view to the given day
+func (m *DayModel) SetDate(date time.Time) {
+	m.date = date
+	m.cursor = 0
+	m.refreshData()
+}
+
+// FocusOverdue shows today with the search and source filter cleared and the
+// cursor on the first overdue item, which lead the list.
+func (m *DayModel) FocusOverdue() {
+	m.searchActive = false
+	m.searchFilterMode = false
+	m.searchQuery = ""
+	m.searchInput.SetValue("")
+	m.sources = sourceFilter{}
+	m.peek = nil
+	m.SetDate(clock.Now())
+}
+
+// Date returns the day being viewed
+func (m DayModel) Date() time.Time {
+	return m.date
+}
+
 // Init implements tea.Model
 func (m DayModel) Init() tea.Cmd {
 	return nil
@@ -140,6 +230,12 @@ func (m DayModel) Init() tea.Cmd {
 func (m DayModel) Update(msg tea.Msg) (DayModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.peek != nil {
+			return m.handlePeek(msg)
+		}
+		if m.actions != nil {
+			return m.handleActions(msg)
+		}
 		if m.searchActive {
 			return m.handleSearchMode(msg)
 		}
@@ -157,7 +253,13 @@ func (m DayModel) Update(msg tea.Msg) (DayModel, tea.Cmd) {
 			m.date = m.date.AddDate(0, 0, 1)
 			m.refreshData()
 		case "t":
-			m.date = time.Now()
+			m.date = clock.Now()
+			m.refreshData()
+		case "s":
+			m.sources = m.sources.next()
+			m.refreshData()
+		case "x":
+			m.sources = m.sources.toggleParked()
 			m.refreshData()
 		case "j", "down":
 			if m.cursor < len(m.items)-1 {
@@ -169,31 +271,72 @@ func (m DayModel) Update(msg tea.Msg) (DayModel, tea.Cmd) {
 			}
 		case "enter":
 			return m.openSelectedItem()
+		case "p":
+			m.openPeek()
+		case "a":
+			if m.cursor < len(m.items) {
+				actions := newActionsModel(m.items[m.cursor], m.taskSvc, m.boards, m.width, m.height)
+				m.actions = &actions
+			}
+		case "m":
+			m.myDay = !m.myDay
+			m.cursor = 0
+			m.refreshData()
+		case "+":
+			if m.cursor < len(m.items) {
+				return m, toggleMyDay(m.items[m.cursor])
+			}
+		case " ":
+			if m.myDay && m.cursor < len(m.items) {
+				return m, completeItem(m.taskSvc, m.items[m.cursor])
+			}
+		case ">", "<", "}", "{":
+			if m.cursor < len(m.items) {
+				days, _ := shared.DueBumpDays(msg.String())
+				return m, bumpDueDate(m.taskSvc, m.items[m.cursor], days)
+			}
 		}
 	}
 
 	return m, nil
 }
 
+// openPeek opens the quick-look popup for the selected item.
+func (m *DayModel) openPeek() {
+	if m.cursor < len(m.items) {
+		peek := peekItem(m.items[m.cursor])
+		peek.SetSize(m.width, m.height)
+		m.peek = &peek
+	}
+}
+
+// handleActions passes keys to the actions menu; choosing Peek there opens
+// the quick-look popup once the menu closes.
+func (m DayModel) handleActions(msg tea.KeyMsg) (DayModel, tea.Cmd) {
+	actions, cmd, done := m.actions.Update(msg)
+	if !done {
+		m.actions = &actions
+		return m, cmd
+	}
+	m.actions = nil
+	if actions.peek {
+		m.openPeek()
+	}
+	return m, cmd
+}
+
+// handlePeek closes the quick-look popup on any key; enter also opens the item.
+func (m DayModel) handlePeek(msg tea.KeyMsg) (DayModel, tea.Cmd) {
+	m.peek = nil
+	if msg.String() == "enter" {
+		return m.openSelectedItem()
+	}
+	return m, nil
+}
+
 func (m DayModel) openSelectedItem() (DayModel, tea.Cmd) {
 	if m.cursor < len(m.items) {
-		item := m.items[m.cursor]
-		switch item.Source {
-		case agendapkg.SourceTask:
-			if item.Task != nil {
-				return m, func() tea.Msg {
-					return messages.FocusTaskMsg{TaskID: item.Task.ID}
-				}
-			}
-		case agendapkg.SourceCard:
-			return m, func() tea.Msg {
-				return messages.OpenBoardMsg{
-					BoardPath: item.BoardPath,
-					ColIndex:  item.ColIndex,
-					CardIndex: item.CardIndex,
-				}
-			}
-		}
+		return m, openItem(m.items[m.cursor])
 	}
 	return m, nil
 }
@@ -229,6 +372,9 @@ func (m DayModel) handleSearchMode(msg tea.KeyMsg) (DayModel, tea.Cmd) {
 		return m, m.searchInput.Focus()
 	case "enter":
 		return m.openSelectedItem()
+	case "p":
+		m.openPeek()
+		return m, nil
 	case "esc":
 		m.searchInput.SetValue("")
 		m.searchQuery = ""
@@ -253,12 +399,22 @@ func (m DayModel) handleSearchMode(msg tea.KeyMsg) (DayModel, tea.Cmd) {
 
 // View renders the day agenda view
 func (m DayModel) View() string {
+	if m.peek != nil {
+		return m.peek.View()
+	}
+	if m.actions != nil {
+		return m.actions.View()
+	}
+	if m.myDay {
+		return m.viewMyDay()
+	}
+
 	var sb strings.Builder
 
 	// Title line
 	dateStr := m.date.Format("Monday, Jan 2 2006")
 	title := titleStyle.Render(fmt.Sprintf(" Agenda: %s", dateStr))
-	sb.WriteString(title)
+	sb.WriteString(title + m.sources.label())
 	sb.WriteString("\n")
 
 	if m.searchActive {
@@ -285,16 +441,18 @@ func (m DayModel) View() string {
 		for i, item := range m.items {
 			selected := i == m.cursor
 			line := RenderItemLine(item, selected, m.width-4)
-			sb.WriteString("   ")
+			sb.WriteString(m.itemIndent(item))
 			sb.WriteString(line)
 			sb.WriteString("\n")
 		}
 	} else {
 		// Separate tasks, cards, notes, project dates, and completed items from buckets
-		var allTasks, allCards, allNotes, allProjectDates, allCompleted []agendapkg.AgendaItem
+		var allTasks, allStarting, allCards, allBlocked, allNotes, allProjectDates, allCompleted []agendapkg.AgendaItem
 		for _, bucket := range m.buckets {
 			allTasks = append(allTasks, bucket.Tasks...)
+			allStarting = append(allStarting, bucket.Starting...)
 			allCards = append(allCards, bucket.Cards...)
+			allBlocked = append(allBlocked, bucket.BlockedCards...)
 			allNotes = append(allNotes, bucket.Notes...)
 			allProjectDates = append(allProjectDates, bucket.ProjectDates...)
 			allCompleted = append(allCompleted, bucket.AllCompletedItems()...)
@@ -309,7 +467,7 @@ func (m DayModel) View() string {
 			for _, item := range m.overdueItems {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -324,7 +482,26 @@ func (m DayModel) View() string {
 			for _, item := range allTasks {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
+				sb.WriteString(line)
+				sb.WriteString("\n")
+				cursorIdx++
+			}
+			sb.WriteString("\n")
+		}
+
+		// Starting tasks section: tasks whose start: date is this day
+		if len(allStarting) > 0 {
+			title := "Starting"
+			if isSameDay(m.date, clock.Now()) {
+				title = "Starts today"
+			}
+			sb.WriteString(sectionStyle.Render(fmt.Sprintf(" %s (%d)", title, len(allStarting))))
+			sb.WriteString("\n")
+			for _, item := range allStarting {
+				selected := cursorIdx == m.cursor
+				line := RenderItemLine(item, selected, m.width-4)
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -339,7 +516,22 @@ func (m DayModel) View() string {
 			for _, item := range allCards {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
+				sb.WriteString(line)
+				sb.WriteString("\n")
+				cursorIdx++
+			}
+			sb.WriteString("\n")
+		}
+
+		// Blocked cards section
+		if len(allBlocked) > 0 {
+			sb.WriteString(blockedHeaderStyle.Render(fmt.Sprintf(" Blocked (%d)", len(allBlocked))))
+			sb.WriteString("\n")
+			for _, item := range allBlocked {
+				selected := cursorIdx == m.cursor
+				line := RenderItemLine(item, selected, m.width-4)
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -354,7 +546,7 @@ func (m DayModel) View() string {
 			for _, item := range allNotes {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -369,7 +561,7 @@ func (m DayModel) View() string {
 			for _, item := range allProjectDates {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -385,7 +577,7 @@ func (m DayModel) View() string {
 			for _, item := range allCompleted {
 				selected := cursorIdx == m.cursor
 				line := RenderItemLine(item, selected, m.width-4)
-				sb.WriteString("   ")
+				sb.WriteString(m.itemIndent(item))
 				sb.WriteString(line)
 				sb.WriteString("\n")
 				cursorIdx++
@@ -395,3 +587,12 @@ func (m DayModel) View() string {
 
 	return shared.CenterContent(sb.String(), m.height)
 }
+
+// itemIndent returns the indent of an item line in the full agenda, with
+// shared.MyDayGlyph in it when the item is picked for My Day.
+func (m DayModel) itemIndent(item agendapkg.AgendaItem) string {
+	if key := item.MyDayKey(); key != "" && slices.Contains(m.myDayKeys, key) {
+		return " " + cursorStyle.Render(shared.MyDayGlyph) + " "
+	}
+	return "   "
+}
@@ -0,0 +1,137 @@
+package agenda
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/clock"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/stats"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/shared"
+)
+
+// MinHeatmapWidth and MinHeatmapHeight are the smallest area the 52 weeks
+// and their legend fit in.
+const (
+	MinHeatmapWidth  = 110
+	MinHeatmapHeight = 16
+)
+
+// HeatmapModel is the year view: a GitHub-style heatmap of completed tasks
+// and cards over the 52 weeks ending at end.
+type HeatmapModel struct {
+	end         time.Time
+	completions stats.Completions
+	taskSvc     service.TaskService
+	boards      []kanbanmodels.Board
+	width       int
+	height      int
+}
+
+// NewHeatmapModel creates a new completions heatmap view
+func NewHeatmapModel(taskSvc service.TaskService, boards []kanbanmodels.Board) HeatmapModel {
+	m := HeatmapModel{
+		end:     clock.Now(),
+		taskSvc: taskSvc,
+		boards:  boards,
+	}
+	m.refreshData()
+	return m
+}
+
+func (m *HeatmapModel) refreshData() {
+	var tasks []data.Task
+	if m.taskSvc != nil {
+		// The heatmap reaches back into last year, so read every done file
+		tasks, _ = m.taskSvc.ListAll()
+	}
+	m.completions = stats.CollectCompletions(tasks, m.boards)
+}
+
+func (m HeatmapModel) start() time.Time {
+	return m.end.AddDate(0, 0, -7*52)
+}
+
+// SetSize updates the view dimensions
+func (m *HeatmapModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// SetData updates the data sources and refreshes
+func (m *HeatmapModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Board) {
+	m.end = clock.Now()
+	m.taskSvc = taskSvc
+	m.boards = boards
+	m.refreshData()
+}
+
+// SetDate moves the window to end at the given date
+func (m *HeatmapModel) SetDate(date time.Time) {
+	m.end = date
+}
+
+// Update handles key events for the heatmap view
+func (m HeatmapModel) Update(msg tea.Msg) (HeatmapModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "h", "left":
+			m.end = m.end.AddDate(-1, 0, 0)
+		case "l", "right":
+			m.end = m.end.AddDate(1, 0, 0)
+			if now := clock.Now(); m.end.After(now) {
+				m.end = now
+			}
+		case "t":
+			m.end = clock.Now()
+		}
+	}
+	return m, nil
+}
+
+// View renders the heatmap and streak summary
+func (m HeatmapModel) View() string {
+	var sb strings.Builder
+
+	start, end := m.start(), m.end
+	title := titleStyle.Render(fmt.Sprintf(" Completions: %s – %s", start.Format("Jan 2 2006"), end.Format("Jan 2 2006")))
+	sb.WriteString(title)
+	sb.WriteString("\n\n")
+
+	sb.WriteString(stats.RenderHeatmap(m.completions, start, end, func(level int, glyph string) string {
+		return heatmapLevelStyles[level].Render(glyph)
+	}))
+	sb.WriteString("\n\n")
+
+	current, longest := stats.Streaks(m.completions, start, end)
+	total := m.completions.Total(start, end)
+
+	busiestDay, busiest := time.Time{}, 0
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		if n := m.completions.Count(d); n > busiest {
+			busiestDay, busiest = d, n
+		}
+	}
+
+	sb.WriteString(sectionStyle.Render(" Summary"))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("   %d completed  ", total))
+	sb.WriteString(weekCountStyle.Render(fmt.Sprintf("(%.1f per week)", float64(total)/52)))
+	sb.WriteString("\n")
+	sb.WriteString(fmt.Sprintf("   Current streak: %d day(s)   Longest streak: %d day(s)\n", current, longest))
+	if busiest > 0 {
+		sb.WriteString(fmt.Sprintf("   Busiest day: %s (%d)\n", busiestDay.Format("Mon, Jan 2 2006"), busiest))
+	}
+
+	return shared.CenterContent(sb.String(), m.height)
+}
+
+// HintText returns the raw hint string for the heatmap view.
+func (m HeatmapModel) HintText() string {
+	return "h/l:year  t:today"
+}
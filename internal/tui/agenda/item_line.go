@@ -3,12 +3,11 @@ package agenda
 import (
 	"fmt"
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/lipgloss"
 	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
 	"wydo/internal/tui/shared"
-	"wydo/internal/tui/theme"
 )
 
 // RenderItemLine renders a single AgendaItem as a styled line
@@ -26,6 +25,9 @@ func RenderItemLine(item agendapkg.AgendaItem, selected bool, width int) string
 	if item.Source == agendapkg.SourceTask && item.Task != nil && item.Task.Priority != 0 && !item.Completed {
 		parts = append(parts, shared.AgendaPriorityBadge(item.Task.Priority))
 	}
+	if item.Source == agendapkg.SourceCard && item.Card != nil && item.Card.Priority > 0 && !item.Completed {
+		parts = append(parts, shared.PriorityStyle(item.Card.Priority).Render(fmt.Sprintf("(%d)", item.Card.Priority)))
+	}
 
 	// Title (without priority prefix)
 	title := itemTitleNoPrefix(item)
@@ -37,6 +39,11 @@ func RenderItemLine(item agendapkg.AgendaItem, selected bool, width int) string
 		parts = append(parts, normalStyle.Render(title))
 	}
 
+	// Blocked badge for pending cards
+	if item.Source == agendapkg.SourceCard && item.Card != nil && item.Card.IsBlocked() && !item.Completed {
+		parts = append(parts, blockedBadgeStyle.Render("blocked: "+item.Card.Blocked))
+	}
+
 	// Context info (projects for tasks, board/column for cards)
 	if item.Completed {
 		context := itemContextText(item)
@@ -50,6 +57,10 @@ func RenderItemLine(item agendapkg.AgendaItem, selected bool, width int) string
 		}
 	}
 
+	if item.Projected {
+		parts = append(parts, completedTagStyle.Render("↻ repeats"))
+	}
+
 	// Right-aligned: reason + relative date
 	line := strings.Join(parts, " ")
 
@@ -92,6 +103,8 @@ func itemTitleNoPrefix(item agendapkg.AgendaItem) string {
 			label = "milestone"
 		}
 		return item.ProjectName + " · " + label
+	case agendapkg.SourceBoardReview:
+		return "Review board: " + item.BoardName
 	}
 	return ""
 }
@@ -120,6 +133,9 @@ func itemContextText(item agendapkg.AgendaItem) string {
 		}
 	case agendapkg.SourceNote:
 		if item.Note != nil {
+			if tags := item.Note.TagLabel(); tags != "" {
+				return item.Note.RelPath + " " + tags
+			}
 			return item.Note.RelPath
 		}
 	case agendapkg.SourceProjectDate:
@@ -144,6 +160,9 @@ func itemContext(item agendapkg.AgendaItem) string {
 		}
 	case agendapkg.SourceNote:
 		if item.Note != nil {
+			if tags := item.Note.TagLabel(); tags != "" {
+				return notePathStyle.Render(item.Note.RelPath) + " " + noteTagStyle.Render(tags)
+			}
 			return notePathStyle.Render(item.Note.RelPath)
 		}
 	case agendapkg.SourceProjectDate:
@@ -160,40 +179,12 @@ func formatReasonDate(item agendapkg.AgendaItem) string {
 		return reasonNoteStyle.Render("milestone")
 	}
 
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
-	targetDate := time.Date(item.Date.Year(), item.Date.Month(), item.Date.Day(), 0, 0, 0, 0, time.Local)
-
-	daysUntil := int(targetDate.Sub(today).Hours() / 24)
-
-	var relStr string
-	switch {
-	case daysUntil == 0:
-		relStr = "0d"
-	case daysUntil > 0:
-		relStr = fmt.Sprintf("+%dd", daysUntil)
-	default:
-		relStr = fmt.Sprintf("%dd", daysUntil)
-	}
-
-	reason := item.Reason.String()
-
-	var style lipgloss.Style
-	if daysUntil > 7 {
-		style = lipgloss.NewStyle().Foreground(theme.Success)
-	} else if daysUntil > 0 {
-		style = lipgloss.NewStyle().Foreground(theme.Warning)
-	} else if daysUntil == 0 {
-		style = lipgloss.NewStyle().Foreground(theme.Primary)
-	} else {
-		style = lipgloss.NewStyle().Foreground(theme.Danger)
-	}
-
-	label := fmt.Sprintf("%s %s", reason, relStr)
+	daysUntil := shared.DaysUntil(item.Date, clock.Now())
+	label := item.Reason.String() + " " + shared.Countdown(daysUntil)
 	// For items 7+ days overdue, append the absolute date
 	if daysUntil <= -7 {
-		label += " " + targetDate.Format("Jan 2")
+		label += " " + item.Date.Format("Jan 2")
 	}
 
-	return style.Render(label)
+	return lipgloss.NewStyle().Foreground(shared.CountdownColor(daysUntil)).Render(label)
 }
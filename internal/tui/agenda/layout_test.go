@@ -0,0 +1,47 @@
+package agenda
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+// view is what the boundary tests render: any of the agenda views.
+type view interface {
+	View() string
+}
+
+func TestAgendaViews_MinimumSize(t *testing.T) {
+	golden.FixClock(t)
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	month := NewMonthModel(svc, nil, nil, nil)
+	heatmap := NewHeatmapModel(svc, nil)
+	tests := []struct {
+		name          string
+		setSize       func(w, h int)
+		view          view
+		width, height int
+	}{
+		{"month", month.SetSize, &month, MinMonthWidth, MinMonthHeight},
+		{"heatmap", heatmap.SetSize, &heatmap, MinHeatmapWidth, MinHeatmapHeight},
+	}
+	for _, tt := range tests {
+		tt.setSize(tt.width, tt.height)
+		out := tt.view.View()
+		if w, h := lipgloss.Width(out), lipgloss.Height(out); w > tt.width || h > tt.height {
+			t.Errorf("%s is %dx%d at its minimum %dx%d:\n%s", tt.name, w, h, tt.width, tt.height, out)
+		}
+
+		// Below the minimum the app shows a placeholder, but the math must not panic
+		for _, size := range [][2]int{{0, 0}, {1, 1}, {tt.width - 1, tt.height - 1}} {
+			tt.setSize(size[0], size[1])
+			_ = tt.view.View()
+		}
+	}
+}
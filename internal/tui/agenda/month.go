@@ -7,6 +7,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/notes"
 	"wydo/internal/tasks/service"
@@ -14,10 +15,18 @@ import (
 	"wydo/internal/tui/shared"
 )
 
+// MinMonthWidth and MinMonthHeight are the smallest area the calendar grid
+// fits in.
+const (
+	MinMonthWidth  = 70
+	MinMonthHeight = 14
+)
+
 // MonthModel is the month agenda view with calendar grid
 type MonthModel struct {
 	viewMonth    time.Time // first of the month being viewed
 	cursorDate   time.Time // the day under cursor in the calendar
+	refreshedOn  time.Time // today as of the last SetData, see followsToday
 	buckets      []agendapkg.DateBucket
 	bucketMap    map[string]*agendapkg.DateBucket
 	taskSvc      service.TaskService
@@ -26,18 +35,20 @@ type MonthModel struct {
 	projectDates []agendapkg.ProjectDateSource
 	// Detail panel: items for the cursor day
 	detailItems []agendapkg.AgendaItem
-	detailIdx   int  // cursor within detail panel
-	inDetail    bool // true when navigating in the detail panel
+	detailIdx   int          // cursor within detail panel
+	inDetail    bool         // true when navigating in the detail panel
+	sources     sourceFilter // s cycles which kind of item is shown, x shows parked items
 	width       int
 	height      int
 }
 
 // NewMonthModel creates a new month agenda view
 func NewMonthModel(taskSvc service.TaskService, boards []kanbanmodels.Board, allNotes []notes.Note, projectDates []agendapkg.ProjectDateSource) MonthModel {
-	now := time.Now()
+	now := clock.Now()
 	m := MonthModel{
 		viewMonth:    time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local),
 		cursorDate:   now,
+		refreshedOn:  now,
 		taskSvc:      taskSvc,
 		boards:       boards,
 		notes:        allNotes,
@@ -49,7 +60,7 @@ func NewMonthModel(taskSvc service.TaskService, boards []kanbanmodels.Board, all
 
 func (m *MonthModel) refreshData() {
 	dateRange := agendapkg.MonthRange(m.cursorDate)
-	m.buckets = agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange)
+	m.buckets = m.sources.buckets(agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange))
 
 	m.bucketMap = make(map[string]*agendapkg.DateBucket)
 	for i := range m.buckets {
@@ -82,9 +93,12 @@ func (m *MonthModel) SetSize(width, height int) {
 
 // SetData updates the data sources and refreshes
 func (m *MonthModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Board, allNotes []notes.Note, projectDates []agendapkg.ProjectDateSource) {
-	now := time.Now()
-	m.viewMonth = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
-	m.cursorDate = now
+	now := clock.Now()
+	if followsToday(m.cursorDate, m.refreshedOn) {
+		m.viewMonth = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+		m.cursorDate = now
+	}
+	m.refreshedOn = now
 	m.taskSvc = taskSvc
 	m.boards = boards
 	m.notes = allNotes
@@ -92,6 +106,20 @@ func (m *MonthModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.
 	m.refreshData()
 }
 
+// SetDate moves the calendar cursor to the given date
+func (m *MonthModel) SetDate(date time.Time) {
+	m.cursorDate = date
+	m.viewMonth = time.Date(date.Year(), date.Month(), 1, 0, 0, 0, 0, time.Local)
+	m.inDetail = false
+	m.detailIdx = 0
+	m.refreshData()
+}
+
+// Date returns the day under the calendar cursor
+func (m MonthModel) Date() time.Time {
+	return m.cursorDate
+}
+
 // Update handles key events for the month view
 func (m MonthModel) Update(msg tea.Msg) (MonthModel, tea.Cmd) {
 	switch msg := msg.(type) {
@@ -133,10 +161,16 @@ func (m MonthModel) updateCalendar(msg tea.KeyMsg) (MonthModel, tea.Cmd) {
 		m.cursorDate = m.viewMonth
 		m.refreshData()
 	case "t":
-		now := time.Now()
+		now := clock.Now()
 		m.cursorDate = now
 		m.viewMonth = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
 		m.refreshData()
+	case "s":
+		m.sources = m.sources.next()
+		m.refreshData()
+	case "x":
+		m.sources = m.sources.toggleParked()
+		m.refreshData()
 	case "enter":
 		// Enter detail panel if there are items
 		if len(m.detailItems) > 0 {
@@ -173,10 +207,15 @@ func (m MonthModel) updateDetail(msg tea.KeyMsg) (MonthModel, tea.Cmd) {
 				return m, func() tea.Msg {
 					return messages.OpenBoardMsg{
 						BoardPath: item.BoardPath,
+						HasTarget: true,
 						ColIndex:  item.ColIndex,
 						CardIndex: item.CardIndex,
 					}
 				}
+			case agendapkg.SourceBoardReview:
+				return m, func() tea.Msg {
+					return messages.OpenBoardMsg{BoardPath: item.BoardPath}
+				}
 			}
 		}
 	}
@@ -197,7 +236,7 @@ func (m MonthModel) View() string {
 	// Title line
 	monthStr := m.viewMonth.Format("January 2006")
 	title := calMonthTitleStyle.Render(fmt.Sprintf(" %s", monthStr))
-	sb.WriteString(title)
+	sb.WriteString(title + m.sources.label())
 	sb.WriteString("\n\n")
 
 	// Calendar grid
@@ -224,7 +263,7 @@ func (m MonthModel) renderCalendar() string {
 	startWeekday := int(firstDay.Weekday())
 	lastDay := firstDay.AddDate(0, 1, -1)
 	daysInMonth := lastDay.Day()
-	today := time.Now()
+	today := clock.Now()
 
 	currentDay := 1 - startWeekday
 
@@ -305,7 +344,7 @@ func (m MonthModel) HintText() string {
 	if m.inDetail {
 		return "j/k:navigate  enter:open  esc:back"
 	}
-	return "h/l:day  j/k:week  H/L:month  t:today  enter:detail"
+	return "h/l:day  j/k:week  H/L:month  t:today  s:source  x:parked  enter:detail"
 }
 
 func isSameDay(d1, d2 time.Time) bool {
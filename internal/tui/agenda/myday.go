@@ -0,0 +1,133 @@
+package agenda
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/logs"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
+)
+
+// toggleMyDay asks the app to pick the item for My Day, or to drop it.
+// Notes and project dates can't be picked.
+func toggleMyDay(item agendapkg.AgendaItem) tea.Cmd {
+	key := item.MyDayKey()
+	if key == "" {
+		return nil
+	}
+	return func() tea.Msg { return messages.ToggleMyDayMsg{Key: key} }
+}
+
+// completeItem marks a task done, or moves a card to its board's Done
+// column, and asks the app to reload. Done items, projected occurrences of
+// recurring tasks and other sources are ignored.
+func completeItem(svc service.TaskService, item agendapkg.AgendaItem) tea.Cmd {
+	if item.Completed || item.Projected {
+		return nil
+	}
+	switch item.Source {
+	case agendapkg.SourceTask:
+		if svc == nil || item.Task == nil {
+			return nil
+		}
+		if err := svc.Complete(item.Task.ID); err != nil {
+			logs.Logger.Printf("Error completing task: %v", err)
+			return nil
+		}
+	case agendapkg.SourceCard:
+		board, err := fs.ReadBoard(item.BoardPath)
+		if err != nil {
+			logs.Logger.Printf("Error loading board: %v", err)
+			return nil
+		}
+		done := -1
+		for i, col := range board.Columns {
+			if board.IsDoneColumn(col.Name) {
+				done = i
+			}
+		}
+		if done < 0 {
+			return nil
+		}
+		if err := operations.MoveCard(&board, item.ColIndex, item.CardIndex, done); err != nil {
+			logs.Logger.Printf("Error completing card: %v", err)
+			return nil
+		}
+	default:
+		return nil
+	}
+	return func() tea.Msg { return messages.DataRefreshMsg{} }
+}
+
+// renderMyDayLine renders a My Day item as a checklist line, without the
+// dates the full agenda shows.
+func renderMyDayLine(item agendapkg.AgendaItem, selected bool) string {
+	cursor := " "
+	if selected {
+		cursor = cursorStyle.Render(">")
+	}
+	box, title := "[ ]", itemTitleNoPrefix(item)
+	switch {
+	case selected:
+		title = selectedStyle.Render(title)
+	case item.Completed:
+		box, title = completedTagStyle.Render("[x]"), completedStyle.Render(title)
+	default:
+		title = normalStyle.Render(title)
+	}
+	parts := []string{cursor, box, title}
+	if context := itemContextText(item); context != "" {
+		parts = append(parts, completedTagStyle.Render(context))
+	}
+	return strings.Join(parts, " ")
+}
+
+// viewMyDay renders today's My Day list: the picked items in the order they
+// were picked, done ones struck through.
+func (m DayModel) viewMyDay() string {
+	var sb strings.Builder
+
+	done := 0
+	for _, item := range m.allItems {
+		if item.Completed {
+			done++
+		}
+	}
+	title := titleStyle.Render(fmt.Sprintf(" %s My Day: %s", shared.MyDayGlyph, clock.Now().Format("Monday, Jan 2")))
+	sb.WriteString(title)
+	if len(m.allItems) > 0 {
+		sb.WriteString(completedTagStyle.Render(fmt.Sprintf("  %d/%d done", done, len(m.allItems))))
+	}
+	sb.WriteString("\n")
+
+	if m.searchActive {
+		if m.searchFilterMode {
+			sb.WriteString("  " + m.searchInput.View())
+		} else if m.searchQuery != "" {
+			sb.WriteString("  " + searchLabelStyle.Render("Filter: ") + m.searchQuery)
+		}
+		sb.WriteString("\n")
+	}
+	sb.WriteString("\n")
+
+	if len(m.items) == 0 {
+		if m.searchQuery != "" {
+			sb.WriteString(emptyStyle.Render("  No matching items."))
+		} else {
+			sb.WriteString(emptyStyle.Render("  Nothing picked yet. Press + on a task or card in the agenda or task manager, m for the full agenda."))
+		}
+		sb.WriteString("\n")
+	}
+	for i, item := range m.items {
+		sb.WriteString("   " + renderMyDayLine(item, i == m.cursor) + "\n")
+	}
+
+	return shared.CenterContent(sb.String(), m.height)
+}
@@ -0,0 +1,134 @@
+package agenda
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/notes"
+	"wydo/internal/tui/shared"
+)
+
+// peekItem builds the quick-look popup for an agenda item from its source:
+// a task's full line and tags, a card's frontmatter and body, or a note's
+// frontmatter and body.
+func peekItem(item agendapkg.AgendaItem) shared.PeekModel {
+	kind := item.Source.String()
+	switch {
+	case item.Source == agendapkg.SourceTask && item.Task != nil:
+		t := item.Task
+		fields := []shared.PeekField{
+			{Label: "Line", Value: t.String()},
+			{Label: "File", Value: t.File},
+			{Label: "Created", Value: t.CreatedDate},
+			{Label: "Completed", Value: t.CompletionDate},
+			{Label: "Projects", Value: joinNames(t.Projects, "+")},
+			{Label: "Contexts", Value: joinNames(t.Contexts, "@")},
+		}
+		keys := make([]string, 0, len(t.Tags))
+		for k := range t.Tags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			fields = append(fields, shared.PeekField{Label: k, Value: t.Tags[k]})
+		}
+		return shared.NewPeekModel(kind, t.Name, fields, "")
+
+	case item.Source == agendapkg.SourceCard && item.Card != nil:
+		c := item.Card
+		fields := []shared.PeekField{
+			{Label: "Board", Value: item.BoardName + " > " + item.ColumnName},
+			{Label: "File", Value: filepath.Join(item.BoardPath, "cards", c.Filename)},
+			{Label: "Due", Value: formatPeekDate(c.DueDate)},
+			{Label: "Scheduled", Value: formatPeekDate(c.ScheduledDate)},
+			{Label: "Completed", Value: formatPeekDate(c.DateCompleted)},
+			{Label: "Tags", Value: strings.Join(c.Tags, ", ")},
+			{Label: "Projects", Value: joinNames(c.Projects, "+")},
+			{Label: "Blocked", Value: c.Blocked},
+			{Label: "Goal", Value: c.Goal},
+			{Label: "Tmux", Value: c.TmuxSession},
+			{Label: "Jira", Value: strings.TrimSpace(c.JiraKey + " " + c.JiraStatus)},
+		}
+		if c.Priority > 0 {
+			fields = append(fields, shared.PeekField{Label: "Priority", Value: fmt.Sprint(c.Priority)})
+		}
+		for _, u := range c.URLs {
+			value := u.URL
+			if u.Label != "" {
+				value = u.Label + ": " + u.URL
+			}
+			fields = append(fields, shared.PeekField{Label: "URL", Value: value})
+		}
+		for _, a := range c.Actions {
+			fields = append(fields, shared.PeekField{Label: "Action", Value: a.Name + ": " + a.Command})
+		}
+		for _, a := range c.Attachments {
+			fields = append(fields, shared.PeekField{Label: "Attachment", Value: a})
+		}
+		return shared.NewPeekModel(kind, c.Title, fields, stripTitleHeading(c.Content, c.Title))
+
+	case item.Source == agendapkg.SourceNote && item.Note != nil:
+		n := item.Note
+		fields := []shared.PeekField{
+			{Label: "File", Value: n.RelPath},
+			{Label: "Date", Value: n.Date.Format("2006-01-02")},
+			{Label: "Projects", Value: joinNames(n.Projects, "+")},
+			{Label: "Tags", Value: joinNames(n.Tags, "#")},
+		}
+		body, err := notes.ReadBody(n.FilePath)
+		if err != nil {
+			fields = append(fields, shared.PeekField{Label: "Error", Value: err.Error()})
+		}
+		return shared.NewPeekModel(kind, n.Title, fields, stripTitleHeading(body, n.Title))
+
+	case item.Source == agendapkg.SourceProjectDate:
+		fields := []shared.PeekField{
+			{Label: "Project", Value: item.ProjectName},
+			{Label: "Label", Value: item.ProjectLabel},
+			{Label: "Date", Value: item.Date.Format("2006-01-02")},
+		}
+		return shared.NewPeekModel(kind, itemTitleNoPrefix(item), fields, "")
+
+	case item.Source == agendapkg.SourceBoardReview:
+		fields := []shared.PeekField{
+			{Label: "Board", Value: item.BoardName},
+			{Label: "Due", Value: item.Date.Format("2006-01-02")},
+		}
+		if item.Completed {
+			fields[1].Label = "Reviewed"
+		}
+		return shared.NewPeekModel(kind, itemTitleNoPrefix(item), fields, "Opening the board marks the review done.")
+	}
+	return shared.NewPeekModel(kind, itemTitleNoPrefix(item), nil, "")
+}
+
+// joinNames prefixes each name with sigil and joins them with spaces.
+func joinNames(names []string, sigil string) string {
+	prefixed := make([]string, len(names))
+	for i, n := range names {
+		prefixed[i] = sigil + n
+	}
+	return strings.Join(prefixed, " ")
+}
+
+func formatPeekDate(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format("2006-01-02")
+}
+
+// stripTitleHeading drops a leading "# title" line, since the popup already
+// shows the title.
+func stripTitleHeading(body, title string) string {
+	body = strings.TrimLeft(body, "\n")
+	first, rest, _ := strings.Cut(body, "\n")
+	if strings.TrimSpace(first) == "# "+title {
+		return rest
+	}
+	return body
+}
@@ -0,0 +1,286 @@
+package agenda
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+	"wydo/internal/tui/shared"
+	"wydo/internal/tui/theme"
+)
+
+// planModel is the week view's planning mode (w). The left pane lists the
+// backlog, pending tasks without a scheduled date; the right pane the seven
+// days of the week with the tasks scheduled on them. enter puts the selected
+// backlog task on the selected day by setting its scheduled: date.
+type planModel struct {
+	svc       service.TaskService
+	week      time.Time // Monday of the week being planned
+	backlog   []data.Task
+	days      [7][]data.Task
+	cursor    int  // backlog row
+	day       int  // selected day, 0 = Monday
+	dayCursor int  // row in the selected day while it has the focus
+	onDay     bool // tab gave the focus to the selected day's tasks
+	message   string
+	err       error
+	width     int
+	height    int
+}
+
+func newPlanModel(svc service.TaskService, date time.Time, width, height int) planModel {
+	p := planModel{
+		svc:    svc,
+		week:   agendapkg.WeekRange(date).Start,
+		width:  width,
+		height: height,
+	}
+	// Start on today when planning the current week
+	if offset := daysBetween(p.week, startOfDay(clock.Now())); offset >= 0 && offset < 7 {
+		p.day = offset
+	}
+	p.reload()
+	return p
+}
+
+func startOfDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// daysBetween returns the number of days from one midnight to another,
+// rounded so DST changes don't shift it.
+func daysBetween(from, to time.Time) int {
+	return int(math.Round(to.Sub(from).Hours() / 24))
+}
+
+// reload sorts the pending tasks into the backlog and the days of the week.
+// Tasks that only start after the week are left out of the backlog.
+func (p *planModel) reload() {
+	p.backlog = nil
+	p.days = [7][]data.Task{}
+	if p.svc == nil {
+		return
+	}
+	tasks, err := p.svc.ListPending()
+	if err != nil {
+		p.err = err
+		return
+	}
+	weekEnd := p.week.AddDate(0, 0, 6)
+	for _, t := range tasks {
+		sched := t.GetScheduledDate()
+		if sched == "" {
+			if !t.NotStarted(weekEnd) {
+				p.backlog = append(p.backlog, t)
+			}
+			continue
+		}
+		date, err := time.ParseInLocation("2006-01-02", sched, time.Local)
+		if err != nil {
+			continue
+		}
+		if offset := daysBetween(p.week, date); offset >= 0 && offset < 7 {
+			p.days[offset] = append(p.days[offset], t)
+		}
+	}
+	sortPlanTasks(p.backlog)
+	for i := range p.days {
+		sortPlanTasks(p.days[i])
+	}
+	p.cursor = min(p.cursor, max(0, len(p.backlog)-1))
+	p.dayCursor = min(p.dayCursor, max(0, len(p.days[p.day])-1))
+	if len(p.days[p.day]) == 0 {
+		p.onDay = false
+	}
+}
+
+// sortPlanTasks orders tasks by priority, then due date (undated last),
+// then name.
+func sortPlanTasks(tasks []data.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if a.Priority != b.Priority {
+			if a.Priority == data.PriorityNone || b.Priority == data.PriorityNone {
+				return b.Priority == data.PriorityNone
+			}
+			return a.Priority < b.Priority
+		}
+		da, db := a.GetDueDate(), b.GetDueDate()
+		if da != db {
+			if da == "" || db == "" {
+				return db == ""
+			}
+			return da < db
+		}
+		return strings.ToLower(a.Name) < strings.ToLower(b.Name)
+	})
+}
+
+// schedule sets the scheduled date of a task ("" clears it) and reloads.
+func (p *planModel) schedule(task data.Task, date string) {
+	task.Tags = maps.Clone(task.Tags)
+	task.SetScheduledDate(date)
+	if err := p.svc.Update(task); err != nil {
+		p.err = err
+		return
+	}
+	p.err = nil
+	p.reload()
+}
+
+// Update handles a key; done is true when planning is left.
+func (p planModel) Update(msg tea.KeyMsg) (planModel, bool) {
+	p.message = ""
+	switch msg.String() {
+	case "esc", "q", "w":
+		return p, true
+	case "h", "left":
+		if p.day > 0 {
+			p.day--
+			p.dayCursor = 0
+			p.onDay = p.onDay && len(p.days[p.day]) > 0
+		}
+	case "l", "right":
+		if p.day < 6 {
+			p.day++
+			p.dayCursor = 0
+			p.onDay = p.onDay && len(p.days[p.day]) > 0
+		}
+	case "H":
+		p.week = p.week.AddDate(0, 0, -7)
+		p.reload()
+	case "L":
+		p.week = p.week.AddDate(0, 0, 7)
+		p.reload()
+	case "tab":
+		p.onDay = !p.onDay && len(p.days[p.day]) > 0
+		p.dayCursor = 0
+	case "j", "down":
+		if p.onDay {
+			p.dayCursor = min(p.dayCursor+1, max(0, len(p.days[p.day])-1))
+		} else {
+			p.cursor = min(p.cursor+1, max(0, len(p.backlog)-1))
+		}
+	case "k", "up":
+		if p.onDay {
+			p.dayCursor = max(p.dayCursor-1, 0)
+		} else {
+			p.cursor = max(p.cursor-1, 0)
+		}
+	case "enter":
+		day := p.week.AddDate(0, 0, p.day)
+		if p.onDay {
+			// Send the task back to the backlog
+			if p.dayCursor < len(p.days[p.day]) {
+				task := p.days[p.day][p.dayCursor]
+				p.schedule(task, "")
+				p.message = fmt.Sprintf("Unscheduled %q", task.Name)
+			}
+		} else if p.cursor < len(p.backlog) {
+			task := p.backlog[p.cursor]
+			p.schedule(task, day.Format("2006-01-02"))
+			p.message = fmt.Sprintf("Scheduled %q for %s", task.Name, day.Format("Mon Jan 2"))
+		}
+	}
+	return p, false
+}
+
+// View renders the backlog beside the days of the week.
+func (p planModel) View() string {
+	end := p.week.AddDate(0, 0, 6)
+	var sb strings.Builder
+	sb.WriteString(titleStyle.Render(fmt.Sprintf(" Plan week: %s - %s", p.week.Format("Jan 2"), end.Format("Jan 2 2006"))))
+	sb.WriteString("\n\n")
+
+	leftWidth := max(p.width*2/5, 20)
+	rightWidth := max(p.width-leftWidth-4, 20)
+
+	var left strings.Builder
+	left.WriteString(sectionStyle.Render(fmt.Sprintf(" Backlog (%d)", len(p.backlog))))
+	left.WriteString("\n")
+	if len(p.backlog) == 0 {
+		left.WriteString(emptyStyle.Render("  Nothing left to schedule."))
+		left.WriteString("\n")
+	}
+	// The title, a blank line, the backlog header and the footer take four
+	// lines; the backlog scrolls in the rest
+	first, last := shared.ScrollWindow(p.cursor, len(p.backlog), max(p.height-4, 1))
+	for i := first; i < last; i++ {
+		left.WriteString(planTaskLine(p.backlog[i], !p.onDay && i == p.cursor, leftWidth))
+		left.WriteString("\n")
+	}
+
+	var right strings.Builder
+	today := startOfDay(clock.Now())
+	for d := 0; d < 7; d++ {
+		day := p.week.AddDate(0, 0, d)
+		header := " " + day.Format("Mon Jan 2")
+		if day.Equal(today) {
+			header += " (today)"
+		}
+		style := weekDayHeaderStyle
+		if d == p.day {
+			style = selectedStyle
+			header = "▸" + header[1:]
+		}
+		right.WriteString(style.Render(header))
+		if n := len(p.days[d]); n > 0 {
+			right.WriteString(" " + weekCountStyle.Render(fmt.Sprintf("(%d)", n)))
+		}
+		right.WriteString("\n")
+		for i, t := range p.days[d] {
+			right.WriteString(planTaskLine(t, p.onDay && d == p.day && i == p.dayCursor, rightWidth))
+			right.WriteString("\n")
+		}
+	}
+
+	sb.WriteString(lipgloss.JoinHorizontal(lipgloss.Top,
+		lipgloss.NewStyle().Width(leftWidth).Render(left.String()),
+		"    ",
+		lipgloss.NewStyle().Width(rightWidth).Render(right.String()),
+	))
+	sb.WriteString("\n")
+
+	switch {
+	case p.err != nil:
+		sb.WriteString(overdueHeaderStyle.Render(fmt.Sprintf(" Error: %v", p.err)))
+	case p.message != "":
+		sb.WriteString(theme.Ok.Render(" " + p.message))
+	default:
+		sb.WriteString(emptyStyle.Render(" h/l: day  enter: schedule on day  tab: day's tasks (enter unschedules)  H/L: week  esc: done"))
+	}
+	sb.WriteString("\n")
+	return shared.CenterContent(sb.String(), p.height)
+}
+
+// planTaskLine renders a task as a row of the planning panes.
+func planTaskLine(t data.Task, selected bool, width int) string {
+	parts := []string{" "}
+	if selected {
+		parts[0] = cursorStyle.Render(">")
+	}
+	if t.Priority != data.PriorityNone {
+		parts = append(parts, shared.AgendaPriorityBadge(t.Priority))
+	}
+	if selected {
+		parts = append(parts, selectedStyle.Render(t.Name))
+	} else {
+		parts = append(parts, t.Name)
+	}
+	for _, proj := range t.Projects {
+		parts = append(parts, projectStyle.Render("+"+proj))
+	}
+	if due := t.GetDueDate(); due != "" {
+		parts = append(parts, reasonDueStyle.Render("due "+due))
+	}
+	return shared.Truncate(strings.Join(parts, " "), width)
+}
@@ -0,0 +1,81 @@
+package agenda
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/clock"
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func newTestPlan(t *testing.T, todo string, height int) (planModel, service.TaskService) {
+	t.Helper()
+	golden.FixClock(t)
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte(todo), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newPlanModel(svc, clock.Now(), 100, height), svc
+}
+
+func TestPlan_ScheduleAndUnschedule(t *testing.T) {
+	p, svc := newTestPlan(t, "(A) Write the report\nWater the plants\n", 24)
+	today := clock.Now().Format("2006-01-02")
+
+	// enter puts the selected backlog task on the selected day, today
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(p.backlog) != 1 || p.backlog[0].Name != "Water the plants" {
+		t.Fatalf("backlog = %+v", p.backlog)
+	}
+	if len(p.days[p.day]) != 1 || p.days[p.day][0].GetScheduledDate() != today {
+		t.Fatalf("day = %+v", p.days[p.day])
+	}
+	tasks, err := svc.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range tasks {
+		if task.Name == "Write the report" && task.GetScheduledDate() != today {
+			t.Errorf("scheduled = %q, want %s", task.GetScheduledDate(), today)
+		}
+	}
+
+	// tab, then enter, sends it back to the backlog
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyTab})
+	p, _ = p.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if len(p.backlog) != 2 || len(p.days[p.day]) != 0 || p.onDay {
+		t.Errorf("after unscheduling backlog = %+v, day = %+v, onDay = %v", p.backlog, p.days[p.day], p.onDay)
+	}
+	if !strings.Contains(p.message, `Unscheduled "Write the report"`) {
+		t.Errorf("message = %q", p.message)
+	}
+}
+
+func TestPlan_BacklogScrolls(t *testing.T) {
+	var todo strings.Builder
+	for i := range 30 {
+		fmt.Fprintf(&todo, "Task %02d\n", i)
+	}
+	p, _ := newTestPlan(t, todo.String(), 14)
+
+	for range 29 {
+		p, _ = p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	}
+	view := p.View()
+	if !strings.Contains(view, "Task 29") || strings.Contains(view, "Task 00") {
+		t.Errorf("expected the backlog scrolled to the cursor:\n%s", view)
+	}
+	if lines := strings.Count(view, "\n"); lines > 14 {
+		t.Errorf("view is %d lines, want at most 14:\n%s", lines, view)
+	}
+}
@@ -0,0 +1,89 @@
+package agenda
+
+import (
+	agendapkg "wydo/internal/agenda"
+)
+
+// sourceFilterCycle is the order s steps through; after the last kind the
+// filter turns off again.
+var sourceFilterCycle = []agendapkg.ItemSource{
+	agendapkg.SourceTask,
+	agendapkg.SourceCard,
+	agendapkg.SourceNote,
+	agendapkg.SourceProjectDate,
+	agendapkg.SourceBoardReview,
+}
+
+// sourceFilter limits an agenda view to one kind of item and leaves out
+// the items parked by agenda_exclude. The zero value shows every kind,
+// parked items aside.
+type sourceFilter struct {
+	active bool
+	only   agendapkg.ItemSource
+	parked bool // x: show parked items too
+}
+
+// next returns the filter for the next kind in sourceFilterCycle.
+func (f sourceFilter) next() sourceFilter {
+	if !f.active {
+		return sourceFilter{active: true, only: sourceFilterCycle[0], parked: f.parked}
+	}
+	for i, kind := range sourceFilterCycle {
+		if kind == f.only && i+1 < len(sourceFilterCycle) {
+			return sourceFilter{active: true, only: sourceFilterCycle[i+1], parked: f.parked}
+		}
+	}
+	return sourceFilter{parked: f.parked}
+}
+
+// toggleParked shows or hides the parked items again.
+func (f sourceFilter) toggleParked() sourceFilter {
+	f.parked = !f.parked
+	return f
+}
+
+func (f sourceFilter) keep(item agendapkg.AgendaItem) bool {
+	if !f.parked && agendapkg.Excluded(item) {
+		return false
+	}
+	return !f.active || item.Source == f.only
+}
+
+// passAll reports whether the filter keeps every item.
+func (f sourceFilter) passAll() bool {
+	return !f.active && (f.parked || !agendapkg.HasExclusions())
+}
+
+// buckets drops the items of other kinds, and parked ones, from buckets.
+func (f sourceFilter) buckets(buckets []agendapkg.DateBucket) []agendapkg.DateBucket {
+	if f.passAll() {
+		return buckets
+	}
+	return agendapkg.FilterBuckets(buckets, f.keep)
+}
+
+// items drops the items of other kinds, and parked ones, from items.
+func (f sourceFilter) items(items []agendapkg.AgendaItem) []agendapkg.AgendaItem {
+	if f.passAll() {
+		return items
+	}
+	var kept []agendapkg.AgendaItem
+	for _, item := range items {
+		if f.keep(item) {
+			kept = append(kept, item)
+		}
+	}
+	return kept
+}
+
+// label is shown after the view title while the filter is on.
+func (f sourceFilter) label() string {
+	var label string
+	if f.active {
+		label += "  " + searchLabelStyle.Render("only "+f.only.String()+"s")
+	}
+	if f.parked && agendapkg.HasExclusions() {
+		label += "  " + searchLabelStyle.Render("parked shown")
+	}
+	return label
+}
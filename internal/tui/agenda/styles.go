@@ -2,6 +2,7 @@ package agenda
 
 import (
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/stats"
 	"wydo/internal/tui/theme"
 )
 
@@ -22,11 +23,14 @@ var (
 	projectStyle       = theme.Project
 	boardInfoStyle     = lipgloss.NewStyle().Foreground(theme.Accent)
 	notePathStyle      = theme.Muted
-	selectedStyle      = lipgloss.NewStyle().Bold(true).Foreground(theme.TextBright).Background(theme.Primary)
-	cursorStyle        = lipgloss.NewStyle().Foreground(theme.Primary).Bold(true)
+	noteTagStyle       = theme.Tag
+	selectedStyle      = theme.Selected
+	cursorStyle        = theme.Cursor
 	normalStyle        = lipgloss.NewStyle()
 	completedStyle     = lipgloss.NewStyle().Foreground(theme.TextMuted).Strikethrough(true)
 	completedTagStyle  = theme.Muted
+	blockedHeaderStyle = theme.Warn
+	blockedBadgeStyle  = lipgloss.NewStyle().Foreground(theme.Danger).Italic(true)
 )
 
 // -- week.go styles --
@@ -41,9 +45,18 @@ var (
 	calDayHeaderStyle  = lipgloss.NewStyle().Bold(true).Foreground(theme.TextMuted).Width(5).Align(lipgloss.Center)
 	calDayStyle        = lipgloss.NewStyle().Width(5).Align(lipgloss.Center)
 	calTodayStyle      = lipgloss.NewStyle().Width(5).Align(lipgloss.Center).Bold(true).Foreground(theme.Success)
-	calCursorStyle     = lipgloss.NewStyle().Width(5).Align(lipgloss.Center).Bold(true).Foreground(theme.TextBright).Background(theme.Primary)
+	calCursorStyle     = lipgloss.NewStyle().Width(5).Align(lipgloss.Center).Bold(true).Foreground(theme.SelectionFg).Background(theme.SelectionBg)
 	calHasItemsStyle   = lipgloss.NewStyle().Width(5).Align(lipgloss.Center).Foreground(theme.Warning)
 	calEmptyStyle      = lipgloss.NewStyle().Width(5).Align(lipgloss.Center).Foreground(theme.TextMuted)
 	calMonthTitleStyle = theme.Title
 	detailHeaderStyle  = theme.Subtitle
 )
+
+// -- heatmap.go styles --
+var heatmapLevelStyles = [stats.HeatmapLevels]lipgloss.Style{
+	lipgloss.NewStyle().Foreground(theme.TextMuted),
+	lipgloss.NewStyle().Foreground(theme.Success).Faint(true),
+	lipgloss.NewStyle().Foreground(theme.Success),
+	lipgloss.NewStyle().Foreground(theme.Success),
+	lipgloss.NewStyle().Foreground(theme.Success).Bold(true),
+}
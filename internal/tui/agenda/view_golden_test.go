@@ -0,0 +1,57 @@
+package agenda
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/golden"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestAgendaViews_Golden(t *testing.T) {
+	golden.FixClock(t)
+	dir := t.TempDir()
+	todo := strings.Join([]string{
+		"(A) Call the landlord +home due:2026-03-04",
+		"Write the migration guide +alpha due:2026-03-06",
+		"(B) Renew certificates +ops due:2026-03-02",
+		"Book flights scheduled:2026-03-04",
+		"x 2026-03-04 Fix CI +ops due:2026-03-04",
+	}, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte(todo), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	due := time.Date(2026, time.March, 5, 0, 0, 0, 0, time.Local)
+	boards := []kanbanmodels.Board{{Name: "Platform", Path: "/ws/boards/platform", Columns: []kanbanmodels.Column{
+		{Name: "To Do", Cards: []kanbanmodels.Card{{Filename: "deploy.md", Title: "Deploy API", DueDate: &due}}},
+	}}}
+
+	day := NewDayModel(svc, boards, nil, nil)
+	day.SetSize(80, 24)
+	golden.Assert(t, "day_80x24", day.View())
+
+	day.SetMyDay([]string{"task:Call the landlord", agendapkg.CardMyDayKey("/ws/boards/platform", "deploy.md"), "task:Fix CI"})
+	if !strings.Contains(day.View(), "☀   (A) Call the landlord") {
+		t.Errorf("expected picked items marked in the full agenda:\n%s", day.View())
+	}
+	day, _ = day.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	golden.Assert(t, "my_day_80x24", day.View())
+
+	week := NewWeekModel(svc, boards, nil, nil)
+	week.SetSize(100, 30)
+	golden.Assert(t, "week_100x30", week.View())
+
+	week, _ = week.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("z")})
+	golden.Assert(t, "week_collapsed_100x30", week.View())
+}
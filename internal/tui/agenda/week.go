@@ -9,6 +9,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/sahilm/fuzzy"
 	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/notes"
 	"wydo/internal/tasks/service"
@@ -19,6 +20,7 @@ import (
 // WeekModel is the week agenda view
 type WeekModel struct {
 	date            time.Time // any date in the week being viewed
+	refreshedOn     time.Time // today as of the last SetData, see followsToday
 	buckets         []agendapkg.DateBucket
 	overdueItems    []agendapkg.AgendaItem
 	unfilteredItems []agendapkg.AgendaItem // all flattened items before filtering
@@ -30,6 +32,14 @@ type WeekModel struct {
 	cursor          int
 	width           int
 	height          int
+	pendingKeys     string // "g" or "gd" while a gd<day> jump is being typed
+
+	peek    *shared.PeekModel // quick-look popup for the selected item
+	actions *actionsModel     // a: actions menu for the selected item
+	sources sourceFilter      // s cycles which kind of item is shown, x shows parked items
+	plan    *planModel        // weekly planning mode, opened with w
+
+	collapseEmpty bool // z hides the days with nothing scheduled
 
 	// Search state
 	searchActive     bool
@@ -46,7 +56,8 @@ func NewWeekModel(taskSvc service.TaskService, boards []kanbanmodels.Board, allN
 	si.Width = 40
 
 	m := WeekModel{
-		date:         time.Now(),
+		date:         clock.Now(),
+		refreshedOn:  clock.Now(),
 		taskSvc:      taskSvc,
 		boards:       boards,
 		notes:        allNotes,
@@ -59,8 +70,8 @@ func NewWeekModel(taskSvc service.TaskService, boards []kanbanmodels.Board, allN
 
 func (m *WeekModel) refreshData() {
 	dateRange := agendapkg.WeekRange(m.date)
-	m.buckets = agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange)
-	m.overdueItems = agendapkg.QueryOverdueItems(m.taskSvc, m.boards, dateRange.Start)
+	m.buckets = m.sources.buckets(agendapkg.QueryAgenda(m.taskSvc, m.boards, m.notes, m.projectDates, dateRange))
+	m.overdueItems = m.sources.items(agendapkg.QueryOverdueItems(m.taskSvc, m.boards, dateRange.Start))
 
 	// Build a map of date -> bucket for fast lookup
 	bucketMap := make(map[string]*agendapkg.DateBucket)
@@ -70,7 +81,8 @@ func (m *WeekModel) refreshData() {
 		bucketMap[key] = &m.buckets[i]
 	}
 
-	// Build flattened item list: overdue first, then day offsets for the 7 days
+	// Build flattened item list: overdue first, then day offsets for the 7 days.
+	// Only the items View renders, so cursor indices line up with the rows.
 	m.unfilteredItems = nil
 	m.unfilteredItems = append(m.unfilteredItems, m.overdueItems...)
 
@@ -79,14 +91,22 @@ func (m *WeekModel) refreshData() {
 		day := start.AddDate(0, 0, d)
 		key := day.Format("2006-01-02")
 		if bucket, ok := bucketMap[key]; ok {
-			m.unfilteredItems = append(m.unfilteredItems, bucket.AllItems()...)
-			m.unfilteredItems = append(m.unfilteredItems, bucket.AllCompletedItems()...)
+			m.unfilteredItems = append(m.unfilteredItems, weekDayItems(*bucket)...)
 		}
 	}
 
 	m.applySearchFilter()
 }
 
+// weekDayItems returns the items of a day in the order the week view lists
+// them: the open ones by priority, then tasks before cards, notes and
+// project dates, followed by the completed ones.
+func weekDayItems(bucket agendapkg.DateBucket) []agendapkg.AgendaItem {
+	items := bucket.AllItems()
+	agendapkg.SortByPriority(items)
+	return append(items, bucket.AllCompletedItems()...)
+}
+
 func (m *WeekModel) applySearchFilter() {
 	if m.searchQuery == "" {
 		m.allItems = m.unfilteredItems
@@ -113,6 +133,100 @@ func (m WeekModel) IsSearching() bool {
 	return m.searchActive
 }
 
+// IsPeeking returns true while the quick-look popup is open
+func (m WeekModel) IsPeeking() bool {
+	return m.peek != nil
+}
+
+// ShowingActions reports whether the actions menu is open
+func (m WeekModel) ShowingActions() bool {
+	return m.actions != nil
+}
+
+// IsPlanning returns true while the weekly planning mode is open
+func (m WeekModel) IsPlanning() bool {
+	return m.plan != nil
+}
+
+// HasPendingKeys returns true while a gd<day> jump is half typed, so the
+// app leaves the digit keys to the week view.
+func (m WeekModel) HasPendingKeys() bool {
+	return m.pendingKeys != ""
+}
+
+// weekdayKeys maps the key after gd, or a digit on its own, to a day offset
+// from Monday.
+var weekdayKeys = map[string]int{
+	"1": 0, "2": 1, "3": 2, "4": 3, "5": 4, "6": 5, "7": 6,
+	"m": 0, "t": 1, "w": 2, "r": 3, "f": 4, "s": 5, "u": 6,
+}
+
+func (m WeekModel) handlePendingKeys(msg tea.KeyMsg) WeekModel {
+	pending := m.pendingKeys + msg.String()
+	m.pendingKeys = ""
+	switch {
+	case pending == "gd":
+		m.pendingKeys = pending
+	case strings.HasPrefix(pending, "gd"):
+		if offset, ok := weekdayKeys[strings.TrimPrefix(pending, "gd")]; ok {
+			m.jumpToWeekday(offset)
+		}
+	}
+	return m
+}
+
+// jumpToWeekday moves the cursor to the first item of the day offset days
+// after Monday. Days with no items leave the cursor where it is.
+func (m *WeekModel) jumpToWeekday(offset int) {
+	key := agendapkg.WeekRange(m.date).Start.AddDate(0, 0, offset).Format("2006-01-02")
+	for i := range m.allItems {
+		if m.daySection(i) == key {
+			m.cursor = i
+			return
+		}
+	}
+}
+
+// daySection returns the day heading item i is listed under: its date, or
+// "overdue" for items before the week.
+func (m WeekModel) daySection(i int) string {
+	date := m.allItems[i].Date
+	if date.Before(agendapkg.WeekRange(m.date).Start) {
+		return "overdue"
+	}
+	return date.Format("2006-01-02")
+}
+
+// nextDayStart returns the index of the first item under the next day heading.
+func (m WeekModel) nextDayStart() int {
+	if m.cursor >= len(m.allItems) {
+		return m.cursor
+	}
+	current := m.daySection(m.cursor)
+	for i := m.cursor + 1; i < len(m.allItems); i++ {
+		if m.daySection(i) != current {
+			return i
+		}
+	}
+	return m.cursor
+}
+
+// prevDayStart returns the index of the first item under the current day
+// heading, or under the previous one when the cursor is already there.
+func (m WeekModel) prevDayStart() int {
+	if m.cursor <= 0 || m.cursor >= len(m.allItems) {
+		return m.cursor
+	}
+	i := m.cursor
+	if m.daySection(i-1) != m.daySection(i) {
+		i--
+	}
+	for i > 0 && m.daySection(i-1) == m.daySection(i) {
+		i--
+	}
+	return i
+}
+
 // HintText returns hint text for the current state
 func (m WeekModel) HintText() string {
 	if m.searchActive {
@@ -128,27 +242,91 @@ func (m WeekModel) HintText() string {
 func (m *WeekModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
+	if m.peek != nil {
+		m.peek.SetSize(width, height)
+	}
+	if m.actions != nil {
+		m.actions.SetSize(width, height)
+	}
+	if m.plan != nil {
+		m.plan.width, m.plan.height = width, height
+	}
 }
 
 // SetData updates the data sources and refreshes
 func (m *WeekModel) SetData(taskSvc service.TaskService, boards []kanbanmodels.Board, allNotes []notes.Note, projectDates []agendapkg.ProjectDateSource) {
-	m.date = time.Now()
+	now := clock.Now()
+	if followsToday(m.date, m.refreshedOn) {
+		m.date = now
+	}
+	m.refreshedOn = now
 	m.taskSvc = taskSvc
 	m.boards = boards
 	m.notes = allNotes
 	m.projectDates = projectDates
 	m.refreshData()
+	if m.plan != nil {
+		m.plan.svc = taskSvc
+		m.plan.reload()
+	}
+}
+
+// SetCollapseEmptyDays sets whether days with nothing scheduled are hidden
+// (week_collapse_empty_days); z toggles it.
+func (m *WeekModel) SetCollapseEmptyDays(collapse bool) {
+	m.collapseEmpty = collapse
+}
+
+// SetDate moves the view to the week containing the given date
+func (m *WeekModel) SetDate(date time.Time) {
+	m.date = date
+	m.cursor = 0
+	m.refreshData()
+}
+
+// Date returns a date in the week being viewed
+func (m WeekModel) Date() time.Time {
+	return m.date
 }
 
 // Update handles key events for the week view
 func (m WeekModel) Update(msg tea.Msg) (WeekModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.plan != nil {
+			plan, done := m.plan.Update(msg)
+			if !done {
+				m.plan = &plan
+				return m, nil
+			}
+			// Back to the week view, showing the week just planned
+			m.plan = nil
+			m.date = plan.week
+			m.refreshData()
+			return m, nil
+		}
+		if m.peek != nil {
+			return m.handlePeek(msg)
+		}
+		if m.actions != nil {
+			return m.handleActions(msg)
+		}
 		if m.searchActive {
 			return m.handleSearchMode(msg)
 		}
+		if m.pendingKeys != "" {
+			return m.handlePendingKeys(msg), nil
+		}
 
 		switch msg.String() {
+		case "g":
+			m.pendingKeys = "g"
+		case "1", "2", "3", "4", "5", "6", "7":
+			m.jumpToWeekday(weekdayKeys[msg.String()])
+		case "J":
+			m.cursor = m.nextDayStart()
+		case "K":
+			m.cursor = m.prevDayStart()
 		case "/":
 			m.searchActive = true
 			m.searchFilterMode = true
@@ -161,7 +339,13 @@ func (m WeekModel) Update(msg tea.Msg) (WeekModel, tea.Cmd) {
 			m.date = m.date.AddDate(0, 0, 7)
 			m.refreshData()
 		case "t":
-			m.date = time.Now()
+			m.date = clock.Now()
+			m.refreshData()
+		case "s":
+			m.sources = m.sources.next()
+			m.refreshData()
+		case "x":
+			m.sources = m.sources.toggleParked()
 			m.refreshData()
 		case "j", "down":
 			if m.cursor < len(m.allItems)-1 {
@@ -173,35 +357,87 @@ func (m WeekModel) Update(msg tea.Msg) (WeekModel, tea.Cmd) {
 			}
 		case "enter":
 			return m.openSelectedItem()
+		case "p":
+			m.openPeek()
+		case "a":
+			if m.cursor < len(m.allItems) {
+				actions := newActionsModel(m.allItems[m.cursor], m.taskSvc, m.boards, m.width, m.height)
+				m.actions = &actions
+			}
+		case "z":
+			m.collapseEmpty = !m.collapseEmpty
+		case "w":
+			plan := newPlanModel(m.taskSvc, m.date, m.width, m.height)
+			m.plan = &plan
+		case "n":
+			return m, m.openDayNote()
+		case ">", "<", "}", "{":
+			if m.cursor < len(m.allItems) {
+				days, _ := shared.DueBumpDays(msg.String())
+				return m, bumpDueDate(m.taskSvc, m.allItems[m.cursor], days)
+			}
 		}
 	}
 
 	return m, nil
 }
 
+// openPeek opens the quick-look popup for the selected item.
+func (m *WeekModel) openPeek() {
+	if m.cursor < len(m.allItems) {
+		peek := peekItem(m.allItems[m.cursor])
+		peek.SetSize(m.width, m.height)
+		m.peek = &peek
+	}
+}
+
+// handleActions passes keys to the actions menu; choosing Peek there opens
+// the quick-look popup once the menu closes.
+func (m WeekModel) handleActions(msg tea.KeyMsg) (WeekModel, tea.Cmd) {
+	actions, cmd, done := m.actions.Update(msg)
+	if !done {
+		m.actions = &actions
+		return m, cmd
+	}
+	m.actions = nil
+	if actions.peek {
+		m.openPeek()
+	}
+	return m, cmd
+}
+
+// handlePeek closes the quick-look popup on any key; enter also opens the item.
+func (m WeekModel) handlePeek(msg tea.KeyMsg) (WeekModel, tea.Cmd) {
+	m.peek = nil
+	if msg.String() == "enter" {
+		return m.openSelectedItem()
+	}
+	return m, nil
+}
+
 func (m WeekModel) openSelectedItem() (WeekModel, tea.Cmd) {
 	if m.cursor < len(m.allItems) {
-		item := m.allItems[m.cursor]
-		switch item.Source {
-		case agendapkg.SourceTask:
-			if item.Task != nil {
-				return m, func() tea.Msg {
-					return messages.FocusTaskMsg{TaskID: item.Task.ID}
-				}
-			}
-		case agendapkg.SourceCard:
-			return m, func() tea.Msg {
-				return messages.OpenBoardMsg{
-					BoardPath: item.BoardPath,
-					ColIndex:  item.ColIndex,
-					CardIndex: item.CardIndex,
-				}
-			}
-		}
+		return m, openItem(m.allItems[m.cursor])
 	}
 	return m, nil
 }
 
+// selectedDay returns the day of the selected item, or the week's date when
+// nothing in the week is selected (an overdue item, or an empty week).
+func (m WeekModel) selectedDay() time.Time {
+	if m.cursor < len(m.allItems) && m.daySection(m.cursor) != "overdue" {
+		return m.allItems[m.cursor].Date
+	}
+	return m.date
+}
+
+// openDayNote asks the app to open the selected day's journal note,
+// creating it first if needed.
+func (m WeekModel) openDayNote() tea.Cmd {
+	day := m.selectedDay()
+	return func() tea.Msg { return messages.OpenJournalMsg{Day: day} }
+}
+
 func (m WeekModel) handleSearchMode(msg tea.KeyMsg) (WeekModel, tea.Cmd) {
 	if m.searchFilterMode {
 		switch msg.String() {
@@ -233,6 +469,9 @@ func (m WeekModel) handleSearchMode(msg tea.KeyMsg) (WeekModel, tea.Cmd) {
 		return m, m.searchInput.Focus()
 	case "enter":
 		return m.openSelectedItem()
+	case "p":
+		m.openPeek()
+		return m, nil
 	case "esc":
 		m.searchInput.SetValue("")
 		m.searchQuery = ""
@@ -257,6 +496,16 @@ func (m WeekModel) handleSearchMode(msg tea.KeyMsg) (WeekModel, tea.Cmd) {
 
 // View renders the week agenda view
 func (m WeekModel) View() string {
+	if m.plan != nil {
+		return m.plan.View()
+	}
+	if m.peek != nil {
+		return m.peek.View()
+	}
+	if m.actions != nil {
+		return m.actions.View()
+	}
+
 	var sb strings.Builder
 
 	weekRange := agendapkg.WeekRange(m.date)
@@ -266,7 +515,7 @@ func (m WeekModel) View() string {
 	// Title line
 	titleStr := fmt.Sprintf(" Week: %s - %s", start.Format("Jan 2"), end.Format("Jan 2 2006"))
 	title := titleStyle.Render(titleStr)
-	sb.WriteString(title)
+	sb.WriteString(title + m.sources.label())
 	sb.WriteString("\n")
 
 	if m.searchActive {
@@ -279,23 +528,21 @@ func (m WeekModel) View() string {
 	}
 	sb.WriteString("\n")
 
-	if len(m.allItems) == 0 {
-		if m.searchQuery != "" {
+	if m.searchQuery != "" {
+		if len(m.allItems) == 0 {
 			sb.WriteString(emptyStyle.Render("  No matching items."))
+			sb.WriteString("\n")
 		} else {
-			sb.WriteString(emptyStyle.Render("  No items scheduled for this week."))
-		}
-		sb.WriteString("\n")
-	} else if m.searchQuery != "" {
-		// Filtered flat list — render items directly from m.allItems
-		sb.WriteString(sectionStyle.Render(fmt.Sprintf(" Results (%d)", len(m.allItems))))
-		sb.WriteString("\n")
-		for i, item := range m.allItems {
-			selected := i == m.cursor
-			line := RenderItemLine(item, selected, m.width-6)
-			sb.WriteString("     ")
-			sb.WriteString(line)
+			// Filtered flat list — render items directly from m.allItems
+			sb.WriteString(sectionStyle.Render(fmt.Sprintf(" Results (%d)", len(m.allItems))))
 			sb.WriteString("\n")
+			for i, item := range m.allItems {
+				selected := i == m.cursor
+				line := RenderItemLine(item, selected, m.width-6)
+				sb.WriteString("     ")
+				sb.WriteString(line)
+				sb.WriteString("\n")
+			}
 		}
 	} else {
 		// Build a map of date -> bucket for rendering
@@ -306,7 +553,7 @@ func (m WeekModel) View() string {
 			bucketMap[key] = &b
 		}
 
-		today := time.Now()
+		today := clock.Now()
 		globalIdx := 0
 
 		// Overdue section
@@ -324,16 +571,28 @@ func (m WeekModel) View() string {
 			sb.WriteString("\n")
 		}
 
+		shown := 0
 		for d := 0; d < 7; d++ {
 			day := start.AddDate(0, 0, d)
 			key := day.Format("2006-01-02")
 			isToday := day.Year() == today.Year() && day.Month() == today.Month() && day.Day() == today.Day()
 
 			bucket := bucketMap[key]
+			var items []agendapkg.AgendaItem
 			count := 0
 			if bucket != nil {
+				items = weekDayItems(*bucket)
 				count = bucket.TotalCount()
 			}
+			if len(items) == 0 && m.collapseEmpty {
+				continue
+			}
+
+			// Add spacing between days
+			if shown > 0 {
+				sb.WriteString("\n")
+			}
+			shown++
 
 			// Day header
 			dayName := day.Format("Mon Jan 2")
@@ -349,24 +608,25 @@ func (m WeekModel) View() string {
 			}
 			sb.WriteString("\n")
 
-			// Render items for this day
-			if bucket != nil {
-				items := bucket.AllItems()
-				for _, item := range items {
-					selected := globalIdx == m.cursor
-					line := RenderItemLine(item, selected, m.width-6)
-					sb.WriteString("     ")
-					sb.WriteString(line)
-					sb.WriteString("\n")
-					globalIdx++
-				}
+			// Render items for this day, or a placeholder so the day
+			// still shows when planning
+			if len(items) == 0 {
+				sb.WriteString(emptyStyle.Render("     (nothing scheduled)"))
+				sb.WriteString("\n")
 			}
-
-			// Add spacing between days unless it's the last
-			if d < 6 {
+			for _, item := range items {
+				selected := globalIdx == m.cursor
+				line := RenderItemLine(item, selected, m.width-6)
+				sb.WriteString("     ")
+				sb.WriteString(line)
 				sb.WriteString("\n")
+				globalIdx++
 			}
 		}
+		if shown == 0 && len(m.overdueItems) == 0 {
+			sb.WriteString(emptyStyle.Render("  No items scheduled for this week. z shows the empty days."))
+			sb.WriteString("\n")
+		}
 	}
 
 	return shared.CenterContent(sb.String(), m.height)
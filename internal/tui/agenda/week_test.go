@@ -0,0 +1,61 @@
+package agenda
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestWeek_DayKeys(t *testing.T) {
+	golden.FixClock(t)
+	dir := t.TempDir()
+	todo := "Renew certificates due:2026-03-02\nx 2026-03-04 Fix CI due:2026-03-04\nCall the landlord due:2026-03-04\nWrite the guide due:2026-03-06\n"
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte(todo), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	week := NewWeekModel(svc, nil, nil, nil)
+	week.SetSize(100, 30)
+
+	press := func(key string) {
+		t.Helper()
+		week, _ = week.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	}
+	selected := func() string {
+		t.Helper()
+		if week.cursor >= len(week.allItems) {
+			t.Fatalf("cursor %d past %d items", week.cursor, len(week.allItems))
+		}
+		return week.allItems[week.cursor].Title()
+	}
+
+	// Digits jump straight to a weekday, 1 = Monday
+	press("3")
+	if got := selected(); got != "Call the landlord" {
+		t.Errorf("3 selected %q, want Wednesday's first item", got)
+	}
+	press("5")
+	if got := selected(); got != "Write the guide" {
+		t.Errorf("5 selected %q, want Friday's first item", got)
+	}
+	// A day with nothing on it leaves the cursor where it is
+	press("7")
+	if got := selected(); got != "Write the guide" {
+		t.Errorf("7 selected %q, want the cursor kept", got)
+	}
+
+	// Completed items are listed, and selectable, after the day's open ones
+	press("3")
+	press("j")
+	if got := selected(); got != "Fix CI" || !week.allItems[week.cursor].Completed {
+		t.Errorf("j from Wednesday's first item selected %q", got)
+	}
+}
@@ -1,13 +1,15 @@
 package tui
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"sort"
 	"strings"
-
 	"time"
+
 	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
 	"wydo/internal/config"
 	"wydo/internal/kanban/fs"
 	kanbanmodels "wydo/internal/kanban/models"
@@ -15,25 +17,41 @@ import (
 	"wydo/internal/logs"
 	"wydo/internal/notes"
 	"wydo/internal/scanner"
+	"wydo/internal/state"
+	"wydo/internal/stats"
+	"wydo/internal/tasks/filter"
 	"wydo/internal/tasks/service"
 	agendaview "wydo/internal/tui/agenda"
 	kanbanview "wydo/internal/tui/kanban"
 	notesview "wydo/internal/tui/notes"
+	goalsview "wydo/internal/tui/goals"
 	projectsview "wydo/internal/tui/projects"
 	"wydo/internal/tui/shared"
 	taskview "wydo/internal/tui/tasks"
 	"wydo/internal/tui/theme"
+	"wydo/internal/watch"
 	"wydo/internal/workspace"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
 )
 
 const maxContentWidth = 120
 
+// minWidth is the narrowest terminal the tab bar fits in, and minHeight the
+// fewest lines of content any view is laid out in; chromeHeight is the tab
+// and hint bars around it.
+const (
+	minWidth     = 70
+	minHeight    = 10
+	chromeHeight = 4
+)
+
 // AppModel is the root model that dispatches to child views
 type AppModel struct {
 	cfg         *config.Config
+	state       *state.State // recent boards and search history, persisted across runs
 	workspaces  []*workspace.Workspace
 	taskSvc     service.TaskService // combined task service across all workspaces
 	boards      []kanbanmodels.Board
@@ -43,6 +61,8 @@ type AppModel struct {
 	dayView        agendaview.DayModel
 	weekView    agendaview.WeekModel
 	monthView   agendaview.MonthModel
+	heatmapView agendaview.HeatmapModel
+	commandLine agendaview.CommandLineModel // ':' command line shared by the agenda views
 	pickerView  kanbanview.PickerModel
 	boardView   kanbanview.BoardModel
 	boardLoaded bool // true when boardView has a valid board
@@ -51,15 +71,34 @@ type AppModel struct {
 	projectDetailView   projectsview.DetailModel
 	projectDetailLoaded bool
 	notesView           notesview.NotesModel
+	goalsView           goalsview.GoalsModel
+	tour           tourModel // onboarding tour overlay, active on first run
+	startupSummary stats.Health // load summary shown on the hint bar until the first key press
+	dueSoon        stats.DueSoon // due today / overdue counter kept on the hint bar
+	cardRegister   *CardRegister // card taken with y on a board, kept across board views
+	boardStates    kanbanview.ViewStates // where the user was on each board visited this session
+	watchingWrites bool          // writeQueueTick is running while writes are queued
+	watcher        *watch.Watcher // reloads on outside changes to the files; nil with watch_files off
+	reloadPending  bool           // a reload for changed files is waiting for a prompt to close
+	rolloverAt     time.Time     // time of the last rollover check, to spot a new day or a wake from sleep
+	showSummary    bool
 	showHelp       bool
+	showLog        bool // ctrl+g log overlay
+	clipboard      string // OSC 52 sequence written with the next frames, see handleClipboard
 	exitConfirming bool
 	width          int
 	height         int
 	ready          bool
 }
 
-// NewAppModel creates the root application model
-func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace) AppModel {
+// NewAppModel creates the root application model. scanErrs are the
+// workspaces that failed to load, reported in the startup summary.
+func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace, scanErrs []workspace.ScanError) AppModel {
+	shared.SetHyperlinks(cfg.Hyperlinks)
+	if err := shared.SetPriorityColors(cfg.PriorityColors); err != nil {
+		logs.Logger.Printf("Config: %v", err)
+	}
+
 	// Aggregate boards and notes from all workspaces for display
 	var allBoards []kanbanmodels.Board
 	var allNotes []notes.Note
@@ -80,19 +119,11 @@ func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace) AppModel
 			logs.Logger.Printf("Warning: could not create task service: %v", err)
 		}
 	}
+	useTaskRefs(workspaces, taskSvc)
 
-	view := ViewAgendaDay
-	switch cfg.DefaultView {
-	case "week":
-		view = ViewAgendaWeek
-	case "month":
-		view = ViewAgendaMonth
-	case "tasks":
-		view = ViewTaskManager
-	case "boards":
-		view = ViewKanbanPicker
-	case "projects":
-		view = ViewProjects
+	view, ok := viewFromName(cfg.DefaultView)
+	if !ok || view == ViewKanbanBoard {
+		view = ViewAgendaDay
 	}
 
 	// Compute available boards/ directories for the picker.
@@ -114,10 +145,20 @@ func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace) AppModel
 		defaultDir = availableDirs[0]
 	}
 
-	projDates := collectProjectDates(workspaces)
+	projDates := agendapkg.CollectProjectDates(workspaces)
+
+	st, err := state.Load()
+	if err != nil {
+		logs.Logger.Printf("Error loading state: %v", err)
+	}
 
 	app := AppModel{
 		cfg:             cfg,
+		state:           st,
+		startupSummary:  stats.CollectHealth(workspaces, taskSvc, scanErrs, clock.Now()),
+		dueSoon:         stats.CollectDueSoon(taskSvc, allBoards, clock.Now()),
+		rolloverAt:      time.Now(),
+		showSummary:     true,
 		workspaces:      workspaces,
 		taskSvc:         taskSvc,
 		boards:          allBoards,
@@ -127,10 +168,24 @@ func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace) AppModel
 		dayView:         agendaview.NewDayModel(taskSvc, allBoards, allNotes, projDates),
 		weekView:        agendaview.NewWeekModel(taskSvc, allBoards, allNotes, projDates),
 		monthView:       agendaview.NewMonthModel(taskSvc, allBoards, allNotes, projDates),
+		heatmapView:     agendaview.NewHeatmapModel(taskSvc, allBoards),
+		commandLine:     agendaview.NewCommandLineModel(taskSvc, allBoards),
 		pickerView:      kanbanview.NewPickerModel(allBoards, defaultDir, availableDirs),
 		taskManagerView: taskview.NewTaskManagerModel(taskSvc, cfg.Workspaces, allBoards, collectAllProjects(workspaces)),
 		projectsView:    projectsview.NewProjectsModel(workspaces),
 		notesView:       notesview.NewNotesModel(workspaces),
+		goalsView:       goalsview.NewGoalsModel(workspaces),
+		boardStates:     make(kanbanview.ViewStates),
+	}
+	app.taskManagerView.SetSearchHistory(st.SearchHistory)
+	app.weekView.SetCollapseEmptyDays(cfg.WeekCollapseEmptyDays)
+	app.setMyDay()
+	if dir, err := config.ProjectTemplatesDir(); err == nil {
+		app.projectsView.SetTemplatesDir(dir)
+	}
+
+	if cfg.ShowTour || (cfg.FirstRun && !st.TourCompleted) {
+		app.tour = newTourModel(cfg.GetFirstWorkspace())
 	}
 
 	// If a specific board was requested, find and open it directly
@@ -139,24 +194,143 @@ func NewAppModel(cfg *config.Config, workspaces []*workspace.Workspace) AppModel
 			loaded, err := fs.ReadBoard(board.Path)
 			if err == nil {
 				app.boardView = kanbanview.NewBoardModel(loaded, collectAllProjects(workspaces), allBoards, projectsForBoard(workspaces, board.Path))
+				app.boardView.SetFilterBodies(cfg.FilterCardBodies)
+				app.boardView.SetHighlightMatches(cfg.HighlightFilterMatches)
+				app.boardView.SetDefaultTags(defaultTagsForBoard(workspaces, board.Path))
+				app.boardView.SetBoardInfo(boardInfo(workspaces))
+				app.boardView.SetViewStates(app.boardStates)
+				app.recordRecentBoard(board.Path)
+				if cfg.DefaultCard != "" {
+					if col, card, ok := findCard(loaded, cfg.DefaultCard); ok {
+						app.boardView.NavigateTo(col, card)
+					} else {
+						// No card has that title: list the ones that match it
+						app.boardView.SetFilter(cfg.DefaultCard)
+					}
+				}
+				if cfg.DefaultFilter != "" {
+					app.boardView.SetFilter(cfg.DefaultFilter)
+				}
 				app.boardLoaded = true
 				app.currentView = ViewKanbanBoard
 			}
 		}
+	} else if cfg.DefaultFilter != "" && app.currentView == ViewTaskManager {
+		if f, err := filter.Parse(cfg.DefaultFilter, clock.Now()); err == nil {
+			app.taskManagerView.SetFilter(f)
+		} else {
+			logs.Logger.Printf("Error parsing --filter: %v", err)
+		}
+	}
+
+	if cfg.RestoreSession && !cfg.ExplicitView && st.Session != nil {
+		app.restoreSession(*st.Session)
+	}
+
+	if cfg.WatchFiles {
+		if w, err := watch.New(watch.DefaultQuiet); err == nil {
+			w.Watch(watch.Dirs(workspaces))
+			app.watcher = w
+		} else {
+			logs.Logger.Printf("Error watching files: %v", err)
+		}
 	}
 
 	return app
 }
 
+// recordRecentBoard notes boardPath as the most recently opened board and
+// hands the updated list to the board view's switcher. The board view is
+// told which cards it held at the last visit, to badge the columns that got
+// new ones, and when that was, for its activity feed; this visit is recorded
+// in turn. A due review of the board is marked done.
+func (m *AppModel) recordRecentBoard(boardPath string) {
+	m.state.AddRecentBoard(boardPath)
+	if m.boardView.BoardPath() == boardPath {
+		var seen map[string][]string
+		var lastVisit time.Time
+		if visit, ok := m.state.BoardVisits[boardPath]; ok {
+			seen = visit.Cards
+			lastVisit = visit.At
+		}
+		m.boardView.SetSeenCards(seen)
+		m.boardView.SetLastVisit(lastVisit)
+		m.state.RecordBoardVisit(boardPath, m.boardView.CardsByColumn(), clock.Now())
+		m.markBoardReviewed(boardPath)
+	}
+	if err := m.state.Save(); err != nil {
+		logs.Logger.Printf("Error saving state: %v", err)
+	}
+	m.boardView.SetRecentBoards(m.state.RecentBoards)
+}
+
+// markBoardReviewed marks the review of the open board done when one is due,
+// and updates the boards of the agenda views so its "Review board" item goes.
+func (m *AppModel) markBoardReviewed(boardPath string) {
+	now := clock.Now()
+	if !m.boardView.MarkReviewed(now) {
+		return
+	}
+	for i := range m.boards {
+		if m.boards[i].Path == boardPath {
+			m.boards[i].LastReviewed = &now
+		}
+	}
+	projDates := agendapkg.CollectProjectDates(m.workspaces)
+	m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
+	m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
+	m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
+}
+
+// recordBoardVisit records the cards of the loaded board as seen when it is
+// left, so that the cards made or moved during this visit are not counted as
+// new on the next one.
+func (m *AppModel) recordBoardVisit() {
+	if !m.boardLoaded {
+		return
+	}
+	m.state.RecordBoardVisit(m.boardView.BoardPath(), m.boardView.CardsByColumn(), clock.Now())
+	if err := m.state.Save(); err != nil {
+		logs.Logger.Printf("Error saving state: %v", err)
+	}
+}
+
 func (m AppModel) Init() tea.Cmd {
 	if m.boardLoaded {
-		return m.boardView.Init()
+		return tea.Batch(m.boardView.Init(), rolloverTick(), waitForChanges(m.watcher))
 	}
-	return nil
+	return tea.Batch(rolloverTick(), waitForChanges(m.watcher))
 }
 
 func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(writeQueueTickMsg); ok {
+		return m.watchWriteQueue(nil, true)
+	}
+	model, cmd := m.update(msg)
+	return model.(AppModel).watchWriteQueue(cmd, false)
+}
+
+func (m AppModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if kanbanview.IsBoardMsg(msg) {
+		// A board command finished; the board may no longer be on screen
+		var cmd tea.Cmd
+		m.boardView, cmd = m.boardView.Update(msg)
+		return m, cmd
+	}
+
 	switch msg := msg.(type) {
+	case rolloverTickMsg:
+		return m.checkRollover(msg)
+
+	case shared.ClipboardMsg:
+		return m.handleClipboard(msg)
+
+	case clipboardSentMsg:
+		if m.clipboard == msg.seq {
+			m.clipboard = ""
+		}
+		return m, nil
+
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
@@ -166,6 +340,7 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.dayView.SetSize(contentWidth, contentHeight)
 		m.weekView.SetSize(contentWidth, contentHeight)
 		m.monthView.SetSize(contentWidth, contentHeight)
+		m.heatmapView.SetSize(contentWidth, contentHeight)
 		m.pickerView.SetSize(msg.Width, contentHeight)
 		if m.boardLoaded {
 			m.boardView.SetSize(msg.Width, contentHeight)
@@ -176,24 +351,103 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.projectDetailView.SetSize(msg.Width, contentHeight)
 		}
 		m.notesView.SetSize(msg.Width, contentHeight)
+		m.goalsView.SetSize(msg.Width, contentHeight)
 		return m, nil
 
 	case OpenBoardMsg:
 		// Load the board and switch to board view
 		board, err := fs.ReadBoard(msg.BoardPath)
 		if err != nil {
-			// Stay on current view if board can't be loaded
+			// Stay on current view if board can't be loaded; one deleted
+			// since the picker was filled drops out of it
+			if _, statErr := os.Stat(msg.BoardPath); os.IsNotExist(statErr) {
+				return m, func() tea.Msg { return BoardMissingMsg{Path: msg.BoardPath} }
+			}
 			return m, nil
 		}
+		m.recordBoardVisit() // the board being left
+		if m.boardLoaded {
+			m.boardStates[m.boardView.BoardPath()] = m.boardView.ViewState()
+		}
 		m.boardView = kanbanview.NewBoardModel(board, collectAllProjects(m.workspaces), m.boards, projectsForBoard(m.workspaces, msg.BoardPath))
+		m.boardView.SetFilterBodies(m.cfg.FilterCardBodies)
+		m.boardView.SetHighlightMatches(m.cfg.HighlightFilterMatches)
+		m.boardView.SetDefaultTags(defaultTagsForBoard(m.workspaces, msg.BoardPath))
+		m.boardView.SetBoardInfo(boardInfo(m.workspaces))
+		m.boardView.SetCardRegister(m.cardRegister)
+		m.boardView.SetViewStates(m.boardStates)
 		m.boardView.SetSize(m.width, m.height-4)
-		if msg.ColIndex > 0 || msg.CardIndex > 0 {
+		m.recordRecentBoard(msg.BoardPath)
+		if msg.CardFile != "" {
+			m.boardView.SelectCardFile(msg.CardFile)
+		} else if msg.HasTarget {
 			m.boardView.NavigateTo(msg.ColIndex, msg.CardIndex)
+		} else if s, ok := m.boardStates[msg.BoardPath]; ok && msg.Filter == "" {
+			// Back to a board visited earlier: pick up where the user left it
+			m.boardView.RestoreViewState(s)
+		}
+		if msg.Filter != "" {
+			m.boardView.SetFilter(msg.Filter)
 		}
 		m.boardLoaded = true
 		m.currentView = ViewKanbanBoard
 		return m, m.boardView.Init()
 
+	case BoardSwitchedMsg:
+		// The board view swapped boards in place; resolve the new board's projects
+		m.boardView.SetBoardProjects(projectsForBoard(m.workspaces, msg.BoardPath))
+		m.boardView.SetDefaultTags(defaultTagsForBoard(m.workspaces, msg.BoardPath))
+		m.recordRecentBoard(msg.BoardPath)
+		return m, nil
+
+	case CardRegisterMsg:
+		m.cardRegister = msg.Register
+		return m, nil
+
+	case BoardRenamedMsg:
+		m.state.RenameRecentBoard(msg.OldPath, msg.NewPath)
+		if err := m.state.Save(); err != nil {
+			logs.Logger.Printf("Error saving state: %v", err)
+		}
+		m.boardView.SetRecentBoards(m.state.RecentBoards)
+		if m.boardLoaded && m.boardView.BoardPath() == msg.OldPath {
+			if board, err := fs.ReadBoard(msg.NewPath); err == nil {
+				m.boardView.SetBoard(board)
+			} else {
+				m.boardLoaded = false
+			}
+		}
+		return m, func() tea.Msg { return DataRefreshMsg{} }
+
+	case BoardDeletedMsg:
+		m.state.RenameRecentBoard(msg.Path, "")
+		if err := m.state.Save(); err != nil {
+			logs.Logger.Printf("Error saving state: %v", err)
+		}
+		m.boardView.SetRecentBoards(m.state.RecentBoards)
+		if m.boardLoaded && m.boardView.BoardPath() == msg.Path {
+			m.boardLoaded = false
+		}
+		return m, func() tea.Msg { return DataRefreshMsg{} }
+
+	case BoardMissingMsg:
+		// The board view shows its missing screen; drop the board from the
+		// recent boards and, through the refresh, from the pickers
+		m.state.RenameRecentBoard(msg.Path, "")
+		if err := m.state.Save(); err != nil {
+			logs.Logger.Printf("Error saving state: %v", err)
+		}
+		m.boardView.SetRecentBoards(m.state.RecentBoards)
+		return m, func() tea.Msg { return DataRefreshMsg{} }
+
+	case taskview.SearchSubmittedMsg:
+		m.state.AddSearch(msg.Query)
+		if err := m.state.Save(); err != nil {
+			logs.Logger.Printf("Error saving state: %v", err)
+		}
+		m.taskManagerView.SetSearchHistory(m.state.SearchHistory)
+		return m, nil
+
 	case OpenProjectMsg:
 		// Find workspace by RootDir
 		for _, ws := range m.workspaces {
@@ -224,6 +478,26 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, nil
 
+	case RenameProjectMsg:
+		for _, ws := range m.workspaces {
+			if ws.RootDir != msg.WorkspaceRootDir {
+				continue
+			}
+			if err := ws.RenameProject(msg.OldName, msg.NewName); err != nil {
+				logs.Logger.Printf("Error renaming project: %v", err)
+				return m, tea.Printf("Error renaming project: %v", err)
+			}
+			// Reload first, then reopen the detail view under the new name
+			m.projectDetailLoaded = false
+			return m, tea.Sequence(
+				func() tea.Msg { return DataRefreshMsg{} },
+				func() tea.Msg {
+					return OpenProjectMsg{ProjectName: msg.NewName, WorkspaceRootDir: msg.WorkspaceRootDir}
+				},
+			)
+		}
+		return m, nil
+
 	case SwitchViewMsg:
 		m.currentView = msg.View
 		// Refresh data when switching to certain views
@@ -231,15 +505,19 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case ViewAgendaDay:
 			m.lastAgendaView = ViewAgendaDay
 			m.refreshData()
-			m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+			m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 		case ViewAgendaWeek:
 			m.lastAgendaView = ViewAgendaWeek
 			m.refreshData()
-			m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+			m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 		case ViewAgendaMonth:
 			m.lastAgendaView = ViewAgendaMonth
 			m.refreshData()
-			m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+			m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
+		case ViewAgendaYear:
+			m.lastAgendaView = ViewAgendaYear
+			m.refreshData()
+			m.heatmapView.SetData(m.taskSvc, m.boards)
 		case ViewKanbanPicker:
 			m.refreshData()
 			m.pickerView.SetBoards(m.boards)
@@ -249,9 +527,30 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case ViewNotes:
 			m.refreshData()
 			m.notesView.SetData(m.workspaces)
+		case ViewGoals:
+			m.refreshData()
+			m.goalsView.SetData(m.workspaces)
 		}
 		return m, nil
 
+	case GotoDateMsg:
+		m.dayView.SetDate(msg.Date)
+		m.weekView.SetDate(msg.Date)
+		m.monthView.SetDate(msg.Date)
+		m.heatmapView.SetDate(msg.Date)
+		if !isAgendaView(m.currentView) {
+			m.currentView = m.lastAgendaView
+		}
+		return m, nil
+
+	case ToggleMyDayMsg:
+		m.state.ToggleMyDay(msg.Key, clock.Now())
+		if err := m.state.Save(); err != nil {
+			logs.Logger.Printf("Error saving state: %v", err)
+		}
+		m.setMyDay()
+		return m, nil
+
 	case FocusTaskMsg:
 		m.currentView = ViewTaskManager
 		m.taskManagerView.SetData(m.taskSvc)
@@ -260,18 +559,35 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case taskview.TaskUpdateMsg:
 		// A task was updated in the task manager — persist it
+		taskID := msg.Task.ID
 		if msg.Task.File == "" {
-			if _, err := m.taskSvc.Add(msg.Task.String()); err != nil {
+			added, err := m.taskSvc.Add(msg.Task.String())
+			if err != nil {
 				logs.Logger.Printf("Error adding new task: %v", err)
+			} else {
+				taskID = added.ID
 			}
 		} else {
 			if err := m.taskSvc.Update(msg.Task); err != nil {
 				logs.Logger.Printf("Error updating task: %v", err)
 			}
 		}
+		for _, text := range msg.Annotations {
+			if err := m.taskSvc.Annotate(taskID, text); err != nil {
+				logs.Logger.Printf("Error annotating task: %v", err)
+			}
+		}
 		m.taskManagerView.SetData(m.taskSvc)
 		return m, nil
 
+	case taskview.TasksUpdateMsg:
+		if err := m.taskSvc.UpdateAll(msg.Tasks); err != nil {
+			logs.Logger.Printf("Error updating tasks: %v", err)
+			return m, tea.Printf("Error updating tasks: %v", err)
+		}
+		m.taskManagerView.SetData(m.taskSvc)
+		return m, tea.Printf("%s", msg.Summary)
+
 	case taskview.TaskDeleteMsg:
 		if err := m.taskSvc.Delete(msg.TaskID); err != nil {
 			logs.Logger.Printf("Error deleting task: %v", err)
@@ -286,39 +602,24 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, tea.Printf("Error loading board: %v", err)
 		}
 
-		// Parse dates from task tags
-		var dueDate, scheduledDate *time.Time
-		if d := msg.Task.GetDueDate(); d != "" {
-			if t, err := time.Parse("2006-01-02", d); err == nil {
-				dueDate = &t
-			}
-		}
-		if d := msg.Task.GetScheduledDate(); d != "" {
-			if t, err := time.Parse("2006-01-02", d); err == nil {
-				scheduledDate = &t
-			}
-		}
-
-		priority := operations.TaskPriorityToCardPriority(rune(msg.Task.Priority))
-
 		// Merge board projects into task projects
-		projects := msg.Task.Projects
+		task := msg.Task
+		task.Projects = append([]string{}, task.Projects...)
 		for _, bp := range projectsForBoard(m.workspaces, msg.BoardPath) {
 			found := false
-			for _, p := range projects {
+			for _, p := range task.Projects {
 				if strings.EqualFold(p, bp) {
 					found = true
 					break
 				}
 			}
 			if !found {
-				projects = append(projects, bp)
+				task.Projects = append(task.Projects, bp)
 			}
 		}
 
 		// Create the card
-		_, err = operations.CreateCardFromTask(&board, msg.Task.Name, projects, msg.Task.Contexts, dueDate, scheduledDate, priority)
-		if err != nil {
+		if _, err := operations.CreateCardFromTask(&board, task); err != nil {
 			return m, tea.Printf("Error creating card: %v", err)
 		}
 
@@ -330,7 +631,67 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m.taskManagerView.SetData(m.taskSvc)
-		return m, tea.Printf("Moved \"%s\" to board \"%s\"", msg.Task.Name, board.Name)
+		return m, tea.Batch(tea.Printf("Moved \"%s\" to board \"%s\"", msg.Task.Name, board.Name), func() tea.Msg { return DataRefreshMsg{} })
+
+	case MoveCardToBoardMsg:
+		src, err := fs.ReadBoard(msg.BoardPath)
+		if err != nil {
+			return m, tea.Printf("Error loading board: %v", err)
+		}
+		dst, err := fs.ReadBoard(msg.TargetPath)
+		if err != nil {
+			return m, tea.Printf("Error loading board: %v", err)
+		}
+		// Within a workspace the card keeps the source board's projects,
+		// across workspaces it takes the target board's (as m on a board)
+		info := boardInfo(m.workspaces)
+		projects := info[msg.BoardPath].Projects
+		if info[msg.BoardPath].Workspace != info[msg.TargetPath].Workspace {
+			projects = info[msg.TargetPath].Projects
+		}
+		if msg.ColIndex < 0 || msg.ColIndex >= len(src.Columns) || msg.CardIndex < 0 || msg.CardIndex >= len(src.Columns[msg.ColIndex].Cards) {
+			return m, tea.Printf("Card not found on board \"%s\"", src.Name)
+		}
+		title := src.Columns[msg.ColIndex].Cards[msg.CardIndex].Title
+		if err := operations.MoveCardToBoard(&src, msg.ColIndex, msg.CardIndex, &dst, projects); err != nil {
+			logs.Logger.Printf("Error moving card to board: %v", err)
+			return m, tea.Printf("Error moving card: %v", err)
+		}
+		return m, tea.Batch(tea.Printf("Moved \"%s\" to board \"%s\"", title, dst.Name), func() tea.Msg { return DataRefreshMsg{} })
+
+	case MoveCardToTasksMsg:
+		if m.taskSvc == nil {
+			return m, tea.Printf("No task directory to move the card into")
+		}
+		board, err := fs.ReadBoard(msg.BoardPath)
+		if err != nil {
+			return m, tea.Printf("Error loading board: %v", err)
+		}
+		for colIdx, col := range board.Columns {
+			for cardIdx, card := range col.Cards {
+				if card.Filename != msg.Filename {
+					continue
+				}
+				if _, err := operations.CreateTaskFromCard(m.taskSvc, &board, colIdx, cardIdx); err != nil {
+					logs.Logger.Printf("Error moving card to tasks: %v", err)
+					return m, tea.Batch(tea.Printf("Error moving card to tasks: %v", err), func() tea.Msg { return DataRefreshMsg{} })
+				}
+				return m, tea.Batch(tea.Printf("Moved \"%s\" to tasks", card.Title), func() tea.Msg { return DataRefreshMsg{} })
+			}
+		}
+		return m, tea.Printf("Card not found: %s", msg.Filename)
+
+	case CaptureTaskMsg:
+		if m.taskSvc == nil {
+			return m, tea.Printf("No task directory to add the task to")
+		}
+		task, err := m.taskSvc.Add(msg.Line)
+		if err != nil {
+			logs.Logger.Printf("Error capturing task: %v", err)
+			return m, tea.Printf("Error adding task: %v", err)
+		}
+		m.taskManagerView.SetData(m.taskSvc)
+		return m, tea.Printf("Added task \"%s\"", task.Name)
 
 	case taskview.ArchiveRequestMsg:
 		// Archive completed tasks
@@ -343,6 +704,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return taskview.ArchiveCompleteMsg{Count: msg.Count}
 		}
 
+	case OpenJournalMsg:
+		return m, m.openJournal(msg.Day)
+
 	case CreateSubProjectMsg:
 		for _, ws := range m.workspaces {
 			if ws.RootDir == msg.WsDir {
@@ -360,44 +724,59 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 		return m, func() tea.Msg { return DataRefreshMsg{} }
 
+	case filesChangedMsg:
+		return m.handleFilesChanged(msg)
+
 	case DataRefreshMsg:
+		var cmd tea.Cmd
 		m.refreshData()
 		// Push fresh data into every loaded model, not just the active view.
-		projDates := collectProjectDates(m.workspaces)
+		projDates := agendapkg.CollectProjectDates(m.workspaces)
 		m.pickerView.SetBoards(m.boards)
 		m.taskManagerView.SetData(m.taskSvc)
 		m.taskManagerView.SetBoards(m.boards)
 		m.projectsView.SetData(m.workspaces)
 		m.notesView.SetData(m.workspaces)
+		m.goalsView.SetData(m.workspaces)
 		m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
 		m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
 		m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, projDates)
+		m.heatmapView.SetData(m.taskSvc, m.boards)
 		if m.boardLoaded {
 			if board, err := fs.ReadBoard(m.boardView.BoardPath()); err == nil {
 				m.boardView.SetBoard(board)
+			} else if m.boardView.CheckMissing() {
+				path := m.boardView.BoardPath()
+				cmd = func() tea.Msg { return BoardMissingMsg{Path: path} }
 			} else {
 				logs.Logger.Printf("DataRefreshMsg: failed to reload board: %v", err)
 			}
 			m.boardView.SetAllProjects(collectAllProjects(m.workspaces))
+			m.boardView.SetBoardInfo(boardInfo(m.workspaces))
 			m.boardView.SetBoardProjects(projectsForBoard(m.workspaces, m.boardView.BoardPath()))
+			m.boardView.SetDefaultTags(defaultTagsForBoard(m.workspaces, m.boardView.BoardPath()))
 		}
 		if m.projectDetailLoaded && m.currentView == ViewProjectDetail {
 			projName, wsDir := m.projectDetailView.OpenInfo()
-			return m, func() tea.Msg {
+			return m, tea.Batch(cmd, func() tea.Msg {
 				return OpenProjectMsg{ProjectName: projName, WorkspaceRootDir: wsDir}
-			}
+			})
 		}
-		return m, nil
+		return m, cmd
 
 	case RequestExitMsg:
 		m.exitConfirming = true
 		return m, nil
 
 	case tea.KeyMsg:
+		m.showSummary = false
+
 		// Exit confirmation modal intercepts all keys
 		if m.exitConfirming {
 			switch msg.String() {
 			case "y", "enter", "ctrl+c":
+				m.recordBoardVisit()
+				m.saveSession()
 				return m, tea.Quit
 			case "esc", "n":
 				m.exitConfirming = false
@@ -412,21 +791,63 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		// The onboarding tour takes all other keys while it runs
+		if m.tour.active {
+			return m.updateTour(msg)
+		}
+
 		// Dismiss help overlay on any key
 		if m.showHelp {
 			m.showHelp = false
 			return m, nil
 		}
 
+		// ctrl+g shows the recent log lines; any key dismisses them
+		if m.showLog {
+			m.showLog = false
+			return m, nil
+		}
+		if msg.String() == "ctrl+g" {
+			m.showLog = true
+			return m, nil
+		}
+
+		// The agenda command line takes all keys while open
+		if isAgendaView(m.currentView) {
+			if m.commandLine.IsActive() {
+				var cmd tea.Cmd
+				m.commandLine, cmd = m.commandLine.Update(msg)
+				return m, cmd
+			}
+			m.commandLine.ClearError()
+		}
+
 		// Global view-switching (uppercase) — works in all views when not in modal/typing state
 		if !m.isChildInputActive() {
 			switch msg.String() {
 			case "N":
+				if m.currentView == ViewKanbanBoard {
+					// The board binds N to create a card in a chosen column
+					break
+				}
 				m.currentView = ViewNotes
 				m.refreshData()
 				m.notesView.SetData(m.workspaces)
 				return m, nil
+			case "G":
+				if m.currentView == ViewTaskManager {
+					// The task manager binds G to jump to the last task
+					break
+				}
+				m.currentView = ViewGoals
+				m.refreshData()
+				m.goalsView.SetData(m.workspaces)
+				return m, nil
 			case "P":
+				if m.currentView == ViewKanbanBoard {
+					// The board binds P to put the card in the register
+					break
+				}
 				m.refreshData()
 				if m.projectDetailLoaded {
 					m.currentView = ViewProjectDetail
@@ -440,16 +861,24 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				}
 				return m, nil
 			case "B":
+				if m.currentView == ViewKanbanBoard {
+					// The board binds B to block/unblock the selected card
+					break
+				}
 				m.refreshData()
 				if m.boardLoaded {
 					m.currentView = ViewKanbanBoard
 					m.boardView.SetSize(m.width, m.height-4)
 					if board, err := fs.ReadBoard(m.boardView.BoardPath()); err == nil {
 						m.boardView.SetBoard(board)
+					} else if m.boardView.CheckMissing() {
+						path := m.boardView.BoardPath()
+						return m, func() tea.Msg { return BoardMissingMsg{Path: path} }
 					} else {
 						logs.Logger.Printf("B key: failed to reload board: %v", err)
 					}
 					m.boardView.SetAllProjects(collectAllProjects(m.workspaces))
+					m.boardView.SetBoardInfo(boardInfo(m.workspaces))
 				} else {
 					m.currentView = ViewKanbanPicker
 					m.pickerView.SetBoards(m.boards)
@@ -457,16 +886,30 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				return m, nil
 			case "A":
 				m.refreshData()
+				if isAgendaView(m.currentView) {
+					// Already in the agenda: go on to the next of its views
+					m.lastAgendaView = nextAgendaView(m.currentView)
+				}
 				m.currentView = m.lastAgendaView
 				switch m.lastAgendaView {
 				case ViewAgendaWeek:
-					m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+					m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 				case ViewAgendaMonth:
-					m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+					m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
+				case ViewAgendaYear:
+					m.heatmapView.SetData(m.taskSvc, m.boards)
 				default:
-					m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+					m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 				}
 				return m, nil
+			case "O":
+				// Jump from the due-soon counter to today's overdue items
+				m.refreshData()
+				m.currentView = ViewAgendaDay
+				m.lastAgendaView = ViewAgendaDay
+				m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
+				m.dayView.FocusOverdue()
+				return m, nil
 			case "T":
 				if m.currentView != ViewTaskManager {
 					m.currentView = ViewTaskManager
@@ -477,8 +920,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		// Global ? help — works in all views when not in modal/typing state
-		if msg.String() == "?" && !m.isChildInputActive() {
+		// Global ? help — works in all views when not in modal/typing state;
+		// a board picker that isn't taking text shows its own keys
+		if msg.String() == "?" && (!m.isChildInputActive() || m.hasBoardModalHelp()) {
 			m.showHelp = true
 			return m, nil
 		}
@@ -496,33 +940,48 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		} else if m.currentView == ViewNotes && m.notesView.IsTyping() {
 			// Notes view has active text input (file picker, label input)
 			// Let it handle all keys
-		} else if m.currentView == ViewAgendaDay && m.dayView.IsSearching() {
-			// Day agenda search is active — let it handle all keys
-		} else if m.currentView == ViewAgendaWeek && m.weekView.IsSearching() {
-			// Week agenda search is active — let it handle all keys
+		} else if m.currentView == ViewAgendaDay && (m.dayView.IsSearching() || m.dayView.IsPeeking() || m.dayView.ShowingActions()) {
+			// Day agenda search, quick-look popup or actions menu is active — let it handle all keys
+		} else if m.currentView == ViewAgendaWeek && (m.weekView.IsSearching() || m.weekView.HasPendingKeys() || m.weekView.IsPeeking() || m.weekView.ShowingActions() || m.weekView.IsPlanning()) {
+			// Week agenda search, a gd<day> jump, the quick-look popup, the actions menu or planning is active — let it handle all keys
+		} else if m.currentView == ViewTaskManager && isDigitKey(msg.String()) {
+			// Digits are count prefixes for task manager motions (12j)
+		} else if m.currentView == ViewAgendaWeek && isDigitKey(msg.String()) {
+			// Digits jump to a day of the week (1 = Monday); A switches views
 		} else {
 			// Global navigation keys for agenda/task views
 			switch msg.String() {
 			case "q":
 				m.exitConfirming = true
 				return m, nil
+			case ":":
+				if isAgendaView(m.currentView) {
+					m.commandLine.SetData(m.taskSvc, m.boards)
+					return m, m.commandLine.Open()
+				}
 			case "1":
 				m.currentView = ViewAgendaDay
 				m.lastAgendaView = ViewAgendaDay
 				m.refreshData()
-				m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+				m.dayView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 				return m, nil
 			case "2":
 				m.currentView = ViewAgendaWeek
 				m.lastAgendaView = ViewAgendaWeek
 				m.refreshData()
-				m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+				m.weekView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
 				return m, nil
 			case "3":
 				m.currentView = ViewAgendaMonth
 				m.lastAgendaView = ViewAgendaMonth
 				m.refreshData()
-				m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, collectProjectDates(m.workspaces))
+				m.monthView.SetData(m.taskSvc, m.boards, m.allNotes, agendapkg.CollectProjectDates(m.workspaces))
+				return m, nil
+			case "4":
+				m.currentView = ViewAgendaYear
+				m.lastAgendaView = ViewAgendaYear
+				m.refreshData()
+				m.heatmapView.SetData(m.taskSvc, m.boards)
 				return m, nil
 			}
 		}
@@ -540,6 +999,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ViewAgendaMonth:
 		m.monthView, cmd = m.monthView.Update(msg)
 		return m, cmd
+	case ViewAgendaYear:
+		m.heatmapView, cmd = m.heatmapView.Update(msg)
+		return m, cmd
 	case ViewKanbanPicker:
 		m.pickerView, cmd = m.pickerView.Update(msg)
 		return m, cmd
@@ -562,6 +1024,9 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case ViewNotes:
 		m.notesView, cmd = m.notesView.Update(msg)
 		return m, cmd
+	case ViewGoals:
+		m.goalsView, cmd = m.goalsView.Update(msg)
+		return m, cmd
 	}
 
 	return m, nil
@@ -569,24 +1034,13 @@ func (m AppModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 // refreshData rescans workspaces and refreshes aggregated data
 func (m *AppModel) refreshData() {
+	freshWorkspaces, allTaskDirs := scanWorkspaces(m.cfg)
+
 	var allBoards []kanbanmodels.Board
 	var allNotes []notes.Note
-	var allTaskDirs []scanner.TaskDirInfo
-	var freshWorkspaces []*workspace.Workspace
-
-	for _, wsDir := range m.cfg.Workspaces {
-		scan, err := scanner.ScanWorkspace(wsDir)
-		if err != nil {
-			continue
-		}
-		ws, err := workspace.Load(scan)
-		if err != nil {
-			continue
-		}
-		freshWorkspaces = append(freshWorkspaces, ws)
+	for _, ws := range freshWorkspaces {
 		allBoards = append(allBoards, ws.Boards...)
 		allNotes = append(allNotes, ws.Notes...)
-		allTaskDirs = append(allTaskDirs, scan.TaskDirs...)
 	}
 
 	m.workspaces = freshWorkspaces
@@ -598,6 +1052,40 @@ func (m *AppModel) refreshData() {
 			m.taskSvc = svc
 		}
 	}
+	useTaskRefs(m.workspaces, m.taskSvc)
+	m.dueSoon = stats.CollectDueSoon(m.taskSvc, m.boards, clock.Now())
+	m.setMyDay()
+	if m.watcher != nil {
+		m.watcher.Watch(watch.Dirs(m.workspaces))
+	}
+}
+
+// useTaskRefs has the workspaces' refs indexes read tasks from svc, so
+// backlinks show tasks as they are now rather than as they were scanned.
+func useTaskRefs(workspaces []*workspace.Workspace, svc service.TaskService) {
+	if svc == nil {
+		return
+	}
+	for _, ws := range workspaces {
+		if ws.Refs != nil {
+			ws.Refs.SetTaskSource(svc.List)
+		}
+	}
+}
+
+// setMyDay hands today's My Day picks to the views that show them. Picks
+// from an earlier day have fallen off.
+func (m *AppModel) setMyDay() {
+	keys := m.state.MyDayItems(clock.Now())
+	m.dayView.SetMyDay(keys)
+	m.taskManagerView.SetMyDay(keys)
+}
+
+// scanWorkspaces rescans and loads every configured workspace, skipping
+// ones that fail, and returns them with their task directories.
+func scanWorkspaces(cfg *config.Config) ([]*workspace.Workspace, []scanner.TaskDirInfo) {
+	workspaces, taskDirs, _ := workspace.LoadAll(cfg.Workspaces, cfg.Ignore...)
+	return workspaces, taskDirs
 }
 
 // isChildInputActive returns true when the current child view has an active text input
@@ -606,6 +1094,9 @@ func (m *AppModel) isChildInputActive() bool {
 	if m.exitConfirming {
 		return true
 	}
+	if isAgendaView(m.currentView) && m.commandLine.IsActive() {
+		return true
+	}
 	switch m.currentView {
 	case ViewKanbanBoard:
 		return m.boardView.IsModal()
@@ -620,32 +1111,56 @@ func (m *AppModel) isChildInputActive() bool {
 	case ViewNotes:
 		return m.notesView.IsTyping()
 	case ViewAgendaDay:
-		return m.dayView.IsSearching()
+		return m.dayView.IsSearching() || m.dayView.IsPeeking() || m.dayView.ShowingActions()
 	case ViewAgendaWeek:
-		return m.weekView.IsSearching()
+		return m.weekView.IsSearching() || m.weekView.IsPeeking() || m.weekView.ShowingActions() || m.weekView.IsPlanning()
 	default:
 		return false
 	}
 }
 
-// collectProjectDates collects all labeled project dates from all workspaces.
-func collectProjectDates(workspaces []*workspace.Workspace) []agendapkg.ProjectDateSource {
-	var result []agendapkg.ProjectDateSource
-	for _, ws := range workspaces {
-		if ws.Projects == nil {
-			continue
-		}
-		for _, p := range ws.Projects.List() {
-			for _, d := range p.Dates {
-				result = append(result, agendapkg.ProjectDateSource{
-					ProjectName: p.Name,
-					Label:       d.Label,
-					Date:        d.Date,
-				})
-			}
-		}
+// hasBoardModalHelp reports whether the board has a picker open whose keys
+// ? should show.
+func (m AppModel) hasBoardModalHelp() bool {
+	if m.currentView != ViewKanbanBoard || !m.boardLoaded || m.exitConfirming {
+		return false
 	}
-	return result
+	_, ok := m.boardView.ModalHelp()
+	return ok
+}
+
+// diagnosticCount returns how many values the workspaces failed to parse.
+func (m AppModel) diagnosticCount() int {
+	n := 0
+	for _, ws := range m.workspaces {
+		n += len(ws.Diagnostics)
+	}
+	return n
+}
+
+// isDigitKey reports whether key is a single digit.
+func isDigitKey(key string) bool {
+	return len(key) == 1 && key[0] >= '0' && key[0] <= '9'
+}
+
+// nextAgendaView returns the agenda view A moves to from v: day, week, month,
+// year, then back to day.
+func nextAgendaView(v ViewType) ViewType {
+	switch v {
+	case ViewAgendaDay:
+		return ViewAgendaWeek
+	case ViewAgendaWeek:
+		return ViewAgendaMonth
+	case ViewAgendaMonth:
+		return ViewAgendaYear
+	default:
+		return ViewAgendaDay
+	}
+}
+
+// isAgendaView reports whether v is one of the day/week/month/year agenda views.
+func isAgendaView(v ViewType) bool {
+	return v == ViewAgendaDay || v == ViewAgendaWeek || v == ViewAgendaMonth || v == ViewAgendaYear
 }
 
 // collectAllProjects returns projects in hierarchical DFS order with depth metadata,
@@ -734,6 +1249,47 @@ func projectsForBoard(workspaces []*workspace.Workspace, boardPath string) []str
 	return nil
 }
 
+// boardInfo maps every board path to its workspace and the projects linked
+// to it there.
+func boardInfo(workspaces []*workspace.Workspace) map[string]kanbanview.BoardInfo {
+	info := make(map[string]kanbanview.BoardInfo)
+	for _, ws := range workspaces {
+		for _, b := range ws.Boards {
+			bi := kanbanview.BoardInfo{Workspace: ws.RootDir, Refs: ws.Refs}
+			if ws.Projects != nil {
+				bi.Projects = ws.Projects.ProjectsForBoard(b.Path, ws.Boards)
+			}
+			info[b.Path] = bi
+		}
+	}
+	return info
+}
+
+// defaultTagsForBoard returns the default_tags of the projects linked to a
+// board, without duplicates.
+func defaultTagsForBoard(workspaces []*workspace.Workspace, boardPath string) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, ws := range workspaces {
+		if ws.Projects == nil {
+			continue
+		}
+		for _, name := range ws.Projects.ProjectsForBoard(boardPath, ws.Boards) {
+			p := ws.Projects.Get(name)
+			if p == nil {
+				continue
+			}
+			for _, tag := range p.DefaultTags {
+				if !seen[tag] {
+					seen[tag] = true
+					tags = append(tags, tag)
+				}
+			}
+		}
+	}
+	return tags
+}
+
 // findBoard looks up a board by name or directory basename (case-insensitive).
 func findBoard(boards []kanbanmodels.Board, query string) (kanbanmodels.Board, bool) {
 	q := strings.ToLower(query)
@@ -745,19 +1301,54 @@ func findBoard(boards []kanbanmodels.Board, query string) (kanbanmodels.Board, b
 	return kanbanmodels.Board{}, false
 }
 
+// findCard finds the card of board titled query, ignoring case, else the
+// first card whose title contains it. Returns its column and card index.
+func findCard(board kanbanmodels.Board, query string) (int, int, bool) {
+	q := strings.ToLower(strings.TrimSpace(query))
+	col, card, found := 0, 0, false
+	for i, c := range board.Columns {
+		for j, cd := range c.Cards {
+			title := strings.ToLower(cd.Title)
+			if title == q {
+				return i, j, true
+			}
+			if !found && strings.Contains(title, q) {
+				col, card, found = i, j, true
+			}
+		}
+	}
+	return col, card, found
+}
+
 func (m AppModel) View() string {
+	return m.clipboard + m.view()
+}
+
+func (m AppModel) view() string {
 	if !m.ready {
 		return "Loading..."
 	}
 
+	if width, height := m.minSize(); m.width < width || m.height < height {
+		return shared.TooSmall(m.width, m.height, width, height)
+	}
+
 	if m.showHelp {
 		return m.renderHelpOverlay()
 	}
 
+	if m.showLog {
+		return m.renderLogOverlay()
+	}
+
 	if m.exitConfirming {
 		return m.renderExitConfirmModal()
 	}
 
+	if m.isTourModal() {
+		return m.renderTourModal()
+	}
+
 	var content string
 	centerContent := false
 
@@ -771,6 +1362,9 @@ func (m AppModel) View() string {
 	case ViewAgendaMonth:
 		content = m.monthView.View()
 		centerContent = true
+	case ViewAgendaYear:
+		content = m.heatmapView.View()
+		centerContent = true
 	case ViewKanbanPicker:
 		content = m.pickerView.View()
 	case ViewKanbanBoard:
@@ -792,6 +1386,8 @@ func (m AppModel) View() string {
 		}
 	case ViewNotes:
 		content = m.notesView.View()
+	case ViewGoals:
+		content = m.goalsView.View()
 	}
 
 	if centerContent && m.width > maxContentWidth {
@@ -805,10 +1401,41 @@ func (m AppModel) View() string {
 
 	tabBar := m.renderTabBar()
 	hintBar := m.renderHintBar()
+	// Cut views that outgrow the screen rather than pushing the bars off it
+	content = shared.ClampContent(content, m.width, m.height-lipgloss.Height(tabBar)-lipgloss.Height(hintBar))
+
+	if m.tour.active {
+		// Make room for the tour panel by trimming the bottom of the view
+		panel := m.renderTourPanel()
+		lines := strings.Split(content, "\n")
+		if keep := m.height - 4 - lipgloss.Height(panel); keep < len(lines) {
+			lines = lines[:max(0, keep)]
+		}
+		return lipgloss.JoinVertical(lipgloss.Left, tabBar, strings.Join(lines, "\n"), panel, hintBar)
+	}
 
 	return lipgloss.JoinVertical(lipgloss.Left, tabBar, content, hintBar)
 }
 
+// minSize returns the smallest terminal the current view lays out in,
+// including the tab and hint bars. Below it View shows a placeholder.
+func (m AppModel) minSize() (width, height int) {
+	width, height = minWidth, minHeight
+	switch m.currentView {
+	case ViewAgendaDay, ViewAgendaWeek:
+		width, height = agendaview.MinWidth, agendaview.MinHeight
+	case ViewAgendaMonth:
+		width, height = agendaview.MinMonthWidth, agendaview.MinMonthHeight
+	case ViewAgendaYear:
+		width, height = agendaview.MinHeatmapWidth, agendaview.MinHeatmapHeight
+	case ViewKanbanBoard:
+		width, height = kanbanview.MinWidth, kanbanview.MinHeight
+	case ViewTaskManager:
+		width, height = taskview.MinWidth, taskview.MinHeight
+	}
+	return max(width, minWidth), max(height, minHeight) + chromeHeight
+}
+
 // renderTabBar renders the top tab bar with the active view highlighted.
 func (m AppModel) renderTabBar() string {
 	type tab struct {
@@ -821,6 +1448,7 @@ func (m AppModel) renderTabBar() string {
 		{"T", "asks"},
 		{"P", "rojects"},
 		{"N", "otes"},
+		{"G", "oals"},
 	}
 
 	// Map current view to active tab index
@@ -828,7 +1456,7 @@ func (m AppModel) renderTabBar() string {
 	switch m.currentView {
 	case ViewKanbanPicker, ViewKanbanBoard:
 		activeIdx = 0
-	case ViewAgendaDay, ViewAgendaWeek, ViewAgendaMonth:
+	case ViewAgendaDay, ViewAgendaWeek, ViewAgendaMonth, ViewAgendaYear:
 		activeIdx = 1
 	case ViewTaskManager:
 		activeIdx = 2
@@ -836,14 +1464,21 @@ func (m AppModel) renderTabBar() string {
 		activeIdx = 3
 	case ViewNotes:
 		activeIdx = 4
+	case ViewGoals:
+		activeIdx = 5
 	}
 
 	var parts []string
 	for i, t := range tabs {
+		name := "[" + t.key + "]" + t.label
+		if m.viewTakesKey(t.key) {
+			// The view binds the key itself, so the tab has none here
+			name = t.key + t.label
+		}
 		if i == activeIdx {
-			parts = append(parts, theme.TabActive.Render("["+t.key+"]"+t.label))
+			parts = append(parts, theme.TabActive.Render(name))
 		} else {
-			parts = append(parts, theme.TabInactive.Render("["+t.key+"]"+t.label))
+			parts = append(parts, theme.TabInactive.Render(name))
 		}
 	}
 
@@ -852,6 +1487,19 @@ func (m AppModel) renderTabBar() string {
 	return theme.TabBar.Width(m.width).Render(centered)
 }
 
+// viewTakesKey reports whether the current view binds key itself, in place
+// of the global view switch: G in the task manager jumps to the last task,
+// and N, P and B on a board create, put and block cards.
+func (m AppModel) viewTakesKey(key string) bool {
+	switch m.currentView {
+	case ViewTaskManager:
+		return key == "G"
+	case ViewKanbanBoard:
+		return key == "N" || key == "P" || key == "B"
+	}
+	return false
+}
+
 // renderHintBar renders the bottom hint bar with keybind hints for the current view.
 func (m AppModel) renderHintBar() string {
 	var hintText string
@@ -860,18 +1508,22 @@ func (m AppModel) renderHintBar() string {
 	case ViewAgendaDay:
 		if m.dayView.IsSearching() {
 			hintText = m.dayView.HintText()
+		} else if m.dayView.ShowingMyDay() {
+			hintText = "j/k:navigate  space:done  +:drop  p:peek  a:actions  enter:open  m:full agenda  /:search  ?:help  q:quit"
 		} else {
-			hintText = "1:day 2:week 3:month  h:prev t:today l:next  j/k:navigate  /:search  enter:open  ?:help  q:quit"
+			hintText = "1:day 2:week 3:month 4:year  h:prev t:today l:next  j/k:navigate  p:peek  a:actions  +:pick  m:my day  s:source  x:parked  /:search  :cmd  enter:open  ?:help  q:quit"
 		}
 	case ViewAgendaWeek:
 		if m.weekView.IsSearching() {
 			hintText = m.weekView.HintText()
 		} else {
-			hintText = "1:day 2:week 3:month  h:prev t:today l:next  j/k:navigate  /:search  enter:open  ?:help  q:quit"
+			hintText = "1-7:mon-sun  J/K:day  A:month view  h:prev t:today l:next  j/k:navigate  p:peek  a:actions  z:empty days  s:source  x:parked  /:search  :cmd  enter:open  ?:help  q:quit"
 		}
 	case ViewAgendaMonth:
 		hintText = m.monthView.HintText()
-		hintText = "1:day 2:week 3:month  " + hintText + "  ?:help  q:quit"
+		hintText = "1:day 2:week 3:month 4:year  " + hintText + "  :cmd  ?:help  q:quit"
+	case ViewAgendaYear:
+		hintText = "1:day 2:week 3:month 4:year  " + m.heatmapView.HintText() + "  :cmd  ?:help  q:quit"
 	case ViewTaskManager:
 		hintText = m.taskManagerView.HintText()
 	case ViewKanbanPicker:
@@ -884,13 +1536,34 @@ func (m AppModel) renderHintBar() string {
 		hintText = m.projectsView.HintText()
 	case ViewNotes:
 		hintText = m.notesView.HintText()
+	case ViewGoals:
+		hintText = m.goalsView.HintText()
 	case ViewProjectDetail:
 		if m.projectDetailLoaded {
 			hintText = m.projectDetailView.HintText()
 		}
 	}
 
+	// The agenda command line replaces the hints while open or showing an error
+	if isAgendaView(m.currentView) {
+		if line := m.commandLine.View(); line != "" {
+			return theme.StatusBar.Width(m.width).Render(line)
+		}
+	}
+
 	styled := theme.HelpHint.Render(hintText)
+	if m.showSummary && !m.tour.active {
+		// The load summary stands in for the hints until the first key press
+		if len(m.startupSummary.ScanErrors) > 0 {
+			styled = theme.Warn.Render(m.startupSummary.String() + " (wydo status)")
+		} else {
+			styled = theme.HelpHint.Render(m.startupSummary.String())
+		}
+	}
+	if m.tour.active {
+		// Highlight the view's own hints while the tour points them out
+		styled = theme.Warn.Render(hintText)
+	}
 	centered := lipgloss.PlaceHorizontal(m.width, lipgloss.Center, styled)
 
 	// For kanban board, prepend the mode indicator left-aligned
@@ -900,23 +1573,67 @@ func (m AppModel) renderHintBar() string {
 		centered = modeText + centered[lipgloss.Width(modeText):]
 	}
 
+	// Right-aligned: what is due (O jumps to it), then unparseable dates or
+	// frontmatter pointing at wydo doctor
+	var badges []string
+	if due := m.dueSoon.String(); due != "" {
+		style := theme.HelpHint
+		if m.dueSoon.Overdue > 0 {
+			style = theme.Warn
+		}
+		badges = append(badges, style.Render(due+" (O)"))
+	}
+	if n := m.diagnosticCount(); n > 0 {
+		badges = append(badges, theme.Warn.Render(fmt.Sprintf("⚠ %d (wydo doctor)", n)))
+	}
+	if badge := writeQueueBadge(); badge != "" {
+		badges = append(badges, theme.Warn.Render(badge))
+	}
+	if len(badges) > 0 {
+		badge := strings.Join(badges, "  ")
+		if room := m.width - lipgloss.Width(badge); room > 0 {
+			centered = ansi.Truncate(centered, room, "") + badge
+		}
+	}
+
 	return theme.StatusBar.Width(m.width).Render(centered)
 }
 
 func (m AppModel) renderHelpOverlay() string {
+	if m.currentView == ViewKanbanBoard && m.boardLoaded {
+		if section, ok := m.boardView.ModalHelp(); ok {
+			return shared.RenderHelpPopup([]shared.HelpSection{section}, m.width, m.height)
+		}
+	}
+
 	globalNav := shared.HelpSection{
 		Title: "Global Navigation",
-		Binds: []shared.HelpBind{
-			{"N", "Notes"},
-			{"P", "Projects"},
-			{"B", "Board picker"},
-			{"A", "Agenda (day view)"},
-			{"T", "Task manager"},
-			{"1 / 2 / 3", "Day / week / month"},
-			{"?", "Show this help"},
-			{"q", "Quit"},
-		},
 	}
+	// Views that bind a switch key themselves don't list it; the task
+	// manager takes digits for counts and the week view for its days
+	for _, b := range []shared.HelpBind{
+		{"N", "Notes"},
+		{"G", "Goals"},
+		{"P", "Projects"},
+		{"B", "Board picker"},
+	} {
+		if !m.viewTakesKey(b.Key) {
+			globalNav.Binds = append(globalNav.Binds, b)
+		}
+	}
+	globalNav.Binds = append(globalNav.Binds, []shared.HelpBind{
+		{"A", "Agenda; in the agenda, its next view (day, week, month, year)"},
+		{"O", "Today's overdue items (the due counter on the status bar)"},
+		{"T", "Task manager"},
+	}...)
+	if m.currentView != ViewTaskManager && m.currentView != ViewAgendaWeek {
+		globalNav.Binds = append(globalNav.Binds, shared.HelpBind{"1 / 2 / 3 / 4", "Day / week / month / year"})
+	}
+	globalNav.Binds = append(globalNav.Binds, []shared.HelpBind{
+		{"?", "Show this help"},
+		{"ctrl+g", "Show recent log lines"},
+		{"q", "Quit"},
+	}...)
 
 	var sections []shared.HelpSection
 	sections = append(sections, globalNav)
@@ -927,24 +1644,38 @@ func (m AppModel) renderHelpOverlay() string {
 			Title: "Task Manager",
 			Binds: []shared.HelpBind{
 				{"j / k", "Navigate tasks"},
+				{"{count}j / k", "Move count tasks"},
+				{"gg / G", "Jump to first / last task"},
+				{"{count}G", "Jump to task number count"},
+				{"ctrl+d / ctrl+u", "Half-page down / up"},
 				{"enter", "Open task editor"},
 				{"space", "Toggle done"},
 				{"d", "Due date"},
+				{"> / <", "Due date +/- 1 day"},
+				{"} / {", "Due date +/- 1 week"},
 				{"s", "Scheduled date"},
+				{"ctrl+s", "Scheduled date of all shown pending tasks"},
 				{"t", "Contexts"},
 				{"p", "Projects"},
 				{"i", "Cycle priority"},
 				{"U", "Edit URLs"},
-				{"u", "Open URL"},
+				{"u", "Open URL or attachment"},
+				{"ctrl+f", "Attach a file to the task"},
+				{"A", "Annotate (in task editor)"},
 				{"n", "New task"},
 				{"D", "Delete task"},
 				{"m", "Move to board"},
+				{"o f", "Open the task's project directory"},
+				{"o b", "Open the task's project board"},
 				{"/", "Search"},
 				{"f", "Filter options"},
 				{"S", "Sort options"},
 				{"g", "Group options"},
+				{"g b", "Open the task's linked board (▦)"},
 				{"F", "File view"},
 				{"W", "Workspace filter"},
+				{"+", "Pick / drop the task for My Day (☀)"},
+				{"ctrl+l", "Toggle priority color legend"},
 			},
 		})
 	case ViewKanbanBoard:
@@ -954,25 +1685,45 @@ func (m AppModel) renderHelpOverlay() string {
 				{"h / l", "Navigate columns"},
 				{"j / k", "Navigate cards"},
 				{"enter", "Edit card"},
-				{"n", "New card"},
+				{"r", "Rename card"},
+				{"B", "Block / unblock card"},
+				{"ctrl+f", "Attach a file to the card"},
+				{"R", "Run a card action"},
+				{"f", "Capture a follow-up task for the card"},
+				{"v", "References: tasks, notes and cards linking to the card"},
+				{"I", "Import a markdown checklist as cards"},
+				{"n", "New card in the selected column"},
+				{"N", "New card in a chosen column"},
+				{"w", "New card from a URL, titled with the page's title"},
 				{"d", "Due date"},
+				{"> / <", "Due date +/- 1 day"},
+				{"} / {", "Due date +/- 1 week"},
 				{"s", "Scheduled date"},
 				{"t", "Tags"},
 				{"p", "Projects"},
-				{"i", "Priority"},
+				{"i", "Priority (a-f or 1-6, 0 clears)"},
 				{"U", "Edit URLs"},
-				{"u", "Open URL"},
+				{"o", "Open URL or attachment"},
+				{"u / ctrl+r", "Undo / redo the last card change"},
+				{"H", "Activity: recent card changes, new since the last visit marked"},
 				{"m / space", "Move card"},
-				{"M", "Move to board"},
+				{"M", "Move to board (any workspace, type to filter)"},
+				{"!", "Pin / unpin card (stays at the top of its column)"},
+				{"y", "Take card into the register (again: empty it)"},
+				{"P", "Put the register's card in this column (any board)"},
+				{"ctrl+t", "Move card to tasks"},
+				{"ctrl+b", "Switch board"},
 				{"D", "Delete card"},
 				{"c", "Edit columns"},
 				{"/", "Filter"},
+				{"tab (filtering)", "Also search card bodies"},
 				{"x", "Start work / switch to session"},
 				{"X", "Link tmux session"},
 				{"ctrl+j", "Link Jira board"},
 				{"J", "Link Jira issue to card"},
 				{"a", "Archive / unarchive card"},
 				{"ctrl+a", "Toggle show archived"},
+				{"ctrl+l", "Toggle priority color legend"},
 				{"esc / q", "Back"},
 			},
 		})
@@ -984,6 +1735,8 @@ func (m AppModel) renderHelpOverlay() string {
 				{"enter", "Open board"},
 				{"/", "Search"},
 				{"n", "New board"},
+				{"r", "Rename board"},
+				{"D", "Delete board (moves it to .trash)"},
 				{"a", "Archive / unarchive board"},
 				{"ctrl+a", "Toggle show archived"},
 			},
@@ -996,9 +1749,40 @@ func (m AppModel) renderHelpOverlay() string {
 				{"j / k", "Navigate items"},
 				{"t", "Jump to today"},
 				{"enter", "Open selected item"},
+				{"p", "Peek at selected item"},
+				{"a", "Actions: reschedule, complete, open, move to board, peek, copy link"},
+				{"s", "Show only tasks, cards, notes, project dates, then all"},
+				{"x", "Show / hide items parked by agenda_exclude"},
+				{"> / <", "Due date +/- 1 day"},
+				{"} / {", "Due date +/- 1 week"},
 				{"/", "Search"},
+				{":", "Command line (open/task/goto)"},
 			},
 		})
+		if m.currentView == ViewAgendaDay {
+			sections = append(sections, shared.HelpSection{
+				Title: "Day View",
+				Binds: []shared.HelpBind{
+					{"+", "Pick / drop the item for My Day"},
+					{"m", "Switch between My Day and the full agenda"},
+					{"space (My Day)", "Complete the task or move the card to Done"},
+				},
+			})
+		}
+		if m.currentView == ViewAgendaWeek {
+			sections = append(sections, shared.HelpSection{
+				Title: "Week View",
+				Binds: []shared.HelpBind{
+					{"J / K", "Next / previous day"},
+					{"1-7", "Jump to Monday-Sunday"},
+					{"gd m/t/w/r/f/s/u", "Jump to Monday-Sunday"},
+					{"A", "Month view (digits pick days here)"},
+					{"w", "Plan the week: schedule backlog tasks onto its days"},
+					{"n", "Open the selected day's journal note, creating it if needed"},
+					{"z", "Hide / show days with nothing scheduled"},
+				},
+			})
+		}
 	case ViewAgendaMonth:
 		sections = append(sections, shared.HelpSection{
 			Title: "Month View",
@@ -1007,8 +1791,20 @@ func (m AppModel) renderHelpOverlay() string {
 				{"j / k", "Previous / next week"},
 				{"H / L", "Previous / next month"},
 				{"t", "Jump to today"},
+				{"s", "Show only tasks, cards, notes, project dates, then all"},
+				{"x", "Show / hide items parked by agenda_exclude"},
 				{"enter", "Enter detail panel"},
 				{"esc", "Back to calendar"},
+				{":", "Command line (open/task/goto)"},
+			},
+		})
+	case ViewAgendaYear:
+		sections = append(sections, shared.HelpSection{
+			Title: "Year View",
+			Binds: []shared.HelpBind{
+				{"h / l", "Previous / next year"},
+				{"t", "Jump to today"},
+				{":", "Command line (open/task/goto)"},
 			},
 		})
 	case ViewNotes:
@@ -1017,11 +1813,23 @@ func (m AppModel) renderHelpOverlay() string {
 			Binds: []shared.HelpBind{
 				{"j / k", "Navigate"},
 				{"enter", "Open note in editor"},
+				{"t", "Open today's journal note"},
 				{"p", "Pin a new note"},
 				{"d", "Unpin selected note"},
 				{"esc", "Back"},
 			},
 		})
+	case ViewGoals:
+		sections = append(sections, shared.HelpSection{
+			Title: "Goals",
+			Binds: []shared.HelpBind{
+				{"h / l", "Previous / next month"},
+				{"t", "Jump to this month"},
+				{"j / k", "Navigate"},
+				{"e / enter", "Edit goals.md"},
+				{"esc", "Back"},
+			},
+		})
 	case ViewProjects:
 		sections = append(sections, shared.HelpSection{
 			Title: "Projects",
@@ -1051,6 +1859,7 @@ func (m AppModel) renderHelpOverlay() string {
 				{"u", "Open URL(s)"},
 				{"U", "Edit URLs"},
 				{"d", "Edit dates"},
+				{"r", "Rename project (shows what changes first)"},
 				{"esc / q", "Back to projects"},
 			},
 		})
@@ -0,0 +1,25 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tui/shared"
+)
+
+// clipboardHold is how long the clipboard sequence stays in the view: long
+// enough for the renderer to write a frame carrying it.
+const clipboardHold = 250 * time.Millisecond
+
+// clipboardSentMsg drops the clipboard sequence seq from the view.
+type clipboardSentMsg struct {
+	seq string
+}
+
+// handleClipboard puts the OSC 52 sequence for msg.Text in front of the view
+// for a moment, so the next frame writes it to the terminal.
+func (m AppModel) handleClipboard(msg shared.ClipboardMsg) (tea.Model, tea.Cmd) {
+	seq := shared.ClipboardSequence(msg.Text)
+	m.clipboard = seq
+	return m, tea.Tick(clipboardHold, func(time.Time) tea.Msg { return clipboardSentMsg{seq: seq} })
+}
@@ -0,0 +1,242 @@
+package tui
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/kanban/fs"
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/notes"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+	agendaview "wydo/internal/tui/agenda"
+	"wydo/internal/tui/shared"
+	"wydo/internal/tui/theme"
+	"wydo/internal/workspace"
+)
+
+// compactRefreshInterval is how often the compact pane reloads its data.
+const compactRefreshInterval = 30 * time.Second
+
+type compactTickMsg struct{}
+
+// CompactModel is a single pane for small terminals such as a tmux sidebar:
+// today's agenda, or one board column when a board is given. It rescans
+// the workspaces every compactRefreshInterval and on r.
+type CompactModel struct {
+	cfg        *config.Config
+	boardName  string // "" shows today's agenda
+	columnName string // "" shows the board's first column
+	title      string
+	lines      []string // rendered at the last refresh or resize
+	offset     int
+	width      int
+	height     int
+	err        error
+	refreshed  time.Time
+
+	overdue []agendapkg.AgendaItem
+	today   []agendapkg.AgendaItem
+	done    []agendapkg.AgendaItem
+	cards   []kanbanmodels.Card
+}
+
+// NewCompactModel creates the compact pane. With a boardName it shows that
+// board's columnName column, otherwise today's agenda.
+func NewCompactModel(cfg *config.Config, boardName, columnName string) CompactModel {
+	m := CompactModel{cfg: cfg, boardName: boardName, columnName: columnName, width: 40}
+	m.refresh()
+	return m
+}
+
+func (m CompactModel) Init() tea.Cmd {
+	return compactTick()
+}
+
+func compactTick() tea.Cmd {
+	return tea.Tick(compactRefreshInterval, func(time.Time) tea.Msg { return compactTickMsg{} })
+}
+
+func (m CompactModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.render()
+	case compactTickMsg:
+		m.refresh()
+		return m, compactTick()
+	case tea.KeyMsg:
+		switch msg.String() {
+		case "q", "ctrl+c", "esc":
+			return m, tea.Quit
+		case "r":
+			m.refresh()
+		case "j", "down":
+			m.scroll(1)
+		case "k", "up":
+			m.scroll(-1)
+		case "g":
+			m.offset = 0
+		case "G":
+			m.scroll(len(m.lines))
+		}
+	}
+	return m, nil
+}
+
+// refresh rescans the workspaces and reloads the agenda or board column.
+func (m *CompactModel) refresh() {
+	m.err = nil
+	m.refreshed = clock.Now()
+	workspaces, taskDirs := scanWorkspaces(m.cfg)
+	var boards []kanbanmodels.Board
+	var allNotes []notes.Note
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+		allNotes = append(allNotes, ws.Notes...)
+	}
+
+	if m.boardName != "" {
+		m.loadColumn(boards)
+	} else {
+		m.loadAgenda(workspaces, boards, allNotes, taskDirs)
+	}
+	m.render()
+}
+
+func (m *CompactModel) loadColumn(boards []kanbanmodels.Board) {
+	m.title = m.boardName
+	m.cards = nil
+	found, ok := findBoard(boards, m.boardName)
+	if !ok {
+		m.err = fmt.Errorf("board %q not found", m.boardName)
+		return
+	}
+	board, err := fs.ReadBoard(found.Path)
+	if err != nil {
+		m.err = err
+		return
+	}
+	if len(board.Columns) == 0 {
+		m.err = fmt.Errorf("board %q has no columns", board.Name)
+		return
+	}
+	col := board.Columns[0]
+	if m.columnName != "" {
+		i := -1
+		for j := range board.Columns {
+			if strings.EqualFold(board.Columns[j].Name, m.columnName) {
+				i = j
+			}
+		}
+		if i < 0 {
+			m.err = fmt.Errorf("board %q has no column %q", board.Name, m.columnName)
+			return
+		}
+		col = board.Columns[i]
+	}
+	m.title = board.Name + " / " + col.Name
+	for _, card := range col.Cards {
+		if !card.Archived {
+			m.cards = append(m.cards, card)
+		}
+	}
+}
+
+func (m *CompactModel) loadAgenda(workspaces []*workspace.Workspace, boards []kanbanmodels.Board, allNotes []notes.Note, taskDirs []scanner.TaskDirInfo) {
+	now := clock.Now()
+	m.title = now.Format("Mon Jan 2")
+	m.overdue, m.today, m.done = nil, nil, nil
+	if len(taskDirs) == 0 {
+		return
+	}
+	svc, err := service.NewTaskService(taskDirs)
+	if err != nil {
+		m.err = err
+		return
+	}
+	dateRange := agendapkg.DayRange(now)
+	for _, b := range agendapkg.ExcludeBuckets(agendapkg.QueryAgenda(svc, boards, allNotes, agendapkg.CollectProjectDates(workspaces), dateRange)) {
+		m.today = append(m.today, b.AllItems()...)
+		m.done = append(m.done, b.AllCompletedItems()...)
+	}
+	m.overdue = agendapkg.ExcludeItems(agendapkg.QueryOverdueItems(svc, boards, dateRange.Start))
+}
+
+// render rebuilds the pane's lines for the current data and width.
+func (m *CompactModel) render() {
+	m.lines = nil
+	if m.err != nil {
+		m.lines = append(m.lines, theme.Error.Render(m.err.Error()))
+	} else if m.boardName != "" {
+		for _, card := range m.cards {
+			m.lines = append(m.lines, compactCardLine(card, m.width))
+		}
+		if len(m.cards) == 0 {
+			m.lines = append(m.lines, theme.Muted.Render("No cards"))
+		}
+	} else {
+		m.addSection("Overdue", m.overdue)
+		m.addSection("Today", m.today)
+		m.addSection("Done", m.done)
+		if len(m.lines) == 0 {
+			m.lines = append(m.lines, theme.Muted.Render("Nothing today"))
+		}
+	}
+	m.scroll(0)
+}
+
+func (m *CompactModel) addSection(label string, items []agendapkg.AgendaItem) {
+	if len(items) == 0 {
+		return
+	}
+	m.lines = append(m.lines, theme.Muted.Render(label))
+	for _, item := range items {
+		m.lines = append(m.lines, agendaview.RenderItemLine(item, false, m.width))
+	}
+}
+
+// compactCardLine renders a card as its priority badge, title, due date and
+// blocked marker.
+func compactCardLine(card kanbanmodels.Card, width int) string {
+	var parts []string
+	if card.Priority > 0 {
+		parts = append(parts, shared.PriorityStyle(card.Priority).Render(fmt.Sprintf("(%d)", card.Priority)))
+	}
+	parts = append(parts, card.Title)
+	if card.DueDate != nil {
+		parts = append(parts, theme.Muted.Render("due "+card.DueDate.Format("01-02")))
+	}
+	if card.IsBlocked() {
+		parts = append(parts, theme.Error.Render("blocked"))
+	}
+	return lipgloss.NewStyle().MaxWidth(width).Render(" " + strings.Join(parts, " "))
+}
+
+// visibleLines is the number of body lines below the title line.
+func (m CompactModel) visibleLines() int {
+	return max(m.height-2, 1)
+}
+
+func (m *CompactModel) scroll(delta int) {
+	m.offset = max(min(m.offset+delta, len(m.lines)-m.visibleLines()), 0)
+}
+
+func (m CompactModel) View() string {
+	var b strings.Builder
+	header := TitleStyle.Render(m.title)
+	stamp := theme.Muted.Render(m.refreshed.Format("15:04"))
+	pad := max(m.width-lipgloss.Width(header)-lipgloss.Width(stamp), 1)
+	b.WriteString(header + strings.Repeat(" ", pad) + stamp + "\n\n")
+
+	end := min(m.offset+m.visibleLines(), len(m.lines))
+	b.WriteString(strings.Join(m.lines[m.offset:end], "\n"))
+	return b.String()
+}
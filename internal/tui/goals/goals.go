@@ -0,0 +1,231 @@
+package goals
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"wydo/internal/clock"
+	goalspkg "wydo/internal/goals"
+	"wydo/internal/tui/messages"
+	"wydo/internal/workspace"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const progressBarWidth = 20
+
+// goalRow is a goal with its computed progress.
+type goalRow struct {
+	goal   goalspkg.Goal
+	wsRoot string
+	done   int
+	total  int
+}
+
+// GoalsModel shows monthly goals and the progress of their linked tasks and cards.
+type GoalsModel struct {
+	workspaces []*workspace.Workspace
+	month      time.Time // first day of the displayed month
+	rows       []goalRow
+	cursor     int
+	width      int
+	height     int
+	err        error
+}
+
+// editorFinishedMsg is sent when the editor process exits.
+type editorFinishedMsg struct{ err error }
+
+func NewGoalsModel(workspaces []*workspace.Workspace) GoalsModel {
+	now := clock.Now()
+	m := GoalsModel{
+		month: time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local),
+	}
+	m.SetData(workspaces)
+	return m
+}
+
+// SetData rebuilds the goal rows from fresh workspace data.
+func (m *GoalsModel) SetData(workspaces []*workspace.Workspace) {
+	m.workspaces = workspaces
+	m.rebuild()
+}
+
+func (m *GoalsModel) SetSize(w, h int) {
+	m.width = w
+	m.height = h
+}
+
+func (m *GoalsModel) rebuild() {
+	m.rows = nil
+	monthKey := m.month.Format("2006-01")
+	for _, ws := range m.workspaces {
+		for _, g := range goalspkg.ForMonth(ws.Goals, monthKey) {
+			done, total := goalspkg.Progress(g, ws.Tasks, ws.Boards)
+			m.rows = append(m.rows, goalRow{goal: g, wsRoot: ws.RootDir, done: done, total: total})
+		}
+	}
+	if m.cursor >= len(m.rows) {
+		m.cursor = max(0, len(m.rows)-1)
+	}
+}
+
+// HintText returns the hint bar text.
+func (m GoalsModel) HintText() string {
+	return "h/l:month  t:this month  j/k:navigate  e/enter:edit goals.md  ?:help  q:quit"
+}
+
+func (m GoalsModel) Update(msg tea.Msg) (GoalsModel, tea.Cmd) {
+	switch msg := msg.(type) {
+	case editorFinishedMsg:
+		m.err = msg.err
+		return m, func() tea.Msg { return messages.DataRefreshMsg{} }
+
+	case tea.KeyMsg:
+		return m.updateList(msg)
+	}
+	return m, nil
+}
+
+func (m GoalsModel) updateList(msg tea.KeyMsg) (GoalsModel, tea.Cmd) {
+	switch msg.String() {
+	case "q":
+		return m, func() tea.Msg { return messages.RequestExitMsg{} }
+	case "esc":
+		return m, messages.SwitchView(messages.ViewAgendaDay)
+	case "j", "down":
+		if m.cursor < len(m.rows)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "h", "left":
+		m.month = m.month.AddDate(0, -1, 0)
+		m.cursor = 0
+		m.rebuild()
+	case "l", "right":
+		m.month = m.month.AddDate(0, 1, 0)
+		m.cursor = 0
+		m.rebuild()
+	case "t":
+		now := clock.Now()
+		m.month = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.Local)
+		m.cursor = 0
+		m.rebuild()
+	case "e", "enter":
+		return m, m.editGoals()
+	}
+	return m, nil
+}
+
+// editGoals opens the goals.md of the selected goal, or the first workspace's
+// goals.md when the month has no goals yet, ensuring the month heading exists.
+func (m *GoalsModel) editGoals() tea.Cmd {
+	path := ""
+	if m.cursor < len(m.rows) {
+		path = m.rows[m.cursor].goal.Path
+	} else if len(m.workspaces) > 0 {
+		path = filepath.Join(m.workspaces[0].RootDir, goalspkg.GoalsFilename)
+	}
+	if path == "" {
+		return nil
+	}
+	if err := goalspkg.EnsureMonthHeading(path, m.month.Format("2006-01")); err != nil {
+		m.err = err
+		return nil
+	}
+	return openFile(path)
+}
+
+func (m GoalsModel) View() string {
+	var lines []string
+	lines = append(lines, titleStyle.Render("Goals — "+m.month.Format("January 2006")))
+	lines = append(lines, "")
+
+	if len(m.rows) == 0 {
+		lines = append(lines, listItemStyle.Render("No goals for this month. Press 'e' to add some to goals.md."))
+		lines = append(lines, listItemStyle.Render(mutedStyle.Render("Link tasks with goal:<key> and cards with 'goal: <key>' frontmatter.")))
+	}
+
+	multiWs := len(m.workspaces) > 1
+	lastWs := ""
+	totalDone, totalItems := 0, 0
+	for i, row := range m.rows {
+		if multiWs && row.wsRoot != lastWs {
+			lines = append(lines, sectionHeaderStyle.Render("  "+abbreviatePath(row.wsRoot)))
+			lastWs = row.wsRoot
+		}
+		style := listItemStyle
+		prefix := "  "
+		if i == m.cursor {
+			style = selectedListItemStyle
+			prefix = "► "
+		}
+		title := row.goal.Title
+		if row.goal.Project != "" {
+			title += mutedStyle.Render("  +" + row.goal.Project)
+		}
+		lines = append(lines, style.Render(prefix+title))
+		lines = append(lines, "      "+renderProgress(row.done, row.total)+mutedStyle.Render("  goal:"+row.goal.Key))
+		totalDone += row.done
+		totalItems += row.total
+	}
+
+	if len(m.rows) > 0 {
+		lines = append(lines, "")
+		lines = append(lines, mutedStyle.Render(fmt.Sprintf("  %d goal(s) • %d/%d linked items done", len(m.rows), totalDone, totalItems)))
+	}
+	if m.err != nil {
+		lines = append(lines, "")
+		lines = append(lines, mutedStyle.Render("  Error: "+m.err.Error()))
+	}
+
+	lines = append(lines, "")
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
+// renderProgress renders a fixed-width progress bar with a done/total count.
+func renderProgress(done, total int) string {
+	if total == 0 {
+		return barTodoStyle.Render(strings.Repeat("░", progressBarWidth)) + mutedStyle.Render("  no linked items")
+	}
+	filled := done * progressBarWidth / total
+	bar := barDoneStyle.Render(strings.Repeat("█", filled)) + barTodoStyle.Render(strings.Repeat("░", progressBarWidth-filled))
+	count := fmt.Sprintf("  %d/%d", done, total)
+	if done == total {
+		return bar + completeStyle.Render(count+" ✓")
+	}
+	return bar + count
+}
+
+// openFile opens the given path in $EDITOR (fallback: vim).
+func openFile(absPath string) tea.Cmd {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vim"
+	}
+	c := exec.Command(editor, absPath)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return editorFinishedMsg{err: err}
+	})
+}
+
+// abbreviatePath replaces the home directory with ~.
+func abbreviatePath(path string) string {
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return path
+	}
+	if strings.HasPrefix(path, home) {
+		return "~" + path[len(home):]
+	}
+	return path
+}
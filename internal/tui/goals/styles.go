@@ -0,0 +1,30 @@
+package goals
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+var (
+	titleStyle = theme.Title.Padding(0, 1)
+
+	listItemStyle = lipgloss.NewStyle().
+			Foreground(theme.Text).
+			Padding(0, 2)
+
+	selectedListItemStyle = theme.Selected.Padding(0, 2)
+
+	mutedStyle = theme.Muted
+
+	sectionHeaderStyle = lipgloss.NewStyle().
+				Foreground(theme.Accent).
+				Bold(true)
+
+	barDoneStyle = lipgloss.NewStyle().Foreground(theme.Success)
+
+	barTodoStyle = theme.Muted
+
+	completeStyle = lipgloss.NewStyle().
+			Foreground(theme.Success).
+			Bold(true)
+)
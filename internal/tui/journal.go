@@ -0,0 +1,40 @@
+package tui
+
+import (
+	"os"
+	"os/exec"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	agendapkg "wydo/internal/agenda"
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/logs"
+	"wydo/internal/notes"
+)
+
+// openJournal creates day's journal note (today's for a zero day) if needed
+// and opens it in $EDITOR, reloading the data afterwards so the note shows up
+// in the agenda.
+func (m AppModel) openJournal(day time.Time) tea.Cmd {
+	if len(m.workspaces) == 0 {
+		return nil
+	}
+	if day.IsZero() {
+		day = clock.Now()
+	}
+	dir := config.Get().JournalDirFor(m.workspaces[0].RootDir)
+	path, _, err := notes.OpenJournal(dir, day, agendapkg.DayChecklist(m.taskSvc, m.workspaces, day))
+	if err != nil {
+		logs.Logger.Printf("Error opening journal: %v", err)
+		return nil
+	}
+
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vim"
+	}
+	return tea.ExecProcess(exec.Command(editor, path), func(error) tea.Msg {
+		return DataRefreshMsg{}
+	})
+}
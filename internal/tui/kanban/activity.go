@@ -0,0 +1,134 @@
+package kanban
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/clock"
+	"wydo/internal/stats"
+	"wydo/internal/tui/shared"
+)
+
+// ActivityModel is a popup listing the recent changes on a board, newest
+// first, with the ones since the last visit marked. enter goes to the card.
+type ActivityModel struct {
+	board     string
+	feed      []stats.Activity
+	lastVisit time.Time // zero for a board not visited before
+	now       time.Time
+	cursor    int
+	width     int
+	height    int
+}
+
+func NewActivityModel(board string, feed []stats.Activity, lastVisit time.Time) ActivityModel {
+	return ActivityModel{board: board, feed: feed, lastVisit: lastVisit, now: clock.Now()}
+}
+
+// Update handles key events. Returns (model, the activity whose card to
+// select or nil, done).
+func (m ActivityModel) Update(msg tea.KeyMsg) (ActivityModel, *stats.Activity, bool) {
+	switch msg.String() {
+	case "j", "down":
+		if m.cursor < len(m.feed)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		if m.cursor < len(m.feed) {
+			return m, &m.feed[m.cursor], true
+		}
+		return m, nil, true
+	case "esc", "q", "H":
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// isNew reports whether a happened since the last visit.
+func (m ActivityModel) isNew(a stats.Activity) bool {
+	return !m.lastVisit.IsZero() && a.At.After(m.lastVisit)
+}
+
+// View renders the feed as a centered modal.
+func (m ActivityModel) View() string {
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(72, m.width))
+	inner := box.GetWidth() - box.GetHorizontalFrameSize()
+
+	var lines []string
+	lines = append(lines, tagPickerTitleStyle.Render(shared.Truncate("Activity on "+m.board, inner)))
+	if !m.lastVisit.IsZero() {
+		n := 0
+		for _, a := range m.feed {
+			if m.isNew(a) {
+				n++
+			}
+		}
+		since := fmt.Sprintf("%d since your last visit (%s)", n, stats.FormatAge(m.now.Sub(m.lastVisit)))
+		lines = append(lines, cardPreviewStyle.Render(since))
+	}
+	lines = append(lines, "")
+
+	listStart := len(lines)
+	if len(m.feed) == 0 {
+		lines = append(lines, cardPreviewStyle.Render("No activity recorded on this board yet."))
+	}
+	for i, a := range m.feed {
+		marker := "  "
+		if m.isNew(a) {
+			marker = "• "
+		}
+		prefix := "  "
+		style := listItemStyle
+		if i == m.cursor {
+			prefix = "> "
+			style = selectedListItemStyle
+		}
+		age := fmt.Sprintf("%-9s", stats.FormatAge(m.now.Sub(a.At)))
+		label := shared.Truncate(prefix+marker+age+a.Card.Title+"  "+a.String(), inner)
+		lines = append(lines, style.Render(label))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render("j/k: navigate • enter: go to card • esc: close"))
+
+	lines = shared.FitModalList(lines, listStart, listStart+len(m.feed), m.cursor, box, m.height)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box.Render(content))
+}
+
+// SetLastVisit sets when the board was last visited before this visit, which
+// H marks the changes since. Zero for a board not visited before.
+func (m *BoardModel) SetLastVisit(at time.Time) {
+	m.lastVisit = at
+}
+
+// handleActivity opens the board's activity feed.
+func (m BoardModel) handleActivity() (BoardModel, tea.Cmd) {
+	activity := NewActivityModel(m.board.Name, stats.BoardActivity(m.board), m.lastVisit)
+	activity.width = m.width
+	activity.height = m.height
+	m.activity = &activity
+	m.mode = boardModeActivity
+	return m, nil
+}
+
+func (m BoardModel) updateActivity(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, selected, done := m.activity.Update(msg)
+	m.activity = &updated
+	if done {
+		m.mode = boardModeNormal
+		m.activity = nil
+	}
+	if selected != nil {
+		m.selectCard(selected.Column, selected.Card.Filename)
+		m.adjustScrollPosition()
+	}
+	return m, nil
+}
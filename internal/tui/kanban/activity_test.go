@@ -0,0 +1,55 @@
+package kanban
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/golden"
+	"wydo/internal/kanban/models"
+)
+
+func TestBoardActivity_FeedMarksNewAndSelectsCard(t *testing.T) {
+	golden.FixClock(t)
+	day := func(n int) time.Time { return golden.Now.AddDate(0, 0, -n) }
+	board := models.Board{Name: "Work", Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{
+			Filename:   "old.md",
+			Title:      "Old card",
+			History:    []models.ColumnEntry{{Column: "To Do", At: day(10)}},
+			DueHistory: []models.DueChange{{To: "2026-03-09", At: day(1)}},
+		}}},
+		{Name: "Done", Cards: []models.Card{{
+			Filename: "shipped.md",
+			Title:    "Shipped card",
+			History:  []models.ColumnEntry{{Column: "To Do", At: day(8)}, {Column: "Done", At: day(2)}},
+		}}},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 40)
+	m.SetLastVisit(day(3))
+
+	m = pressAll(m, runeKey("H"))
+	if m.mode != boardModeActivity {
+		t.Fatalf("mode = %v, want the activity feed", m.mode)
+	}
+	view := m.View()
+	for _, want := range []string{"2 since your last visit", "• 1d ago", "due set to Mar 9", "completed (To Do → Done)", "created in To Do"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("feed missing %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "• 8d ago") {
+		t.Errorf("expected changes before the last visit unmarked:\n%s", view)
+	}
+
+	// The second entry, newest first, is the card completed in Done
+	m = pressAll(m, runeKey("j"), tea.KeyMsg{Type: tea.KeyEnter})
+	if m.mode != boardModeNormal || m.activity != nil {
+		t.Fatalf("expected enter to close the feed, mode %v", m.mode)
+	}
+	if m.selectedCol != 1 || m.selectedCard != 0 {
+		t.Errorf("selection %d/%d, want the shipped card", m.selectedCol, m.selectedCard)
+	}
+}
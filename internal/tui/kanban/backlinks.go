@@ -0,0 +1,99 @@
+package kanban
+
+import (
+	"fmt"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/refs"
+	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
+)
+
+// BacklinksModel is a popup listing the tasks, notes and other cards that
+// refer to a card. enter jumps to the selected one.
+type BacklinksModel struct {
+	title  string
+	refs   []refs.Ref
+	cursor int
+	width  int
+	height int
+}
+
+func NewBacklinksModel(title string, backlinks []refs.Ref) BacklinksModel {
+	return BacklinksModel{title: title, refs: backlinks}
+}
+
+// Update handles key events. Returns (model, command to open the selected
+// reference, done).
+func (m BacklinksModel) Update(msg tea.KeyMsg) (BacklinksModel, tea.Cmd, bool) {
+	switch msg.String() {
+	case "j", "down":
+		if m.cursor < len(m.refs)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		if m.cursor < len(m.refs) {
+			return m, openRef(m.refs[m.cursor]), true
+		}
+		return m, nil, true
+	case "esc", "q", "v":
+		return m, nil, true
+	}
+	return m, nil, false
+}
+
+// openRef focuses a task in the task manager, opens a card on its board, or
+// opens a note in $EDITOR (fallback: vim).
+func openRef(ref refs.Ref) tea.Cmd {
+	switch ref.Kind {
+	case refs.KindTask:
+		return func() tea.Msg { return messages.FocusTaskMsg{TaskID: ref.TaskID} }
+	case refs.KindCard:
+		return func() tea.Msg {
+			return messages.OpenBoardMsg{BoardPath: ref.BoardPath, CardFile: filepath.Base(ref.Path)}
+		}
+	case refs.KindNote:
+		return shared.OpenInEditor(ref.Path)
+	}
+	return nil
+}
+
+// View renders the references as a centered modal.
+func (m BacklinksModel) View() string {
+	var lines []string
+
+	lines = append(lines, tagPickerTitleStyle.Render(fmt.Sprintf("References to %q", m.title)))
+	lines = append(lines, "")
+
+	listStart := len(lines)
+	if len(m.refs) == 0 {
+		lines = append(lines, cardPreviewStyle.Render("Nothing refers to this card yet."))
+	}
+	for i, ref := range m.refs {
+		label := fmt.Sprintf("%-4s %s", ref.Kind, ref.Title)
+		label += cardPreviewStyle.Render(" (" + ref.Via + ")")
+		style := listItemStyle
+		prefix := "  "
+		if i == m.cursor {
+			style = selectedListItemStyle
+			prefix = "> "
+		}
+		lines = append(lines, style.Render(prefix+label))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render("j/k: navigate • enter: open • esc: close"))
+
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(60, m.width))
+	lines = shared.FitModalList(lines, listStart, listStart+len(m.refs), m.cursor, box, m.height)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	boxed := box.Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
+}
@@ -0,0 +1,45 @@
+package kanban
+
+import (
+	"strings"
+	"testing"
+
+	"wydo/internal/refs"
+	"wydo/internal/tui/messages"
+)
+
+func TestBacklinksOpensSelectedRef(t *testing.T) {
+	m := NewBacklinksModel("Alpha", []refs.Ref{
+		{Kind: refs.KindTask, Title: "Follow up", Via: "card:", TaskID: "todo.txt:3"},
+		{Kind: refs.KindCard, Title: "Beta", Via: "[[link]]", Path: "/w/boards/b/cards/beta.md", BoardPath: "/w/boards/b"},
+	})
+	m.width, m.height = 80, 24
+
+	m, _, _ = m.Update(key("j"))
+	_, cmd, done := m.Update(key("enter"))
+	if !done || cmd == nil {
+		t.Fatalf("enter: done=%v cmd=%v", done, cmd)
+	}
+	// Cards are found by file, so moves since the index was built don't matter
+	want := messages.OpenBoardMsg{BoardPath: "/w/boards/b", CardFile: "beta.md"}
+	if got := cmd(); got != want {
+		t.Errorf("enter on a card = %#v, want %#v", got, want)
+	}
+
+	m.cursor = 0
+	_, cmd, _ = m.Update(key("enter"))
+	if got := cmd(); got != (messages.FocusTaskMsg{TaskID: "todo.txt:3"}) {
+		t.Errorf("enter on a task = %#v", got)
+	}
+}
+
+func TestBacklinksEmpty(t *testing.T) {
+	m := NewBacklinksModel("Alpha", nil)
+	m.width, m.height = 80, 24
+	if view := m.View(); !strings.Contains(view, "Nothing refers to this card yet.") {
+		t.Errorf("view = %q", view)
+	}
+	if _, cmd, done := m.Update(key("enter")); !done || cmd != nil {
+		t.Errorf("enter with nothing listed: done=%v cmd=%v", done, cmd)
+	}
+}
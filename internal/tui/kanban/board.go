@@ -2,11 +2,12 @@ package kanban
 
 import (
 	"fmt"
-	"os"
-	"os/exec"
 	"path/filepath"
+	"sort"
 	"strings"
 	"time"
+	"wydo/internal/attachments"
+	"wydo/internal/clock"
 	"wydo/internal/config"
 	"wydo/internal/kanban/fs"
 	"wydo/internal/kanban/models"
@@ -37,6 +38,7 @@ const (
 	boardModePriorityInput
 	boardModeFilter
 	boardModeBoardMove
+	boardModeBoardSwitch
 	boardModeTmuxPicker
 	boardModeTmuxLaunch
 	boardModeSessionCreate
@@ -45,6 +47,18 @@ const (
 	boardModeJiraIssue
 	boardModeJiraLoading
 	boardModeProjectLink
+	boardModeRename
+	boardModeBlocked
+	boardModeNewCardColumn
+	boardModeCardConflict
+	boardModeActionPicker
+	boardModeTaskCapture
+	boardModeImportMarkdown
+	boardModeMissing
+	boardModeBacklinks
+	boardModeAttach
+	boardModeCardFromURL
+	boardModeActivity
 )
 
 func (m boardMode) String() string {
@@ -75,6 +89,8 @@ func (m boardMode) String() string {
 		return "FILTER"
 	case boardModeBoardMove:
 		return "BOARD"
+	case boardModeBoardSwitch:
+		return "SWITCH"
 	case boardModeTmuxPicker:
 		return "TMUX"
 	case boardModeTmuxLaunch:
@@ -85,6 +101,30 @@ func (m boardMode) String() string {
 		return "JIRA"
 	case boardModeProjectLink:
 		return "LINK PROJECT"
+	case boardModeRename:
+		return "RENAME"
+	case boardModeBlocked:
+		return "BLOCKED"
+	case boardModeNewCardColumn:
+		return "NEW CARD"
+	case boardModeCardConflict:
+		return "CONFLICT"
+	case boardModeActionPicker:
+		return "RUN"
+	case boardModeTaskCapture:
+		return "CAPTURE"
+	case boardModeImportMarkdown:
+		return "IMPORT"
+	case boardModeMissing:
+		return "MISSING"
+	case boardModeBacklinks:
+		return "REFS"
+	case boardModeAttach:
+		return "ATTACH"
+	case boardModeCardFromURL:
+		return "NEW CARD"
+	case boardModeActivity:
+		return "ACTIVITY"
 	default:
 		return "NORMAL"
 	}
@@ -98,7 +138,7 @@ func (m boardMode) modeColor() lipgloss.Color {
 		return theme.Warning
 	case boardModeFilter:
 		return theme.Secondary
-	case boardModeConfirmDelete:
+	case boardModeConfirmDelete, boardModeBlocked, boardModeCardConflict, boardModeMissing:
 		return theme.Danger
 	case boardModeTmuxPicker, boardModeTmuxLaunch, boardModeSessionCreate:
 		return theme.Success
@@ -129,31 +169,64 @@ type BoardModel struct {
 	urlEditor              *URLEditorModel
 	dueDatePicker          *shared.DatePickerModel
 	scheduledDatePicker    *shared.DatePickerModel
-	priorityInput          *PriorityInputModel
+	priorityInput          *shared.PriorityPickerModel
+	cardRename             *CardRenameModel
+	cardBlocked            *CardBlockedModel
+	cardAttach             *CardAttachModel
+	cardFromURL            *CardFromURLModel
+	viewStates             ViewStates          // per-board cursors, shared with the app
+	history                *operations.History // u / ctrl+r; kept per board in viewStates
+	taskCapture            *TaskCaptureModel
+	markdownImport         *MarkdownImportModel
+	newCardColumnPicker    *ColumnPickerModel
 	deleteConfirm          *DeleteConfirmModel
-	columnScrollOffsets    []int // scroll position (card index) for each column
-	columnCursorPos        []int // cursor position (card index) for each column
-	columnHorizontalOffset int   // horizontal scroll offset (first visible column index)
+	cardConflict           *CardConflictModel
+	actionPicker           *ActionPickerModel
+	backlinks              *BacklinksModel
+	activity               *ActivityModel
+	lastVisit              time.Time                              // previous visit to the board, see SetLastVisit
+	conflictThen           func(BoardModel) (BoardModel, tea.Cmd) // edit to open once a conflict is resolved
+	columnScrollOffsets    []int                                  // scroll position (card index) for each column
+	columnCursorPos        []int                                  // cursor position (card index) for each column
+	columnHorizontalOffset int                                    // horizontal scroll offset (first visible column index)
 	filterInput            textinput.Model
 	filterQuery            string
 	filterActive           bool
 	filteredIndices        [][]int // per-column: original card indices that match
+	filterBodies           bool    // the filter also matches words in card bodies (tab in filter mode)
+	bodyCache              map[string]cardBodyText
+	searchCache            map[string]cardSearchText // lowercased fuzzy search strings per card file
+	filterNarrow           *filterNarrowing          // last result, narrowed when the query grows
+	highlightMatches       bool                      // underline matched title characters while filtering
+	titleMatches           map[string][]int          // card file -> matched byte offsets in its title
 	allBoards              []models.Board
+	recentBoards           []string             // board paths, most recent first (orders the ctrl+b switcher)
+	boardInfo              map[string]BoardInfo // board path -> workspace and linked projects, for M and ctrl+b
 	boardSelector          *BoardSelectorModel
+	register               *messages.CardRegister     // card taken with y, put with P (kept by the app across boards)
+	trashedCopy            string                     // trashed copy of the missing board, restored with r ("" = none)
+	newCards               map[string]map[string]bool // column -> card files that arrived since the last visit, see SetSeenCards
 	tmuxPicker             *TmuxPickerModel
 	tmuxLaunch             *TmuxLaunchModel
 	sessionCreate          *SessionCreateModel
 	boardProjects          []string
+	moveFromCol            int // column and visible index the moving card started at
+	moveFromCard           int
+	defaultTags            []string // added to new cards, from the board projects' default_tags
 	showArchived           bool
-	tmuxSessions           map[string]bool   // cached set of active tmux session names
-	claudeStatus           map[string]string // session name -> "waiting" | "running"
+	showLegend             bool                       // priority color legend on the filter line
+	tmuxSessions           map[string]bool            // cached set of active tmux session names
+	claudeStatus           map[string]string          // session name -> "waiting" | "running"
+	cardCache              *cardRenderCache           // memoized renderCard output
+	columnCache            *columnRenderCache         // last frame of each column, see renderColumn
+	tmuxInfo               map[string]tmuxSessionInfo // windows, clients and last activity of the sessions in tmuxSessions
 	jiraSetup              *JiraSetupModel
 	jiraBoardPicker        *JiraBoardPickerModel
 	jiraIssueInput         *JiraIssueInputModel
 }
 
 func NewBoardModel(board models.Board, allProjects []ProjectPickerItem, allBoards []models.Board, boardProjects []string) BoardModel {
-	return BoardModel{
+	m := BoardModel{
 		board:                  board,
 		allProjects:            allProjects,
 		allBoards:              allBoards,
@@ -164,6 +237,26 @@ func NewBoardModel(board models.Board, allProjects []ProjectPickerItem, allBoard
 		columnScrollOffsets:    make([]int, len(board.Columns)),
 		columnCursorPos:        make([]int, len(board.Columns)),
 		columnHorizontalOffset: 0,
+		cardCache:              newCardRenderCache(),
+		columnCache:            newColumnRenderCache(),
+		history:                operations.NewHistory(),
+	}
+	m.autoArchiveDone()
+	return m
+}
+
+// autoArchiveDone archives old cards in the Done column when the board sets
+// auto_archive_done_after, and reports how many it archived.
+func (m *BoardModel) autoArchiveDone() {
+	n, err := operations.AutoArchiveDoneCards(&m.board, clock.Now())
+	if err != nil {
+		m.message = fmt.Sprintf("Auto-archive failed: %v", err)
+		return
+	}
+	if n == 1 {
+		m.message = "Auto-archived 1 done card"
+	} else if n > 1 {
+		m.message = fmt.Sprintf("Auto-archived %d done cards", n)
 	}
 }
 
@@ -175,6 +268,34 @@ func (m *BoardModel) SetSize(width, height int) {
 		m.sessionCreate.width = width
 		m.sessionCreate.height = height
 	}
+	// Open modals follow the window so they keep fitting when it shrinks
+	if m.columnEditor != nil {
+		m.columnEditor.width = width
+		m.columnEditor.height = height
+	}
+	if m.urlEditor != nil {
+		m.urlEditor.SetSize(width, height)
+	}
+	if m.tagPicker != nil {
+		m.tagPicker.SetSize(width, height)
+	}
+	if m.projectPicker != nil {
+		m.projectPicker.SetSize(width, height)
+	}
+	if m.boardProjectPicker != nil {
+		m.boardProjectPicker.SetSize(width, height)
+	}
+	if m.priorityInput != nil {
+		m.priorityInput.SetSize(width, height)
+	}
+	if m.markdownImport != nil {
+		m.markdownImport.width = width
+		m.markdownImport.height = height
+	}
+	if m.activity != nil {
+		m.activity.width = width
+		m.activity.height = height
+	}
 	m.adjustScrollPosition()
 	m.adjustHorizontalScrollPosition()
 }
@@ -182,6 +303,12 @@ func (m *BoardModel) SetSize(width, height int) {
 // SetBoard updates the board data
 func (m *BoardModel) SetBoard(board models.Board) {
 	m.board = board
+	if m.mode == boardModeMissing {
+		// The board came back, e.g. restored by a sync tool
+		m.mode = boardModeNormal
+		m.trashedCopy = ""
+	}
+	m.filterNarrow = nil // cards may have changed under the same file names
 	m.reloadBoardState()
 }
 
@@ -190,6 +317,11 @@ func (m BoardModel) BoardPath() string {
 	return m.board.Path
 }
 
+// SetRecentBoards sets the recently opened board paths used to order the board switcher.
+func (m *BoardModel) SetRecentBoards(paths []string) {
+	m.recentBoards = paths
+}
+
 // SetAllProjects updates the list of available projects shown in the project picker.
 func (m *BoardModel) SetAllProjects(allProjects []ProjectPickerItem) {
 	m.allProjects = allProjects
@@ -201,6 +333,22 @@ func (m *BoardModel) SetBoardProjects(projects []string) {
 	m.boardProjects = projects
 }
 
+// SetBoardInfo sets the workspace and linked projects of every board, used to
+// group the board selectors and to link projects when moving a card.
+func (m *BoardModel) SetBoardInfo(info map[string]BoardInfo) {
+	m.boardInfo = info
+}
+
+// SetDefaultTags sets the tags added to cards created with n or N.
+func (m *BoardModel) SetDefaultTags(tags []string) {
+	m.defaultTags = tags
+}
+
+// Cursor returns the selected column and card indices.
+func (m BoardModel) Cursor() (col, card int) {
+	return m.selectedCol, m.selectedCard
+}
+
 // NavigateTo positions the cursor at a specific column and card
 func (m *BoardModel) NavigateTo(colIndex, cardIndex int) {
 	if colIndex >= 0 && colIndex < len(m.board.Columns) {
@@ -214,13 +362,74 @@ func (m *BoardModel) NavigateTo(colIndex, cardIndex int) {
 	}
 }
 
+// SelectCardFile selects the card stored in filename, in whichever column it
+// is in.
+func (m *BoardModel) SelectCardFile(filename string) {
+	for _, col := range m.board.Columns {
+		for _, card := range col.Cards {
+			if card.Filename == filename {
+				m.selectCard(col.Name, filename)
+				m.adjustScrollPosition()
+				return
+			}
+		}
+	}
+}
+
 // IsModal returns true if the board is in a modal mode (picking tags, editing, etc.)
 func (m BoardModel) IsModal() bool {
-	return m.mode != boardModeNormal
+	// The missing-board screen leaves the global keys to the app
+	return m.mode != boardModeNormal && m.mode != boardModeMissing
+}
+
+// modalHelp is the help of the picker open in the current mode.
+type modalHelp interface {
+	IsTyping() bool
+	HelpEntries() []shared.HelpBind
+	HintText() string
+}
+
+// activeModalHelp returns the open picker that can describe its own keys,
+// with a title for its help section.
+func (m BoardModel) activeModalHelp() (modalHelp, string) {
+	switch {
+	case m.mode == boardModeTagEdit && m.tagPicker != nil:
+		return m.tagPicker, "Edit Tags"
+	case m.mode == boardModeProjectEdit && m.projectPicker != nil:
+		return m.projectPicker, "Edit Projects"
+	case m.mode == boardModeProjectLink && m.boardProjectPicker != nil:
+		return m.boardProjectPicker, "Link Board to Project"
+	case m.mode == boardModeDueDateEdit && m.dueDatePicker != nil:
+		return datePickerHelp{m.dueDatePicker}, "Due Date"
+	case m.mode == boardModeScheduledDateEdit && m.scheduledDatePicker != nil:
+		return datePickerHelp{m.scheduledDatePicker}, "Scheduled Date"
+	case m.mode == boardModeTmuxPicker && m.tmuxPicker != nil:
+		return m.tmuxPicker, "Link Tmux Session"
+	}
+	return nil, ""
+}
+
+// datePickerHelp adapts the shared date picker to modalHelp.
+type datePickerHelp struct{ *shared.DatePickerModel }
+
+func (d datePickerHelp) IsTyping() bool { return d.IsTextInputActive() }
+
+// ModalHelp returns the help section for the open picker, so ? shows its
+// keys instead of the board's. ok is false when no picker is open or it
+// is taking typed text.
+func (m BoardModel) ModalHelp() (section shared.HelpSection, ok bool) {
+	help, title := m.activeModalHelp()
+	if help == nil || help.IsTyping() {
+		return shared.HelpSection{}, false
+	}
+	return shared.HelpSection{Title: title, Binds: help.HelpEntries()}, true
 }
 
 // HintText returns the raw hint string for the current board mode.
 func (m BoardModel) HintText() string {
+	if help, _ := m.activeModalHelp(); help != nil {
+		return help.HintText()
+	}
 	switch m.mode {
 	case boardModeMove:
 		return "h/l:move card  j/k:reorder  enter:open  esc:cancel"
@@ -230,7 +439,7 @@ func (m BoardModel) HintText() string {
 		if m.filterActive {
 			return "?:help  /:edit filter  esc:clear filter"
 		}
-		return "?:help  /:filter  space/m:move  L:link project  esc:back"
+		return "?:help  /:filter  space/m:move  L:link project  ctrl+b:switch board  esc:back"
 	}
 }
 
@@ -273,13 +482,24 @@ func (m BoardModel) initJiraRefresh() tea.Cmd {
 
 // Update handles board events as a child view
 func (m BoardModel) Update(msg tea.Msg) (BoardModel, tea.Cmd) {
+	m, cmd := m.update(msg)
+	// An operation that failed because the board's directory went away
+	// switches to the missing-board screen instead of failing again
+	if m.err != nil && m.CheckMissing() {
+		return m, tea.Batch(cmd, boardMissingCmd(m.board.Path))
+	}
+	return m, cmd
+}
+
+func (m BoardModel) update(msg tea.Msg) (BoardModel, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tmuxSessionsMsg:
 		set := make(map[string]bool, len(msg.sessions))
-		for _, s := range msg.sessions {
+		for s := range msg.sessions {
 			set[s] = true
 		}
 		m.tmuxSessions = set
+		m.tmuxInfo = msg.sessions
 		m.claudeStatus = msg.claudeStatus
 		return m, scheduleTmuxRefresh()
 
@@ -374,6 +594,18 @@ func (m BoardModel) Update(msg tea.Msg) (BoardModel, tea.Cmd) {
 		}
 		return m, nil
 
+	case pageTitleMsg:
+		return m.applyPageTitle(msg), nil
+
+	case cardActionFinishedMsg:
+		m.message, m.err = actionResult(msg)
+		// The command may have edited the card or board
+		if board, err := fs.ReadBoard(m.board.Path); err == nil {
+			m.board = board
+			m.reloadBoardState()
+		}
+		return m, nil
+
 	case tea.KeyMsg:
 		switch m.mode {
 		case boardModeNormal:
@@ -398,10 +630,36 @@ func (m BoardModel) Update(msg tea.Msg) (BoardModel, tea.Cmd) {
 			return m.updateScheduledDateEdit(msg)
 		case boardModePriorityInput:
 			return m.updatePriorityInput(msg)
+		case boardModeRename:
+			return m.updateRename(msg)
+		case boardModeBlocked:
+			return m.updateBlocked(msg)
+		case boardModeAttach:
+			return m.updateAttach(msg)
+		case boardModeCardFromURL:
+			return m.updateCardFromURL(msg)
+		case boardModeTaskCapture:
+			return m.updateTaskCapture(msg)
+		case boardModeImportMarkdown:
+			return m.updateMarkdownImport(msg)
+		case boardModeMissing:
+			return m.updateMissing(msg)
+		case boardModeNewCardColumn:
+			return m.updateNewCardColumn(msg)
+		case boardModeCardConflict:
+			return m.updateCardConflict(msg)
+		case boardModeActionPicker:
+			return m.updateActionPicker(msg)
+		case boardModeBacklinks:
+			return m.updateBacklinks(msg)
+		case boardModeActivity:
+			return m.updateActivity(msg)
 		case boardModeFilter:
 			return m.updateFilter(msg)
 		case boardModeBoardMove:
 			return m.updateBoardMove(msg)
+		case boardModeBoardSwitch:
+			return m.updateBoardSwitch(msg)
 		case boardModeTmuxPicker:
 			return m.updateTmuxPicker(msg)
 		case boardModeTmuxLaunch:
@@ -528,6 +786,7 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	case "m", " ":
 		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
 			m.mode = boardModeMove
+			m.moveFromCol, m.moveFromCard = m.selectedCol, m.selectedCard
 		}
 
 	case "enter":
@@ -536,29 +795,57 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	case "n":
 		return m.handleNew()
 
+	case "v":
+		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
+			return m.handleBacklinks()
+		}
+
+	case "w":
+		if m.selectedCol < len(m.board.Columns) {
+			return m.handleCardFromURL()
+		}
+
+	case "N":
+		if len(m.board.Columns) > 0 {
+			picker := NewColumnPickerModel(m.board)
+			picker.width = m.width
+			picker.height = m.height
+			m.newCardColumnPicker = &picker
+			m.mode = boardModeNewCardColumn
+		}
+
 	case "d":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleDueDateEdit()
+			return m.guardCard(BoardModel.handleDueDateEdit)
 		}
 
 	case "t":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleTagEdit()
+			return m.guardCard(BoardModel.handleTagEdit)
 		}
 
 	case "p":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleProjectEdit()
+			return m.guardCard(BoardModel.handleProjectEdit)
 		}
 
-	case "u":
+	case "o":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
 			return m.handleOpenURL()
 		}
 
+	case "H":
+		return m.handleActivity()
+
+	case "u":
+		m.undo()
+
+	case "ctrl+r":
+		m.redo()
+
 	case "U":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleURLEdit()
+			return m.guardCard(BoardModel.handleURLEdit)
 		}
 
 	case "D":
@@ -575,12 +862,12 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 
 	case "s":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleScheduledDateEdit()
+			return m.guardCard(BoardModel.handleScheduledDateEdit)
 		}
 
 	case "i":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handlePriorityEdit()
+			return m.guardCard(BoardModel.handlePriorityEdit)
 		}
 
 	case "c":
@@ -591,15 +878,68 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	case "C":
 		return m.handleColumnEdit()
 
+	case "r":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.guardCard(BoardModel.handleRename)
+		}
+
+	case "f":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.handleTaskCapture()
+		}
+
+	case "B":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.guardCard(BoardModel.handleBlocked)
+		}
+
+	case "ctrl+f":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.guardCard(BoardModel.handleAttach)
+		}
+
+	case "!":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.togglePin()
+		}
+
+	case "I":
+		if len(m.board.Columns) > 0 {
+			importer := NewMarkdownImportModel(m.board)
+			importer.width = m.width
+			importer.height = m.height
+			m.markdownImport = &importer
+			m.mode = boardModeImportMarkdown
+			return m, importer.Init()
+		}
+
+	case "R":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.handleActions()
+		}
+
 	case "M":
 		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
 			return m.handleBoardMove()
 		}
 
+	case "y":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			return m.yankCard()
+		}
+
+	case "P":
+		if m.selectedCol < len(m.board.Columns) {
+			return m.putCard()
+		}
+
 	case "a":
 		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-			if err := operations.ToggleCardArchive(&m.board, m.selectedCol, realIdx); err != nil {
+			err := m.trackCard(m.selectedCol, realIdx, "archive", func() error {
+				return operations.ToggleCardArchive(&m.board, m.selectedCol, realIdx)
+			})
+			if err != nil {
 				m.err = err
 			} else {
 				if m.board.Columns[m.selectedCol].Cards[realIdx].Archived {
@@ -624,7 +964,7 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 
 	case "X":
 		if m.selectedCol < len(m.board.Columns) && m.selectedCard < len(m.getVisibleCards(m.selectedCol)) {
-			return m.handleTmuxEdit()
+			return m.guardCard(BoardModel.handleTmuxEdit)
 		}
 
 	case "ctrl+a":
@@ -632,9 +972,42 @@ func (m BoardModel) updateNormal(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		m.clampFilteredCursors()
 		m.adjustScrollPosition()
 
+	case "ctrl+l":
+		m.showLegend = !m.showLegend
+
+	case "ctrl+b":
+		return m.handleBoardSwitch()
+
 	case "ctrl+j":
 		return m.handleJiraLink()
 
+	case ">", "<", "}", "{":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			days, _ := shared.DueBumpDays(msg.String())
+			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+			var due time.Time
+			err := m.trackCard(m.selectedCol, realIdx, "due date change", func() (err error) {
+				due, err = operations.BumpCardDueDate(&m.board, m.selectedCol, realIdx, days)
+				return err
+			})
+			if err != nil {
+				m.err = err
+			} else {
+				m.message = "Due " + due.Format("Mon Jan 2")
+			}
+		}
+
+	case "ctrl+t":
+		if m.selectedCol < len(m.board.Columns) && len(m.getVisibleCards(m.selectedCol)) > 0 {
+			card := m.board.Columns[m.selectedCol].Cards[m.resolveCardIndex(m.selectedCol, m.selectedCard)]
+			model := NewMoveToTasksConfirmModel(card.Title)
+			model.width = m.width
+			model.height = m.height
+			m.deleteConfirm = &model
+			m.mode = boardModeConfirmDelete
+			return m, m.deleteConfirm.Init()
+		}
+
 	case "L":
 		return m.handleBoardProjectLink()
 
@@ -660,7 +1033,10 @@ func (m BoardModel) updateMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	case "h", "left":
 		if m.selectedCol > 0 {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-			if err := operations.MoveCard(&m.board, m.selectedCol, realIdx, m.selectedCol-1); err != nil {
+			err := m.trackCard(m.selectedCol, realIdx, "move", func() error {
+				return operations.MoveCard(&m.board, m.selectedCol, realIdx, m.selectedCol-1)
+			})
+			if err != nil {
 				m.err = err
 			} else {
 				m.selectedCol--
@@ -679,7 +1055,10 @@ func (m BoardModel) updateMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	case "l", "right":
 		if m.selectedCol < len(m.board.Columns)-1 {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-			if err := operations.MoveCard(&m.board, m.selectedCol, realIdx, m.selectedCol+1); err != nil {
+			err := m.trackCard(m.selectedCol, realIdx, "move", func() error {
+				return operations.MoveCard(&m.board, m.selectedCol, realIdx, m.selectedCol+1)
+			})
+			if err != nil {
 				m.err = err
 			} else {
 				m.selectedCol++
@@ -696,34 +1075,67 @@ func (m BoardModel) updateMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		}
 
 	case "j", "down":
-		realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-		col := m.board.Columns[m.selectedCol]
-		if realIdx < len(col.Cards)-1 {
-			if err := operations.ReorderCard(&m.board, m.selectedCol, realIdx, realIdx+1); err != nil {
-				m.err = err
-			} else {
-				m.selectedCard++
-				m.columnCursorPos[m.selectedCol] = m.selectedCard
-				m.adjustScrollPosition()
-			}
-		}
+		m.swapWithVisibleCard(1)
 
 	case "k", "up":
-		realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-		if realIdx > 0 {
-			if err := operations.ReorderCard(&m.board, m.selectedCol, realIdx, realIdx-1); err != nil {
-				m.err = err
-			} else {
-				m.selectedCard--
-				m.columnCursorPos[m.selectedCol] = m.selectedCard
-				m.adjustScrollPosition()
-			}
-		}
+		m.swapWithVisibleCard(-1)
 	}
 
 	return m, nil
 }
 
+// swapWithVisibleCard swaps the selected card with the card shown delta
+// positions away, skipping hidden cards. Pinned cards only swap with pinned
+// cards, since pinning would undo the move.
+func (m *BoardModel) swapWithVisibleCard(delta int) {
+	indices := m.getVisibleCardIndices(m.selectedCol)
+	target := m.selectedCard + delta
+	if m.selectedCard >= len(indices) || target < 0 || target >= len(indices) {
+		return
+	}
+	cards := m.board.Columns[m.selectedCol].Cards
+	if cards[indices[m.selectedCard]].Pinned != cards[indices[target]].Pinned {
+		m.message = "Pinned cards stay above the others (! to unpin)"
+		return
+	}
+	swap, err := operations.SwapCards(&m.board, m.selectedCol, indices[m.selectedCard], indices[target])
+	if err != nil {
+		m.err = err
+		return
+	}
+	m.history.Record(swap)
+	m.selectedCard = target
+	m.columnCursorPos[m.selectedCol] = m.selectedCard
+	m.adjustScrollPosition()
+}
+
+// togglePin pins or unpins the selected card and keeps the cursor on it as
+// it moves within the column.
+func (m BoardModel) togglePin() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	err := m.trackCard(m.selectedCol, realIdx, "pin", func() error {
+		return operations.ToggleCardPin(&m.board, m.selectedCol, realIdx)
+	})
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	if m.board.Columns[m.selectedCol].Cards[realIdx].Pinned {
+		m.message = "Card pinned to the top"
+	} else {
+		m.message = "Card unpinned"
+	}
+	for i, idx := range m.getVisibleCardIndices(m.selectedCol) {
+		if idx == realIdx {
+			m.selectedCard = i
+			break
+		}
+	}
+	m.columnCursorPos[m.selectedCol] = m.selectedCard
+	m.adjustScrollPosition()
+	return m, nil
+}
+
 func (m BoardModel) updateConfirmDelete(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	if m.deleteConfirm == nil {
 		m.mode = boardModeNormal
@@ -733,9 +1145,21 @@ func (m BoardModel) updateConfirmDelete(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	updated, confirmed, cancelled := m.deleteConfirm.Update(msg)
 	*m.deleteConfirm = updated
 
+	if confirmed && m.deleteConfirm.toTasks {
+		m.mode = boardModeNormal
+		m.deleteConfirm = nil
+		card := m.board.Columns[m.selectedCol].Cards[m.resolveCardIndex(m.selectedCol, m.selectedCard)]
+		boardPath := m.board.Path
+		return m, func() tea.Msg {
+			return messages.MoveCardToTasksMsg{BoardPath: boardPath, Filename: card.Filename}
+		}
+	}
 	if confirmed {
 		realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-		if err := operations.DeleteCard(&m.board, m.selectedCol, realIdx); err != nil {
+		err := m.trackCard(m.selectedCol, realIdx, "delete", func() error {
+			return operations.DeleteCard(&m.board, m.selectedCol, realIdx)
+		})
+		if err != nil {
 			m.err = err
 		} else {
 			m.message = "Card deleted"
@@ -782,6 +1206,14 @@ func (m BoardModel) updateFilter(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		m.mode = boardModeNormal
 		return m, nil
 
+	case "tab":
+		m.filterBodies = !m.filterBodies
+		if m.filterActive {
+			m.recomputeFilter()
+			m.clampFilteredCursors()
+		}
+		return m, nil
+
 	case "esc":
 		// Clear filter entirely
 		m.filterQuery = ""
@@ -818,7 +1250,7 @@ type editorFinishedMsg struct {
 
 // tmuxSessionsMsg is sent when the background tmux session list fetch completes.
 type tmuxSessionsMsg struct {
-	sessions     []string
+	sessions     map[string]tmuxSessionInfo
 	claudeStatus map[string]string // session name -> "waiting" | "running"
 }
 
@@ -831,7 +1263,7 @@ type claudeStatusMsg struct {
 func fetchTmuxSessionsCmd() tea.Cmd {
 	return func() tea.Msg {
 		return tmuxSessionsMsg{
-			sessions:     listTmuxSessions(),
+			sessions:     listTmuxSessionInfo(),
 			claudeStatus: readClaudeStatus(),
 		}
 	}
@@ -841,7 +1273,7 @@ func fetchTmuxSessionsCmd() tea.Cmd {
 func scheduleTmuxRefresh() tea.Cmd {
 	return tea.Tick(5*time.Second, func(t time.Time) tea.Msg {
 		return tmuxSessionsMsg{
-			sessions:     listTmuxSessions(),
+			sessions:     listTmuxSessionInfo(),
 			claudeStatus: readClaudeStatus(),
 		}
 	})
@@ -854,13 +1286,11 @@ func scheduleClaudeStatusRefresh() tea.Cmd {
 	})
 }
 
-func openEditor(boardPath, filename string) tea.Cmd {
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = "vim"
-	}
-	cardPath := filepath.Join(boardPath, "cards", filename)
-	c := exec.Command(editor, cardPath)
+// openEditor opens a card of the board in the board's editor, else the
+// card_editor configured for its workspace or globally, else $EDITOR.
+func (m BoardModel) openEditor(filename string) tea.Cmd {
+	fallback := config.Get().CardEditorFor(m.boardInfo[m.board.Path].Workspace)
+	c := operations.CardEditorCommand(m.board, filename, fallback)
 	return tea.ExecProcess(c, func(err error) tea.Msg {
 		return editorFinishedMsg{err: err}
 	})
@@ -880,7 +1310,7 @@ func (m BoardModel) handleEdit() (BoardModel, tea.Cmd) {
 	card := m.board.Columns[m.selectedCol].Cards[realIdx]
 
 	// Open editor
-	return m, openEditor(m.board.Path, card.Filename)
+	return m, m.openEditor(card.Filename)
 }
 
 func (m BoardModel) handleNew() (BoardModel, tea.Cmd) {
@@ -899,11 +1329,34 @@ func (m BoardModel) handleNew() (BoardModel, tea.Cmd) {
 	m.selectedCard = len(m.board.Columns[m.selectedCol].Cards) - 1
 	m.columnCursorPos[m.selectedCol] = m.selectedCard
 
-	// Apply board projects immediately so they appear in the editor
+	// Apply board projects and default tags immediately so they appear in the editor
 	m.ensureCardBoardProjects(m.selectedCol, m.selectedCard)
+	if len(m.defaultTags) > 0 {
+		_ = operations.UpdateCardTags(&m.board, m.selectedCol, m.selectedCard, append([]string{}, m.defaultTags...))
+	}
 
 	// Open editor for the new card
-	return m, openEditor(m.board.Path, card.Filename)
+	return m, m.openEditor(card.Filename)
+}
+
+// updateNewCardColumn handles the column picker opened with N, then creates
+// the card in the chosen column as n would.
+func (m BoardModel) updateNewCardColumn(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, colIdx, done := m.newCardColumnPicker.Update(msg)
+	m.newCardColumnPicker = &updated
+	if !done {
+		return m, nil
+	}
+
+	m.mode = boardModeNormal
+	m.newCardColumnPicker = nil
+	if colIdx < 0 || colIdx >= len(m.board.Columns) {
+		return m, nil
+	}
+
+	m.selectedCol = colIdx
+	m.adjustHorizontalScrollPosition()
+	return m.handleNew()
 }
 
 func (m BoardModel) handleTagEdit() (BoardModel, tea.Cmd) {
@@ -912,6 +1365,7 @@ func (m BoardModel) handleTagEdit() (BoardModel, tea.Cmd) {
 	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
 
 	picker := NewTagPickerModel(currentCard.Tags, allTags)
+	picker.SetSize(m.width, m.height)
 	m.tagPicker = &picker
 	m.mode = boardModeTagEdit
 
@@ -931,7 +1385,9 @@ func (m BoardModel) updateTagEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		if msg.String() == "enter" {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 			newTags := m.tagPicker.GetSelectedTags()
-			err := operations.UpdateCardTags(&m.board, m.selectedCol, realIdx, newTags)
+			err := m.trackCard(m.selectedCol, realIdx, "tag edit", func() error {
+				return operations.UpdateCardTags(&m.board, m.selectedCol, realIdx, newTags)
+			})
 			if err != nil {
 				m.err = err
 			} else {
@@ -962,6 +1418,7 @@ func (m BoardModel) handleProjectEdit() (BoardModel, tea.Cmd) {
 	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
 
 	picker := NewProjectPickerModel(currentCard.Projects, allProjects)
+	picker.SetSize(m.width, m.height)
 	m.projectPicker = &picker
 	m.mode = boardModeProjectEdit
 
@@ -981,7 +1438,9 @@ func (m BoardModel) updateProjectEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		if msg.String() == "enter" {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 			newProjects := m.projectPicker.GetSelectedProjects()
-			err := operations.UpdateCardProjects(&m.board, m.selectedCol, realIdx, newProjects)
+			err := m.trackCard(m.selectedCol, realIdx, "project edit", func() error {
+				return operations.UpdateCardProjects(&m.board, m.selectedCol, realIdx, newProjects)
+			})
 			if err != nil {
 				m.err = err
 			} else {
@@ -1029,6 +1488,17 @@ func (m BoardModel) updateColumnEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 			// Apply changes from editor
 			m.board.Columns = m.columnEditor.columns
 
+			// Archive the cards of deleted columns sent to the archive
+			for _, col := range m.board.Columns {
+				for _, card := range col.Cards {
+					if m.columnEditor.archived[card.Filename] {
+						if err := fs.WriteCard(card, filepath.Join(m.board.Path, "cards", card.Filename)); err != nil {
+							m.err = err
+						}
+					}
+				}
+			}
+
 			// Persist to disk
 			err := fs.WriteBoard(m.board)
 			if err != nil {
@@ -1086,7 +1556,9 @@ func (m BoardModel) updateURLEditor(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		if saved {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 			newURLs := m.urlEditor.GetURLs()
-			err := operations.UpdateCardURLs(&m.board, m.selectedCol, realIdx, newURLs)
+			err := m.trackCard(m.selectedCol, realIdx, "URL edit", func() error {
+				return operations.UpdateCardURLs(&m.board, m.selectedCol, realIdx, newURLs)
+			})
 			if err != nil {
 				m.err = err
 			} else {
@@ -1150,7 +1622,9 @@ func (m BoardModel) updateDueDateEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 		// Save date (or clear if 'c' was pressed)
 		realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 		newDate := m.dueDatePicker.GetDate()
-		err := operations.UpdateCardDueDate(&m.board, m.selectedCol, realIdx, newDate)
+		err := m.trackCard(m.selectedCol, realIdx, "due date change", func() error {
+			return operations.UpdateCardDueDate(&m.board, m.selectedCol, realIdx, newDate)
+		})
 		if err != nil {
 			m.err = err
 		} else {
@@ -1201,7 +1675,9 @@ func (m BoardModel) updateScheduledDateEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd
 		// Save date (or clear if 'c' was pressed)
 		realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 		newDate := m.scheduledDatePicker.GetDate()
-		err := operations.UpdateCardScheduledDate(&m.board, m.selectedCol, realIdx, newDate)
+		err := m.trackCard(m.selectedCol, realIdx, "scheduled date change", func() error {
+			return operations.UpdateCardScheduledDate(&m.board, m.selectedCol, realIdx, newDate)
+		})
 		if err != nil {
 			m.err = err
 		} else {
@@ -1235,24 +1711,25 @@ func (m BoardModel) updateScheduledDateEdit(msg tea.KeyMsg) (BoardModel, tea.Cmd
 func (m BoardModel) handlePriorityEdit() (BoardModel, tea.Cmd) {
 	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
-	priorityInputModel := NewPriorityInputModel(currentCard.Priority)
-	priorityInputModel.width = m.width
-	priorityInputModel.height = m.height
-	m.priorityInput = &priorityInputModel
+	picker := shared.NewPriorityPickerModel(currentCard.Priority, "Set Priority")
+	picker.SetSize(m.width, m.height)
+	m.priorityInput = &picker
 	m.mode = boardModePriorityInput
-	return m, priorityInputModel.Init()
+	return m, nil
 }
 
 func (m BoardModel) updatePriorityInput(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
-	var isDone bool
+	var isDone, confirmed bool
 
-	*m.priorityInput, isDone = m.priorityInput.Update(msg)
+	*m.priorityInput, isDone, confirmed = m.priorityInput.Update(msg)
 
 	if isDone {
-		if msg.String() == "enter" {
+		if confirmed {
 			realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-			newPriority := m.priorityInput.GetPriority()
-			err := operations.UpdateCardPriority(&m.board, m.selectedCol, realIdx, newPriority)
+			newPriority := m.priorityInput.Level()
+			err := m.trackCard(m.selectedCol, realIdx, "priority change", func() error {
+				return operations.UpdateCardPriority(&m.board, m.selectedCol, realIdx, newPriority)
+			})
 			if err != nil {
 				m.err = err
 			} else {
@@ -1278,75 +1755,333 @@ func (m BoardModel) updatePriorityInput(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	return m, nil
 }
 
-func (m BoardModel) handleOpenURL() (BoardModel, tea.Cmd) {
+func (m BoardModel) handleRename() (BoardModel, tea.Cmd) {
 	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
-
-	// Build effective URL list: Jira ticket first (if linked), then card URLs
-	urls := make([]models.CardURL, 0, len(currentCard.URLs)+1)
-	if currentCard.JiraKey != "" {
-		jiraURL := currentCard.JiraKey // fallback label if no base URL
-		cfg := config.Get()
-		if cfg != nil && cfg.Jira != nil {
-			jiraURL = cfg.Jira.BaseURL + "/browse/" + currentCard.JiraKey
-		}
-		urls = append(urls, models.CardURL{Label: "jira", URL: jiraURL})
+	rename := NewCardRenameModel(currentCard.Title)
+	rename.width = m.width
+	rename.height = m.height
+	m.cardRename = &rename
+	m.mode = boardModeRename
+	return m, rename.Init()
+}
+
+func (m BoardModel) updateRename(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.cardRename.Update(msg)
+	m.cardRename = &updated
+	if !done {
+		return m, cmd
 	}
-	urls = append(urls, currentCard.URLs...)
 
-	if len(urls) == 0 {
-		m.message = "No URLs set for this card"
+	title := m.cardRename.Title()
+	m.mode = boardModeNormal
+	m.cardRename = nil
+	if !confirmed || title == "" {
 		return m, nil
 	}
 
-	// Single URL — open directly
-	if len(urls) == 1 {
-		err := operations.OpenURL(urls[0].URL)
-		if err != nil {
-			m.err = fmt.Errorf("failed to open URL: %v", err)
-		} else {
-			m.message = "Opening URL in browser..."
-		}
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	err := m.trackCard(m.selectedCol, realIdx, "rename", func() error {
+		return operations.RenameCard(&m.board, m.selectedCol, realIdx, title)
+	})
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	board, err := fs.ReadBoard(m.board.Path)
+	if err != nil {
+		m.err = err
 		return m, nil
 	}
+	m.board = board
+	m.message = "Card renamed"
+	m.reloadBoardState()
+	return m, nil
+}
 
-	// Multiple URLs — open picker
-	picker := NewURLPickerModel(urls)
+func (m BoardModel) handleActions() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	card := m.board.Columns[m.selectedCol].Cards[realIdx]
+	if len(card.Actions) == 0 {
+		m.message = "No actions (add actions: to the card's frontmatter)"
+		return m, nil
+	}
+	picker := NewActionPickerModel(card.Actions)
 	picker.width = m.width
 	picker.height = m.height
-	m.urlPicker = &picker
-	m.mode = boardModeURLPicker
+	m.actionPicker = &picker
+	m.mode = boardModeActionPicker
 	return m, nil
 }
 
-func (m BoardModel) handleBoardMove() (BoardModel, tea.Cmd) {
-	selector := NewBoardSelectorModel(m.allBoards, m.board.Path, "Move to Board")
-	if selector.Empty() {
-		m.message = "No other boards available"
+func (m BoardModel) updateActionPicker(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, idx, done := m.actionPicker.Update(msg)
+	m.actionPicker = &updated
+	if !done {
+		return m, nil
+	}
+	m.mode = boardModeNormal
+	m.actionPicker = nil
+	if idx < 0 {
 		return m, nil
 	}
-	selector.width = m.width
-	selector.height = m.height
-	m.boardSelector = &selector
-	m.mode = boardModeBoardMove
-	return m, nil
-}
 
-func (m BoardModel) updateBoardMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
-	var selectedPath string
-	var done bool
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	card := m.board.Columns[m.selectedCol].Cards[realIdx]
+	return m, runCardAction(m.board.Path, card, card.Actions[idx])
+}
 
-	*m.boardSelector, selectedPath, done = m.boardSelector.Update(msg)
+// handleBacklinks opens the list of tasks, notes and cards that refer to the
+// selected card.
+func (m BoardModel) handleBacklinks() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	card := m.board.Columns[m.selectedCol].Cards[realIdx]
+	m.updateRefs()
+	backlinks := NewBacklinksModel(card.Title, m.boardInfo[m.board.Path].Refs.Backlinks(m.board.Path, card))
+	backlinks.width = m.width
+	backlinks.height = m.height
+	m.backlinks = &backlinks
+	m.mode = boardModeBacklinks
+	return m, nil
+}
 
+func (m BoardModel) updateBacklinks(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done := m.backlinks.Update(msg)
+	m.backlinks = &updated
 	if done {
-		if selectedPath != "" {
-			// Load target board
+		m.mode = boardModeNormal
+		m.backlinks = nil
+	}
+	return m, cmd
+}
+
+func (m BoardModel) handleBlocked() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
+	blocked := NewCardBlockedModel(currentCard.Blocked)
+	blocked.width = m.width
+	blocked.height = m.height
+	m.cardBlocked = &blocked
+	m.mode = boardModeBlocked
+	return m, blocked.Init()
+}
+
+func (m BoardModel) updateBlocked(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.cardBlocked.Update(msg)
+	m.cardBlocked = &updated
+	if !done {
+		return m, cmd
+	}
+
+	reason := m.cardBlocked.Reason()
+	m.mode = boardModeNormal
+	m.cardBlocked = nil
+	if !confirmed {
+		return m, nil
+	}
+
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	err := m.trackCard(m.selectedCol, realIdx, "block", func() error {
+		return operations.SetCardBlocked(&m.board, m.selectedCol, realIdx, reason)
+	})
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	if reason != "" {
+		m.message = "Card blocked"
+	} else {
+		m.message = "Card unblocked"
+	}
+	return m, nil
+}
+
+func (m BoardModel) handleAttach() (BoardModel, tea.Cmd) {
+	attach := NewCardAttachModel()
+	attach.width = m.width
+	attach.height = m.height
+	m.cardAttach = &attach
+	m.mode = boardModeAttach
+	return m, attach.Init()
+}
+
+// updateAttach stores the chosen file with the board's workspace (the board
+// directory when its workspace is unknown) and records it on the card.
+func (m BoardModel) updateAttach(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.cardAttach.Update(msg)
+	m.cardAttach = &updated
+	if !done {
+		return m, cmd
+	}
+
+	path := m.cardAttach.Path()
+	m.mode = boardModeNormal
+	m.cardAttach = nil
+	if !confirmed {
+		return m, nil
+	}
+
+	rel, err := attachments.Store(m.workspaceRoot(), path)
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	err = m.trackCard(m.selectedCol, realIdx, "attachment", func() error {
+		return operations.AddCardAttachment(&m.board, m.selectedCol, realIdx, rel)
+	})
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.message = "Attached " + rel
+	return m, nil
+}
+
+// workspaceRoot returns the root of the board's workspace, which attachment
+// paths are relative to, or the board directory when it is unknown.
+func (m BoardModel) workspaceRoot() string {
+	if info, ok := m.boardInfo[m.board.Path]; ok && info.Workspace != "" {
+		return info.Workspace
+	}
+	return m.board.Path
+}
+
+// handleTaskCapture asks for a follow-up task about the selected card, to be
+// added to todo.txt rather than becoming a card of its own.
+func (m BoardModel) handleTaskCapture() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	card := m.board.Columns[m.selectedCol].Cards[realIdx]
+	var suffix []string
+	for _, p := range m.boardProjects {
+		suffix = append(suffix, "+"+p)
+	}
+	suffix = append(suffix, cardReference(m.board.Path, card.Filename))
+	capture := NewTaskCaptureModel(strings.Join(suffix, " "))
+	capture.width = m.width
+	capture.height = m.height
+	m.taskCapture = &capture
+	m.mode = boardModeTaskCapture
+	return m, capture.Init()
+}
+
+func (m BoardModel) updateTaskCapture(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.taskCapture.Update(msg)
+	m.taskCapture = &updated
+	if !done {
+		return m, cmd
+	}
+
+	line := m.taskCapture.Line()
+	m.mode = boardModeNormal
+	m.taskCapture = nil
+	if !confirmed || line == "" {
+		return m, nil
+	}
+	return m, func() tea.Msg { return messages.CaptureTaskMsg{Line: line} }
+}
+
+// updateMarkdownImport handles the markdown import modal opened with I and,
+// once confirmed, adds its cards with the board's projects and default tags.
+func (m BoardModel) updateMarkdownImport(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.markdownImport.Update(msg)
+	m.markdownImport = &updated
+	if !done {
+		return m, cmd
+	}
+
+	cards := m.markdownImport.Cards()
+	m.mode = boardModeNormal
+	m.markdownImport = nil
+	if !confirmed {
+		return m, nil
+	}
+	n, err := operations.ImportMarkdownCards(&m.board, cards, m.boardProjects, m.defaultTags)
+	m.filterNarrow = nil
+	m.reloadBoardState()
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.message = fmt.Sprintf("Imported %d card(s)", n)
+	return m, nil
+}
+
+func (m BoardModel) handleOpenURL() (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
+
+	// Build effective URL list: Jira ticket first (if linked), then card URLs
+	urls := make([]models.CardURL, 0, len(currentCard.URLs)+1)
+	if currentCard.JiraKey != "" {
+		jiraURL := currentCard.JiraKey // fallback label if no base URL
+		cfg := config.Get()
+		if cfg != nil && cfg.Jira != nil {
+			jiraURL = cfg.Jira.BaseURL + "/browse/" + currentCard.JiraKey
+		}
+		urls = append(urls, models.CardURL{Label: "jira", URL: jiraURL})
+	}
+	urls = append(urls, currentCard.URLs...)
+	for _, a := range currentCard.Attachments {
+		urls = append(urls, models.CardURL{Label: filepath.Base(a), URL: filepath.Join(m.workspaceRoot(), a)})
+	}
+
+	if len(urls) == 0 {
+		m.message = "No URLs or attachments for this card"
+		return m, nil
+	}
+
+	// Single URL — open directly
+	if len(urls) == 1 {
+		err := operations.OpenURL(urls[0].URL)
+		if err != nil {
+			m.err = fmt.Errorf("failed to open URL: %v", err)
+		} else {
+			m.message = "Opening URL in browser..."
+		}
+		return m, nil
+	}
+
+	// Multiple URLs — open picker
+	picker := NewURLPickerModel(urls)
+	picker.width = m.width
+	picker.height = m.height
+	m.urlPicker = &picker
+	m.mode = boardModeURLPicker
+	return m, nil
+}
+
+func (m BoardModel) handleBoardMove() (BoardModel, tea.Cmd) {
+	selector := NewBoardSelectorModel(m.allBoards, m.board.Path, "Move to Board")
+	if selector.Empty() {
+		m.message = "No other boards available"
+		return m, nil
+	}
+	selector.SortByRecent(m.recentBoards)
+	selector.GroupByWorkspace(m.boardInfo)
+	selector.EnableFilter()
+	selector.width = m.width
+	selector.height = m.height
+	m.boardSelector = &selector
+	m.mode = boardModeBoardMove
+	return m, nil
+}
+
+func (m BoardModel) updateBoardMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	var selectedPath string
+	var done bool
+
+	*m.boardSelector, selectedPath, done = m.boardSelector.Update(msg)
+
+	if done {
+		if selectedPath != "" {
+			// Load target board
 			dstBoard, err := fs.ReadBoard(selectedPath)
 			if err != nil {
 				m.err = fmt.Errorf("load target board: %w", err)
 			} else {
 				realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
-				err := operations.MoveCardToBoard(&m.board, m.selectedCol, realIdx, &dstBoard, m.boardProjects)
+				err := operations.MoveCardToBoard(&m.board, m.selectedCol, realIdx, &dstBoard, m.moveProjects(selectedPath))
 				if err != nil {
 					m.err = err
 				} else {
@@ -1375,6 +2110,83 @@ func (m BoardModel) updateBoardMove(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
 	return m, nil
 }
 
+// moveProjects returns the projects to link to a card moved to the board at
+// dstPath. Within a workspace the card keeps this board's projects; moved to
+// another workspace, where those projects don't exist, it takes the
+// destination board's own projects instead.
+func (m BoardModel) moveProjects(dstPath string) []string {
+	src, srcOK := m.boardInfo[m.board.Path]
+	dst, dstOK := m.boardInfo[dstPath]
+	if !srcOK || !dstOK || src.Workspace == dst.Workspace {
+		return m.boardProjects
+	}
+	return dst.Projects
+}
+
+func (m BoardModel) handleBoardSwitch() (BoardModel, tea.Cmd) {
+	selector := NewBoardSelectorModel(m.allBoards, m.board.Path, "Switch Board")
+	if selector.Empty() {
+		m.message = "No other boards available"
+		return m, nil
+	}
+	selector.SortByRecent(m.recentBoards)
+	selector.GroupByWorkspace(m.boardInfo)
+	selector.EnableFilter()
+	selector.width = m.width
+	selector.height = m.height
+	m.boardSelector = &selector
+	m.mode = boardModeBoardSwitch
+	return m, nil
+}
+
+func (m BoardModel) updateBoardSwitch(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	var selectedPath string
+	var done bool
+
+	*m.boardSelector, selectedPath, done = m.boardSelector.Update(msg)
+	if !done {
+		return m, nil
+	}
+	m.mode = boardModeNormal
+	m.boardSelector = nil
+	if selectedPath == "" {
+		return m, nil
+	}
+
+	dstBoard, err := fs.ReadBoard(selectedPath)
+	if err != nil {
+		m.err = fmt.Errorf("load board: %w", err)
+		return m, nil
+	}
+	m.switchToBoard(dstBoard)
+	m.message = fmt.Sprintf("Switched to %s", dstBoard.Name)
+	return m, func() tea.Msg {
+		return messages.BoardSwitchedMsg{BoardPath: selectedPath}
+	}
+}
+
+// switchToBoard swaps the open board in place. Cursor and scroll state are
+// reset, while the filter query and show-archived toggle carry over.
+func (m *BoardModel) switchToBoard(board models.Board) {
+	m.saveViewState()
+	m.board = board
+	m.history = operations.NewHistory()
+	m.selectedCol = 0
+	m.selectedCard = 0
+	m.columnScrollOffsets = make([]int, len(board.Columns))
+	m.columnCursorPos = make([]int, len(board.Columns))
+	m.columnHorizontalOffset = 0
+	m.boardProjects = nil
+	if m.filterQuery != "" {
+		m.filterActive = true
+	}
+	m.reloadBoardState()
+	m.adjustScrollPosition()
+	if s, ok := m.viewStates[board.Path]; ok {
+		m.RestoreViewState(s)
+	}
+}
+
 func (m BoardModel) handleTmuxEdit() (BoardModel, tea.Cmd) {
 	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
 	currentCard := m.board.Columns[m.selectedCol].Cards[realIdx]
@@ -1441,7 +2253,11 @@ func (m BoardModel) handleTmuxLaunch() (BoardModel, tea.Cmd) {
 
 	// Session linked -> existing launch popup (unchanged behavior)
 	children := m.getChildSessionsFromCache(currentCard.TmuxSession)
-	launch := NewTmuxLaunchModel(currentCard.TmuxSession, children)
+	var info *tmuxSessionInfo
+	if i, ok := m.tmuxInfo[currentCard.TmuxSession]; ok {
+		info = &i
+	}
+	launch := NewTmuxLaunchModel(currentCard.TmuxSession, children, info)
 	launch.width = m.width
 	launch.height = m.height
 	m.tmuxLaunch = &launch
@@ -1512,6 +2328,7 @@ func (m BoardModel) handleBoardProjectLink() (BoardModel, tea.Cmd) {
 		currentProject = m.boardProjects[0]
 	}
 	picker := NewBoardProjectPickerModel(currentProject, m.allProjects)
+	picker.SetSize(m.width, m.height)
 	m.boardProjectPicker = &picker
 	m.mode = boardModeProjectLink
 	return m, picker.Init()
@@ -1751,13 +2568,61 @@ func (m BoardModel) View() string {
 		return m.priorityInput.View()
 	}
 
+	if m.mode == boardModeRename && m.cardRename != nil {
+		return m.cardRename.View()
+	}
+
+	if m.mode == boardModeBlocked && m.cardBlocked != nil {
+		return m.cardBlocked.View()
+	}
+
+	if m.mode == boardModeAttach && m.cardAttach != nil {
+		return m.cardAttach.View()
+	}
+
+	if m.mode == boardModeCardFromURL && m.cardFromURL != nil {
+		return m.cardFromURL.View()
+	}
+
+	if m.mode == boardModeTaskCapture && m.taskCapture != nil {
+		return m.taskCapture.View()
+	}
+
+	if m.mode == boardModeImportMarkdown && m.markdownImport != nil {
+		return m.markdownImport.View()
+	}
+
+	if m.mode == boardModeNewCardColumn && m.newCardColumnPicker != nil {
+		return m.newCardColumnPicker.View()
+	}
+
+	if m.mode == boardModeBacklinks && m.backlinks != nil {
+		return m.backlinks.View()
+	}
+
+	if m.mode == boardModeActivity && m.activity != nil {
+		return m.activity.View()
+	}
+
+	if m.mode == boardModeCardConflict && m.cardConflict != nil {
+		return m.cardConflict.View()
+	}
+
+	if m.mode == boardModeActionPicker && m.actionPicker != nil {
+		return m.actionPicker.View()
+	}
+
+	if m.mode == boardModeMissing {
+		return m.viewMissing()
+	}
+
 	// Show delete confirm modal if in confirm delete mode
 	if m.mode == boardModeConfirmDelete && m.deleteConfirm != nil {
 		return m.deleteConfirm.View()
 	}
 
-	// Show board selector if in board move mode
-	if m.mode == boardModeBoardMove && m.boardSelector != nil {
+	// Show board selector if in board move or switch mode
+	if (m.mode == boardModeBoardMove || m.mode == boardModeBoardSwitch) && m.boardSelector != nil {
 		return m.boardSelector.View()
 	}
 
@@ -1781,11 +2646,26 @@ func (m BoardModel) View() string {
 	s.WriteString(titleStyle.Render(fmt.Sprintf("Board: %s", m.board.Name)))
 	s.WriteString("\n")
 
-	// Filter bar
-	if m.mode == boardModeFilter {
+	// Filter bar, or the card in motion while moving
+	if m.mode == boardModeMove {
+		s.WriteString(m.moveHeader())
+	} else if m.mode == boardModeFilter {
 		s.WriteString("  / " + m.filterInput.View())
+		if m.filterBodies {
+			s.WriteString("  " + filterIndicatorStyle.Render("[bodies]"))
+		}
 	} else if m.filterActive {
-		s.WriteString("  " + filterIndicatorStyle.Render("Filter: "+m.filterQuery))
+		filterLabel := "Filter: "
+		if m.filterBodies {
+			filterLabel = "Filter (bodies): "
+		}
+		s.WriteString("  " + filterIndicatorStyle.Render(filterLabel+m.filterQuery))
+	}
+	if m.showLegend {
+		s.WriteString("  " + shared.PriorityLegend())
+	}
+	if m.register != nil && m.mode != boardModeMove {
+		s.WriteString("  " + cardPreviewStyle.Render("register: "+m.register.Title+" (P puts it here)"))
 	}
 	s.WriteString("\n")
 
@@ -1800,39 +2680,33 @@ func (m BoardModel) View() string {
 		totalFixedColumnHeight = 10
 	}
 
-	// Render columns with fixed height and horizontal scrolling
+	// Render columns with fixed height and horizontal scrolling. Columns
+	// that didn't change since the last frame come from the column cache.
 	startCol, endCol := m.calculateVisibleColumns()
-	visibleColumnViews := []string{}
+	visibleColumnViews := []renderedBlock{}
 
 	// Left scroll indicator space (always allocated)
 	if startCol > 0 {
-		leftIndicator := m.renderScrollIndicator("◀", totalFixedColumnHeight)
-		visibleColumnViews = append(visibleColumnViews, leftIndicator)
+		visibleColumnViews = append(visibleColumnViews, m.renderScrollIndicator("◀", totalFixedColumnHeight))
 	} else {
-		emptySpace := m.renderScrollIndicator(" ", totalFixedColumnHeight)
-		visibleColumnViews = append(visibleColumnViews, emptySpace)
+		visibleColumnViews = append(visibleColumnViews, m.renderScrollIndicator(" ", totalFixedColumnHeight))
 	}
 
 	// Render visible columns only
 	for i := startCol; i < endCol; i++ {
 		col := m.board.Columns[i]
-		cards := m.getVisibleCards(i)
-		colView := m.renderColumn(i, col, cards, totalFixedColumnHeight)
+		colView := m.renderColumn(i, col, m.getVisibleCardIndices(i), totalFixedColumnHeight)
 		visibleColumnViews = append(visibleColumnViews, colView)
 	}
 
 	// Right scroll indicator space (always allocated)
 	if endCol < len(m.board.Columns) {
-		rightIndicator := m.renderScrollIndicator("▶", totalFixedColumnHeight)
-		visibleColumnViews = append(visibleColumnViews, rightIndicator)
+		visibleColumnViews = append(visibleColumnViews, m.renderScrollIndicator("▶", totalFixedColumnHeight))
 	} else {
-		emptySpace := m.renderScrollIndicator(" ", totalFixedColumnHeight)
-		visibleColumnViews = append(visibleColumnViews, emptySpace)
+		visibleColumnViews = append(visibleColumnViews, m.renderScrollIndicator(" ", totalFixedColumnHeight))
 	}
 
-	columns := lipgloss.JoinHorizontal(lipgloss.Top, visibleColumnViews...)
-	centeredColumns := lipgloss.Place(m.width, 0, lipgloss.Center, lipgloss.Top, columns)
-	s.WriteString(centeredColumns)
+	s.WriteString(joinBlocks(m.width, visibleColumnViews...))
 	s.WriteString("\n")
 
 	// Status message or error
@@ -1847,27 +2721,61 @@ func (m BoardModel) View() string {
 	return s.String()
 }
 
-func (m BoardModel) renderColumn(index int, col models.Column, cards []models.Card, fixedHeight int) string {
+// renderColumn frames a column's content, from the column cache when
+// neither the content nor the frame changed since the last frame.
+func (m BoardModel) renderColumn(index int, col models.Column, indices []int, fixedHeight int) renderedBlock {
+	content := m.columnContent(index, col, indices, fixedHeight)
+	selected := index == m.selectedCol
+	key := columnKey(content, selected, col.Color, fixedHeight)
+	return m.columnCache.render(index, key, func() string {
+		// The selected column keeps the focus border so the cursor stays
+		// visible; others are tinted by the column's color if it has one
+		style := columnStyle
+		if selected {
+			style = selectedColumnStyle
+		} else if color, ok := columnColor(col.Color); ok {
+			style = style.BorderForeground(color)
+		}
+		return style.Height(fixedHeight).Render(content)
+	})
+}
+
+// columnContent renders the inside of a column: its title, the cards that
+// fit and the scroll indicators. indices are the column's visible cards, see
+// getVisibleCardIndices; only the cards on screen are read.
+func (m BoardModel) columnContent(index int, col models.Column, indices []int, fixedHeight int) string {
 	var s strings.Builder
 
-	// Column title
+	// Column title, tinted by the column's color if it has one
 	colTitleStyle := columnTitleStyle
 	if index == m.selectedCol {
 		colTitleStyle = selectedColumnTitleStyle
 	}
-	s.WriteString(colTitleStyle.Render(col.Name))
+	if color, ok := columnColor(col.Color); ok {
+		colTitleStyle = colTitleStyle.Foreground(color)
+	}
+	header := col.Name
+	if col.Icon != "" {
+		header = col.Icon + " " + header
+	}
+	if n := m.newCardCount(col); n > 0 {
+		badge := fmt.Sprintf("%d new", n)
+		s.WriteString(colTitleStyle.Render(shared.Truncate(header, columnWidth-2*columnPaddingHorizontal-len(badge)-1)))
+		s.WriteString(" " + newCardsBadgeStyle.Render(badge))
+	} else {
+		s.WriteString(colTitleStyle.Render(shared.Truncate(header, columnWidth-2*columnPaddingHorizontal)))
+	}
 	s.WriteString("\n\n")
 
 	// Handle empty column
-	if len(cards) == 0 {
-		s.WriteString(cardPreviewStyle.Render("(empty)"))
-		s.WriteString("\n")
-
-		style := columnStyle
-		if index == m.selectedCol {
-			style = selectedColumnStyle
+	if len(indices) == 0 {
+		if m.moveGhostIndex(index, 0) == 0 {
+			s.WriteString(m.renderMoveGhost())
+		} else {
+			s.WriteString(cardPreviewStyle.Render("(empty)"))
 		}
-		return style.Height(fixedHeight).Render(s.String())
+		s.WriteString("\n")
+		return s.String()
 	}
 
 	// Get scroll offset
@@ -1898,9 +2806,13 @@ func (m BoardModel) renderColumn(index int, col models.Column, cards []models.Ca
 	cardsRendered := 0
 	currentCardHeight := 0
 
-	for i := scrollOffset; i < len(cards); i++ {
-		card := cards[i]
+	ghostAt := m.moveGhostIndex(index, len(indices))
+	for i := scrollOffset; i < len(indices); i++ {
+		card := col.Cards[indices[i]]
 		cardView := m.renderCard(index, i, card)
+		if i == ghostAt {
+			cardView = m.renderMoveGhost() + "\n" + cardView
+		}
 		cardHeight := lipgloss.Height(cardView)
 
 		if cardsRendered > 0 && currentCardHeight+cardHeight > availableCardSpace {
@@ -1912,25 +2824,86 @@ func (m BoardModel) renderColumn(index int, col models.Column, cards []models.Ca
 		cardsRendered++
 		currentCardHeight += cardHeight
 	}
+	if ghostAt == len(indices) && scrollOffset+cardsRendered == len(indices) {
+		cardBuilder.WriteString(m.renderMoveGhost())
+		cardBuilder.WriteString("\n")
+	}
 
 	s.WriteString(cardBuilder.String())
 
-	cardsBelow := len(cards) - scrollOffset - cardsRendered
+	cardsBelow := len(indices) - scrollOffset - cardsRendered
 
 	if cardsBelow > 0 {
 		indicator := scrollIndicatorStyle.Render(fmt.Sprintf("▼ +%d cards below", cardsBelow))
 		s.WriteString(indicator)
 	}
 
-	style := columnStyle
-	if index == m.selectedCol {
-		style = selectedColumnStyle
+	return s.String()
+}
+
+// moveHeader is the sticky line shown in move mode: the moving card's title,
+// where it will land and, once it has changed column, where it came from.
+func (m BoardModel) moveHeader() string {
+	cards := m.getVisibleCards(m.selectedCol)
+	if m.selectedCard >= len(cards) {
+		return ""
 	}
+	header := "  " + filterIndicatorStyle.Render("moving: "+cards[m.selectedCard].Title)
+	dest := fmt.Sprintf("→ %s %d/%d", m.board.Columns[m.selectedCol].Name, m.selectedCard+1, len(cards))
+	if m.moveFromCol != m.selectedCol && m.moveFromCol < len(m.board.Columns) {
+		dest = fmt.Sprintf("from %s %d %s", m.board.Columns[m.moveFromCol].Name, m.moveFromCard+1, dest)
+	}
+	return header + "  " + cardPreviewStyle.Render(dest)
+}
+
+// moveGhostIndex returns where the placeholder for the moving card goes in
+// column colIndex, or -1. The ghost only appears in the column the card left;
+// within its own column the card itself marks where it will land.
+func (m BoardModel) moveGhostIndex(colIndex, cardCount int) int {
+	if m.mode != boardModeMove || colIndex != m.moveFromCol || colIndex == m.selectedCol {
+		return -1
+	}
+	return min(m.moveFromCard, cardCount)
+}
 
-	return style.Height(fixedHeight).Render(s.String())
+func (m BoardModel) renderMoveGhost() string {
+	maxWidth := columnWidth - (2 * columnPaddingHorizontal) - cardBorderWidth - (2 * cardPaddingHorizontal)
+	return moveGhostStyle.Render(shared.Truncate("moved from here", maxWidth))
 }
 
+// renderCard returns the rendered card, from the render cache when nothing it
+// depends on has changed.
 func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string {
+	isSelected := colIndex == m.selectedCol && cardIndex == m.selectedCard
+	key := cardRenderKey{
+		hash:         hashCard(card),
+		selected:     isSelected,
+		moveSelected: isSelected && m.mode == boardModeMove,
+		done:         m.board.IsDoneColumn(m.board.Columns[colIndex].Name),
+		width:        columnWidth,
+		day:          clock.Now().Format("2006-01-02"),
+	}
+	if offsets := m.titleHighlight(card); len(offsets) > 0 {
+		key.highlight = fmt.Sprint(offsets)
+	}
+	if card.TmuxSession != "" {
+		claudeSession := card.TmuxSession + "-claude"
+		key.tmuxActive = m.tmuxSessions[card.TmuxSession]
+		key.claudeActive = m.tmuxSessions[claudeSession]
+		key.claudeWaiting = m.claudeStatus[claudeSession] == "waiting"
+	}
+
+	if rendered, ok := m.cardCache.get(key); ok {
+		return rendered
+	}
+	rendered := m.buildCard(colIndex, cardIndex, card)
+	m.cardCache.put(key, rendered)
+	return rendered
+}
+
+// buildCard renders a card with lipgloss. Anything it reads must be part of
+// cardRenderKey.
+func (m BoardModel) buildCard(colIndex, cardIndex int, card models.Card) string {
 	maxWidth := columnWidth - (2 * columnPaddingHorizontal) - cardBorderWidth - (2 * cardPaddingHorizontal)
 
 	var lines []string
@@ -1953,13 +2926,32 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 	if urlIndicator != "" {
 		effectiveMaxWidth -= 2
 	}
-
-	if len(title) > effectiveMaxWidth {
-		title = title[:effectiveMaxWidth-3] + "..."
+	pinPrefix := ""
+	pinPrefixWidth := 0
+	if card.Pinned {
+		pinPrefix = cardPinStyle.Render(pinGlyph + " ")
+		pinPrefixWidth = 2
+		effectiveMaxWidth -= pinPrefixWidth
 	}
 
+	title = shared.Truncate(title, effectiveMaxWidth)
+
+	// Filter highlights inside the (possibly truncated) title
+	var highlight []int
+	for _, o := range m.titleHighlight(card) {
+		if o < len(title) && (len(title) == len(card.Title) || o < len(title)-3) {
+			highlight = append(highlight, o)
+		}
+	}
+	suffix := ""
 	if urlIndicator != "" {
-		title = title + " " + urlIndicator
+		suffix = " " + shared.Hyperlink(card.FirstURL(), urlIndicator)
+	}
+	renderTitle := func(style lipgloss.Style) string {
+		if len(highlight) == 0 {
+			return style.Render(title + suffix)
+		}
+		return highlightTitle(title, highlight, suffix, style)
 	}
 
 	isSelected := colIndex == m.selectedCol && cardIndex == m.selectedCard
@@ -1968,18 +2960,18 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 		pStyle := kanbanPriorityStyle(card.Priority)
 		tStyle := cardTitleStyle
 		if isMoveSelected {
-			pStyle = pStyle.Background(lipgloss.Color("54")).Foreground(lipgloss.Color("15"))
-			tStyle = tStyle.Foreground(theme.Warning).Background(lipgloss.Color("54")).Width(maxWidth - priorityPrefixWidth)
+			pStyle = pStyle.Background(theme.MoveBg).Foreground(theme.SelectionFg)
+			tStyle = tStyle.Foreground(theme.SelectionFg).Background(theme.MoveBg).Width(maxWidth - priorityPrefixWidth - pinPrefixWidth)
 		} else if isSelected {
-			tStyle = tStyle.Background(theme.Surface)
+			tStyle = tStyle.Background(theme.SelectionBg)
 		}
-		lines = append(lines, pStyle.Render(priorityPrefix)+tStyle.Render(title))
+		lines = append(lines, pStyle.Render(priorityPrefix)+pinPrefix+renderTitle(tStyle))
 	} else {
 		tStyle := cardTitleStyle
 		if isMoveSelected {
-			tStyle = tStyle.Foreground(theme.Warning).Background(lipgloss.Color("54")).Width(maxWidth)
+			tStyle = tStyle.Foreground(theme.SelectionFg).Background(theme.MoveBg).Width(maxWidth - pinPrefixWidth)
 		}
-		lines = append(lines, tStyle.Render(title))
+		lines = append(lines, pinPrefix+renderTitle(tStyle))
 	}
 
 	// Line 2: Preview/Description (only if not empty)
@@ -1987,12 +2979,17 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 		preview := strings.ReplaceAll(card.Preview, "\n", " ")
 		preview = strings.ReplaceAll(preview, "\r", " ")
 		preview = strings.Join(strings.Fields(preview), " ")
-		if len(preview) > maxWidth {
-			preview = preview[:maxWidth-3] + "..."
-		}
+		preview = shared.Truncate(preview, maxWidth)
 		lines = append(lines, cardPreviewStyle.Render(preview))
 	}
 
+	// Blocked reason
+	if card.IsBlocked() {
+		blockedLine := "⊘ blocked: " + card.Blocked
+		blockedLine = shared.Truncate(blockedLine, maxWidth)
+		lines = append(lines, cardBlockedStyle.Render(blockedLine))
+	}
+
 	// Line 3-4: Dates
 	isDone := m.board.IsDoneColumn(m.board.Columns[colIndex].Name)
 	if isDone {
@@ -2017,27 +3014,21 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 	// Line 5: Projects (only if not empty)
 	if len(card.Projects) > 0 {
 		projectsLine := "+" + strings.Join(card.Projects, " +")
-		if len(projectsLine) > maxWidth {
-			projectsLine = projectsLine[:maxWidth-3] + "..."
-		}
+		projectsLine = shared.Truncate(projectsLine, maxWidth)
 		lines = append(lines, cardProjectStyle.Render(projectsLine))
 	}
 
 	// Line 6: Tags (only if not empty)
 	if len(card.Tags) > 0 {
 		tagsLine := "#" + strings.Join(card.Tags, " #")
-		if len(tagsLine) > maxWidth {
-			tagsLine = tagsLine[:maxWidth-3] + "..."
-		}
+		tagsLine = shared.Truncate(tagsLine, maxWidth)
 		lines = append(lines, cardTagStyle.Render(tagsLine))
 	}
 
 	// Jira issue badge
 	if card.JiraKey != "" {
 		jiraLine := jiraStatusLabel(card.JiraKey, card.JiraStatus)
-		if len(jiraLine) > maxWidth {
-			jiraLine = jiraLine[:maxWidth-3] + "..."
-		}
+		jiraLine = shared.Truncate(jiraLine, maxWidth)
 		lines = append(lines, jiraStatusStyle.Render(jiraLine))
 	}
 
@@ -2049,22 +3040,23 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 		if !hasRootSession {
 			tmuxBadgeStyle = cardTmuxInactiveStyle
 		}
-		tmuxLine := " " + card.TmuxSession + " "
+		tmuxLine := " ● " + card.TmuxSession + " "
+		if !hasRootSession {
+			tmuxLine = " ○ " + card.TmuxSession + " "
+		}
 		if hasClaudeSession {
 			// Reserve 4 chars for " C " plus min gap (space + " C ")
 			maxTmux := maxWidth - 4
-			if len(tmuxLine) > maxTmux {
-				tmuxLine = tmuxLine[:maxTmux-3] + "..."
-			}
-			padding := maxWidth - len(tmuxLine) - 3 // 3 for " C "
+			tmuxLine = shared.Truncate(tmuxLine, maxTmux)
+			padding := maxWidth - lipgloss.Width(tmuxLine) - 3 // 3 for " C "
 			if padding < 1 {
 				padding = 1
 			}
 			var gapStyle lipgloss.Style
 			if isMoveSelected {
-				gapStyle = lipgloss.NewStyle().Background(lipgloss.Color("54"))
+				gapStyle = lipgloss.NewStyle().Background(theme.MoveBg)
 			} else if isSelected {
-				gapStyle = lipgloss.NewStyle().Background(theme.Surface)
+				gapStyle = lipgloss.NewStyle().Background(theme.SelectionBg)
 			} else {
 				gapStyle = lipgloss.NewStyle()
 			}
@@ -2075,9 +3067,7 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 			}
 			lines = append(lines, tmuxBadgeStyle.Render(tmuxLine)+gapStyle.Render(strings.Repeat(" ", padding))+claudeBadgeStyle.Render(" C "))
 		} else {
-			if len(tmuxLine) > maxWidth {
-				tmuxLine = tmuxLine[:maxWidth-3] + "..."
-			}
+			tmuxLine = shared.Truncate(tmuxLine, maxWidth)
 			lines = append(lines, tmuxBadgeStyle.Render(tmuxLine))
 		}
 	}
@@ -2097,6 +3087,8 @@ func (m BoardModel) renderCard(colIndex, cardIndex int, card models.Card) string
 		} else {
 			style = selectedCardStyle
 		}
+	} else if card.IsBlocked() {
+		style = blockedCardStyle
 	}
 
 	return style.Render(content)
@@ -2110,6 +3102,9 @@ func (m *BoardModel) cardLineCount(colIndex int, card models.Card) int {
 	if card.Preview != "" {
 		lines++
 	}
+	if card.IsBlocked() {
+		lines++
+	}
 	isDone := m.board.IsDoneColumn(m.board.Columns[colIndex].Name)
 	if isDone {
 		if card.DateCompleted != nil {
@@ -2151,44 +3146,40 @@ func (m BoardModel) getChildSessionsFromCache(root string) map[string]bool {
 	return children
 }
 
-// formatDateWithDaysUntil formats a date with days until/overdue, coloring only the offset
+// formatDateWithDaysUntil formats a date with days until/overdue, coloring only the offset.
 func formatDateWithDaysUntil(date *time.Time, prefix string, selected bool) string {
 	if date == nil {
 		return ""
 	}
 
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
-	targetDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
-
-	daysUntil := int(targetDate.Sub(today).Hours() / 24)
+	daysUntil := shared.DaysUntil(*date, clock.Now())
 
 	dayOfWeek := strings.ToLower(date.Weekday().String()[:3])
 	datePart := fmt.Sprintf("%s:%02d-%02d %s", prefix, date.Month(), date.Day(), dayOfWeek)
-	offsetPart := fmt.Sprintf(" %+d", -daysUntil)
-
-	var offsetColor lipgloss.Color
-	if daysUntil > 7 {
-		offsetColor = theme.Success
-	} else if daysUntil > 0 {
-		offsetColor = theme.Warning
-	} else {
-		offsetColor = theme.Danger
-	}
+	offsetPart := " " + shared.Countdown(daysUntil)
 
 	dateStyle := lipgloss.NewStyle().Bold(true)
-	offsetStyle := lipgloss.NewStyle().Foreground(offsetColor).Bold(true)
+	offsetStyle := lipgloss.NewStyle().Foreground(shared.CountdownColor(daysUntil)).Bold(true)
 
 	if selected {
-		dateStyle = dateStyle.Background(theme.Surface)
-		offsetStyle = offsetStyle.Background(theme.Surface)
+		dateStyle = dateStyle.Background(theme.SelectionBg)
+		offsetStyle = offsetStyle.Background(theme.SelectionBg)
 	}
 
 	return dateStyle.Render(datePart) + offsetStyle.Render(offsetPart)
 }
 
+// updateRefs reindexes the board's cards in the workspace's refs index, so
+// backlinks follow cards that were added, moved, renamed or removed.
+func (m *BoardModel) updateRefs() {
+	if ix := m.boardInfo[m.board.Path].Refs; ix != nil {
+		ix.UpdateBoard(m.board)
+	}
+}
+
 // reloadBoardState syncs arrays and validates cursors after a board reload
 func (m *BoardModel) reloadBoardState() {
+	m.updateRefs()
 	if len(m.columnScrollOffsets) != len(m.board.Columns) {
 		newOffsets := make([]int, len(m.board.Columns))
 		copy(newOffsets, m.columnScrollOffsets)
@@ -2262,6 +3253,120 @@ func cardSearchString(card models.Card) string {
 	return strings.Join(parts, " ")
 }
 
+// cardBodyText is a card body flattened for word search, with the content it
+// was built from so an edited card is rebuilt.
+type cardBodyText struct {
+	content string
+	text    string
+}
+
+// SetFilterBodies sets whether the filter also searches card bodies.
+func (m *BoardModel) SetFilterBodies(on bool) {
+	m.filterBodies = on
+}
+
+// SetFilter applies query as a locked filter, as if typed after / and
+// confirmed with enter.
+func (m *BoardModel) SetFilter(query string) {
+	m.filterQuery = query
+	m.filterActive = query != ""
+	m.filteredIndices = nil
+	if m.filterActive {
+		m.recomputeFilter()
+	}
+	m.selectedCard = 0
+	m.columnCursorPos[m.selectedCol] = 0
+	m.adjustScrollPosition()
+}
+
+// SetHighlightMatches sets whether the filter underlines the matched
+// characters of card titles.
+func (m *BoardModel) SetHighlightMatches(on bool) {
+	m.highlightMatches = on
+}
+
+// cardSearchText is a card's lowercased fuzzy search string, with the hash of
+// the card it was built from so an edited card is rebuilt.
+type cardSearchText struct {
+	hash uint64
+	text string
+}
+
+// cardSearchLower returns cardSearchString lowercased, cached per card file.
+func (m *BoardModel) cardSearchLower(card models.Card) string {
+	h := hashCard(card)
+	if cached, ok := m.searchCache[card.Filename]; ok && cached.hash == h {
+		return cached.text
+	}
+	if m.searchCache == nil {
+		m.searchCache = make(map[string]cardSearchText)
+	}
+	text := strings.ToLower(cardSearchString(card))
+	m.searchCache[card.Filename] = cardSearchText{hash: h, text: text}
+	return text
+}
+
+// filterNarrowing is the result of the last filter run. When the next query
+// extends it, only the cards that matched can match again: fuzzy matches
+// are subsequences and body matches are substrings of a longer query.
+type filterNarrowing struct {
+	query     string
+	bodies    bool
+	filenames [][]string // per column: the card files the result was computed over
+	matched   [][]int    // per column: indices that matched query
+}
+
+// candidates returns the card indices of column colIdx worth matching
+// against query, or nil to scan the whole column.
+func (n *filterNarrowing) candidates(board models.Board, query string, bodies bool, colIdx int) []int {
+	if n == nil || n.bodies != bodies || len(query) <= len(n.query) || !strings.HasPrefix(query, n.query) {
+		return nil
+	}
+	if len(n.filenames) != len(board.Columns) {
+		return nil
+	}
+	cards := board.Columns[colIdx].Cards
+	if len(cards) != len(n.filenames[colIdx]) {
+		return nil
+	}
+	for i, card := range cards {
+		if card.Filename != n.filenames[colIdx][i] {
+			return nil
+		}
+	}
+	return n.matched[colIdx]
+}
+
+// cardBodySearchText returns the card's body lowercased with whitespace
+// collapsed. Bodies are only flattened once the body filter is used, and
+// cached per card file.
+func (m *BoardModel) cardBodySearchText(card models.Card) string {
+	if cached, ok := m.bodyCache[card.Filename]; ok && cached.content == card.Content {
+		return cached.text
+	}
+	if m.bodyCache == nil {
+		m.bodyCache = make(map[string]cardBodyText)
+	}
+	text := strings.ToLower(strings.Join(strings.Fields(card.Content), " "))
+	m.bodyCache[card.Filename] = cardBodyText{content: card.Content, text: text}
+	return text
+}
+
+// bodyMatches reports whether every word of query appears in the card body.
+func (m *BoardModel) bodyMatches(card models.Card, query string) bool {
+	words := strings.Fields(strings.ToLower(query))
+	if len(words) == 0 {
+		return false
+	}
+	body := m.cardBodySearchText(card)
+	for _, w := range words {
+		if !strings.Contains(body, w) {
+			return false
+		}
+	}
+	return true
+}
+
 // recomputeFilter rebuilds filteredIndices for each column based on the current filterQuery
 func (m *BoardModel) recomputeFilter() {
 	if m.filterQuery == "" {
@@ -2270,19 +3375,129 @@ func (m *BoardModel) recomputeFilter() {
 		return
 	}
 
+	query := strings.ToLower(m.filterQuery)
+	next := &filterNarrowing{
+		query:     query,
+		bodies:    m.filterBodies,
+		filenames: make([][]string, len(m.board.Columns)),
+		matched:   make([][]int, len(m.board.Columns)),
+	}
+	m.titleMatches = nil
+	if m.highlightMatches {
+		m.titleMatches = make(map[string][]int)
+	}
+
 	m.filteredIndices = make([][]int, len(m.board.Columns))
 	for colIdx, col := range m.board.Columns {
-		searchStrings := make([]string, len(col.Cards))
-		for i, card := range col.Cards {
-			searchStrings[i] = cardSearchString(card)
+		candidates := m.filterNarrow.candidates(m.board, query, m.filterBodies, colIdx)
+		if candidates == nil {
+			candidates = make([]int, len(col.Cards))
+			for i := range col.Cards {
+				candidates[i] = i
+			}
+		}
+		searchStrings := make([]string, len(candidates))
+		for i, idx := range candidates {
+			searchStrings[i] = m.cardSearchLower(col.Cards[idx])
 		}
-		matches := fuzzy.Find(m.filterQuery, searchStrings)
+		matches := fuzzy.Find(query, searchStrings)
 		indices := make([]int, len(matches))
+		matched := make(map[int]bool, len(matches))
 		for i, match := range matches {
-			indices[i] = match.Index
+			idx := candidates[match.Index]
+			indices[i] = idx
+			matched[idx] = true
+			if m.titleMatches != nil {
+				m.recordTitleMatch(col.Cards[idx], match.MatchedIndexes)
+			}
+		}
+		// Body matches come after the field matches, in column order
+		if m.filterBodies {
+			inOrder := append([]int{}, candidates...)
+			sort.Ints(inOrder)
+			for _, i := range inOrder {
+				if !matched[i] && m.bodyMatches(col.Cards[i], query) {
+					indices = append(indices, i)
+				}
+			}
 		}
 		m.filteredIndices[colIdx] = indices
+
+		next.filenames[colIdx] = make([]string, len(col.Cards))
+		for i, card := range col.Cards {
+			next.filenames[colIdx][i] = card.Filename
+		}
+		next.matched[colIdx] = indices
+	}
+	m.filterNarrow = next
+}
+
+// titleHighlight returns the title offsets to underline for card, if the
+// filter is active and highlighting is on.
+func (m BoardModel) titleHighlight(card models.Card) []int {
+	if !m.filterActive || m.titleMatches == nil {
+		return nil
+	}
+	return m.titleMatches[card.Filename]
+}
+
+// recordTitleMatch keeps the matched offsets that fall inside the card's
+// title, which leads its search string. Titles whose lowercase form has a
+// different byte length are skipped since the offsets would not line up.
+func (m *BoardModel) recordTitleMatch(card models.Card, offsets []int) {
+	if len(strings.ToLower(card.Title)) != len(card.Title) {
+		return
+	}
+	var inTitle []int
+	for _, o := range offsets {
+		if o < len(card.Title) {
+			inTitle = append(inTitle, o)
+		}
+	}
+	if len(inTitle) > 0 {
+		m.titleMatches[card.Filename] = inTitle
+	}
+}
+
+// highlightTitle renders title and then suffix in style, with the title bytes
+// at offsets underlined. A fixed style width is applied to the whole line
+// rather than each run.
+func highlightTitle(title string, offsets []int, suffix string, style lipgloss.Style) string {
+	hit := make(map[int]bool, len(offsets))
+	for _, o := range offsets {
+		hit[o] = true
+	}
+	width := style.GetWidth()
+	base := style.UnsetWidth()
+	mark := base.Underline(true).Foreground(theme.Warning)
+
+	// Runs are split on rune boundaries so multi-byte characters stay whole
+	var b strings.Builder
+	start := 0
+	for i := range title {
+		if i > start && hit[i] != hit[start] {
+			b.WriteString(renderRun(title[start:i], hit[start], mark, base))
+			start = i
+		}
+	}
+	if start < len(title) {
+		b.WriteString(renderRun(title[start:], hit[start], mark, base))
+	}
+	if suffix != "" {
+		b.WriteString(base.Render(suffix))
+	}
+	out := b.String()
+	if pad := width - lipgloss.Width(out); width > 0 && pad > 0 {
+		out += base.Render(strings.Repeat(" ", pad))
+	}
+	return out
+}
+
+func renderRun(run string, marked bool, mark, base lipgloss.Style) string {
+	if marked {
+		return mark.Render(run)
 	}
+	return base.Render(run)
 }
 
 // getVisibleCardIndices returns the real card indices that should be visible,
@@ -2302,17 +3517,42 @@ func (m *BoardModel) getVisibleCardIndices(colIndex int) []int {
 	}
 
 	// Filter out archived cards unless showArchived
-	if m.showArchived {
-		return baseIndices
+	visible := baseIndices
+	if !m.showArchived {
+		visible = make([]int, 0, len(baseIndices))
+		for _, idx := range baseIndices {
+			if !allCards[idx].Archived {
+				visible = append(visible, idx)
+			}
+		}
 	}
+	return pinnedFirst(allCards, visible)
+}
 
-	visible := make([]int, 0, len(baseIndices))
-	for _, idx := range baseIndices {
-		if !allCards[idx].Archived {
-			visible = append(visible, idx)
+// pinnedFirst moves the indices of pinned cards to the front, keeping the
+// order within pinned and unpinned cards.
+func pinnedFirst(cards []models.Card, indices []int) []int {
+	pinned := 0
+	for _, idx := range indices {
+		if cards[idx].Pinned {
+			pinned++
+		}
+	}
+	if pinned == 0 || pinned == len(indices) {
+		return indices
+	}
+	ordered := make([]int, 0, len(indices))
+	for _, idx := range indices {
+		if cards[idx].Pinned {
+			ordered = append(ordered, idx)
 		}
 	}
-	return visible
+	for _, idx := range indices {
+		if !cards[idx].Pinned {
+			ordered = append(ordered, idx)
+		}
+	}
+	return ordered
 }
 
 // getVisibleCards returns the cards to display for a column, respecting the active filter and archive state
@@ -2437,7 +3677,21 @@ func (m *BoardModel) calculateVisibleColumns() (startCol, endCol int) {
 }
 
 // renderScrollIndicator renders ◀ and ▶ indicators for horizontal scrolling
-func (m *BoardModel) renderScrollIndicator(symbol string, height int) string {
+func (m *BoardModel) renderScrollIndicator(symbol string, height int) renderedBlock {
+	// They are kept in the column cache, one slot per symbol below the columns
+	slot := -1
+	switch symbol {
+	case "▶":
+		slot = -2
+	case " ":
+		slot = -3
+	}
+	return m.columnCache.render(slot, columnKey(symbol, false, "", height), func() string {
+		return buildScrollIndicator(symbol, height)
+	})
+}
+
+func buildScrollIndicator(symbol string, height int) string {
 	indicatorStyle := lipgloss.NewStyle().
 		Foreground(theme.Primary).
 		Bold(true)
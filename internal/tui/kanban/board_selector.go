@@ -3,13 +3,26 @@ package kanban
 import (
 	"os"
 	"path/filepath"
+	"sort"
 	"strings"
+	"sync"
 	"wydo/internal/kanban/models"
+	"wydo/internal/refs"
+	"wydo/internal/tui/shared"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
 )
 
+// BoardInfo is the workspace a board belongs to and the projects linked to
+// it there. Each board's projects are resolved against its own workspace.
+type BoardInfo struct {
+	Workspace string      // root directory of the workspace holding the board
+	Projects  []string    // projects linked to the board in that workspace
+	Refs      *refs.Index // what refers to the board's cards, for v
+}
+
 // BoardSelectorModel is a simple single-select list for picking a board.
 type BoardSelectorModel struct {
 	boards []models.Board
@@ -17,6 +30,14 @@ type BoardSelectorModel struct {
 	width  int
 	height int
 	title  string
+
+	// Workspace root of each board, parallel to boards (see GroupByWorkspace)
+	workspaces []string
+
+	// Optional fuzzy filtering (see EnableFilter)
+	filterable bool
+	query      string
+	matches    []int // indices into boards that match query
 }
 
 // NewBoardSelectorModel creates a new board selector, excluding the current board.
@@ -36,6 +57,123 @@ func (m *BoardSelectorModel) SetSize(w, h int) {
 	m.height = h
 }
 
+// SortByRecent moves boards in recent (paths, most recent first) to the top,
+// keeping the remaining boards in their original order.
+func (m *BoardSelectorModel) SortByRecent(recent []string) {
+	rank := make(map[string]int, len(recent))
+	for i, p := range recent {
+		rank[p] = i
+	}
+	sort.SliceStable(m.boards, func(i, j int) bool {
+		ri, iok := rank[m.boards[i].Path]
+		rj, jok := rank[m.boards[j].Path]
+		if iok && jok {
+			return ri < rj
+		}
+		return iok && !jok
+	})
+}
+
+// GroupByWorkspace orders the boards workspace by workspace and shows each
+// workspace's name above its boards when there is more than one. Workspaces
+// keep the order of their first board, so after SortByRecent the workspace
+// used last comes first and boards keep their recent order within it.
+func (m *BoardSelectorModel) GroupByWorkspace(info map[string]BoardInfo) {
+	order := make(map[string]int)
+	for _, b := range m.boards {
+		ws := info[b.Path].Workspace
+		if _, ok := order[ws]; !ok {
+			order[ws] = len(order)
+		}
+	}
+	sort.SliceStable(m.boards, func(i, j int) bool {
+		return order[info[m.boards[i].Path].Workspace] < order[info[m.boards[j].Path].Workspace]
+	})
+	m.workspaces = make([]string, len(m.boards))
+	for i, b := range m.boards {
+		m.workspaces[i] = info[b.Path].Workspace
+	}
+	if m.filterable {
+		m.applyFilter()
+	}
+}
+
+// EnableFilter turns on type-to-filter: printable keys narrow the list by fuzzy
+// match on board name and path, and navigation moves to arrows / ctrl+j/k.
+func (m *BoardSelectorModel) EnableFilter() {
+	m.filterable = true
+	m.applyFilter()
+}
+
+// applyFilter recomputes matches for the current query. Each workspace's
+// boards are matched in parallel and keep their group, best match first.
+func (m *BoardSelectorModel) applyFilter() {
+	m.matches = m.matches[:0]
+	if m.query == "" {
+		for i := range m.boards {
+			m.matches = append(m.matches, i)
+		}
+	} else {
+		groups := m.groupRanges()
+		results := make([][]int, len(groups))
+		var wg sync.WaitGroup
+		for g, rng := range groups {
+			wg.Add(1)
+			go func(g, start, end int) {
+				defer wg.Done()
+				targets := make([]string, end-start)
+				for i, b := range m.boards[start:end] {
+					targets[i] = b.Name + " " + filepath.Dir(b.Path)
+				}
+				for _, match := range fuzzy.Find(m.query, targets) {
+					results[g] = append(results[g], start+match.Index)
+				}
+			}(g, rng[0], rng[1])
+		}
+		wg.Wait()
+		for _, r := range results {
+			m.matches = append(m.matches, r...)
+		}
+	}
+	if m.cursor >= len(m.matches) {
+		m.cursor = max(0, len(m.matches)-1)
+	}
+}
+
+// groupRanges returns the [start, end) ranges of boards sharing a
+// workspace, or one range over all boards when they aren't grouped.
+func (m BoardSelectorModel) groupRanges() [][2]int {
+	if len(m.workspaces) != len(m.boards) {
+		return [][2]int{{0, len(m.boards)}}
+	}
+	var ranges [][2]int
+	start := 0
+	for i := 1; i <= len(m.boards); i++ {
+		if i == len(m.boards) || m.workspaces[i] != m.workspaces[start] {
+			ranges = append(ranges, [2]int{start, i})
+			start = i
+		}
+	}
+	return ranges
+}
+
+// multiWorkspace reports whether the boards come from more than one workspace.
+func (m BoardSelectorModel) multiWorkspace() bool {
+	return len(m.groupRanges()) > 1
+}
+
+// visible returns the indices of boards currently shown.
+func (m BoardSelectorModel) visible() []int {
+	if m.filterable {
+		return m.matches
+	}
+	indices := make([]int, len(m.boards))
+	for i := range m.boards {
+		indices[i] = i
+	}
+	return indices
+}
+
 // Empty returns true when there are no boards to choose from.
 func (m BoardSelectorModel) Empty() bool {
 	return len(m.boards) == 0
@@ -44,6 +182,9 @@ func (m BoardSelectorModel) Empty() bool {
 // Update handles key events. Returns (model, selectedPath, done).
 // selectedPath is non-empty only on enter; done is true on enter or esc.
 func (m BoardSelectorModel) Update(msg tea.KeyMsg) (BoardSelectorModel, string, bool) {
+	if m.filterable {
+		return m.updateFilterable(msg)
+	}
 	switch msg.String() {
 	case "j", "down":
 		if m.cursor < len(m.boards)-1 {
@@ -64,31 +205,93 @@ func (m BoardSelectorModel) Update(msg tea.KeyMsg) (BoardSelectorModel, string,
 	return m, "", false
 }
 
+func (m BoardSelectorModel) updateFilterable(msg tea.KeyMsg) (BoardSelectorModel, string, bool) {
+	switch msg.String() {
+	case "down", "ctrl+j", "ctrl+n":
+		if m.cursor < len(m.matches)-1 {
+			m.cursor++
+		}
+	case "up", "ctrl+k", "ctrl+p":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		if m.cursor < len(m.matches) {
+			return m, m.boards[m.matches[m.cursor]].Path, true
+		}
+		return m, "", true
+	case "esc":
+		return m, "", true
+	case "backspace":
+		if len(m.query) > 0 {
+			runes := []rune(m.query)
+			m.query = string(runes[:len(runes)-1])
+			m.applyFilter()
+		}
+	default:
+		switch msg.Type {
+		case tea.KeyRunes:
+			m.query += string(msg.Runes)
+		case tea.KeySpace:
+			m.query += " "
+		default:
+			return m, "", false
+		}
+		m.cursor = 0
+		m.applyFilter()
+	}
+	return m, "", false
+}
+
 // View renders the board selector as a centered modal.
 func (m BoardSelectorModel) View() string {
 	var lines []string
 
 	lines = append(lines, tagPickerTitleStyle.Render(m.title))
+	if m.filterable {
+		lines = append(lines, columnEditorPromptStyle.Render("> ")+m.query+"█")
+	}
 	lines = append(lines, "")
 
-	for i, b := range m.boards {
+	listStart := len(lines)
+	cursorRow := 0
+	lastWorkspace := "\x00" // sentinel so the first header always shows
+	for i, idx := range m.visible() {
+		b := m.boards[idx]
+		if m.multiWorkspace() && m.workspaces[idx] != lastWorkspace {
+			lastWorkspace = m.workspaces[idx]
+			lines = append(lines, columnEditorPromptStyle.Render(abbreviateBoardPath(lastWorkspace)))
+		}
 		style := listItemStyle
 		prefix := "  "
 		if i == m.cursor {
 			style = selectedListItemStyle
 			prefix = "► "
+			cursorRow = len(lines) - listStart
 		}
 		parentDir := filepath.Dir(b.Path)
 		displayPath := abbreviateBoardPath(parentDir)
-		line := style.Render(prefix+b.Name) + "  " + pathStyle.Render(displayPath)
+		line := style.Render(prefix+b.Name) + "  " + shared.FileLink(b.Path, pathStyle.Render(displayPath))
 		lines = append(lines, line)
 	}
+	listEnd := len(lines)
+
+	if m.filterable && len(m.matches) == 0 {
+		lines = append(lines, helpStyle.Render("  no matching boards"))
+	}
 
 	lines = append(lines, "")
-	lines = append(lines, helpStyle.Render("j/k: navigate • enter: select • esc: cancel"))
+	if m.filterable {
+		lines = append(lines, helpStyle.Render("type to filter • ↑/↓: navigate • enter: open • esc: cancel"))
+	} else {
+		lines = append(lines, helpStyle.Render("j/k: navigate • enter: select • esc: cancel"))
+	}
+
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(60, m.width))
+	lines = shared.FitModalList(lines, listStart, listEnd, cursorRow, box, m.height)
 
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
-	boxed := tagPickerBoxStyle.Width(60).Render(content)
+	boxed := box.Render(content)
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
 }
 
@@ -0,0 +1,123 @@
+package kanban
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/models"
+)
+
+func newTestBoardSwitcher() BoardSelectorModel {
+	boards := []models.Board{
+		{Name: "Current", Path: "/ws/boards/current/board.md"},
+		{Name: "Personal", Path: "/ws/boards/personal/board.md"},
+		{Name: "Platform Team", Path: "/ws/boards/platform/board.md"},
+		{Name: "Reading", Path: "/ws/boards/reading/board.md"},
+	}
+	m := NewBoardSelectorModel(boards, "/ws/boards/current/board.md", "Switch Board")
+	m.EnableFilter()
+	return m
+}
+
+func typeQuery(m BoardSelectorModel, q string) BoardSelectorModel {
+	for _, r := range q {
+		m, _, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestBoardSwitcher_ExcludesCurrentBoard(t *testing.T) {
+	m := newTestBoardSwitcher()
+	if len(m.visible()) != 3 {
+		t.Fatalf("expected 3 boards, got %d", len(m.visible()))
+	}
+	for _, idx := range m.visible() {
+		if m.boards[idx].Name == "Current" {
+			t.Fatal("current board should not be listed")
+		}
+	}
+}
+
+func TestBoardSwitcher_FuzzyFilterAndSelect(t *testing.T) {
+	m := typeQuery(newTestBoardSwitcher(), "plat")
+	if len(m.visible()) != 1 {
+		t.Fatalf("expected 1 match for 'plat', got %d", len(m.visible()))
+	}
+	_, path, done := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !done || path != "/ws/boards/platform/board.md" {
+		t.Fatalf("expected platform board selected, got path=%q done=%v", path, done)
+	}
+}
+
+func TestBoardSwitcher_LettersFilterInsteadOfNavigating(t *testing.T) {
+	m := typeQuery(newTestBoardSwitcher(), "j")
+	if m.query != "j" {
+		t.Fatalf("expected 'j' to be typed into the query, got %q", m.query)
+	}
+	m, _, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if m.query != "" || len(m.visible()) != 3 {
+		t.Fatalf("expected backspace to clear the query, got %q (%d visible)", m.query, len(m.visible()))
+	}
+}
+
+func TestBoardSwitcher_EscCancels(t *testing.T) {
+	m := newTestBoardSwitcher()
+	_, path, done := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if !done || path != "" {
+		t.Fatalf("expected cancel, got path=%q done=%v", path, done)
+	}
+}
+
+func TestBoardSwitcher_SortByRecent(t *testing.T) {
+	m := newTestBoardSwitcher()
+	m.SortByRecent([]string{"/ws/boards/reading/board.md", "/ws/boards/current/board.md", "/ws/boards/platform/board.md"})
+	got := []string{m.boards[0].Name, m.boards[1].Name, m.boards[2].Name}
+	want := []string{"Reading", "Platform Team", "Personal"}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("expected order %v, got %v", want, got)
+		}
+	}
+}
+
+func TestBoardSelector_GroupsByWorkspaceAndFiltersWithinGroups(t *testing.T) {
+	boards := []models.Board{
+		{Name: "Work Plan", Path: "/work/boards/plan/board.md"},
+		{Name: "Home Plan", Path: "/home/boards/plan/board.md"},
+		{Name: "Ops", Path: "/work/boards/ops/board.md"},
+		{Name: "Garden", Path: "/home/boards/garden/board.md"},
+	}
+	info := map[string]BoardInfo{
+		"/work/boards/plan/board.md":   {Workspace: "/work"},
+		"/work/boards/ops/board.md":    {Workspace: "/work"},
+		"/home/boards/plan/board.md":   {Workspace: "/home"},
+		"/home/boards/garden/board.md": {Workspace: "/home"},
+	}
+	m := NewBoardSelectorModel(boards, "", "Move to Board")
+	m.SortByRecent([]string{"/home/boards/garden/board.md"})
+	m.GroupByWorkspace(info)
+	m.EnableFilter()
+
+	var got []string
+	for _, idx := range m.visible() {
+		got = append(got, m.boards[idx].Name)
+	}
+	want := []string{"Garden", "Home Plan", "Work Plan", "Ops"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected %v (recent workspace first), got %v", want, got)
+	}
+	view := m.View()
+	if !strings.Contains(view, "/home") || !strings.Contains(view, "/work") {
+		t.Errorf("expected workspace headers:\n%s", view)
+	}
+
+	m = typeQuery(m, "plan")
+	got = got[:0]
+	for _, idx := range m.visible() {
+		got = append(got, m.boards[idx].Name)
+	}
+	if strings.Join(got, ",") != "Home Plan,Work Plan" {
+		t.Fatalf("expected matches in workspace order, got %v", got)
+	}
+}
@@ -0,0 +1,125 @@
+package kanban
+
+import (
+	"errors"
+	"fmt"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
+)
+
+// ActionPickerModel is a small popup listing a card's actions: frontmatter
+// `actions:` entries, each a name and a shell command.
+type ActionPickerModel struct {
+	actions []models.CardAction
+	cursor  int
+	width   int
+	height  int
+}
+
+func NewActionPickerModel(actions []models.CardAction) ActionPickerModel {
+	return ActionPickerModel{actions: actions}
+}
+
+// Update handles key events. Returns (model, action index, done); the index
+// is -1 when the picker was cancelled.
+func (m ActionPickerModel) Update(msg tea.KeyMsg) (ActionPickerModel, int, bool) {
+	switch msg.String() {
+	case "j", "down":
+		if m.cursor < len(m.actions)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		return m, m.cursor, true
+	case "esc", "q":
+		return m, -1, true
+	default:
+		// 1-9 run an action directly
+		if s := msg.String(); len(s) == 1 && s[0] >= '1' && s[0] <= '9' {
+			if idx := int(s[0] - '1'); idx < len(m.actions) {
+				return m, idx, true
+			}
+		}
+	}
+	return m, -1, false
+}
+
+// View renders the action picker as a centered modal.
+func (m ActionPickerModel) View() string {
+	var lines []string
+
+	lines = append(lines, tagPickerTitleStyle.Render("Run Action"))
+	lines = append(lines, "")
+
+	for i, a := range m.actions {
+		style := listItemStyle
+		prefix := "  "
+		if i == m.cursor {
+			style = selectedListItemStyle
+			prefix = "> "
+		}
+		command := shared.Truncate(a.Command, 40)
+		lines = append(lines, style.Render(fmt.Sprintf("%s%d %s", prefix, i+1, a.Name))+cardPreviewStyle.Render("  "+command))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render("j/k: navigate • enter/1-9: run • esc: cancel"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	boxed := tagPickerBoxStyle.Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
+}
+
+// cardActionFinishedMsg is sent when an action's command exits.
+type cardActionFinishedMsg struct {
+	name string
+	err  error
+}
+
+// expandActionCommand fills in an action command's placeholders, each
+// shell-quoted: {{card}} is the card file, {{title}} the card title,
+// {{board}} the board directory and {{filename}} the card file name.
+func expandActionCommand(command, boardPath string, card models.Card) string {
+	return strings.NewReplacer(
+		"{{card}}", shellQuote(filepath.Join(boardPath, "cards", card.Filename)),
+		"{{title}}", shellQuote(card.Title),
+		"{{board}}", shellQuote(boardPath),
+		"{{filename}}", shellQuote(card.Filename),
+	).Replace(command)
+}
+
+// shellQuote wraps s in single quotes for sh.
+func shellQuote(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", `'\''`) + "'"
+}
+
+// runCardAction runs an action with sh in the board directory, handing it
+// the terminal until it exits.
+func runCardAction(boardPath string, card models.Card, action models.CardAction) tea.Cmd {
+	c := exec.Command("sh", "-c", expandActionCommand(action.Command, boardPath, card))
+	c.Dir = boardPath
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return cardActionFinishedMsg{name: action.Name, err: err}
+	})
+}
+
+// actionResult describes how an action ended, for the status message.
+func actionResult(msg cardActionFinishedMsg) (string, error) {
+	if msg.err == nil {
+		return fmt.Sprintf("%s: done", msg.name), nil
+	}
+	var exitErr *exec.ExitError
+	if errors.As(msg.err, &exitErr) {
+		return "", fmt.Errorf("%s: exited with status %d", msg.name, exitErr.ExitCode())
+	}
+	return "", fmt.Errorf("%s: %w", msg.name, msg.err)
+}
@@ -0,0 +1,29 @@
+package kanban
+
+import (
+	"os/exec"
+	"testing"
+
+	"wydo/internal/kanban/models"
+)
+
+func TestExpandActionCommand(t *testing.T) {
+	card := models.Card{Filename: "fix-bug.md", Title: "Fix Bob's bug"}
+	got := expandActionCommand("echo {{title}} > {{card}}; ls {{board}}/{{filename}}", "/ws/boards/dev", card)
+	want := `echo 'Fix Bob'\''s bug' > '/ws/boards/dev/cards/fix-bug.md'; ls '/ws/boards/dev'/'fix-bug.md'`
+	if got != want {
+		t.Errorf("expandActionCommand:\n got %s\nwant %s", got, want)
+	}
+}
+
+func TestActionResult(t *testing.T) {
+	if msg, err := actionResult(cardActionFinishedMsg{name: "Build"}); err != nil || msg != "Build: done" {
+		t.Errorf("success: got %q, %v", msg, err)
+	}
+
+	exitErr := exec.Command("sh", "-c", "exit 3").Run()
+	_, err := actionResult(cardActionFinishedMsg{name: "Build", err: exitErr})
+	if err == nil || err.Error() != "Build: exited with status 3" {
+		t.Errorf("failure: got %v", err)
+	}
+}
@@ -0,0 +1,78 @@
+package kanban
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/attachments"
+)
+
+// CardAttachModel is a one-line input for the path of a file to attach to a
+// card. tab completes the path; a file dragged onto the terminal pastes its
+// path, quoted or escaped, which is understood too.
+type CardAttachModel struct {
+	input  textinput.Model
+	err    string
+	width  int
+	height int
+}
+
+func NewCardAttachModel() CardAttachModel {
+	ti := textinput.New()
+	ti.Placeholder = "~/Downloads/spec.pdf (or drag a file here)"
+	ti.CharLimit = 1024
+	ti.Width = 50
+	ti.Focus()
+	return CardAttachModel{input: ti}
+}
+
+func (m CardAttachModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true on esc, or on enter once the path names a file;
+// confirmed is true only for enter.
+func (m CardAttachModel) Update(msg tea.KeyMsg) (model CardAttachModel, cmd tea.Cmd, done, confirmed bool) {
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		if err := attachments.Validate(m.input.Value()); err != nil {
+			m.err = err.Error()
+			return m, nil, false, false
+		}
+		return m, nil, true, true
+	case "tab":
+		m.input.SetValue(attachments.Complete(m.input.Value()))
+		m.input.CursorEnd()
+		m.err = ""
+		return m, nil, false, false
+	}
+	m.err = ""
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// Path returns the entered path as typed; attachments.Store normalizes it.
+func (m CardAttachModel) Path() string {
+	return m.input.Value()
+}
+
+func (m CardAttachModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("Attach File"))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	s.WriteString("\n\n")
+	if m.err != "" {
+		s.WriteString(errorStyle.Render(m.err))
+		s.WriteString("\n\n")
+	}
+	s.WriteString(helpStyle.Render("tab: complete • enter: attach • esc: cancel"))
+
+	box := renameInputBoxStyle.Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
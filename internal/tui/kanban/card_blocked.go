@@ -0,0 +1,61 @@
+package kanban
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CardBlockedModel is a one-line input for the reason a card is blocked.
+type CardBlockedModel struct {
+	input  textinput.Model
+	width  int
+	height int
+}
+
+func NewCardBlockedModel(currentReason string) CardBlockedModel {
+	ti := textinput.New()
+	ti.Placeholder = "Waiting on..."
+	ti.CharLimit = 200
+	ti.Width = 50
+	ti.SetValue(currentReason)
+	ti.CursorEnd()
+	ti.Focus()
+	return CardBlockedModel{input: ti}
+}
+
+func (m CardBlockedModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true on enter or esc; confirmed is true only for enter.
+func (m CardBlockedModel) Update(msg tea.KeyMsg) (model CardBlockedModel, cmd tea.Cmd, done, confirmed bool) {
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		return m, nil, true, true
+	}
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// Reason returns the entered reason, trimmed. Empty means unblocked.
+func (m CardBlockedModel) Reason() string {
+	return strings.TrimSpace(m.input.Value())
+}
+
+func (m CardBlockedModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("Block Card"))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	s.WriteString("\n\n")
+	s.WriteString(helpStyle.Render("enter: save (empty unblocks) • esc: cancel"))
+
+	box := renameInputBoxStyle.Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
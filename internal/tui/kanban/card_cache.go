@@ -0,0 +1,105 @@
+package kanban
+
+import (
+	"hash/fnv"
+	"strconv"
+	"time"
+
+	"wydo/internal/kanban/models"
+)
+
+// maxCardCacheEntries bounds the render cache. When it fills up the cache is
+// simply cleared; entries for the current screen are rebuilt on the next frame.
+const maxCardCacheEntries = 4096
+
+// cardRenderKey identifies one rendered card. It covers everything renderCard
+// reads: the card's displayed fields, selection and move state, whether the
+// column is a done column, the render width, today's date (for the relative
+// due/scheduled offsets), the tmux/claude badge state and the title
+// characters highlighted by the filter.
+type cardRenderKey struct {
+	hash          uint64
+	selected      bool
+	moveSelected  bool
+	done          bool
+	width         int
+	day           string
+	tmuxActive    bool
+	claudeActive  bool
+	claudeWaiting bool
+	highlight     string
+}
+
+// cardRenderCache memoizes rendered card strings so navigating large boards
+// doesn't rebuild every lipgloss string on each keypress. It is shared by
+// pointer between copies of BoardModel.
+type cardRenderCache struct {
+	entries map[cardRenderKey]string
+}
+
+func newCardRenderCache() *cardRenderCache {
+	return &cardRenderCache{entries: make(map[cardRenderKey]string)}
+}
+
+func (c *cardRenderCache) get(key cardRenderKey) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+	s, ok := c.entries[key]
+	return s, ok
+}
+
+func (c *cardRenderCache) put(key cardRenderKey, rendered string) {
+	if c == nil {
+		return
+	}
+	if len(c.entries) >= maxCardCacheEntries {
+		c.entries = make(map[cardRenderKey]string)
+	}
+	c.entries[key] = rendered
+}
+
+// hashCard hashes the card fields that affect how it renders or what the
+// filter matches.
+func hashCard(card models.Card) uint64 {
+	h := fnv.New64a()
+	write := func(s string) {
+		h.Write([]byte(s))
+		h.Write([]byte{0})
+	}
+	writeDate := func(t *time.Time) {
+		if t == nil {
+			write("")
+			return
+		}
+		write(t.Format(time.RFC3339))
+	}
+
+	write(card.Filename)
+	write(card.Title)
+	write(card.Preview)
+	for _, tag := range card.Tags {
+		write(tag)
+	}
+	write("|")
+	for _, p := range card.Projects {
+		write(p)
+	}
+	write("|")
+	write(strconv.Itoa(len(card.URLs)))
+	for _, u := range card.URLs { // the ↗ indicator links to the first; the filter searches all
+		write(u.Label)
+		write(u.URL)
+	}
+	writeDate(card.DueDate)
+	writeDate(card.ScheduledDate)
+	writeDate(card.DateCompleted)
+	write(strconv.Itoa(card.Priority))
+	write(strconv.FormatBool(card.Archived))
+	write(strconv.FormatBool(card.Pinned))
+	write(card.TmuxSession)
+	write(card.JiraKey)
+	write(card.JiraStatus)
+	write(card.Blocked)
+	return h.Sum64()
+}
@@ -0,0 +1,57 @@
+package kanban
+
+import (
+	"testing"
+
+	"wydo/internal/kanban/models"
+)
+
+func TestHashCard_ChangesWithDisplayedFields(t *testing.T) {
+	base := models.Card{Filename: "a.md", Title: "Ship it", Tags: []string{"x"}}
+	h := hashCard(base)
+
+	if hashCard(base) != h {
+		t.Fatal("hash should be stable")
+	}
+
+	changed := []models.Card{
+		{Filename: "a.md", Title: "Ship it now", Tags: []string{"x"}},
+		{Filename: "a.md", Title: "Ship it", Tags: []string{"y"}},
+		{Filename: "a.md", Title: "Ship it", Projects: []string{"x"}},
+		{Filename: "a.md", Title: "Ship it", Tags: []string{"x"}, Priority: 1},
+		{Filename: "a.md", Title: "Ship it", Tags: []string{"x"}, Blocked: "waiting"},
+	}
+	for i, c := range changed {
+		if hashCard(c) == h {
+			t.Errorf("case %d: expected a different hash", i)
+		}
+	}
+}
+
+func TestRenderCard_UsesCacheAndTracksSelection(t *testing.T) {
+	card := models.Card{Filename: "a.md", Title: "Ship it"}
+	other := models.Card{Filename: "b.md", Title: "Write docs"}
+	board := models.Board{Columns: []models.Column{{Name: "To Do", Cards: []models.Card{card, other}}}}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	selected := m.renderCard(0, 0, card)
+	if len(m.cardCache.entries) != 1 {
+		t.Fatalf("expected 1 cache entry, got %d", len(m.cardCache.entries))
+	}
+	if again := m.renderCard(0, 0, card); again != selected || len(m.cardCache.entries) != 1 {
+		t.Error("expected a cache hit for an unchanged card")
+	}
+
+	// Same card, no longer selected, is a separate entry
+	m.selectedCard = 1
+	m.renderCard(0, 0, card)
+	if len(m.cardCache.entries) != 2 {
+		t.Errorf("selection change should miss the cache, got %d entries", len(m.cardCache.entries))
+	}
+
+	// Editing the card misses the cache
+	card.Title = "Ship it today"
+	if edited := m.renderCard(0, 0, card); edited == selected || len(m.cardCache.entries) != 3 {
+		t.Error("edited card should not reuse the old render")
+	}
+}
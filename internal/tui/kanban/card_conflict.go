@@ -0,0 +1,177 @@
+package kanban
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
+	"wydo/internal/tui/theme"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// conflictChoice is how the user resolved a card that changed on disk.
+type conflictChoice int
+
+const (
+	conflictCancel   conflictChoice = iota
+	conflictKeepMine                // write the board's copy over the file
+	conflictKeepDisk                // replace the board's copy with the file
+)
+
+// CardConflictModel shows a word diff between the card file on disk and the
+// copy the board loaded, and asks which to keep.
+type CardConflictModel struct {
+	disk   models.Card
+	mine   models.Card
+	width  int
+	height int
+}
+
+func NewCardConflictModel(disk, mine models.Card) CardConflictModel {
+	return CardConflictModel{disk: disk, mine: mine}
+}
+
+// Update returns done=true once a choice is made; esc cancels.
+func (m CardConflictModel) Update(msg tea.KeyMsg) (choice conflictChoice, done bool) {
+	switch msg.String() {
+	case "m":
+		return conflictKeepMine, true
+	case "d":
+		return conflictKeepDisk, true
+	case "esc":
+		return conflictCancel, true
+	}
+	return conflictCancel, false
+}
+
+func (m CardConflictModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("Card Changed on Disk"))
+	s.WriteString("\n\n")
+	s.WriteString(shared.RenderWordDiff(cardDiffText(m.disk), cardDiffText(m.mine)))
+	s.WriteString("\n\n")
+	s.WriteString(theme.Muted.Render("struck out: on disk • underlined: this board"))
+	s.WriteString("\n")
+	s.WriteString(helpStyle.Render("m: keep mine • d: keep disk version • esc: cancel"))
+
+	box := theme.ModalBox.Width(min(max(m.width-10, 60), 100)).Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// cardDiffText renders the fields a card file stores, one per line, followed
+// by its body. Two cards with the same text have the same file content.
+func cardDiffText(c models.Card) string {
+	var lines []string
+	add := func(key, value string) {
+		if value != "" {
+			lines = append(lines, key+": "+value)
+		}
+	}
+	date := func(t *time.Time, layout string) string {
+		if t == nil {
+			return ""
+		}
+		return t.Format(layout)
+	}
+
+	add("tags", strings.Join(c.Tags, ", "))
+	add("projects", strings.Join(c.Projects, ", "))
+	for _, u := range c.URLs {
+		if u.Label != "" {
+			add("url", u.URL+" ("+u.Label+")")
+		} else {
+			add("url", u.URL)
+		}
+	}
+	add("due", date(c.DueDate, "2006-01-02"))
+	add("scheduled", date(c.ScheduledDate, "2006-01-02"))
+	add("completed", date(c.DateCompleted, time.RFC3339))
+	if c.Priority > 0 {
+		add("priority", fmt.Sprint(c.Priority))
+	}
+	if c.Archived {
+		add("archived", "true")
+	}
+	add("tmux", c.TmuxSession)
+	add("jira", strings.TrimSpace(c.JiraKey+" "+c.JiraStatus))
+	add("goal", c.Goal)
+	add("blocked", c.Blocked)
+	keys := make([]string, 0, len(c.TaskTags))
+	for k := range c.TaskTags {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		add("task tag", k+"="+c.TaskTags[k])
+	}
+	for _, a := range c.Actions {
+		add("action", a.Name+": "+a.Command)
+	}
+	for _, n := range c.Notify {
+		add("notify", n)
+	}
+
+	return strings.Join(lines, "\n") + "\n\n" + strings.TrimSpace(c.Content)
+}
+
+// guardCard opens an edit of the selected card via then, unless the card
+// file changed on disk since the board loaded it. In that case the conflict
+// modal is shown first and then runs once the user picks a version.
+func (m BoardModel) guardCard(then func(BoardModel) (BoardModel, tea.Cmd)) (BoardModel, tea.Cmd) {
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	mine := m.board.Columns[m.selectedCol].Cards[realIdx]
+	disk, err := fs.ReadCard(filepath.Join(m.board.Path, "cards", mine.Filename))
+	if err != nil || cardDiffText(disk) == cardDiffText(mine) {
+		return then(m)
+	}
+
+	conflict := NewCardConflictModel(disk, mine)
+	conflict.width = m.width
+	conflict.height = m.height
+	m.cardConflict = &conflict
+	m.conflictThen = then
+	m.mode = boardModeCardConflict
+	return m, nil
+}
+
+func (m BoardModel) updateCardConflict(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	choice, done := m.cardConflict.Update(msg)
+	if !done {
+		return m, nil
+	}
+
+	conflict, then := *m.cardConflict, m.conflictThen
+	m.mode = boardModeNormal
+	m.cardConflict = nil
+	m.conflictThen = nil
+
+	realIdx := m.resolveCardIndex(m.selectedCol, m.selectedCard)
+	switch choice {
+	case conflictKeepMine:
+		if err := fs.WriteCard(conflict.mine, filepath.Join(m.board.Path, "cards", conflict.mine.Filename)); err != nil {
+			m.err = err
+			return m, nil
+		}
+	case conflictKeepDisk:
+		m.board.Columns[m.selectedCol].Cards[realIdx] = conflict.disk
+		m.reloadBoardState()
+		// The disk version may no longer match the filter; don't edit whatever took its place
+		realIdx = m.resolveCardIndex(m.selectedCol, m.selectedCard)
+		cards := m.board.Columns[m.selectedCol].Cards
+		if realIdx >= len(cards) || cards[realIdx].Filename != conflict.disk.Filename {
+			m.message = "Card reloaded from disk"
+			return m, nil
+		}
+	default:
+		return m, nil
+	}
+	return then(m)
+}
@@ -0,0 +1,109 @@
+package kanban
+
+import (
+	"path/filepath"
+	"testing"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func newConflictBoard(t *testing.T) BoardModel {
+	t.Helper()
+	board, err := operations.CreateBoard(t.TempDir(), "Conflicts")
+	if err != nil {
+		t.Fatalf("CreateBoard: %v", err)
+	}
+	card, err := operations.CreateCard(&board, "To Do")
+	if err != nil {
+		t.Fatalf("CreateCard: %v", err)
+	}
+	card.Tags = []string{"mine"}
+	if err := fs.WriteCard(card, filepath.Join(board.Path, "cards", card.Filename)); err != nil {
+		t.Fatalf("WriteCard: %v", err)
+	}
+	loaded, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatalf("ReadBoard: %v", err)
+	}
+	return NewBoardModel(loaded, nil, nil, nil)
+}
+
+// editOnDisk changes the selected card's file behind the board's back.
+func editOnDisk(t *testing.T, m BoardModel) {
+	t.Helper()
+	card := m.board.Columns[0].Cards[0]
+	card.Tags = []string{"theirs"}
+	if err := fs.WriteCard(card, filepath.Join(m.board.Path, "cards", card.Filename)); err != nil {
+		t.Fatalf("WriteCard: %v", err)
+	}
+}
+
+func recordEdit(opened *bool) func(BoardModel) (BoardModel, tea.Cmd) {
+	return func(m BoardModel) (BoardModel, tea.Cmd) {
+		*opened = true
+		return m, nil
+	}
+}
+
+func TestGuardCard_NoConflictOpensEdit(t *testing.T) {
+	m := newConflictBoard(t)
+	opened := false
+	m, _ = m.guardCard(recordEdit(&opened))
+	if !opened || m.mode != boardModeNormal {
+		t.Errorf("expected the edit to open directly, mode %v", m.mode)
+	}
+}
+
+func TestGuardCard_KeepDisk(t *testing.T) {
+	m := newConflictBoard(t)
+	editOnDisk(t, m)
+
+	opened := false
+	m, _ = m.guardCard(recordEdit(&opened))
+	if opened || m.mode != boardModeCardConflict {
+		t.Fatalf("expected the conflict modal, mode %v", m.mode)
+	}
+
+	m, _ = m.updateCardConflict(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")})
+	if !opened {
+		t.Error("expected the edit to open after resolving")
+	}
+	if tags := m.board.Columns[0].Cards[0].Tags; len(tags) != 1 || tags[0] != "theirs" {
+		t.Errorf("expected the disk tags, got %v", tags)
+	}
+}
+
+func TestGuardCard_KeepMine(t *testing.T) {
+	m := newConflictBoard(t)
+	editOnDisk(t, m)
+
+	opened := false
+	m, _ = m.guardCard(recordEdit(&opened))
+	m, _ = m.updateCardConflict(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	if !opened {
+		t.Error("expected the edit to open after resolving")
+	}
+	card := m.board.Columns[0].Cards[0]
+	disk, err := fs.ReadCard(filepath.Join(m.board.Path, "cards", card.Filename))
+	if err != nil {
+		t.Fatalf("ReadCard: %v", err)
+	}
+	if len(disk.Tags) != 1 || disk.Tags[0] != "mine" {
+		t.Errorf("expected the board's tags written back, got %v", disk.Tags)
+	}
+}
+
+func TestGuardCard_EscCancels(t *testing.T) {
+	m := newConflictBoard(t)
+	editOnDisk(t, m)
+
+	opened := false
+	m, _ = m.guardCard(recordEdit(&opened))
+	m, _ = m.updateCardConflict(tea.KeyMsg{Type: tea.KeyEsc})
+	if opened || m.mode != boardModeNormal {
+		t.Errorf("expected esc to cancel, opened=%v mode %v", opened, m.mode)
+	}
+}
@@ -0,0 +1,197 @@
+package kanban
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/logs"
+	"wydo/internal/webmeta"
+)
+
+// CardFromURLModel is a one-line input for the address of a web page to make
+// a card of, e.g. a browser tab pasted in.
+type CardFromURLModel struct {
+	input  textinput.Model
+	url    string // the normalized URL, once enter accepted it
+	err    string
+	width  int
+	height int
+}
+
+func NewCardFromURLModel() CardFromURLModel {
+	ti := textinput.New()
+	ti.Placeholder = "https://example.com/article"
+	ti.CharLimit = 2048
+	ti.Width = 50
+	ti.Focus()
+	return CardFromURLModel{input: ti}
+}
+
+func (m CardFromURLModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true on esc, or on enter once the input is a web URL;
+// confirmed is true only for enter.
+func (m CardFromURLModel) Update(msg tea.KeyMsg) (model CardFromURLModel, cmd tea.Cmd, done, confirmed bool) {
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		url, err := webmeta.Normalize(m.input.Value())
+		if err != nil {
+			m.err = err.Error()
+			return m, nil, false, false
+		}
+		m.url = url
+		return m, nil, true, true
+	}
+	m.err = ""
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// URL returns the accepted URL, with a scheme added if it was left out.
+func (m CardFromURLModel) URL() string {
+	return m.url
+}
+
+func (m CardFromURLModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("New Card from URL"))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	s.WriteString("\n\n")
+	if m.err != "" {
+		s.WriteString(errorStyle.Render(m.err))
+		s.WriteString("\n\n")
+	}
+	s.WriteString(helpStyle.Render("enter: create card • esc: cancel"))
+
+	box := renameInputBoxStyle.Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// pageTitleMsg carries the title fetched for a card made from a URL.
+type pageTitleMsg struct {
+	boardPath string
+	filename  string
+	url       string
+	title     string
+	err       error
+}
+
+// fetchPageTitleCmd fetches the title of the page a new card links to.
+func fetchPageTitleCmd(boardPath, filename, url string) tea.Cmd {
+	return func() tea.Msg {
+		ctx, cancel := context.WithTimeout(context.Background(), webmeta.Timeout)
+		defer cancel()
+		title, err := webmeta.Title(ctx, url)
+		return pageTitleMsg{boardPath: boardPath, filename: filename, url: url, title: title, err: err}
+	}
+}
+
+func (m BoardModel) handleCardFromURL() (BoardModel, tea.Cmd) {
+	prompt := NewCardFromURLModel()
+	prompt.width = m.width
+	prompt.height = m.height
+	m.cardFromURL = &prompt
+	m.mode = boardModeCardFromURL
+	return m, prompt.Init()
+}
+
+// updateCardFromURL creates the card in the selected column right away,
+// titled with its URL, and fetches the page's title to retitle it with.
+func (m BoardModel) updateCardFromURL(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	updated, cmd, done, confirmed := m.cardFromURL.Update(msg)
+	m.cardFromURL = &updated
+	if !done {
+		return m, cmd
+	}
+
+	url := m.cardFromURL.URL()
+	m.mode = boardModeNormal
+	m.cardFromURL = nil
+	if !confirmed || m.selectedCol >= len(m.board.Columns) {
+		return m, nil
+	}
+
+	card, err := operations.CreateCardFromURL(&m.board, m.selectedCol, url, "")
+	if err != nil {
+		m.err = err
+		return m, nil
+	}
+	m.selectedCard = len(m.board.Columns[m.selectedCol].Cards) - 1
+	m.columnCursorPos[m.selectedCol] = m.selectedCard
+	m.ensureCardBoardProjects(m.selectedCol, m.selectedCard)
+	if len(m.defaultTags) > 0 {
+		tags := append(append([]string{}, card.Tags...), m.defaultTags...)
+		_ = operations.UpdateCardTags(&m.board, m.selectedCol, m.selectedCard, tags)
+	}
+	m.message = "Fetching the title of " + url + "..."
+	return m, fetchPageTitleCmd(m.board.Path, card.Filename, url)
+}
+
+// IsBoardMsg reports whether msg is for the board model whichever view is
+// active, such as the title fetched for a card made from a URL after the
+// user left the board.
+func IsBoardMsg(msg tea.Msg) bool {
+	_, ok := msg.(pageTitleMsg)
+	return ok
+}
+
+// applyPageTitle retitles the card made from msg.url, unless it has been
+// renamed, moved to another board or deleted since. A card on a board other
+// than the open one (or with no board open) is retitled on disk.
+func (m BoardModel) applyPageTitle(msg pageTitleMsg) BoardModel {
+	if msg.err != nil || msg.title == "" {
+		if m.board.Path != "" && filepath.Clean(msg.boardPath) == filepath.Clean(m.board.Path) {
+			m.message = "No page title found; the card keeps its URL as title"
+			if msg.err != nil {
+				m.message = "Couldn't fetch the page title: " + msg.err.Error()
+			}
+		}
+		return m
+	}
+	if m.board.Path == "" || filepath.Clean(msg.boardPath) != filepath.Clean(m.board.Path) {
+		board, err := fs.ReadBoard(msg.boardPath)
+		if err == nil {
+			_, err = retitleURLCard(&board, msg)
+		}
+		if err != nil {
+			logs.Logger.Printf("Retitling card %s: %v", msg.filename, err)
+		}
+		return m
+	}
+	found, err := retitleURLCard(&m.board, msg)
+	switch {
+	case err != nil:
+		m.err = err
+	case found:
+		m.message = "Added " + msg.title
+		m.reloadBoardState()
+	}
+	return m
+}
+
+// retitleURLCard renames the card msg is for, if it still has its URL as
+// title, and reports whether it did.
+func retitleURLCard(board *models.Board, msg pageTitleMsg) (bool, error) {
+	for ci, col := range board.Columns {
+		for ki, card := range col.Cards {
+			if card.Filename != msg.filename || card.Title != msg.url {
+				continue
+			}
+			return true, operations.RenameCard(board, ci, ki, msg.title)
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,93 @@
+package kanban
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+func TestCardFromURL_CreatesThenRetitles(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<title>Release Notes</title>")
+	}))
+	defer srv.Close()
+
+	board := models.Board{Name: "Reading", Path: t.TempDir(), Columns: []models.Column{{Name: "To Read"}}}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	if m.mode != boardModeCardFromURL {
+		t.Fatalf("mode = %v, want NEW CARD", m.mode)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ftp://nope")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.mode != boardModeCardFromURL || m.cardFromURL.err == "" {
+		t.Fatal("expected a non-web URL to be refused")
+	}
+
+	m.cardFromURL.input.SetValue(srv.URL + "/notes")
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	cards := m.board.Columns[0].Cards
+	if len(cards) != 1 || cards[0].Title != srv.URL+"/notes" || cmd == nil {
+		t.Fatalf("expected a card titled with its URL while the title loads, got %+v", cards)
+	}
+
+	m, _ = m.Update(cmd())
+	card := m.board.Columns[0].Cards[0]
+	if card.Title != "Release Notes" || card.Filename != "release_notes.md" {
+		t.Errorf("card = %q (%s), want it retitled from the page", card.Title, card.Filename)
+	}
+	if len(card.Tags) != 1 || card.Tags[0] != "127.0.0.1" || card.FirstURL() != srv.URL+"/notes" {
+		t.Errorf("tags %v, url %q", card.Tags, card.FirstURL())
+	}
+}
+
+func TestCardFromURL_KeepsRenamedTitle(t *testing.T) {
+	board := models.Board{Name: "Reading", Path: "/ws/boards/reading", Columns: []models.Column{
+		{Name: "To Read", Cards: []models.Card{{Filename: "mine.md", Title: "My own title"}}},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	m = m.applyPageTitle(pageTitleMsg{boardPath: board.Path, filename: "mine.md", url: "https://example.com", title: "Page"})
+	if got := m.board.Columns[0].Cards[0].Title; got != "My own title" {
+		t.Errorf("Title = %q, want the rename kept", got)
+	}
+}
+
+func TestCardFromURL_RetitlesAfterLeavingBoard(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "text/html")
+		fmt.Fprint(w, "<title>Release Notes</title>")
+	}))
+	defer srv.Close()
+
+	board := models.Board{Name: "Reading", Path: t.TempDir(), Columns: []models.Column{{Name: "To Read"}}}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, nil)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("w")})
+	m.cardFromURL.input.SetValue(srv.URL + "/notes")
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	msg := cmd()
+	if !IsBoardMsg(msg) {
+		t.Fatalf("expected a board message, got %T", msg)
+	}
+
+	// The title arrives once another board, or none, is open
+	var other BoardModel
+	other, _ = other.Update(msg)
+	saved, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cards := saved.Columns[0].Cards; len(cards) != 1 || cards[0].Title != "Release Notes" {
+		t.Errorf("cards on disk = %+v, want the card retitled", cards)
+	}
+}
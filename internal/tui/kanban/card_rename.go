@@ -0,0 +1,63 @@
+package kanban
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// CardRenameModel is a one-line input for retitling a card in place.
+type CardRenameModel struct {
+	input  textinput.Model
+	width  int
+	height int
+}
+
+func NewCardRenameModel(currentTitle string) CardRenameModel {
+	ti := textinput.New()
+	ti.Placeholder = "Card title"
+	ti.CharLimit = 200
+	ti.Width = 50
+	if currentTitle != "Untitled" {
+		ti.SetValue(currentTitle)
+	}
+	ti.CursorEnd()
+	ti.Focus()
+	return CardRenameModel{input: ti}
+}
+
+func (m CardRenameModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true on enter or esc; confirmed is true only for enter.
+func (m CardRenameModel) Update(msg tea.KeyMsg) (model CardRenameModel, cmd tea.Cmd, done, confirmed bool) {
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		return m, nil, true, true
+	}
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// Title returns the entered title, trimmed.
+func (m CardRenameModel) Title() string {
+	return strings.TrimSpace(m.input.Value())
+}
+
+func (m CardRenameModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("Rename Card"))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	s.WriteString("\n\n")
+	s.WriteString(helpStyle.Render("enter: save • esc: cancel"))
+
+	box := renameInputBoxStyle.Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -0,0 +1,113 @@
+package kanban
+
+import (
+	"hash/fnv"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// renderedBlock is a rendered view split into lines padded to one width, so
+// that blocks can be joined side by side without measuring every line of
+// them again on each frame.
+type renderedBlock struct {
+	lines []string
+	width int
+}
+
+func newRenderedBlock(s string) renderedBlock {
+	lines := strings.Split(s, "\n")
+	widths := make([]int, len(lines))
+	width := 0
+	for i, l := range lines {
+		widths[i] = ansi.StringWidth(l)
+		width = max(width, widths[i])
+	}
+	for i, l := range lines {
+		if widths[i] < width {
+			lines[i] = l + strings.Repeat(" ", width-widths[i])
+		}
+	}
+	return renderedBlock{lines: lines, width: width}
+}
+
+// joinBlocks places blocks side by side, aligned at the top, and centers the
+// result in width. The output is the same as lipgloss.Place(width, 0,
+// Center, Top, lipgloss.JoinHorizontal(Top, ...)), which measures each line
+// again.
+func joinBlocks(width int, blocks ...renderedBlock) string {
+	height, total := 0, 0
+	for _, b := range blocks {
+		height = max(height, len(b.lines))
+		total += b.width
+	}
+	left, right := "", ""
+	if gap := width - total; gap > 0 {
+		split := int(math.Round(float64(gap) * 0.5))
+		left, right = strings.Repeat(" ", gap-split), strings.Repeat(" ", split)
+	}
+
+	var s strings.Builder
+	for i := 0; i < height; i++ {
+		if i > 0 {
+			s.WriteByte('\n')
+		}
+		s.WriteString(left)
+		for _, b := range blocks {
+			if i < len(b.lines) {
+				s.WriteString(b.lines[i])
+			} else {
+				s.WriteString(strings.Repeat(" ", b.width))
+			}
+		}
+		s.WriteString(right)
+	}
+	return s.String()
+}
+
+// columnRenderCache keeps the last render of each column. A keypress usually
+// changes one or two columns (the cursor moved, a card was edited); the
+// others are reused as they were instead of being framed by lipgloss again.
+// Like cardRenderCache it is shared by pointer between copies of BoardModel.
+type columnRenderCache struct {
+	entries map[int]cachedColumn // by column index
+}
+
+type cachedColumn struct {
+	key   uint64
+	block renderedBlock
+}
+
+func newColumnRenderCache() *columnRenderCache {
+	return &columnRenderCache{entries: make(map[int]cachedColumn)}
+}
+
+// render returns the column at index framed by render, reusing the last
+// frame when key, which covers everything render reads, is unchanged.
+func (c *columnRenderCache) render(index int, key uint64, render func() string) renderedBlock {
+	if c == nil {
+		return newRenderedBlock(render())
+	}
+	if cached, ok := c.entries[index]; ok && cached.key == key {
+		return cached.block
+	}
+	block := newRenderedBlock(render())
+	c.entries[index] = cachedColumn{key: key, block: block}
+	return block
+}
+
+// columnKey hashes what a column's frame depends on: its content, whether it
+// is selected, its color and its height.
+func columnKey(content string, selected bool, color string, height int) uint64 {
+	h := fnv.New64a()
+	h.Write([]byte(content))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.FormatBool(selected)))
+	h.Write([]byte{0})
+	h.Write([]byte(color))
+	h.Write([]byte{0})
+	h.Write([]byte(strconv.Itoa(height)))
+	return h.Sum64()
+}
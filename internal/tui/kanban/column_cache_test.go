@@ -0,0 +1,78 @@
+package kanban
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/models"
+)
+
+func TestJoinBlocks_MatchesLipgloss(t *testing.T) {
+	blocks := []string{"a\nbb\nccc", "wide line\nx", "\x1b[1mbold\x1b[0m\n\n\nlast"}
+	for _, width := range []int{0, 10, 40, 41} {
+		want := lipgloss.Place(width, 0, lipgloss.Center, lipgloss.Top, lipgloss.JoinHorizontal(lipgloss.Top, blocks...))
+		var rendered []renderedBlock
+		for _, b := range blocks {
+			rendered = append(rendered, newRenderedBlock(b))
+		}
+		if got := joinBlocks(width, rendered...); got != want {
+			t.Errorf("width %d:\ngot  %q\nwant %q", width, got, want)
+		}
+	}
+}
+
+func TestRenderColumn_ReusesUnchangedColumns(t *testing.T) {
+	board := bigBoard(2, 5)
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 40)
+	m.View()
+
+	other := m.columnCache.entries[1]
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	m.View()
+	if m.columnCache.entries[1].key != other.key {
+		t.Error("moving the cursor in column 0 should not re-render column 1")
+	}
+
+	selected := m.columnCache.entries[0].key
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	m.View()
+	if m.columnCache.entries[0].key == selected || m.columnCache.entries[1].key == other.key {
+		t.Error("moving to column 1 should re-render both columns")
+	}
+}
+
+func bigBoard(columns, cards int) models.Board {
+	board := models.Board{Name: "big"}
+	for c := range columns {
+		col := models.Column{Name: fmt.Sprintf("Column %d", c)}
+		for i := range cards {
+			col.Cards = append(col.Cards, models.Card{
+				Filename: fmt.Sprintf("c%d-%d.md", c, i),
+				Title:    fmt.Sprintf("Card %d in column %d", i, c),
+				Preview:  "a line of preview text",
+				Tags:     []string{"backend", "review"},
+				Priority: i % 4,
+			})
+		}
+		board.Columns = append(board.Columns, col)
+	}
+	return board
+}
+
+// BenchmarkBoardView moves the cursor up and down a 240 card board and
+// renders each frame, as holding j or k does.
+func BenchmarkBoardView(b *testing.B) {
+	m := NewBoardModel(bigBoard(4, 60), nil, nil, nil)
+	m.SetSize(200, 60)
+	for i := 0; b.Loop(); i++ {
+		key := "j"
+		if i%40 >= 20 {
+			key = "k"
+		}
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+		_ = m.View()
+	}
+}
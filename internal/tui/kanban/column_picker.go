@@ -0,0 +1,89 @@
+package kanban
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
+)
+
+// ColumnPickerModel is a small popup for choosing which column a new card
+// goes to. The cursor starts on the board's default new-card column.
+type ColumnPickerModel struct {
+	columns    []string
+	defaultIdx int
+	cursor     int
+	width      int
+	height     int
+}
+
+func NewColumnPickerModel(board models.Board) ColumnPickerModel {
+	columns := make([]string, len(board.Columns))
+	for i, col := range board.Columns {
+		columns[i] = col.Name
+	}
+	idx := board.NewCardColumnIndex()
+	return ColumnPickerModel{columns: columns, defaultIdx: idx, cursor: idx}
+}
+
+// Update handles key events. Returns (model, column index, done); the index is
+// -1 when the picker was cancelled.
+func (m ColumnPickerModel) Update(msg tea.KeyMsg) (ColumnPickerModel, int, bool) {
+	switch msg.String() {
+	case "j", "down":
+		if m.cursor < len(m.columns)-1 {
+			m.cursor++
+		}
+	case "k", "up":
+		if m.cursor > 0 {
+			m.cursor--
+		}
+	case "enter":
+		return m, m.cursor, true
+	case "esc", "q":
+		return m, -1, true
+	default:
+		// 1-9 pick a column directly
+		if s := msg.String(); len(s) == 1 && s[0] >= '1' && s[0] <= '9' {
+			if idx := int(s[0] - '1'); idx < len(m.columns) {
+				return m, idx, true
+			}
+		}
+	}
+	return m, -1, false
+}
+
+// View renders the column picker as a centered modal.
+func (m ColumnPickerModel) View() string {
+	var lines []string
+
+	lines = append(lines, tagPickerTitleStyle.Render("New Card In Column"))
+	lines = append(lines, "")
+
+	listStart := len(lines)
+	for i, name := range m.columns {
+		label := fmt.Sprintf("%d %s", i+1, name)
+		if i == m.defaultIdx {
+			label += cardPreviewStyle.Render(" (default)")
+		}
+		style := listItemStyle
+		prefix := "  "
+		if i == m.cursor {
+			style = selectedListItemStyle
+			prefix = "> "
+		}
+		lines = append(lines, style.Render(prefix+label))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render("j/k: navigate • enter/1-9: create • esc: cancel"))
+
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(50, m.width))
+	lines = shared.FitModalList(lines, listStart, listStart+len(m.columns), m.cursor, box, m.height)
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	boxed := box.Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
+}
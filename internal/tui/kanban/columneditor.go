@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -17,8 +18,16 @@ const (
 	columnEditorModeRename
 	columnEditorModeAdd
 	columnEditorModeConfirmDelete
+	columnEditorModeDeleteTarget
 )
 
+// deleteTarget is where the cards of a deleted column go: another column,
+// or archived in the column they would go to by default.
+type deleteTarget struct {
+	column  int
+	archive bool
+}
+
 // ColumnEditorModel handles column editing
 type ColumnEditorModel struct {
 	board         *models.Board
@@ -29,9 +38,13 @@ type ColumnEditorModel struct {
 	message       string
 	err           error
 	deleteConfirm bool // Track if in delete confirmation
+	deleteCursor  int  // Selected entry of deleteTargets while choosing where cards go
 	insertBefore  bool // Track if inserting before vs after cursor
 	width         int
 	height        int
+
+	// archived are the cards archived by deleting their column, written on save
+	archived map[string]bool
 }
 
 // NewColumnEditorModel creates a new column editor
@@ -73,6 +86,8 @@ func (m ColumnEditorModel) Update(msg tea.KeyMsg) (ColumnEditorModel, tea.Cmd, b
 		return m.updateAdd(msg)
 	case columnEditorModeConfirmDelete:
 		return m.updateConfirmDelete(msg)
+	case columnEditorModeDeleteTarget:
+		return m.updateDeleteTarget(msg)
 	}
 
 	return m, nil, false
@@ -130,8 +145,18 @@ func (m ColumnEditorModel) updateNormal(msg tea.KeyMsg) (ColumnEditorModel, tea.
 	case "d":
 		// Delete column
 		if m.cursorPos < len(m.columns) {
-			if canDelete, msg := m.board.CanDeleteColumn(m.cursorPos); !canDelete {
+			if canDelete, msg := m.canDelete(m.cursorPos); !canDelete {
 				m.err = fmt.Errorf("%s", msg)
+			} else if len(m.columns[m.cursorPos].Cards) > 0 {
+				// Ask where the cards go, starting at the adjacent column
+				m.deleteCursor = 0
+				adjacent := m.board.AdjacentColumn(m.cursorPos)
+				for i, t := range m.deleteTargets() {
+					if t.column == adjacent && !t.archive {
+						m.deleteCursor = i
+					}
+				}
+				m.mode = columnEditorModeDeleteTarget
 			} else {
 				m.mode = columnEditorModeConfirmDelete
 			}
@@ -291,33 +316,7 @@ func (m ColumnEditorModel) updateConfirmDelete(msg tea.KeyMsg) (ColumnEditorMode
 	case "y":
 		// Confirm delete
 		if m.cursorPos < len(m.columns) {
-			column := m.columns[m.cursorPos]
-
-			// Migrate cards to adjacent column if any exist
-			if len(column.Cards) > 0 {
-				targetIndex := m.cursorPos - 1 // Try left
-				if targetIndex < 0 {
-					targetIndex = m.cursorPos + 1 // Use right if leftmost
-				}
-
-				if targetIndex >= 0 && targetIndex < len(m.columns) {
-					// Move all cards to target column
-					m.columns[targetIndex].Cards = append(
-						m.columns[targetIndex].Cards,
-						column.Cards...,
-					)
-				}
-			}
-
-			// Remove column
-			m.columns = append(m.columns[:m.cursorPos], m.columns[m.cursorPos+1:]...)
-
-			// Adjust cursor if needed
-			if m.cursorPos >= len(m.columns) && m.cursorPos > 0 {
-				m.cursorPos--
-			}
-
-			m.message = "Column deleted"
+			m.deleteColumn(deleteTarget{column: -1})
 		}
 		m.mode = columnEditorModeNormal
 
@@ -329,27 +328,104 @@ func (m ColumnEditorModel) updateConfirmDelete(msg tea.KeyMsg) (ColumnEditorMode
 	return m, nil, false
 }
 
+func (m ColumnEditorModel) updateDeleteTarget(msg tea.KeyMsg) (ColumnEditorModel, tea.Cmd, bool) {
+	targets := m.deleteTargets()
+	switch msg.String() {
+	case "j", "down":
+		if m.deleteCursor < len(targets)-1 {
+			m.deleteCursor++
+		}
+	case "k", "up":
+		if m.deleteCursor > 0 {
+			m.deleteCursor--
+		}
+	case "enter", "y":
+		if m.deleteCursor < len(targets) {
+			m.deleteColumn(targets[m.deleteCursor])
+		}
+		m.mode = columnEditorModeNormal
+	case "n", "esc":
+		m.mode = columnEditorModeNormal
+	}
+	return m, nil, false
+}
+
+// canDelete checks the working copy, which may differ from the board on disk.
+func (m ColumnEditorModel) canDelete(index int) (bool, string) {
+	b := models.Board{Columns: m.columns}
+	return b.CanDeleteColumn(index)
+}
+
+// deleteTargets lists where the cards of the column under the cursor can
+// go: each other column, then archived in the adjacent one.
+func (m ColumnEditorModel) deleteTargets() []deleteTarget {
+	var targets []deleteTarget
+	for i := range m.columns {
+		if i != m.cursorPos {
+			targets = append(targets, deleteTarget{column: i})
+		}
+	}
+	return append(targets, deleteTarget{column: m.board.AdjacentColumn(m.cursorPos), archive: true})
+}
+
+// deleteColumn removes the column under the cursor from the working copy,
+// moving its cards as target says.
+func (m *ColumnEditorModel) deleteColumn(target deleteTarget) {
+	column := m.columns[m.cursorPos]
+	if len(column.Cards) > 0 && target.column >= 0 && target.column < len(m.columns) {
+		// Copy before changing, the working copy shares cards with the board
+		cards := append([]models.Card(nil), m.columns[target.column].Cards...)
+		for _, card := range column.Cards {
+			if target.archive && !card.Archived {
+				card.Archived = true
+				if m.archived == nil {
+					m.archived = make(map[string]bool)
+				}
+				m.archived[card.Filename] = true
+			}
+			cards = append(cards, card)
+		}
+		m.columns[target.column].Cards = cards
+	}
+
+	m.columns = append(m.columns[:m.cursorPos:m.cursorPos], m.columns[m.cursorPos+1:]...)
+	if m.cursorPos >= len(m.columns) && m.cursorPos > 0 {
+		m.cursorPos--
+	}
+	m.message = "Column deleted"
+}
+
+// deletePreview says what happens to the cards of the column under the
+// cursor when it is deleted into target.
+func (m ColumnEditorModel) deletePreview(target deleteTarget) string {
+	n := len(m.columns[m.cursorPos].Cards)
+	cards := fmt.Sprintf("%d cards", n)
+	if n == 1 {
+		cards = "1 card"
+	}
+	into := m.columns[target.column].Name
+	if target.archive {
+		return fmt.Sprintf("%s archived, kept in %s", cards, into)
+	}
+	return fmt.Sprintf("%s move to %s", cards, into)
+}
+
 // View renders the column editor
 func (m ColumnEditorModel) View() string {
-	var s strings.Builder
-
-	// Title
-	title := columnEditorTitleStyle.Render("Column Editor")
-	s.WriteString(title)
-	s.WriteString("\n\n")
+	box := columnEditorBoxStyle.Width(shared.ModalWidth(60, m.width))
+	innerWidth := box.GetWidth() - box.GetHorizontalPadding()
+	m.textInput.Width = min(50, innerWidth-lipgloss.Width("New column: ")-2)
 
-	// Show text input if in rename/add mode
+	// Title, and the text input in rename/add mode
+	header := []string{columnEditorTitleStyle.Render("Column Editor"), ""}
 	if m.mode == columnEditorModeRename {
-		s.WriteString(columnEditorPromptStyle.Render("Rename: "))
-		s.WriteString(m.textInput.View())
-		s.WriteString("\n\n")
+		header = append(header, columnEditorPromptStyle.Render("Rename: ")+m.textInput.View(), "")
 	} else if m.mode == columnEditorModeAdd {
-		s.WriteString(columnEditorPromptStyle.Render("New column: "))
-		s.WriteString(m.textInput.View())
-		s.WriteString("\n\n")
+		header = append(header, columnEditorPromptStyle.Render("New column: ")+m.textInput.View(), "")
 	}
 
 	// Column list
+	var rows []string
 	for i, col := range m.columns {
 		cardCount := len(col.Cards)
 		isDone := m.board.IsDoneColumn(col.Name)
@@ -368,19 +444,16 @@ func (m ColumnEditorModel) View() string {
 			style = columnEditorItemImmutableStyle
 		}
 
-		s.WriteString(style.Render(line))
-		s.WriteString("\n")
+		rows = append(rows, style.Render(shared.Truncate(line, innerWidth-style.GetHorizontalFrameSize())))
 	}
 
-	s.WriteString("\n")
+	footer := []string{""}
 
 	// Error or message
 	if m.err != nil {
-		s.WriteString(errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
-		s.WriteString("\n")
+		footer = append(footer, errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
 	} else if m.message != "" {
-		s.WriteString(successStyle.Render(m.message))
-		s.WriteString("\n")
+		footer = append(footer, successStyle.Render(m.message))
 	}
 
 	// Help text based on mode
@@ -389,21 +462,32 @@ func (m ColumnEditorModel) View() string {
 	case columnEditorModeRename, columnEditorModeAdd:
 		help = helpStyle.Render("enter: confirm • esc: cancel")
 	case columnEditorModeConfirmDelete:
-		if m.cursorPos < len(m.columns) {
-			cardCount := len(m.columns[m.cursorPos].Cards)
-			if cardCount > 0 {
-				help = warningStyle.Render(fmt.Sprintf("Delete column and move %d cards to adjacent column? (y/n)", cardCount))
-			} else {
-				help = warningStyle.Render("Delete this column? (y/n)")
+		help = warningStyle.Render("Delete this column? (y/n)")
+	case columnEditorModeDeleteTarget:
+		footer = append(footer, warningStyle.Render(fmt.Sprintf("Delete %q. Its cards go to:", m.columns[m.cursorPos].Name)))
+		targets := m.deleteTargets()
+		for i, t := range targets {
+			label := "  " + m.columns[t.column].Name
+			if t.archive {
+				label = "  Archive (kept in " + m.columns[t.column].Name + ")"
+			}
+			style := columnEditorItemStyle
+			if i == m.deleteCursor {
+				style = columnEditorItemHighlightStyle
 			}
+			footer = append(footer, style.Render(shared.Truncate(label, innerWidth-style.GetHorizontalFrameSize())))
+		}
+		if m.deleteCursor < len(targets) {
+			footer = append(footer, "", successStyle.Render(m.deletePreview(targets[m.deleteCursor])))
 		}
+		help = helpStyle.Render("jk: choose • enter: delete • esc: cancel")
 	default:
 		help = helpStyle.Render("jk: navigate • r: rename • o: add below • O: add above • d: delete • JK: reorder • enter: save • esc: cancel")
 	}
-	s.WriteString(help)
+	footer = append(footer, help)
 
-	// Wrap in box
-	content := s.String()
-	box := columnEditorBoxStyle.Render(content)
-	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+	// Scroll the list when the whole box would not fit on screen
+	lines := append(append(header, rows...), footer...)
+	lines = shared.FitModalList(lines, len(header), len(header)+len(rows), m.cursorPos, box, m.height)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box.Render(strings.Join(lines, "\n")))
 }
@@ -0,0 +1,88 @@
+package kanban
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/models"
+)
+
+func key(s string) tea.KeyMsg {
+	switch s {
+	case "enter":
+		return tea.KeyMsg{Type: tea.KeyEnter}
+	case "esc":
+		return tea.KeyMsg{Type: tea.KeyEsc}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
+
+func columnEditorBoard(t *testing.T) models.Board {
+	return models.Board{Name: "b", Path: boardDir(t), Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{Filename: "a.md", Title: "Alpha"}}},
+		{Name: "Review", Cards: []models.Card{{Filename: "b.md", Title: "Beta"}, {Filename: "c.md", Title: "Gamma"}}},
+		{Name: "In Progress"},
+		{Name: "Done"},
+	}}
+}
+
+func TestColumnEditor_DeletePicksTargetWithPreview(t *testing.T) {
+	board := columnEditorBoard(t)
+	m := NewColumnEditorModel(&board)
+	m.width, m.height = 80, 30
+
+	m, _, _ = m.Update(key("j"))
+	m, _, _ = m.Update(key("d"))
+	if m.mode != columnEditorModeDeleteTarget {
+		t.Fatalf("expected the target picker, got mode %v", m.mode)
+	}
+	if view := m.View(); !strings.Contains(view, "2 cards move to To Do") {
+		t.Errorf("expected the adjacent column preselected in the preview:\n%s", view)
+	}
+
+	m, _, _ = m.Update(key("j"))
+	if view := m.View(); !strings.Contains(view, "2 cards move to In Progress") {
+		t.Errorf("expected the preview to follow the selection:\n%s", view)
+	}
+	m, _, _ = m.Update(key("enter"))
+
+	if len(m.columns) != 3 || m.columns[1].Name != "In Progress" || len(m.columns[1].Cards) != 2 {
+		t.Fatalf("expected Review's cards in In Progress, got %+v", m.columns)
+	}
+	if len(board.Columns[2].Cards) != 0 {
+		t.Error("the board's columns changed before saving")
+	}
+}
+
+func TestColumnEditor_DeleteToArchiveSaves(t *testing.T) {
+	board := columnEditorBoard(t)
+	if err := os.WriteFile(filepath.Join(board.Path, "board.md"), nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 40)
+
+	m, _ = m.Update(key("C"))
+	for _, k := range []string{"j", "d", "j", "j", "j"} {
+		m, _ = m.Update(key(k))
+	}
+	if view := m.View(); !strings.Contains(view, "2 cards archived, kept in To Do") {
+		t.Errorf("expected the archive preview:\n%s", view)
+	}
+	m, _ = m.Update(key("enter"))
+	m, _ = m.Update(key("enter"))
+	if m.err != nil {
+		t.Fatal(m.err)
+	}
+
+	content, err := os.ReadFile(filepath.Join(board.Path, "cards", "b.md"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(content), "archived: true") {
+		t.Errorf("expected the card archived:\n%s", content)
+	}
+}
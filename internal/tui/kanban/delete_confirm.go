@@ -8,10 +8,11 @@ import (
 	"wydo/internal/tui/theme"
 )
 
-// DeleteConfirmModel is a modal that asks the user to confirm deleting a card.
-// It follows the same pattern as PriorityInputModel.
+// DeleteConfirmModel is a modal that asks the user to confirm deleting a card,
+// or turning it into a task, which deletes it too.
 type DeleteConfirmModel struct {
 	cardTitle string
+	toTasks   bool // the card becomes a task rather than just going away
 	width     int
 	height    int
 }
@@ -20,6 +21,11 @@ func NewDeleteConfirmModel(cardTitle string) DeleteConfirmModel {
 	return DeleteConfirmModel{cardTitle: cardTitle}
 }
 
+// NewMoveToTasksConfirmModel asks to confirm turning a card into a task.
+func NewMoveToTasksConfirmModel(cardTitle string) DeleteConfirmModel {
+	return DeleteConfirmModel{cardTitle: cardTitle, toTasks: true}
+}
+
 func (m DeleteConfirmModel) Init() tea.Cmd {
 	return nil
 }
@@ -38,7 +44,11 @@ func (m DeleteConfirmModel) Update(msg tea.KeyMsg) (DeleteConfirmModel, bool, bo
 func (m DeleteConfirmModel) View() string {
 	var s strings.Builder
 
-	title := deleteConfirmTitleStyle.Render("Delete Card?")
+	heading, note := "Delete Card?", "u on the board undoes it."
+	if m.toTasks {
+		heading, note = "Move Card to Tasks?", "The card file is deleted; its body becomes an annotation."
+	}
+	title := deleteConfirmTitleStyle.Render(heading)
 	s.WriteString(title)
 	s.WriteString("\n\n")
 
@@ -52,7 +62,7 @@ func (m DeleteConfirmModel) View() string {
 	s.WriteString(deleteConfirmCardTitleStyle.Render(`"` + displayTitle + `"`))
 	s.WriteString("\n\n")
 
-	s.WriteString(theme.Muted.Render("This action cannot be undone."))
+	s.WriteString(theme.Muted.Render(note))
 	s.WriteString("\n\n")
 
 	s.WriteString(theme.ModalHelp.Render("y:confirm  n/esc:cancel"))
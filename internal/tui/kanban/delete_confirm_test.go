@@ -0,0 +1,34 @@
+package kanban
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tui/messages"
+)
+
+func TestMoveCardToTasks_AsksFirst(t *testing.T) {
+	board := models.Board{Name: "b", Path: t.TempDir(), Columns: []models.Column{{Name: "To Do", Cards: []models.Card{{Filename: "a.md", Title: "a"}}}}}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	if m.mode != boardModeConfirmDelete || cmd != nil {
+		t.Fatalf("mode = %v, want a confirmation before the card goes", m.mode)
+	}
+	if m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")}); m.mode != boardModeNormal || cmd != nil {
+		t.Fatal("expected n to cancel")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlT})
+	m, cmd = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd == nil {
+		t.Fatal("expected y to move the card")
+	}
+	if msg, ok := cmd().(messages.MoveCardToTasksMsg); !ok || msg.Filename != "a.md" {
+		t.Errorf("got %#v, want a MoveCardToTasksMsg for a.md", msg)
+	}
+	if len(m.board.Columns[0].Cards) != 1 {
+		t.Error("the board itself should leave the card to the app to convert")
+	}
+}
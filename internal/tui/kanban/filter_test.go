@@ -0,0 +1,82 @@
+package kanban
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+	"wydo/internal/kanban/models"
+)
+
+func TestRecomputeFilter_SearchesBodiesWhenEnabled(t *testing.T) {
+	board := models.Board{Name: "b", Columns: []models.Column{{Name: "To Do", Cards: []models.Card{
+		{Filename: "a.md", Title: "Deploy", Content: "# Deploy\n\nRoll out the\nnew   Kubernetes cluster"},
+		{Filename: "b.md", Title: "Kubernetes upgrade", Content: "# Kubernetes upgrade\n"},
+		{Filename: "c.md", Title: "Lunch", Content: "# Lunch\n"},
+	}}}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.filterQuery = "new kubernetes"
+	m.filterActive = true
+
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 0 {
+		t.Errorf("without body search got %v", got)
+	}
+
+	m.SetFilterBodies(true)
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 1 || got[0] != 0 {
+		t.Errorf("with body search got %v, want [0]", got)
+	}
+
+	// An edited body replaces the cached text
+	m.board.Columns[0].Cards[0].Content = "# Deploy\n"
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 0 {
+		t.Errorf("after edit got %v", got)
+	}
+}
+
+func TestRecomputeFilter_NarrowsExtendedQuery(t *testing.T) {
+	board := models.Board{Name: "b", Columns: []models.Column{{Name: "To Do", Cards: []models.Card{
+		{Filename: "a.md", Title: "Deploy API"},
+		{Filename: "b.md", Title: "Design review"},
+		{Filename: "c.md", Title: "Lunch"},
+	}}}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetHighlightMatches(true)
+	m.filterActive = true
+
+	m.filterQuery = "de"
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 2 {
+		t.Fatalf("%q matched %v, want 2 cards", m.filterQuery, got)
+	}
+
+	m.filterQuery = "DEP"
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 1 || got[0] != 0 {
+		t.Fatalf("%q matched %v, want [0]", m.filterQuery, got)
+	}
+	if got := m.titleMatches["a.md"]; len(got) != 3 || got[0] != 0 || got[2] != 2 {
+		t.Errorf("title offsets = %v, want [0 1 2]", got)
+	}
+
+	// A card renamed under the same file name is rescanned once the board is reloaded
+	board.Columns[0].Cards[2].Title = "Deploy docs"
+	m.SetBoard(board)
+	m.filterActive = true
+	m.filterQuery = "depl"
+	m.recomputeFilter()
+	if got := m.filteredIndices[0]; len(got) != 2 {
+		t.Errorf("after reload %q matched %v, want 2 cards", m.filterQuery, got)
+	}
+}
+
+func TestHighlightTitle_KeepsMultibyteRunesWhole(t *testing.T) {
+	title := "修正 bug 🚀"
+	// Offsets of "修" and "b"
+	out := highlightTitle(title, []int{0, 7}, "", cardTitleStyle)
+	if got := ansi.Strip(out); got != title {
+		t.Errorf("highlightTitle text = %q, want %q", got, title)
+	}
+}
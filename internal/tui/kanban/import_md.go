@@ -0,0 +1,145 @@
+package kanban
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/config"
+	"wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/tui/shared"
+)
+
+// MarkdownImportModel asks for a markdown file and previews the cards its
+// list items become before they are added to the board.
+type MarkdownImportModel struct {
+	input   textinput.Model
+	board   models.Board
+	cards   []operations.ImportedCard
+	preview bool // the file was read and its cards are shown
+	err     string
+	cursor  int
+	width   int
+	height  int
+}
+
+func NewMarkdownImportModel(board models.Board) MarkdownImportModel {
+	ti := textinput.New()
+	ti.Placeholder = "~/notes/meeting.md"
+	ti.CharLimit = 500
+	ti.Width = 50
+	ti.Focus()
+	return MarkdownImportModel{input: ti, board: board}
+}
+
+func (m MarkdownImportModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true when the import is confirmed from the preview or
+// cancelled; confirmed is true only for the former.
+func (m MarkdownImportModel) Update(msg tea.KeyMsg) (model MarkdownImportModel, cmd tea.Cmd, done, confirmed bool) {
+	if m.preview {
+		switch msg.String() {
+		case "j", "down":
+			if m.cursor < len(m.cards)-1 {
+				m.cursor++
+			}
+		case "k", "up":
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case "enter", "y":
+			return m, nil, true, true
+		case "esc", "n", "q":
+			return m, nil, true, false
+		}
+		return m, nil, false, false
+	}
+
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		m.readFile()
+		return m, nil, false, false
+	}
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// readFile parses the entered file and switches to the preview, or keeps
+// the input open with the error.
+func (m *MarkdownImportModel) readFile() {
+	path := strings.TrimSpace(m.input.Value())
+	if path == "" {
+		return
+	}
+	content, err := os.ReadFile(config.ExpandPath(path))
+	if err != nil {
+		m.err = err.Error()
+		return
+	}
+	m.cards = operations.ParseMarkdownCards(string(content))
+	if len(m.cards) == 0 {
+		m.err = "no list items in " + path
+		return
+	}
+	m.err = ""
+	m.preview = true
+	m.cursor = 0
+}
+
+// Cards returns the cards parsed from the file.
+func (m MarkdownImportModel) Cards() []operations.ImportedCard {
+	return m.cards
+}
+
+func (m MarkdownImportModel) View() string {
+	boxWidth := shared.ModalWidth(64, m.width)
+	var lines []string
+
+	if !m.preview {
+		lines = append(lines, renameInputTitleStyle.Render("Import Markdown Checklist"))
+		lines = append(lines, "")
+		lines = append(lines, m.input.View())
+		if m.err != "" {
+			lines = append(lines, errorStyle.Render(shared.Truncate(m.err, boxWidth-4)))
+		}
+		lines = append(lines, "")
+		lines = append(lines, helpStyle.Render("enter: preview • esc: cancel"))
+		box := renameInputBoxStyle.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+	}
+
+	lines = append(lines, renameInputTitleStyle.Render(fmt.Sprintf("Import %d Card(s)", len(m.cards))))
+	lines = append(lines, "")
+
+	listStart := len(lines)
+	for i, c := range m.cards {
+		column := m.board.Columns[operations.ImportColumn(&m.board, c)].Name
+		label := c.Title
+		if n := strings.Count(c.Body, "- ["); n > 0 {
+			label += cardPreviewStyle.Render(fmt.Sprintf(" (%d items)", n))
+		}
+		style := listItemStyle
+		prefix := "  "
+		if i == m.cursor {
+			style = selectedListItemStyle
+			prefix = "> "
+		}
+		lines = append(lines, style.Render(shared.Truncate(prefix+column+" / "+label, boxWidth-4)))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, helpStyle.Render("j/k: scroll • enter/y: import • esc: cancel"))
+
+	box := renameInputBoxStyle.Width(boxWidth)
+	lines = shared.FitModalList(lines, listStart, listStart+len(m.cards), m.cursor, box, m.height)
+	boxed := box.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
+}
@@ -0,0 +1,54 @@
+package kanban
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+func TestMarkdownImport_PreviewThenAddsCards(t *testing.T) {
+	dir := t.TempDir()
+	board := models.Board{Name: "Sync", Path: dir, Columns: []models.Column{{Name: "To Do"}, {Name: "Done"}}}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+	notes := filepath.Join(dir, "notes.md")
+	if err := os.WriteFile(notes, []byte("- Draft RFC\n  - outline\n- [x] Ship 1.2\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, []string{"alpha"})
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("I")})
+	if m.mode != boardModeImportMarkdown {
+		t.Fatalf("mode = %v, want IMPORT", m.mode)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(filepath.Join(dir, "missing.md"))})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.markdownImport.preview || m.markdownImport.err == "" {
+		t.Fatal("expected a missing file to keep the input open with an error")
+	}
+
+	m.markdownImport.input.SetValue(notes)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !m.markdownImport.preview || len(m.markdownImport.Cards()) != 2 {
+		t.Fatalf("expected a preview of 2 cards, got %+v", m.markdownImport.Cards())
+	}
+	if len(m.board.Columns[0].Cards) != 0 {
+		t.Fatal("the preview must not add cards")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.mode != boardModeNormal {
+		t.Fatalf("mode = %v, want NORMAL", m.mode)
+	}
+	if len(m.board.Columns[0].Cards) != 1 || len(m.board.Columns[1].Cards) != 1 {
+		t.Fatalf("expected one card per column, got %d and %d", len(m.board.Columns[0].Cards), len(m.board.Columns[1].Cards))
+	}
+	if got := m.board.Columns[0].Cards[0].Projects; len(got) != 1 || got[0] != "alpha" {
+		t.Errorf("expected the board's projects on the card, got %v", got)
+	}
+}
@@ -0,0 +1,34 @@
+package kanban
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/models"
+)
+
+func TestBoardView_MinimumSize(t *testing.T) {
+	var cards []models.Card
+	for i := range 20 {
+		cards = append(cards, models.Card{Filename: fmt.Sprintf("c%d.md", i), Title: fmt.Sprintf("A rather long card title number %d", i)})
+	}
+	board := models.Board{Name: "Roadmap", Columns: []models.Column{
+		{Name: "To Do", Cards: cards},
+		{Name: "Doing", Cards: cards[:3]},
+		{Name: "Done"},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	m.SetSize(MinWidth, MinHeight)
+	view := m.View()
+	if w, h := lipgloss.Width(view), lipgloss.Height(view); w > MinWidth || h > MinHeight {
+		t.Errorf("board is %dx%d at its minimum %dx%d:\n%s", w, h, MinWidth, MinHeight, view)
+	}
+
+	// Below the minimum the app shows a placeholder, but the math must not panic
+	for _, size := range [][2]int{{0, 0}, {1, 1}, {MinWidth - 1, MinHeight - 1}} {
+		m.SetSize(size[0], size[1])
+		_ = m.View()
+	}
+}
@@ -0,0 +1,86 @@
+package kanban
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/tui/messages"
+)
+
+// CheckMissing switches to the missing-board screen when the board's
+// directory no longer exists, e.g. after a sync tool deleted it, and reports
+// whether it did. It is false once the screen is showing.
+func (m *BoardModel) CheckMissing() bool {
+	if m.mode == boardModeMissing {
+		return false
+	}
+	if _, err := os.Stat(m.board.Path); !errors.Is(err, os.ErrNotExist) {
+		return false
+	}
+	m.mode = boardModeMissing
+	m.err = nil
+	m.message = ""
+	m.trashedCopy, _ = operations.FindTrashedBoard(m.board.Path)
+	return true
+}
+
+// boardMissingCmd tells the app the board is gone, so it drops it from the
+// pickers and recent boards.
+func boardMissingCmd(path string) tea.Cmd {
+	return func() tea.Msg { return messages.BoardMissingMsg{Path: path} }
+}
+
+// updateMissing handles the missing-board screen: r restores the board from
+// the trash, b, q or esc go back to the board picker.
+func (m BoardModel) updateMissing(msg tea.KeyMsg) (BoardModel, tea.Cmd) {
+	switch msg.String() {
+	case "r":
+		if m.trashedCopy == "" {
+			return m, nil
+		}
+		if err := operations.RestoreBoard(m.trashedCopy, m.board.Path); err != nil {
+			m.err = err
+			return m, nil
+		}
+		board, err := fs.ReadBoard(m.board.Path)
+		if err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.err = nil
+		m.SetBoard(board)
+		m.message = "Board restored from " + operations.TrashDir
+		return m, func() tea.Msg { return messages.DataRefreshMsg{} }
+
+	case "b", "q", "esc":
+		path := m.board.Path
+		return m, tea.Sequence(
+			func() tea.Msg { return messages.BoardDeletedMsg{Path: path} },
+			func() tea.Msg { return messages.SwitchViewMsg{View: messages.ViewKanbanPicker} },
+		)
+	}
+	return m, nil
+}
+
+func (m BoardModel) viewMissing() string {
+	var lines []string
+	lines = append(lines, titleStyle.Render("Board Missing"))
+	lines = append(lines, "")
+	lines = append(lines, listItemStyle.Render(fmt.Sprintf("%s was deleted or moved outside wydo.", abbreviatePath(m.board.Path))))
+	lines = append(lines, "")
+	if m.trashedCopy != "" {
+		lines = append(lines, listItemStyle.Render(fmt.Sprintf("r  restore it from %s", abbreviatePath(m.trashedCopy))))
+	}
+	lines = append(lines, listItemStyle.Render("b  back to the board picker"))
+	if m.err != nil {
+		lines = append(lines, "")
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
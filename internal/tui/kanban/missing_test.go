@@ -0,0 +1,77 @@
+package kanban
+
+import (
+	"os"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/tui/messages"
+)
+
+func TestMissingBoard_ShowsScreenAndRestores(t *testing.T) {
+	root := t.TempDir()
+	board, err := operations.CreateBoard(root, "Synced")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := operations.CreateCard(&board, "To Do"); err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	if _, err := operations.DeleteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+
+	// Pinning writes the card, which fails with the board gone
+	m, msg := press(m, "!")
+	if m.mode != boardModeMissing {
+		t.Fatalf("expected the missing screen, got mode %v (err %v)", m.mode, m.err)
+	}
+	if missing, ok := msg.(messages.BoardMissingMsg); !ok || missing.Path != board.Path {
+		t.Errorf("expected BoardMissingMsg, got %#v", msg)
+	}
+	if m.trashedCopy == "" || m.IsModal() {
+		t.Errorf("trashed copy %q, modal %v", m.trashedCopy, m.IsModal())
+	}
+
+	m, msg = press(m, "r")
+	if m.mode != boardModeNormal || m.err != nil {
+		t.Fatalf("restore failed: mode %v, err %v", m.mode, m.err)
+	}
+	if _, ok := msg.(messages.DataRefreshMsg); !ok {
+		t.Errorf("expected a refresh after the restore, got %#v", msg)
+	}
+	if _, err := os.Stat(board.Path); err != nil {
+		t.Errorf("board not back in place: %v", err)
+	}
+	if len(m.board.Columns[0].Cards) != 1 {
+		t.Errorf("restored board lost its card: %+v", m.board.Columns[0])
+	}
+}
+
+func TestMissingBoard_BackLeavesBoard(t *testing.T) {
+	root := t.TempDir()
+	board, err := operations.CreateBoard(root, "Gone")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, nil)
+	if err := os.RemoveAll(board.Path); err != nil {
+		t.Fatal(err)
+	}
+	if !m.CheckMissing() || m.CheckMissing() {
+		t.Fatal("CheckMissing should switch once")
+	}
+	if m.trashedCopy != "" {
+		t.Errorf("no trashed copy expected, got %q", m.trashedCopy)
+	}
+	m, _ = press(m, "r")
+	if m.mode != boardModeMissing {
+		t.Error("r without a trashed copy should keep the missing screen")
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("b")}); cmd == nil {
+		t.Error("b should leave for the board picker")
+	}
+}
@@ -0,0 +1,123 @@
+package kanban
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/models"
+)
+
+// boardDir returns a board directory with an empty cards/ directory, which
+// moves write the card files to.
+func boardDir(t *testing.T) string {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestMoveMode_ShowsHeaderAndGhost(t *testing.T) {
+	board := models.Board{Name: "b", Path: boardDir(t), Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{Filename: "a.md", Title: "Alpha"}, {Filename: "b.md", Title: "Beta"}}},
+		{Name: "Doing"},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 40)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	view := m.View()
+	if !strings.Contains(view, "moving: Alpha") || !strings.Contains(view, "→ To Do 1/2") {
+		t.Fatalf("move header missing:\n%s", view)
+	}
+	if strings.Contains(view, "moved from here") {
+		t.Error("ghost shown before the card left its column")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	view = m.View()
+	if !strings.Contains(view, "from To Do 1 → Doing 1/1") {
+		t.Errorf("header after moving right:\n%s", view)
+	}
+	if !strings.Contains(view, "moved from here") {
+		t.Errorf("ghost missing from the origin column:\n%s", view)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if strings.Contains(m.View(), "moved from here") {
+		t.Error("ghost still shown after leaving move mode")
+	}
+}
+
+func TestMoveProjects_ResolvedForDestinationWorkspace(t *testing.T) {
+	board := models.Board{Name: "Plan", Path: "/work/boards/plan"}
+	m := NewBoardModel(board, nil, nil, []string{"alpha"})
+	m.SetBoardInfo(map[string]BoardInfo{
+		"/work/boards/plan": {Workspace: "/work", Projects: []string{"alpha"}},
+		"/work/boards/ops":  {Workspace: "/work", Projects: []string{"ops"}},
+		"/home/boards/todo": {Workspace: "/home", Projects: []string{"house"}},
+		"/home/boards/misc": {Workspace: "/home"},
+	})
+
+	cases := map[string][]string{
+		"/work/boards/ops":  {"alpha"}, // same workspace: keep this board's projects
+		"/home/boards/todo": {"house"}, // other workspace: the destination's own
+		"/home/boards/misc": nil,
+	}
+	for dst, want := range cases {
+		if got := m.moveProjects(dst); strings.Join(got, ",") != strings.Join(want, ",") {
+			t.Errorf("moveProjects(%s) = %v, want %v", dst, got, want)
+		}
+	}
+}
+
+func TestPinnedCards_StayOnTop(t *testing.T) {
+	board := models.Board{Name: "b", Path: boardDir(t), Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{
+			{Filename: "a.md", Title: "Alpha"},
+			{Filename: "b.md", Title: "Beta"},
+			{Filename: "r.md", Title: "Runbook", Pinned: true},
+		}},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 40)
+
+	titles := func() string {
+		var names []string
+		for _, c := range m.getVisibleCards(0) {
+			names = append(names, c.Title)
+		}
+		return strings.Join(names, ",")
+	}
+	if got := titles(); got != "Runbook,Alpha,Beta" {
+		t.Fatalf("visible order = %s, want the pinned card first", got)
+	}
+	if !strings.Contains(m.View(), pinGlyph+" Runbook") {
+		t.Error("expected the pin glyph before the pinned card's title")
+	}
+
+	// Moving an unpinned card up can't pass the pinned one
+	m.selectedCard = 1
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("k")})
+	if got := titles(); got != "Runbook,Alpha,Beta" || m.selectedCard != 1 {
+		t.Errorf("order = %s, cursor %d; want the pinned card kept on top", got, m.selectedCard)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("j")})
+	if got := titles(); got != "Runbook,Beta,Alpha" || m.selectedCard != 2 {
+		t.Errorf("order = %s, cursor %d; want Alpha moved below Beta", got, m.selectedCard)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	// ! pins the selected card and the cursor follows it to the top
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("!")})
+	if m.err != nil {
+		t.Fatal(m.err)
+	}
+	if got := titles(); got != "Alpha,Runbook,Beta" || m.selectedCard != 0 {
+		t.Errorf("after pinning: order = %s, cursor %d; want pinned cards in column order", got, m.selectedCard)
+	}
+}
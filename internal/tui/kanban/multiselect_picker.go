@@ -5,7 +5,9 @@ import (
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 	"github.com/sahilm/fuzzy"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -234,45 +236,88 @@ func (m MultiSelectPickerModel) Update(msg tea.Msg) (MultiSelectPickerModel, tea
 	return m, nil, false, false
 }
 
+// IsTyping reports whether the filter or new-item input has focus, so keys
+// such as ? are typed rather than handled.
+func (m MultiSelectPickerModel) IsTyping() bool {
+	return m.filterMode || m.createMode
+}
+
+// HelpEntries returns the picker's keybindings for the help overlay.
+func (m MultiSelectPickerModel) HelpEntries() []shared.HelpBind {
+	if m.config.SingleSelect {
+		return []shared.HelpBind{
+			{Key: "j / k", Desc: "Navigate"},
+			{Key: "enter / space", Desc: "Select (again to clear)"},
+			{Key: "/", Desc: "Filter"},
+			{Key: "esc", Desc: "Clear filter, then cancel"},
+		}
+	}
+	return []shared.HelpBind{
+		{Key: "j / k", Desc: "Navigate"},
+		{Key: "space / tab", Desc: "Toggle " + m.config.ItemTypeSingular},
+		{Key: "n", Desc: "Create a new " + m.config.ItemTypeSingular},
+		{Key: "/", Desc: "Filter (enter keeps it, esc clears it)"},
+		{Key: "enter", Desc: "Save and close"},
+		{Key: "esc", Desc: "Clear filter, then cancel"},
+	}
+}
+
+// HintText returns the hint bar text for the picker's current mode.
+func (m MultiSelectPickerModel) HintText() string {
+	switch {
+	case m.filterMode:
+		return "type to filter  enter:keep filter  esc:clear"
+	case m.createMode:
+		return "type a name  enter:create  esc:cancel"
+	case m.config.SingleSelect:
+		return "?:help  j/k:navigate  enter:select  /:filter  esc:cancel"
+	}
+	return "?:help  j/k:navigate  space:toggle  n:new  /:filter  enter:save  esc:cancel"
+}
+
+// SetSize sets the screen area the picker is centered in, which bounds the
+// box width and how many items are listed before it scrolls.
+func (m *MultiSelectPickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
 // View renders the picker
 func (m MultiSelectPickerModel) View() string {
-	var s strings.Builder
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(50, m.width))
+	innerWidth := box.GetWidth() - box.GetHorizontalPadding()
+	m.textInput.Width = min(40, innerWidth-lipgloss.Width("Create new: ")-2)
 
 	// Title
-	title := tagPickerTitleStyle.Render(m.config.Title)
-	s.WriteString(title)
-	s.WriteString("\n\n")
+	header := []string{tagPickerTitleStyle.Render(m.config.Title), ""}
 
 	// Text input - show with indicator based on mode
+	input := m.textInput.View()
 	if m.createMode {
-		s.WriteString(tagPickerTitleStyle.Render("Create new: "))
+		input = tagPickerTitleStyle.Render("Create new: ") + input
 	} else if m.filterMode {
-		s.WriteString(tagPickerTitleStyle.Render("Filtering: "))
+		input = tagPickerTitleStyle.Render("Filtering: ") + input
 	}
-	s.WriteString(m.textInput.View())
-	s.WriteString("\n\n")
+	header = append(header, input, "")
 
 	// Item list
+	var rows []string
 	if len(m.config.AllItems) == 0 {
-		s.WriteString(tagItemStyle.Render("No " + m.config.ItemTypeSingular + "s yet. Press 'n' to create one."))
-		s.WriteString("\n")
+		rows = append(rows, tagItemStyle.Render("No "+m.config.ItemTypeSingular+"s yet. Press 'n' to create one."))
 	} else if len(m.filteredItems) == 0 && !m.showCreate {
-		s.WriteString(tagItemStyle.Render("No matching " + m.config.ItemTypeSingular + "s"))
-		s.WriteString("\n")
+		rows = append(rows, tagItemStyle.Render("No matching "+m.config.ItemTypeSingular+"s"))
 	} else {
 		// Render filtered items
 		for i, item := range m.filteredItems {
-			s.WriteString(m.renderItem(i, item))
+			rows = append(rows, m.renderItem(i, item, innerWidth))
 		}
 
 		// Render "create new" option if query doesn't match existing item
 		if m.showCreate && m.query != "" {
-			s.WriteString(m.renderCreateNew(len(m.filteredItems)))
+			rows = append(rows, m.renderCreateNew(len(m.filteredItems), innerWidth))
 		}
 	}
 
-	s.WriteString("\n")
-
 	// Help text - show different help based on mode
 	var help string
 	if m.createMode {
@@ -286,15 +331,16 @@ func (m MultiSelectPickerModel) View() string {
 	} else {
 		help = helpStyle.Render("jk: navigate • tab: toggle • n: new • /: filter • enter: save • esc: cancel")
 	}
-	s.WriteString(help)
+	footer := []string{"", help}
 
-	// Wrap in box
-	content := s.String()
-	return tagPickerBoxStyle.Render(content)
+	// Scroll the list when the whole box would not fit on screen
+	lines := append(append(header, rows...), footer...)
+	lines = shared.FitModalList(lines, len(header), len(header)+len(rows), m.cursorPos, box, m.height)
+	return box.Render(strings.Join(lines, "\n"))
 }
 
 // renderItem renders a single item
-func (m MultiSelectPickerModel) renderItem(index int, item string) string {
+func (m MultiSelectPickerModel) renderItem(index int, item string, width int) string {
 	// Indentation based on depth
 	indent := ""
 	if m.config.ItemDepths != nil {
@@ -322,11 +368,11 @@ func (m MultiSelectPickerModel) renderItem(index int, item string) string {
 		style = tagItemSelectedStyle
 	}
 
-	return style.Render(text) + "\n"
+	return style.Render(shared.Truncate(text, width))
 }
 
 // renderCreateNew renders the "create new" option
-func (m MultiSelectPickerModel) renderCreateNew(index int) string {
+func (m MultiSelectPickerModel) renderCreateNew(index int, width int) string {
 	checkbox := "[ ]"
 	if m.config.SelectedItems[m.query] {
 		checkbox = "[x]"
@@ -339,7 +385,7 @@ func (m MultiSelectPickerModel) renderCreateNew(index int) string {
 		style = tagItemHighlightStyle.Copy().Foreground(theme.Success)
 	}
 
-	return style.Render(text) + "\n"
+	return style.Render(shared.Truncate(text, width))
 }
 
 // toggleItem toggles the item at the current cursor position
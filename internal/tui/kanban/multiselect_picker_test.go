@@ -1,9 +1,13 @@
 package kanban
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/kanban/models"
 )
 
 func newTestSingleSelectPicker(selected string, items []string) MultiSelectPickerModel {
@@ -127,3 +131,54 @@ func TestMultiSelect_CancelledTrueOnEscNoFilter(t *testing.T) {
 		t.Fatal("expected cancelled=true on esc with no filter")
 	}
 }
+
+func TestBoardModalHelp_FollowsOpenPicker(t *testing.T) {
+	m := NewBoardModel(models.Board{Name: "b", Columns: []models.Column{{Name: "To Do"}}}, nil, nil, nil)
+	if _, ok := m.ModalHelp(); ok {
+		t.Fatal("no picker is open")
+	}
+
+	picker := NewTagPickerModel(nil, []string{"bug"})
+	m.tagPicker = &picker
+	m.mode = boardModeTagEdit
+	section, ok := m.ModalHelp()
+	if !ok || section.Title != "Edit Tags" || len(section.Binds) == 0 {
+		t.Fatalf("expected tag picker help, got %+v, %v", section, ok)
+	}
+	if !strings.Contains(m.HintText(), "space:toggle") {
+		t.Errorf("hint text should come from the picker, got %q", m.HintText())
+	}
+
+	// While filtering, ? is typed into the filter instead
+	picker, _, _, _ = picker.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	m.tagPicker = &picker
+	if _, ok := m.ModalHelp(); ok {
+		t.Error("no modal help while the filter has focus")
+	}
+}
+
+func TestView_ScrollsToFitSmallTerminal(t *testing.T) {
+	var items []string
+	for i := 0; i < 40; i++ {
+		items = append(items, fmt.Sprintf("item-%02d", i))
+	}
+	m := newTestSingleSelectPicker("", items)
+	m.SetSize(80, 22)
+	for i := 0; i < 30; i++ {
+		m, _, _, _ = pressKey(m, "j")
+	}
+
+	view := m.View()
+	if h := lipgloss.Height(view); h > 22 {
+		t.Errorf("view is %d rows tall, want at most 22", h)
+	}
+	if !strings.Contains(view, "item-30") {
+		t.Error("cursor item should be visible after scrolling")
+	}
+	if strings.Contains(view, "item-00") {
+		t.Error("first item should have scrolled out of view")
+	}
+	if !strings.Contains(view, "more above") || !strings.Contains(view, "more below") {
+		t.Error("expected scroll indicators above and below the list")
+	}
+}
@@ -0,0 +1,63 @@
+package kanban
+
+import "wydo/internal/kanban/models"
+
+// CardsByColumn lists the card files in each column of the board, which is
+// what the app records as a visit to it.
+func (m BoardModel) CardsByColumn() map[string][]string {
+	cards := make(map[string][]string, len(m.board.Columns))
+	for _, col := range m.board.Columns {
+		files := make([]string, 0, len(col.Cards))
+		for _, card := range col.Cards {
+			files = append(files, card.Filename)
+		}
+		cards[col.Name] = files
+	}
+	return cards
+}
+
+// SetSeenCards takes the card files each column held at the last visit, see
+// CardsByColumn. Cards that have arrived in a column since, from a sync or
+// another tool, are counted in a "3 new" badge on its title for the rest of
+// this visit. nil (a board never visited before) shows no badges.
+func (m *BoardModel) SetSeenCards(seen map[string][]string) {
+	m.newCards = nil
+	if seen == nil {
+		return
+	}
+	for _, col := range m.board.Columns {
+		had := make(map[string]bool, len(seen[col.Name]))
+		for _, f := range seen[col.Name] {
+			had[f] = true
+		}
+		for _, card := range col.Cards {
+			if had[card.Filename] {
+				continue
+			}
+			if m.newCards == nil {
+				m.newCards = make(map[string]map[string]bool)
+			}
+			if m.newCards[col.Name] == nil {
+				m.newCards[col.Name] = make(map[string]bool)
+			}
+			m.newCards[col.Name][card.Filename] = true
+		}
+	}
+}
+
+// newCardCount returns how many of the cards in col are new since the last
+// visit. A new card moved to another column during the visit is counted in
+// neither, and archived cards are left out.
+func (m BoardModel) newCardCount(col models.Column) int {
+	files := m.newCards[col.Name]
+	if len(files) == 0 {
+		return 0
+	}
+	n := 0
+	for _, card := range col.Cards {
+		if files[card.Filename] && !card.Archived {
+			n++
+		}
+	}
+	return n
+}
@@ -0,0 +1,39 @@
+package kanban
+
+import (
+	"strings"
+	"testing"
+
+	"wydo/internal/kanban/models"
+)
+
+func TestSetSeenCards_BadgesNewArrivals(t *testing.T) {
+	board := models.Board{Name: "Shared", Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{Filename: "a.md", Title: "A"}, {Filename: "b.md", Title: "B"}, {Filename: "c.md", Title: "C"}}},
+		{Name: "Doing", Cards: []models.Card{{Filename: "d.md", Title: "D"}}},
+		{Name: "Done", Cards: []models.Card{{Filename: "e.md", Title: "E", Archived: true}}},
+	}}
+	m := NewBoardModel(board, nil, nil, nil)
+	m.SetSize(120, 30)
+
+	// Last visit: a.md in To Do and d.md still in To Do (since moved)
+	m.SetSeenCards(map[string][]string{"To Do": {"a.md", "d.md"}, "Done": {}})
+	for i, want := range []int{2, 1, 0} {
+		if got := m.newCardCount(m.board.Columns[i]); got != want {
+			t.Errorf("%s: %d new, want %d", m.board.Columns[i].Name, got, want)
+		}
+	}
+	if view := m.View(); !strings.Contains(view, "2 new") || !strings.Contains(view, "1 new") {
+		t.Errorf("expected the badges in the column titles:\n%s", view)
+	}
+
+	// A board never visited shows no badges, and the visit lists every card
+	m.SetSeenCards(nil)
+	if strings.Contains(m.View(), " new") {
+		t.Error("expected no badges without a previous visit")
+	}
+	cards := m.CardsByColumn()
+	if got := strings.Join(cards["To Do"], ","); got != "a.md,b.md,c.md" || len(cards["Done"]) != 1 {
+		t.Errorf("CardsByColumn = %v", cards)
+	}
+}
@@ -5,8 +5,10 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"wydo/internal/clock"
 	"wydo/internal/kanban/models"
 	"wydo/internal/kanban/operations"
+	"wydo/internal/stats"
 	"wydo/internal/tui/messages"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -23,6 +25,7 @@ const (
 	modeSelectDir
 	modeCreate
 	modeRename
+	modeDelete
 )
 
 type PickerModel struct {
@@ -35,11 +38,14 @@ type PickerModel struct {
 	defaultDir     string
 	availableDirs  []string
 	selectedDirIdx int
-	renameIdx      int
+	renameIdx      int // board being renamed or deleted
 	width          int
 	height         int
 	err            error
 	showArchived   bool
+	// summaries caches the footer stats of boards highlighted so far, by
+	// path; computed on first highlight and dropped when the boards reload
+	summaries map[string]stats.BoardSummary
 }
 
 func NewPickerModel(boards []models.Board, defaultDir string, availableDirs []string) PickerModel {
@@ -62,6 +68,7 @@ func NewPickerModel(boards []models.Board, defaultDir string, availableDirs []st
 		textInput:     ti,
 		defaultDir:    defaultDir,
 		availableDirs: availableDirs,
+		summaries:     make(map[string]stats.BoardSummary),
 	}
 }
 
@@ -73,7 +80,7 @@ func (m *PickerModel) SetSize(width, height int) {
 
 // IsTyping returns true when the picker is in create, search, or rename mode with active text input
 func (m PickerModel) IsTyping() bool {
-	return m.mode == modeCreate || m.mode == modeSearch || m.mode == modeRename
+	return m.mode == modeCreate || m.mode == modeSearch || m.mode == modeRename || m.mode == modeDelete
 }
 
 // HintText returns the raw hint string for the current picker mode.
@@ -87,14 +94,17 @@ func (m PickerModel) HintText() string {
 		return "enter:create  esc:cancel"
 	case modeRename:
 		return "enter:rename  esc:cancel"
+	case modeDelete:
+		return "type the board name  enter:delete  esc:cancel"
 	default:
-		return "j/k:navigate  /:search  enter:select  n:new board  r:rename  ?:help  q:quit"
+		return "j/k:navigate  /:search  enter:select  n:new board  r:rename  D:delete  ?:help  q:quit"
 	}
 }
 
 // SetBoards updates the boards list
 func (m *PickerModel) SetBoards(boards []models.Board) {
 	m.boards = boards
+	m.summaries = make(map[string]stats.BoardSummary)
 	m.applyFilter()
 }
 
@@ -157,6 +167,8 @@ func (m PickerModel) Update(msg tea.Msg) (PickerModel, tea.Cmd) {
 			return m.updateCreate(msg)
 		case modeRename:
 			return m.updateRename(msg)
+		case modeDelete:
+			return m.updateDelete(msg)
 		}
 	}
 
@@ -236,6 +248,17 @@ func (m PickerModel) updateList(msg tea.KeyMsg) (PickerModel, tea.Cmd) {
 			return m, textinput.Blink
 		}
 
+	case "D":
+		if len(m.filtered) > 0 && m.selected < len(m.filtered) {
+			m.renameIdx = m.filtered[m.selected]
+			m.mode = modeDelete
+			m.err = nil
+			m.textInput.Placeholder = m.boards[m.renameIdx].Name
+			m.textInput.SetValue("")
+			m.textInput.Focus()
+			return m, textinput.Blink
+		}
+
 	case "a":
 		if len(m.filtered) > 0 && m.selected < len(m.filtered) {
 			board := &m.boards[m.filtered[m.selected]]
@@ -320,16 +343,48 @@ func (m PickerModel) updateRename(msg tea.KeyMsg) (PickerModel, tea.Cmd) {
 		return m, nil
 	case "enter":
 		newName := strings.TrimSpace(m.textInput.Value())
+		var cmd tea.Cmd
 		if newName != "" {
+			oldPath := m.boards[m.renameIdx].Path
 			if err := operations.RenameBoard(&m.boards[m.renameIdx], newName); err != nil {
 				m.err = err
 			} else {
 				m.applyFilter()
+				newPath := m.boards[m.renameIdx].Path
+				cmd = func() tea.Msg { return messages.BoardRenamedMsg{OldPath: oldPath, NewPath: newPath} }
 			}
 		}
 		m.mode = modeList
 		m.textInput.SetValue("")
+		return m, cmd
+	}
+	var cmd tea.Cmd
+	m.textInput, cmd = m.textInput.Update(msg)
+	return m, cmd
+}
+
+func (m PickerModel) updateDelete(msg tea.KeyMsg) (PickerModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
+		m.mode = modeList
+		m.err = nil
+		m.textInput.SetValue("")
 		return m, nil
+	case "enter":
+		board := m.boards[m.renameIdx]
+		if strings.TrimSpace(m.textInput.Value()) != board.Name {
+			m.err = fmt.Errorf("name does not match %q", board.Name)
+			return m, nil
+		}
+		if _, err := operations.DeleteBoard(board); err != nil {
+			m.err = err
+			return m, nil
+		}
+		m.boards = append(m.boards[:m.renameIdx:m.renameIdx], m.boards[m.renameIdx+1:]...)
+		m.applyFilter()
+		m.mode = modeList
+		m.textInput.SetValue("")
+		return m, func() tea.Msg { return messages.BoardDeletedMsg{Path: board.Path} }
 	}
 	var cmd tea.Cmd
 	m.textInput, cmd = m.textInput.Update(msg)
@@ -374,6 +429,8 @@ func (m PickerModel) View() string {
 		return m.viewCreate()
 	case modeRename:
 		return m.viewRename()
+	case modeDelete:
+		return m.viewDelete()
 	default:
 		return m.viewList()
 	}
@@ -458,6 +515,9 @@ func (m PickerModel) viewList() string {
 			lines = append(lines, line)
 		}
 		lines = append(lines, "")
+		if m.selected < len(m.filtered) {
+			lines = append(lines, m.viewSummary(m.boards[m.filtered[m.selected]]), "")
+		}
 	}
 
 	// Error message
@@ -470,6 +530,22 @@ func (m PickerModel) viewList() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
 }
 
+// viewSummary renders the footer stats of the highlighted board, with the
+// overdue count in the warning color.
+func (m PickerModel) viewSummary(board models.Board) string {
+	s, ok := m.summaries[board.Path]
+	if !ok {
+		s = stats.CollectBoardSummary(board, clock.Now())
+		// The map is shared by every copy of the model, so this caches
+		m.summaries[board.Path] = s
+	}
+	style := pathStyle
+	if s.Overdue > 0 {
+		style = warningStyle
+	}
+	return style.Render("  " + s.String(clock.Now()))
+}
+
 func (m PickerModel) viewSelectDir() string {
 	var lines []string
 
@@ -506,6 +582,24 @@ func (m PickerModel) viewRename() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
 }
 
+func (m PickerModel) viewDelete() string {
+	board := m.boards[m.renameIdx]
+	var lines []string
+	lines = append(lines, titleStyle.Render("Delete Board"))
+	lines = append(lines, "")
+	lines = append(lines, listItemStyle.Render(fmt.Sprintf("Moves %s to %s/ beside it.", abbreviatePath(board.Path), operations.TrashDir)))
+	lines = append(lines, listItemStyle.Render(fmt.Sprintf("Type %q to confirm:", board.Name)))
+	lines = append(lines, "")
+	lines = append(lines, m.textInput.View())
+	lines = append(lines, "")
+	if m.err != nil {
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+		lines = append(lines, "")
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
 func (m PickerModel) viewCreate() string {
 	var lines []string
 
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tui/shared"
 )
 
 // ProjectPickerItem represents a project with its nesting depth for display
@@ -61,11 +62,31 @@ func (m ProjectPickerModel) Update(msg tea.Msg) (ProjectPickerModel, tea.Cmd, bo
 	return m, cmd, isDone, cancelled
 }
 
+// SetSize sets the screen area the picker is centered in
+func (m *ProjectPickerModel) SetSize(width, height int) {
+	m.picker.SetSize(width, height)
+}
+
 // View renders the project picker
 func (m ProjectPickerModel) View() string {
 	return m.picker.View()
 }
 
+// IsTyping reports whether the picker's filter or new-project input has focus
+func (m ProjectPickerModel) IsTyping() bool {
+	return m.picker.IsTyping()
+}
+
+// HelpEntries returns the picker's keybindings for the help overlay
+func (m ProjectPickerModel) HelpEntries() []shared.HelpBind {
+	return m.picker.HelpEntries()
+}
+
+// HintText returns the hint bar text for the picker
+func (m ProjectPickerModel) HintText() string {
+	return m.picker.HintText()
+}
+
 // sanitizeProject cleans and normalizes a project string
 func sanitizeProject(project string) string {
 	// Trim spaces and convert to lowercase
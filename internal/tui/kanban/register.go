@@ -0,0 +1,120 @@
+package kanban
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/tui/messages"
+)
+
+// SetCardRegister sets the card waiting in the register, which the app keeps
+// across board views. nil empties it.
+func (m *BoardModel) SetCardRegister(reg *messages.CardRegister) {
+	m.register = reg
+}
+
+// registerCmd tells the app the register changed.
+func registerCmd(reg *messages.CardRegister) tea.Cmd {
+	return func() tea.Msg { return messages.CardRegisterMsg{Register: reg} }
+}
+
+// yankCard takes the selected card into the register, or empties the
+// register when the card is already in it. The card stays where it is until
+// it is put somewhere with P.
+func (m BoardModel) yankCard() (BoardModel, tea.Cmd) {
+	card := m.board.Columns[m.selectedCol].Cards[m.resolveCardIndex(m.selectedCol, m.selectedCard)]
+	if m.register != nil && m.register.BoardPath == m.board.Path && m.register.Filename == card.Filename {
+		m.register = nil
+		m.message = "Register emptied"
+		return m, registerCmd(nil)
+	}
+	m.register = &messages.CardRegister{
+		BoardPath: m.board.Path,
+		Filename:  card.Filename,
+		Title:     card.Title,
+		Projects:  append([]string(nil), m.boardProjects...),
+	}
+	m.message = fmt.Sprintf("%q in the register: P puts it in a column of any board", card.Title)
+	return m, registerCmd(m.register)
+}
+
+// putCard moves the card in the register to the end of the selected column.
+// From another board it moves like M does: the file goes to this board and,
+// within a workspace, keeps the source board's projects.
+func (m BoardModel) putCard() (BoardModel, tea.Cmd) {
+	reg := m.register
+	if reg == nil {
+		m.message = "Register is empty: y takes a card into it"
+		return m, nil
+	}
+
+	if reg.BoardPath == m.board.Path {
+		col, idx, ok := findCardFile(m.board, reg.Filename)
+		if !ok {
+			return m.dropRegister(reg)
+		}
+		if col != m.selectedCol {
+			if err := operations.MoveCard(&m.board, col, idx, m.selectedCol); err != nil {
+				m.err = err
+				return m, nil
+			}
+		}
+	} else {
+		src, err := fs.ReadBoard(reg.BoardPath)
+		if err != nil {
+			m.err = fmt.Errorf("load source board: %w", err)
+			return m, nil
+		}
+		col, idx, ok := findCardFile(src, reg.Filename)
+		if !ok {
+			return m.dropRegister(reg)
+		}
+		if err := operations.MoveCardToBoardColumn(&src, col, idx, &m.board, m.selectedCol, m.putProjects(reg)); err != nil {
+			m.err = err
+			return m, nil
+		}
+	}
+
+	m.register = nil
+	m.message = fmt.Sprintf("Put %q in %s", reg.Title, m.board.Columns[m.selectedCol].Name)
+	if m.filterActive {
+		m.recomputeFilter()
+	}
+	m.selectedCard = max(0, len(m.getVisibleCards(m.selectedCol))-1)
+	m.columnCursorPos[m.selectedCol] = m.selectedCard
+	m.adjustScrollPosition()
+	return m, registerCmd(nil)
+}
+
+// dropRegister empties a register whose card was moved or deleted since.
+func (m BoardModel) dropRegister(reg *messages.CardRegister) (BoardModel, tea.Cmd) {
+	m.register = nil
+	m.message = fmt.Sprintf("%q is no longer on its board; register emptied", reg.Title)
+	return m, registerCmd(nil)
+}
+
+// putProjects returns the projects to link to a card put on this board: the
+// source board's within its workspace, this board's own from another one.
+func (m BoardModel) putProjects(reg *messages.CardRegister) []string {
+	src, srcOK := m.boardInfo[reg.BoardPath]
+	dst, dstOK := m.boardInfo[m.board.Path]
+	if !srcOK || !dstOK || src.Workspace == dst.Workspace {
+		return reg.Projects
+	}
+	return dst.Projects
+}
+
+// findCardFile returns the column and index of the card with the given file.
+func findCardFile(board models.Board, filename string) (col, idx int, ok bool) {
+	for c, column := range board.Columns {
+		for i, card := range column.Cards {
+			if card.Filename == filename {
+				return c, i, true
+			}
+		}
+	}
+	return 0, 0, false
+}
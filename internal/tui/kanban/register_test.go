@@ -0,0 +1,111 @@
+package kanban
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/tui/messages"
+)
+
+func press(m BoardModel, key string) (BoardModel, tea.Msg) {
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+	if cmd == nil {
+		return m, nil
+	}
+	return m, cmd()
+}
+
+func TestRegister_PutsCardOnAnotherBoard(t *testing.T) {
+	root := t.TempDir()
+	src, err := operations.CreateBoard(root, "Source")
+	if err != nil {
+		t.Fatal(err)
+	}
+	card, err := operations.CreateCard(&src, "To Do")
+	if err != nil {
+		t.Fatal(err)
+	}
+	dst, err := operations.CreateBoard(root, "Dest")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewBoardModel(src, nil, nil, []string{"alpha"})
+	m, msg := press(m, "y")
+	regMsg, ok := msg.(messages.CardRegisterMsg)
+	if !ok || regMsg.Register == nil || regMsg.Register.Filename != card.Filename {
+		t.Fatalf("expected the card in the register, got %#v", msg)
+	}
+	if len(m.board.Columns[0].Cards) != 1 {
+		t.Fatal("y should leave the card in place until it is put")
+	}
+
+	// The app hands the register to the next board it opens
+	other := NewBoardModel(dst, nil, nil, nil)
+	other.SetCardRegister(regMsg.Register)
+	other, _ = press(other, "l")
+	other, msg = press(other, "P")
+	if m.err != nil || other.err != nil {
+		t.Fatalf("put failed: %v", other.err)
+	}
+	if regMsg, ok := msg.(messages.CardRegisterMsg); !ok || regMsg.Register != nil {
+		t.Errorf("expected the register emptied after the put, got %#v", msg)
+	}
+
+	col := other.board.Columns[1]
+	if len(col.Cards) != 1 || other.selectedCol != 1 || other.selectedCard != 0 {
+		t.Fatalf("expected the card in %s under the cursor, got %+v", col.Name, col.Cards)
+	}
+	if _, err := os.Stat(filepath.Join(src.Path, "cards", card.Filename)); !os.IsNotExist(err) {
+		t.Error("expected the card file gone from the source board")
+	}
+	moved, err := fs.ReadCard(filepath.Join(dst.Path, "cards", col.Cards[0].Filename))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(moved.Projects) != 1 || moved.Projects[0] != "alpha" {
+		t.Errorf("expected the source board's projects linked, got %v", moved.Projects)
+	}
+	reread, _ := fs.ReadBoard(src.Path)
+	if len(reread.Columns[0].Cards) != 0 {
+		t.Error("expected the card removed from the source board.md")
+	}
+}
+
+func TestRegister_PutWithinBoardAndEmpty(t *testing.T) {
+	board, err := operations.CreateBoard(t.TempDir(), "Solo")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := operations.CreateCard(&board, "To Do"); err != nil {
+		t.Fatal(err)
+	}
+	m := NewBoardModel(board, nil, nil, nil)
+
+	m, _ = press(m, "P")
+	if m.register != nil || m.message == "" {
+		t.Errorf("expected a hint that the register is empty, got %q", m.message)
+	}
+
+	m, _ = press(m, "y")
+	m, _ = press(m, "l")
+	m, _ = press(m, "l")
+	m, _ = press(m, "P")
+	if m.err != nil {
+		t.Fatal(m.err)
+	}
+	if len(m.board.Columns[0].Cards) != 0 || len(m.board.Columns[2].Cards) != 1 {
+		t.Errorf("expected the card moved to %s", m.board.Columns[2].Name)
+	}
+
+	// y twice on the same card empties the register
+	m, _ = press(m, "y")
+	m, msg := press(m, "y")
+	if regMsg, ok := msg.(messages.CardRegisterMsg); !ok || regMsg.Register != nil || m.register != nil {
+		t.Errorf("expected the register emptied, got %#v", msg)
+	}
+}
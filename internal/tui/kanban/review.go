@@ -0,0 +1,23 @@
+package kanban
+
+import (
+	"time"
+
+	"wydo/internal/kanban/operations"
+)
+
+// MarkReviewed marks the board's review done when its review: cadence has
+// one due on or before now, and reports whether it did. The app calls it
+// when the board is opened.
+func (m *BoardModel) MarkReviewed(now time.Time) bool {
+	if !m.board.ReviewDue(now) {
+		return false
+	}
+	if err := operations.MarkBoardReviewed(&m.board, now); err != nil {
+		m.err = err
+		return false
+	}
+	next, _ := m.board.NextReview(now)
+	m.message = "Review done; the next one is due " + next.Format("Mon Jan 2")
+	return true
+}
@@ -0,0 +1,35 @@
+package kanban
+
+import (
+	"testing"
+	"time"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/operations"
+)
+
+func TestMarkReviewed(t *testing.T) {
+	board, err := operations.CreateBoard(t.TempDir(), "Sprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	board.Review = "weekly"
+	m := NewBoardModel(board, nil, nil, nil)
+
+	now := time.Date(2026, 3, 4, 9, 30, 0, 0, time.Local)
+	if !m.MarkReviewed(now) {
+		t.Fatal("expected a board never reviewed to have its review due")
+	}
+	loaded, err := fs.ReadBoard(board.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.LastReviewed == nil || !loaded.LastReviewed.Equal(now) {
+		t.Errorf("last_reviewed = %v, want %v", loaded.LastReviewed, now)
+	}
+
+	// Opening it again the next day changes nothing: the review is a week on
+	if m.MarkReviewed(now.AddDate(0, 0, 1)) {
+		t.Error("expected no review due the day after one")
+	}
+}
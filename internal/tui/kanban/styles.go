@@ -2,6 +2,7 @@ package kanban
 
 import (
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -11,6 +12,14 @@ const (
 	columnPaddingHorizontal = 2
 	cardPaddingHorizontal   = 1
 	cardBorderWidth         = 1
+
+	// MinWidth and MinHeight are the smallest area the board lays out in:
+	// one column with a card, its header and the scroll hints
+	MinWidth  = 50
+	MinHeight = 15
+
+	// pinGlyph marks pinned cards; it is one cell wide in every terminal font
+	pinGlyph = "⚑"
 )
 
 var (
@@ -31,14 +40,14 @@ var (
 
 	selectedColumnTitleStyle = lipgloss.NewStyle().
 					Bold(true).
-					Foreground(theme.Warning).
-					Background(theme.Surface).
+					Foreground(theme.SelectionFg).
+					Background(theme.SelectionBg).
 					Underline(true).
 					Align(lipgloss.Center)
 
 	selectedColumnStyle = lipgloss.NewStyle().
 				Border(lipgloss.RoundedBorder()).
-				BorderForeground(theme.BorderFocused).
+				BorderForeground(theme.FocusBorder).
 				Padding(1, columnPaddingHorizontal).
 				Width(columnWidth)
 
@@ -51,20 +60,35 @@ var (
 
 	selectedCardStyle = lipgloss.NewStyle().
 				Border(lipgloss.RoundedBorder(), false, false, false, true).
-				BorderForeground(theme.BorderFocused).
-				Background(theme.Surface).
+				BorderForeground(theme.FocusBorder).
+				Background(theme.SelectionBg).
 				Padding(0, cardPaddingHorizontal).
 				MarginBottom(1).
 				Bold(true)
 
 	moveSelectedCardStyle = lipgloss.NewStyle().
 				Border(lipgloss.RoundedBorder(), false, false, false, true).
-				BorderForeground(theme.Warning).
-				Background(lipgloss.Color("54")).
+				BorderForeground(theme.FocusBorder).
+				Background(theme.MoveBg).
 				Padding(0, cardPaddingHorizontal).
 				MarginBottom(1).
 				Bold(true)
 
+	// Placeholder left in a card's starting slot while it is moved to another column
+	moveGhostStyle = lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(theme.MoveBg).
+			Foreground(theme.Disabled).
+			Italic(true).
+			Padding(0, cardPaddingHorizontal).
+			MarginBottom(1)
+
+	blockedCardStyle = lipgloss.NewStyle().
+				Border(lipgloss.ThickBorder(), false, false, false, true).
+				BorderForeground(theme.Danger).
+				Padding(0, cardPaddingHorizontal).
+				MarginBottom(1)
+
 	cardTitleStyle = lipgloss.NewStyle().
 			Foreground(theme.Primary).
 			Bold(true)
@@ -80,6 +104,17 @@ var (
 	cardPreviewStyle = lipgloss.NewStyle().
 				Foreground(theme.TextMuted)
 
+	cardBlockedStyle = lipgloss.NewStyle().
+				Foreground(theme.Danger).
+				Italic(true)
+
+	// cardPinStyle draws pinGlyph before the titles of pinned cards
+	cardPinStyle = lipgloss.NewStyle().Foreground(theme.Warning)
+
+	// newCardsBadgeStyle draws a column's "3 new" count of cards that
+	// arrived since the last visit
+	newCardsBadgeStyle = lipgloss.NewStyle().Foreground(theme.Secondary).Bold(true)
+
 	// Help styles
 	helpStyle = theme.Muted.Padding(1, 2)
 
@@ -88,10 +123,7 @@ var (
 			Foreground(theme.Text).
 			Padding(0, 2)
 
-	selectedListItemStyle = lipgloss.NewStyle().
-				Foreground(theme.Warning).
-				Bold(true).
-				Padding(0, 2)
+	selectedListItemStyle = theme.Selected.Padding(0, 2)
 
 	// Message styles
 	errorStyle   = theme.Error
@@ -111,8 +143,8 @@ var (
 				Bold(true)
 
 	tagItemHighlightStyle = lipgloss.NewStyle().
-				Background(lipgloss.Color("0")).
-				Foreground(theme.Warning)
+				Background(theme.SelectionBg).
+				Foreground(theme.SelectionFg)
 
 	tagCreateNewStyle = lipgloss.NewStyle().
 				Foreground(theme.Success).
@@ -139,9 +171,9 @@ var (
 
 	// Claude session waiting-for-input badge style
 	cardClaudeWaitingStyle = lipgloss.NewStyle().
-			Background(theme.Warning).
-			Foreground(lipgloss.Color("16")).
-			Bold(true)
+				Background(theme.Warning).
+				Foreground(lipgloss.Color("16")).
+				Bold(true)
 
 	// Jira issue badge style
 	jiraStatusStyle = lipgloss.NewStyle().
@@ -169,24 +201,19 @@ var (
 	columnEditorItemStyle = lipgloss.NewStyle().
 				Foreground(theme.Text)
 
-	columnEditorItemHighlightStyle = lipgloss.NewStyle().
-					Background(theme.Surface).
-					Foreground(theme.Warning).
-					Bold(true)
+	columnEditorItemHighlightStyle = theme.Selected
 
-	columnEditorItemImmutableStyle = lipgloss.NewStyle().
-					Foreground(theme.TextMuted).
-					Italic(true)
+	columnEditorItemImmutableStyle = theme.DisabledText
 
 	// URL input modal styles
 	urlInputBoxStyle = theme.ModalBox.Width(60)
 
 	urlInputTitleStyle = theme.ModalTitle.Align(lipgloss.Center)
 
-	// Priority input modal styles
-	priorityInputBoxStyle = theme.ModalBox.Width(60)
+	// Card rename modal styles
+	renameInputBoxStyle = theme.ModalBox.Width(60)
 
-	priorityInputTitleStyle = theme.ModalTitle.Align(lipgloss.Center)
+	renameInputTitleStyle = theme.ModalTitle.Align(lipgloss.Center)
 
 	// Filter indicator style
 	filterIndicatorStyle = lipgloss.NewStyle().
@@ -203,6 +230,11 @@ var (
 	deleteConfirmCardTitleStyle = lipgloss.NewStyle().Foreground(theme.Text)
 )
 
+// columnColor resolves a column_colors value from board.md; see shared.ParseColor.
+func columnColor(spec string) (lipgloss.Color, bool) {
+	return shared.ParseColor(spec)
+}
+
 // modeIndicatorStyle returns a bold style with the given foreground color for mode badges.
 func modeIndicatorStyle(color lipgloss.Color) lipgloss.Style {
 	return lipgloss.NewStyle().Bold(true).Foreground(color)
@@ -210,20 +242,5 @@ func modeIndicatorStyle(color lipgloss.Color) lipgloss.Style {
 
 // kanbanPriorityStyle returns a bold badge style for the given priority level.
 func kanbanPriorityStyle(priority int) lipgloss.Style {
-	var bg, fg lipgloss.Color
-	switch priority {
-	case 1:
-		bg, fg = lipgloss.Color("5"), lipgloss.Color("16")   // magenta
-	case 2:
-		bg, fg = lipgloss.Color("1"), lipgloss.Color("16")   // red
-	case 3:
-		bg, fg = lipgloss.Color("208"), lipgloss.Color("16") // orange
-	case 4:
-		bg, fg = lipgloss.Color("3"), lipgloss.Color("16")   // yellow
-	case 5:
-		bg, fg = lipgloss.Color("2"), lipgloss.Color("16")   // green
-	default:
-		bg, fg = lipgloss.Color("8"), lipgloss.Color("15")  // gray
-	}
-	return lipgloss.NewStyle().Bold(true).Background(bg).Foreground(fg)
+	return shared.PriorityStyle(priority)
 }
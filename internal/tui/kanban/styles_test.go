@@ -0,0 +1,30 @@
+package kanban
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+func TestColumnColor(t *testing.T) {
+	cases := []struct {
+		spec string
+		want lipgloss.Color
+		ok   bool
+	}{
+		{"", "", false},
+		{"Yellow", theme.Warning, true},
+		{" red ", theme.Danger, true},
+		{"#ff8800", "#ff8800", true},
+		{"208", "208", true},
+		{"256", "", false},
+		{"chartreuse", "", false},
+	}
+	for _, c := range cases {
+		got, ok := columnColor(c.spec)
+		if got != c.want || ok != c.ok {
+			t.Errorf("columnColor(%q) = %q, %v; want %q, %v", c.spec, got, ok, c.want, c.ok)
+		}
+	}
+}
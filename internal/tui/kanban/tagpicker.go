@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tui/shared"
 )
 
 // TagPickerModel is a fuzzy-searchable multi-select tag picker
@@ -45,11 +46,31 @@ func (m TagPickerModel) Update(msg tea.Msg) (TagPickerModel, tea.Cmd, bool, bool
 	return m, cmd, isDone, cancelled
 }
 
+// SetSize sets the screen area the picker is centered in
+func (m *TagPickerModel) SetSize(width, height int) {
+	m.picker.SetSize(width, height)
+}
+
 // View renders the tag picker
 func (m TagPickerModel) View() string {
 	return m.picker.View()
 }
 
+// IsTyping reports whether the picker's filter or new-tag input has focus
+func (m TagPickerModel) IsTyping() bool {
+	return m.picker.IsTyping()
+}
+
+// HelpEntries returns the picker's keybindings for the help overlay
+func (m TagPickerModel) HelpEntries() []shared.HelpBind {
+	return m.picker.HelpEntries()
+}
+
+// HintText returns the hint bar text for the picker
+func (m TagPickerModel) HintText() string {
+	return m.picker.HintText()
+}
+
 // sanitizeTag cleans and normalizes a tag string
 func sanitizeTag(tag string) string {
 	// Trim spaces and convert to lowercase
@@ -0,0 +1,75 @@
+package kanban
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tasks/data"
+)
+
+// TaskCaptureModel is a one-line input for a follow-up task spawned from a
+// card. The task is tagged with the board's projects and a card: reference.
+type TaskCaptureModel struct {
+	input  textinput.Model
+	suffix string // projects and card reference appended to the text
+	width  int
+	height int
+}
+
+func NewTaskCaptureModel(suffix string) TaskCaptureModel {
+	ti := textinput.New()
+	ti.Placeholder = "Follow-up task"
+	ti.CharLimit = 200
+	ti.Width = 50
+	ti.Focus()
+	return TaskCaptureModel{input: ti, suffix: suffix}
+}
+
+func (m TaskCaptureModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+// Update returns done=true on enter or esc; confirmed is true only for enter.
+func (m TaskCaptureModel) Update(msg tea.KeyMsg) (model TaskCaptureModel, cmd tea.Cmd, done, confirmed bool) {
+	switch msg.String() {
+	case "esc":
+		return m, nil, true, false
+	case "enter":
+		return m, nil, true, true
+	}
+	m.input, cmd = m.input.Update(msg)
+	return m, cmd, false, false
+}
+
+// Line returns the todo.txt line to add, or "" if no text was entered.
+func (m TaskCaptureModel) Line() string {
+	text := strings.TrimSpace(m.input.Value())
+	if text == "" {
+		return ""
+	}
+	return text + " " + m.suffix
+}
+
+func (m TaskCaptureModel) View() string {
+	var s strings.Builder
+
+	s.WriteString(renameInputTitleStyle.Render("Capture Follow-up Task"))
+	s.WriteString("\n\n")
+	s.WriteString(m.input.View())
+	s.WriteString("\n")
+	s.WriteString(cardPreviewStyle.Render(m.suffix))
+	s.WriteString("\n\n")
+	s.WriteString(helpStyle.Render("enter: add to todo.txt • esc: cancel"))
+
+	box := renameInputBoxStyle.Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// cardReference is the card:<board>/<filename> tag value of a task captured
+// from a card, with the board's directory name standing for the board.
+func cardReference(boardPath, filename string) string {
+	return "card:" + data.FormatTagValue(filepath.Base(boardPath)+"/"+filename)
+}
@@ -0,0 +1,36 @@
+package kanban
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/models"
+	"wydo/internal/tui/messages"
+)
+
+func TestTaskCapture_TagsProjectsAndCard(t *testing.T) {
+	board := models.Board{Name: "Dev Work", Path: "/ws/boards/dev-work", Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{{Filename: "fix-login.md", Title: "Fix login"}}},
+	}}
+	m := NewBoardModel(board, nil, nil, []string{"wydo"})
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("f")})
+	if m.mode != boardModeTaskCapture {
+		t.Fatalf("mode = %v, want CAPTURE", m.mode)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("ask QA")})
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		t.Fatal("enter returned no command")
+	}
+	msg, ok := cmd().(messages.CaptureTaskMsg)
+	if !ok {
+		t.Fatalf("got %T, want CaptureTaskMsg", cmd())
+	}
+	if want := `ask QA +wydo card:"dev-work/fix-login.md"`; msg.Line != want {
+		t.Errorf("line = %q, want %q", msg.Line, want)
+	}
+	if m.mode != boardModeNormal {
+		t.Errorf("mode after enter = %v, want NORMAL", m.mode)
+	}
+}
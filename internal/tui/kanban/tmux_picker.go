@@ -1,14 +1,21 @@
 package kanban
 
 import (
+	"fmt"
 	"os"
 	"os/exec"
 	"path/filepath"
+	"strconv"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sahilm/fuzzy"
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/stats"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -121,10 +128,36 @@ func (m TmuxPickerModel) Update(msg tea.KeyMsg) (TmuxPickerModel, string, bool)
 	return m, "", false
 }
 
+// IsTyping reports whether the session filter has focus
+func (m TmuxPickerModel) IsTyping() bool {
+	return m.filterMode
+}
+
+// HelpEntries returns the picker's keybindings for the help overlay
+func (m TmuxPickerModel) HelpEntries() []shared.HelpBind {
+	return []shared.HelpBind{
+		{Key: "j / k", Desc: "Navigate sessions"},
+		{Key: "enter", Desc: "Link the selected session"},
+		{Key: "d", Desc: "Unlink the card's session"},
+		{Key: "/", Desc: "Filter (enter keeps it, esc clears it)"},
+		{Key: "backspace", Desc: "Shorten the filter"},
+		{Key: "esc", Desc: "Cancel"},
+	}
+}
+
+// HintText returns the hint bar text for the picker
+func (m TmuxPickerModel) HintText() string {
+	if m.filterMode {
+		return "type to filter  enter:keep filter  esc:clear"
+	}
+	return "?:help  j/k:navigate  enter:link  d:unlink  /:filter  esc:cancel"
+}
+
 // View renders the tmux session picker as a centered modal.
 func (m TmuxPickerModel) View() string {
 	var lines []string
 
+	listStart, listEnd := 0, 0
 	lines = append(lines, tagPickerTitleStyle.Render("Link Tmux Session"))
 	lines = append(lines, "")
 
@@ -141,6 +174,7 @@ func (m TmuxPickerModel) View() string {
 	} else if len(m.filtered) == 0 {
 		lines = append(lines, cardPreviewStyle.Render("No matching sessions"))
 	} else {
+		listStart = len(lines)
 		for i, idx := range m.filtered {
 			session := m.sessions[idx]
 			style := listItemStyle
@@ -157,6 +191,7 @@ func (m TmuxPickerModel) View() string {
 
 			lines = append(lines, style.Render(prefix+session+indicator))
 		}
+		listEnd = len(lines)
 	}
 
 	lines = append(lines, "")
@@ -168,24 +203,29 @@ func (m TmuxPickerModel) View() string {
 	}
 	lines = append(lines, helpStyle.Render(help))
 
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(50, m.width))
+	lines = shared.FitModalList(lines, listStart, listEnd, m.cursor, box, m.height)
+
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
-	boxed := tagPickerBoxStyle.Width(50).Render(content)
+	boxed := box.Render(content)
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
 }
 
 // TmuxLaunchModel is a small popup for choosing root vs child tmux session.
 type TmuxLaunchModel struct {
 	rootSession string
-	children    map[string]bool // suffix -> exists
+	children    map[string]bool  // suffix -> exists
+	info        *tmuxSessionInfo // root session state, nil when it isn't running
 	width       int
 	height      int
 }
 
 // NewTmuxLaunchModel creates a launch popup for a root session with pre-fetched child session state.
-func NewTmuxLaunchModel(rootSession string, children map[string]bool) TmuxLaunchModel {
+func NewTmuxLaunchModel(rootSession string, children map[string]bool, info *tmuxSessionInfo) TmuxLaunchModel {
 	return TmuxLaunchModel{
 		rootSession: rootSession,
 		children:    children,
+		info:        info,
 	}
 }
 
@@ -219,6 +259,7 @@ func (m TmuxLaunchModel) View() string {
 	lines = append(lines, tagPickerTitleStyle.Render("Switch to Session"))
 	lines = append(lines, "")
 	lines = append(lines, lipgloss.NewStyle().Bold(true).Foreground(theme.Primary).Render(m.rootSession))
+	lines = append(lines, helpStyle.Render(m.info.Detail(clock.Now())))
 	lines = append(lines, "")
 
 	type entry struct {
@@ -254,6 +295,65 @@ func (m TmuxLaunchModel) View() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
 }
 
+// tmuxSessionInfo is what tmux reports about one running session.
+type tmuxSessionInfo struct {
+	Windows  int
+	Attached bool      // a client is showing it
+	Activity time.Time // last input or output in the session
+}
+
+// Detail describes the session for the launch popup, e.g. "● 3 windows ·
+// attached · active 5m ago". A nil info is a session that isn't running.
+func (info *tmuxSessionInfo) Detail(now time.Time) string {
+	if info == nil {
+		return "○ not running (r starts it)"
+	}
+	parts := []string{"● 1 window"}
+	if info.Windows != 1 {
+		parts[0] = fmt.Sprintf("● %d windows", info.Windows)
+	}
+	if info.Attached {
+		parts = append(parts, "attached")
+	}
+	if !info.Activity.IsZero() {
+		parts = append(parts, "active "+stats.FormatAge(now.Sub(info.Activity)))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// tmuxInfoFormat is the list-sessions format parseTmuxSessionInfo reads.
+const tmuxInfoFormat = "#{session_name}\t#{session_windows}\t#{session_attached}\t#{session_activity}"
+
+// listTmuxSessionInfo returns the running sessions by name from a single tmux
+// call; nil when tmux isn't running.
+func listTmuxSessionInfo() map[string]tmuxSessionInfo {
+	out, err := exec.Command("tmux", "list-sessions", "-F", tmuxInfoFormat).Output()
+	if err != nil {
+		return nil
+	}
+	return parseTmuxSessionInfo(string(out))
+}
+
+// parseTmuxSessionInfo parses tmuxInfoFormat lines, skipping any it can't read.
+func parseTmuxSessionInfo(out string) map[string]tmuxSessionInfo {
+	sessions := make(map[string]tmuxSessionInfo)
+	for _, line := range strings.Split(out, "\n") {
+		fields := strings.Split(strings.TrimRight(line, "\r"), "\t")
+		if len(fields) != 4 || fields[0] == "" {
+			continue
+		}
+		var info tmuxSessionInfo
+		info.Windows, _ = strconv.Atoi(fields[1])
+		attached, _ := strconv.Atoi(fields[2])
+		info.Attached = attached > 0
+		if secs, err := strconv.ParseInt(fields[3], 10, 64); err == nil && secs > 0 {
+			info.Activity = time.Unix(secs, 0)
+		}
+		sessions[fields[0]] = info
+	}
+	return sessions
+}
+
 // listTmuxSessions returns all tmux session names.
 func listTmuxSessions() []string {
 	out, err := exec.Command("tmux", "list-sessions", "-F", "#{session_name}").Output()
@@ -323,12 +423,11 @@ func switchTmuxSession(name string) tea.Cmd {
 // readClaudeStatus scans ~/.config/wydo/claude-status/ and returns
 // a map of session name -> status string ("waiting" or "running").
 func readClaudeStatus() map[string]string {
-	configDir := os.Getenv("XDG_CONFIG_HOME")
-	if configDir == "" {
-		home, _ := os.UserHomeDir()
-		configDir = filepath.Join(home, ".config")
+	configDir, err := config.ConfigDir()
+	if err != nil {
+		return nil
 	}
-	dir := filepath.Join(configDir, "wydo", "claude-status")
+	dir := filepath.Join(configDir, "claude-status")
 
 	entries, err := os.ReadDir(dir)
 	if err != nil {
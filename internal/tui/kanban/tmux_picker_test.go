@@ -0,0 +1,37 @@
+package kanban
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTmuxSessionInfo(t *testing.T) {
+	out := "api\t3\t1\t1760600000\napi-claude\t1\t0\t1760599000\n\nbroken line\n"
+	got := parseTmuxSessionInfo(out)
+	if len(got) != 2 {
+		t.Fatalf("got %d sessions, want 2: %+v", len(got), got)
+	}
+	api := got["api"]
+	if api.Windows != 3 || !api.Attached || !api.Activity.Equal(time.Unix(1760600000, 0)) {
+		t.Errorf("api = %+v", api)
+	}
+	if got["api-claude"].Attached {
+		t.Error("api-claude should not be attached")
+	}
+}
+
+func TestTmuxSessionInfoDetail(t *testing.T) {
+	now := time.Unix(1760600000, 0)
+	info := &tmuxSessionInfo{Windows: 3, Attached: true, Activity: now.Add(-5 * time.Minute)}
+	if got, want := info.Detail(now), "● 3 windows · attached · active 5m ago"; got != want {
+		t.Errorf("Detail = %q, want %q", got, want)
+	}
+	info = &tmuxSessionInfo{Windows: 1}
+	if got, want := info.Detail(now), "● 1 window"; got != want {
+		t.Errorf("Detail = %q, want %q", got, want)
+	}
+	var missing *tmuxSessionInfo
+	if got, want := missing.Detail(now), "○ not running (r starts it)"; got != want {
+		t.Errorf("Detail = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,86 @@
+package kanban
+
+import (
+	"wydo/internal/kanban/operations"
+)
+
+// trackCard runs op, a change to the card at col/cardIndex (an index into
+// the column's cards, not the visible ones), and records it for u to undo.
+func (m *BoardModel) trackCard(col, cardIndex int, label string, op func() error) error {
+	change, err := operations.TrackCard(&m.board, col, cardIndex, label, op)
+	if err != nil {
+		return err
+	}
+	if change != nil {
+		m.history.Record(change)
+	}
+	m.updateRefs()
+	return nil
+}
+
+// undo reverts the last change made on the board and selects its card.
+func (m *BoardModel) undo() {
+	change, err := m.history.Undo(&m.board)
+	m.afterHistoryStep(change, err, "Nothing to undo", "Undid ", true)
+}
+
+// redo reapplies the last undone change and selects its card.
+func (m *BoardModel) redo() {
+	change, err := m.history.Redo(&m.board)
+	m.afterHistoryStep(change, err, "Nothing to redo", "Redid ", false)
+}
+
+func (m *BoardModel) afterHistoryStep(change operations.Change, err error, none, done string, undone bool) {
+	switch {
+	case err != nil:
+		m.err = err
+	case change == nil:
+		m.message = none
+	default:
+		m.message = done + change.Label()
+	}
+	m.reloadBoardState()
+	if change != nil {
+		if column, filename := changedCard(change, undone); filename != "" {
+			m.selectCard(column, filename)
+		}
+	}
+	m.adjustScrollPosition()
+}
+
+// changedCard returns the column and file of the card change touched, as
+// the board is left after undoing (undone) or redoing it.
+func changedCard(change operations.Change, undone bool) (string, string) {
+	switch c := change.(type) {
+	case *operations.CardChange:
+		state := c.After
+		if undone {
+			state = c.Before
+		}
+		if state != nil {
+			return state.Column, state.Filename
+		}
+	case *operations.CardSwap:
+		return c.Column, c.First
+	}
+	return "", ""
+}
+
+// selectCard moves the cursor to the card named filename in column, if it
+// is shown.
+func (m *BoardModel) selectCard(column, filename string) {
+	col := m.board.GetColumnIndex(column)
+	if col < 0 {
+		return
+	}
+	cards := m.board.Columns[col].Cards
+	for visible, idx := range m.getVisibleCardIndices(col) {
+		if cards[idx].Filename == filename {
+			m.selectedCol = col
+			m.selectedCard = visible
+			m.columnCursorPos[col] = visible
+			m.adjustHorizontalScrollPosition()
+			return
+		}
+	}
+}
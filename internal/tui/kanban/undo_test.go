@@ -0,0 +1,96 @@
+package kanban
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+)
+
+func undoTestBoard(t *testing.T) models.Board {
+	t.Helper()
+	board := models.Board{Name: "Work", Path: t.TempDir(), Columns: []models.Column{{Name: "To Do"}, {Name: "Done"}}}
+	if err := os.MkdirAll(filepath.Join(board.Path, "cards"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"first", "second"} {
+		card := models.Card{Filename: name + ".md", Title: name, Content: "# " + name + "\n"}
+		if err := fs.WriteCard(card, filepath.Join(board.Path, "cards", card.Filename)); err != nil {
+			t.Fatal(err)
+		}
+		board.Columns[0].Cards = append(board.Columns[0].Cards, card)
+	}
+	if err := fs.WriteBoard(board); err != nil {
+		t.Fatal(err)
+	}
+	return board
+}
+
+func pressAll(m BoardModel, keys ...tea.KeyMsg) BoardModel {
+	for _, k := range keys {
+		m, _ = m.Update(k)
+	}
+	return m
+}
+
+func runeKey(s string) tea.KeyMsg { return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)} }
+
+func TestBoardUndoRedo_Move(t *testing.T) {
+	m := NewBoardModel(undoTestBoard(t), nil, nil, nil)
+	m.SetSize(120, 40)
+
+	m = pressAll(m, runeKey("m"), runeKey("l"), tea.KeyMsg{Type: tea.KeyEsc})
+	if len(m.board.Columns[1].Cards) != 1 {
+		t.Fatalf("expected the card moved to Done, got %+v", m.board.Columns)
+	}
+
+	m = pressAll(m, runeKey("u"))
+	if got := m.board.Columns[0].Cards; len(got) != 2 || got[0].Filename != "first.md" {
+		t.Fatalf("after undo To Do = %+v, want first back on top", got)
+	}
+	if m.selectedCol != 0 || m.selectedCard != 0 || m.message != "Undid move" {
+		t.Errorf("selection %d/%d, message %q", m.selectedCol, m.selectedCard, m.message)
+	}
+	reread, err := fs.ReadBoard(m.board.Path)
+	if err != nil || len(reread.Columns[1].Cards) != 0 {
+		t.Errorf("expected the undo written to board.md, got %+v, %v", reread.Columns, err)
+	}
+
+	m = pressAll(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	if len(m.board.Columns[1].Cards) != 1 || m.selectedCol != 1 {
+		t.Errorf("expected redo to move the card to Done again and follow it, got col %d", m.selectedCol)
+	}
+	m = pressAll(m, tea.KeyMsg{Type: tea.KeyCtrlR})
+	if m.message != "Nothing to redo" {
+		t.Errorf("message = %q", m.message)
+	}
+}
+
+func TestBoardUndo_KeptAcrossBoardSwitch(t *testing.T) {
+	a, b := undoTestBoard(t), undoTestBoard(t)
+	m := NewBoardModel(a, nil, nil, nil)
+	m.SetViewStates(ViewStates{})
+	m = pressAll(m, runeKey("a"))
+	if !m.board.Columns[0].Cards[0].Archived {
+		t.Fatal("expected the card archived")
+	}
+
+	m.switchToBoard(b)
+	m = pressAll(m, runeKey("u"))
+	if m.message != "Nothing to undo" {
+		t.Errorf("expected a separate history on the other board, got %q", m.message)
+	}
+
+	reread, err := fs.ReadBoard(a.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.switchToBoard(reread)
+	m = pressAll(m, runeKey("u"))
+	if m.board.Columns[0].Cards[0].Archived || m.message != "Undid archive" {
+		t.Errorf("expected the archive undone on the reloaded board, got %q", m.message)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -338,15 +339,16 @@ func (m URLEditorModel) IsTyping() bool {
 
 // View renders the URL editor modal.
 func (m URLEditorModel) View() string {
-	var s strings.Builder
+	box := urlInputBoxStyle.Width(shared.ModalWidth(60, m.width))
+	innerWidth := box.GetWidth() - box.GetHorizontalPadding()
+	m.textInput.Width = min(50, innerWidth-2)
 
-	title := urlInputTitleStyle.Render("Edit URLs")
-	s.WriteString(title)
-	s.WriteString("\n\n")
+	header := []string{urlInputTitleStyle.Render("Edit URLs"), ""}
+	var rows, footer []string
+	cursorRow := 0
 
 	if m.mode == urlEditorSelectProject {
-		s.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render("Add to project:"))
-		s.WriteString("\n\n")
+		header = append(header, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render("Add to project:"), "")
 		for i, name := range m.projectNames {
 			prefix := "  "
 			if i == m.projectCursor {
@@ -358,11 +360,10 @@ func (m URLEditorModel) View() string {
 			} else {
 				line = listItemStyle.Render(prefix + name)
 			}
-			s.WriteString(line)
-			s.WriteString("\n")
+			rows = append(rows, line)
 		}
-		s.WriteString("\n")
-		s.WriteString(helpStyle.Render("j/k: navigate  enter: confirm  esc: cancel"))
+		cursorRow = m.projectCursor
+		footer = []string{"", helpStyle.Render("j/k: navigate  enter: confirm  esc: cancel")}
 	} else if m.mode != urlEditorNav {
 		// Show text input
 		var prompt string
@@ -376,37 +377,33 @@ func (m URLEditorModel) View() string {
 		case urlEditorEditLabel:
 			prompt = "Edit Label:"
 		}
-		s.WriteString(lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render(prompt))
-		s.WriteString("\n")
-		s.WriteString(m.textInput.View())
-		s.WriteString("\n\n")
-		s.WriteString(helpStyle.Render("enter: confirm • esc: cancel"))
+		header = append(header, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render(prompt))
+		header = append(header, m.textInput.View(), "")
+		footer = []string{helpStyle.Render("enter: confirm • esc: cancel")}
 	} else {
 		// Show URL list
 		multiProject := len(m.projectNames) > 1
 		if len(m.items) == 0 {
-			s.WriteString(cardPreviewStyle.Render("  No URLs"))
-			s.WriteString("\n")
+			rows = append(rows, cardPreviewStyle.Render("  No URLs"))
 		} else {
-			const maxWidth = 52 // usable width inside the modal box
-			lastProject := "\x00"  // sentinel so first header always shows
+			maxWidth := innerWidth - 4 // usable width inside the modal box
+			lastProject := "\x00"      // sentinel so first header always shows
 			for i, item := range m.items {
 				u := item.url
 
 				// Project header when in multi-project mode and project changes
 				if multiProject && item.projectName != lastProject {
 					if lastProject != "\x00" {
-						s.WriteString("\n")
+						rows = append(rows, "")
 					}
-					header := lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render("  " + item.projectName)
-					s.WriteString(header)
-					s.WriteString("\n")
+					rows = append(rows, lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("12")).Render("  "+item.projectName))
 					lastProject = item.projectName
 				}
 
 				prefix := "  "
 				if i == m.cursor {
 					prefix = "> "
+					cursorRow = len(rows)
 				}
 
 				var line string
@@ -415,42 +412,33 @@ func (m URLEditorModel) View() string {
 					urlStyle := lipgloss.NewStyle().Foreground(theme.TextMuted)
 					bgStyle := lipgloss.NewStyle()
 					if i == m.cursor {
-						labelStyle = labelStyle.Foreground(theme.Accent).Background(theme.Surface)
-						urlStyle = urlStyle.Foreground(theme.Warning).Background(theme.Surface)
-						bgStyle = bgStyle.Background(theme.Surface)
+						labelStyle = labelStyle.Foreground(theme.SelectionFg).Background(theme.SelectionBg)
+						urlStyle = urlStyle.Foreground(theme.SelectionFg).Background(theme.SelectionBg)
+						bgStyle = bgStyle.Background(theme.SelectionBg)
 					}
-					label := u.Label
-					urlStr := u.URL
-					remaining := maxWidth - len(prefix) - len(label) - 1
+					label := shared.Truncate(u.Label, maxWidth/2)
+					remaining := maxWidth - len(prefix) - lipgloss.Width(label) - 1
 					if remaining < 10 {
 						remaining = 10
 					}
-					if len(urlStr) > remaining {
-						urlStr = urlStr[:remaining-3] + "..."
-					}
-					line = bgStyle.Render(prefix) + labelStyle.Render(label) + bgStyle.Render(" ") + urlStyle.Render(urlStr)
+					urlStr := shared.Truncate(u.URL, remaining)
+					line = bgStyle.Render(prefix) + labelStyle.Render(label) + bgStyle.Render(" ") + shared.Hyperlink(u.URL, urlStyle.Render(urlStr))
 				} else {
 					style := listItemStyle
 					if i == m.cursor {
 						style = selectedListItemStyle
 					}
-					urlStr := u.URL
-					remaining := maxWidth - len(prefix)
-					if len(urlStr) > remaining {
-						urlStr = urlStr[:remaining-3] + "..."
-					}
-					line = style.Render(prefix + urlStr)
+					urlStr := shared.Truncate(u.URL, maxWidth-len(prefix))
+					line = style.Render(prefix + shared.Hyperlink(u.URL, urlStr))
 				}
-				s.WriteString(line)
-				s.WriteString("\n")
+				rows = append(rows, line)
 			}
 		}
-		s.WriteString("\n")
-		s.WriteString(helpStyle.Render("n:add  d:delete  e:edit url  l:edit label  enter:save  esc:cancel"))
+		footer = []string{"", helpStyle.Render("n:add  d:delete  e:edit url  l:edit label  enter:save  esc:cancel")}
 	}
 
-	content := s.String()
-	box := urlInputBoxStyle.Render(content)
-
-	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+	// Scroll the list when the whole box would not fit on screen
+	lines := append(append(header, rows...), footer...)
+	lines = shared.FitModalList(lines, len(header), len(header)+len(rows), cursorRow, box, m.height)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box.Render(strings.Join(lines, "\n")))
 }
@@ -7,6 +7,7 @@ import (
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sahilm/fuzzy"
 	"wydo/internal/kanban/models"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -123,7 +124,9 @@ func (m URLPickerModel) Update(msg tea.KeyMsg) (URLPickerModel, string, bool) {
 
 // View renders the URL picker as a centered modal.
 func (m URLPickerModel) View() string {
+	box := tagPickerBoxStyle.Width(shared.ModalWidth(60, m.width))
 	var lines []string
+	listStart, listEnd := 0, 0
 
 	lines = append(lines, tagPickerTitleStyle.Render("Open URL"))
 	lines = append(lines, "")
@@ -139,7 +142,8 @@ func (m URLPickerModel) View() string {
 	} else if len(m.filtered) == 0 {
 		lines = append(lines, cardPreviewStyle.Render("No matching URLs"))
 	} else {
-		const maxWidth = 52
+		maxWidth := box.GetWidth() - box.GetHorizontalPadding() - 4
+		listStart = len(lines)
 		for i, idx := range m.filtered {
 			prefix := "  "
 			if i == m.cursor {
@@ -153,33 +157,28 @@ func (m URLPickerModel) View() string {
 				urlStyle := lipgloss.NewStyle().Foreground(theme.TextMuted)
 				bgStyle := lipgloss.NewStyle()
 				if i == m.cursor {
-					labelStyle = labelStyle.Foreground(theme.Accent).Background(theme.Surface)
-					urlStyle = urlStyle.Foreground(theme.Warning).Background(theme.Surface)
-					bgStyle = bgStyle.Background(theme.Surface)
+					labelStyle = labelStyle.Foreground(theme.SelectionFg).Background(theme.SelectionBg)
+					urlStyle = urlStyle.Foreground(theme.SelectionFg).Background(theme.SelectionBg)
+					bgStyle = bgStyle.Background(theme.SelectionBg)
 				}
-				urlStr := u.URL
-				remaining := maxWidth - len(prefix) - len(u.Label) - 1
+				label := shared.Truncate(u.Label, maxWidth/2)
+				remaining := maxWidth - len(prefix) - lipgloss.Width(label) - 1
 				if remaining < 10 {
 					remaining = 10
 				}
-				if len(urlStr) > remaining {
-					urlStr = urlStr[:remaining-3] + "..."
-				}
-				line = bgStyle.Render(prefix) + labelStyle.Render(u.Label) + bgStyle.Render(" ") + urlStyle.Render(urlStr)
+				urlStr := shared.Truncate(u.URL, remaining)
+				line = bgStyle.Render(prefix) + labelStyle.Render(label) + bgStyle.Render(" ") + shared.Hyperlink(u.URL, urlStyle.Render(urlStr))
 			} else {
 				style := listItemStyle
 				if i == m.cursor {
 					style = selectedListItemStyle
 				}
-				urlStr := u.URL
-				remaining := maxWidth - len(prefix)
-				if len(urlStr) > remaining {
-					urlStr = urlStr[:remaining-3] + "..."
-				}
-				line = style.Render(prefix + urlStr)
+				urlStr := shared.Truncate(u.URL, maxWidth-len(prefix))
+				line = style.Render(prefix + shared.Hyperlink(u.URL, urlStr))
 			}
 			lines = append(lines, line)
 		}
+		listEnd = len(lines)
 	}
 
 	lines = append(lines, "")
@@ -190,8 +189,9 @@ func (m URLPickerModel) View() string {
 		help = "j/k: navigate  /: search  enter: open  esc: cancel"
 	}
 	lines = append(lines, helpStyle.Render(help))
+	lines = shared.FitModalList(lines, listStart, listEnd, m.cursor, box, m.height)
 
 	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
-	boxed := tagPickerBoxStyle.Width(60).Render(content)
+	boxed := box.Render(content)
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, boxed)
 }
@@ -0,0 +1,44 @@
+package kanban
+
+import (
+	"testing"
+	"time"
+
+	"wydo/internal/golden"
+	"wydo/internal/kanban/models"
+)
+
+func TestBoardView_Golden(t *testing.T) {
+	golden.FixClock(t)
+	day := func(offset int) *time.Time {
+		d := golden.Now.AddDate(0, 0, offset)
+		return &d
+	}
+	board := models.Board{Name: "Platform", Path: "/ws/boards/platform", Columns: []models.Column{
+		{Name: "To Do", Cards: []models.Card{
+			{Filename: "deploy.md", Title: "Deploy API", Tags: []string{"ops"}, DueDate: day(3), Priority: 1},
+			{Filename: "docs.md", Title: "Write the migration guide", Projects: []string{"alpha"}},
+			{Filename: "late.md", Title: "Renew certificates", DueDate: day(-2)},
+		}},
+		{Name: "In Progress", Cards: []models.Card{
+			{Filename: "cache.md", Title: "Cache warmup", Blocked: "waiting on infra"},
+		}},
+		{Name: "Done", Cards: []models.Card{
+			{Filename: "ci.md", Title: "Fix CI", DateCompleted: day(-1)},
+		}},
+	}}
+
+	for _, size := range []struct {
+		name          string
+		width, height int
+	}{
+		{"board_80x24", 80, 24},
+		{"board_120x30", 120, 30},
+	} {
+		t.Run(size.name, func(t *testing.T) {
+			m := NewBoardModel(board, nil, nil, nil)
+			m.SetSize(size.width, size.height)
+			golden.Assert(t, size.name, m.View())
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package kanban
+
+import (
+	"slices"
+
+	"wydo/internal/kanban/operations"
+)
+
+// ViewState is where the user was on a board: the selected card, each
+// column's cursor and scroll position, the horizontal scroll and the filter.
+// It also carries the board's undo history.
+type ViewState struct {
+	Col, Card        int
+	ColumnCursors    []int
+	ColumnScrolls    []int
+	HorizontalOffset int
+	Filter           string
+	History          *operations.History
+}
+
+// ViewStates holds a ViewState per board path. The app keeps one for the
+// session, so a board left for another view or board is returned to in the
+// same place.
+type ViewStates map[string]ViewState
+
+// ViewState returns where the user is on the board.
+func (m BoardModel) ViewState() ViewState {
+	return ViewState{
+		Col:              m.selectedCol,
+		Card:             m.selectedCard,
+		ColumnCursors:    slices.Clone(m.columnCursorPos),
+		ColumnScrolls:    slices.Clone(m.columnScrollOffsets),
+		HorizontalOffset: m.columnHorizontalOffset,
+		Filter:           m.filterQuery,
+		History:          m.history,
+	}
+}
+
+// RestoreViewState returns to a ViewState saved for the board, clamped to
+// the columns and cards it has now.
+func (m *BoardModel) RestoreViewState(s ViewState) {
+	if s.History != nil {
+		m.history = s.History
+	}
+	if len(m.board.Columns) == 0 {
+		return
+	}
+	m.filterQuery = s.Filter
+	m.filterActive = s.Filter != ""
+	m.filteredIndices = nil
+	m.columnCursorPos = slices.Clone(s.ColumnCursors)
+	m.columnScrollOffsets = slices.Clone(s.ColumnScrolls)
+	m.columnHorizontalOffset = s.HorizontalOffset
+	m.selectedCol = min(max(s.Col, 0), len(m.board.Columns)-1)
+	m.selectedCard = max(s.Card, 0)
+	m.reloadBoardState()
+	m.adjustScrollPosition()
+}
+
+// SetViewStates shares the app's per-board view states, which switching
+// boards in place (ctrl+b) saves to and restores from. The board picks up
+// the undo history saved for it, even when it opens on a different card.
+func (m *BoardModel) SetViewStates(states ViewStates) {
+	m.viewStates = states
+	if s, ok := states[m.board.Path]; ok && s.History != nil {
+		m.history = s.History
+	}
+}
+
+// saveViewState records where the user is on the board being left.
+func (m *BoardModel) saveViewState() {
+	if m.viewStates != nil && m.board.Path != "" {
+		m.viewStates[m.board.Path] = m.ViewState()
+	}
+}
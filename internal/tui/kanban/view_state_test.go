@@ -0,0 +1,56 @@
+package kanban
+
+import (
+	"testing"
+
+	"wydo/internal/kanban/models"
+)
+
+func viewStateBoard(path string, cards ...int) models.Board {
+	board := models.Board{Name: path, Path: path}
+	for i, n := range cards {
+		col := models.Column{Name: string(rune('A' + i))}
+		for j := 0; j < n; j++ {
+			col.Cards = append(col.Cards, models.Card{Filename: string(rune('a'+j)) + ".md", Title: string(rune('a' + j))})
+		}
+		board.Columns = append(board.Columns, col)
+	}
+	return board
+}
+
+func TestViewState_RoundTrip(t *testing.T) {
+	m := NewBoardModel(viewStateBoard("/ws/boards/a", 3, 4), nil, nil, nil)
+	m.selectedCol, m.selectedCard = 1, 2
+	m.columnCursorPos = []int{1, 2}
+
+	saved := m.ViewState()
+	fresh := NewBoardModel(viewStateBoard("/ws/boards/a", 3, 4), nil, nil, nil)
+	fresh.RestoreViewState(saved)
+	if fresh.selectedCol != 1 || fresh.selectedCard != 2 {
+		t.Errorf("selection = %d/%d, want 1/2", fresh.selectedCol, fresh.selectedCard)
+	}
+}
+
+func TestViewState_ClampsToShrunkBoard(t *testing.T) {
+	m := NewBoardModel(viewStateBoard("/ws/boards/a", 1), nil, nil, nil)
+	m.RestoreViewState(ViewState{Col: 3, Card: 9, ColumnCursors: []int{0, 0, 0, 9}})
+	if m.selectedCol != 0 || m.selectedCard != 0 {
+		t.Errorf("selection = %d/%d, want clamped to 0/0", m.selectedCol, m.selectedCard)
+	}
+}
+
+func TestViewState_SwitchBoardsRemembersEach(t *testing.T) {
+	a, b := viewStateBoard("/ws/boards/a", 3, 4), viewStateBoard("/ws/boards/b", 2)
+	m := NewBoardModel(a, nil, nil, nil)
+	m.SetViewStates(ViewStates{})
+	m.selectedCol, m.selectedCard = 1, 3
+
+	m.switchToBoard(b)
+	if m.selectedCol != 0 || m.selectedCard != 0 {
+		t.Fatalf("new board opened at %d/%d, want 0/0", m.selectedCol, m.selectedCard)
+	}
+	m.switchToBoard(a)
+	if m.selectedCol != 1 || m.selectedCard != 3 {
+		t.Errorf("returned to %d/%d, want 1/3", m.selectedCol, m.selectedCard)
+	}
+}
@@ -0,0 +1,42 @@
+package tui
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/logs"
+	"wydo/internal/tui/theme"
+)
+
+// renderLogOverlay shows the latest log lines, as many as fit, for
+// diagnosing a problem without leaving the TUI. --debug adds debug lines.
+func (m AppModel) renderLogOverlay() string {
+	width := max(m.width-8, 20)
+	rows := max(m.height-8, 3)
+
+	var b strings.Builder
+	b.WriteString(theme.ModalTitle.Render("Log") + "\n\n")
+	lines := logs.Recent(rows)
+	if len(lines) == 0 {
+		b.WriteString(theme.Muted.Render("Nothing logged yet. Start wydo with --debug for more detail.") + "\n")
+	}
+	for _, line := range lines {
+		line = strings.ReplaceAll(line, "\n", " ")
+		if len([]rune(line)) > width {
+			line = string([]rune(line)[:width-1]) + "…"
+		}
+		switch {
+		case strings.Contains(line, " ERROR "):
+			line = theme.Error.Render(line)
+		case strings.Contains(line, " WARN "):
+			line = theme.Warn.Render(line)
+		case strings.Contains(line, " DEBUG "):
+			line = theme.Muted.Render(line)
+		}
+		b.WriteString(line + "\n")
+	}
+	b.WriteString("\n" + theme.Muted.Render("Press any key to close"))
+
+	box := theme.ModalBox.Width(width + 4).Render(b.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
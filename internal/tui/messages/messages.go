@@ -1,7 +1,10 @@
 package messages
 
 import (
+	"time"
+
 	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tasks/data"
 	"wydo/internal/workspace"
 )
 
@@ -12,12 +15,14 @@ const (
 	ViewAgendaDay ViewType = iota
 	ViewAgendaWeek
 	ViewAgendaMonth
+	ViewAgendaYear
 	ViewKanbanPicker
 	ViewKanbanBoard
 	ViewTaskManager
 	ViewProjects
 	ViewProjectDetail
 	ViewNotes
+	ViewGoals
 )
 
 // SwitchViewMsg is sent by child views to switch to a different view
@@ -25,11 +30,86 @@ type SwitchViewMsg struct {
 	View ViewType
 }
 
-// OpenBoardMsg requests opening a specific board at a specific position
+// OpenBoardMsg requests opening a specific board, at a specific card when
+// HasTarget or CardFile is set
 type OpenBoardMsg struct {
 	BoardPath string
+	HasTarget bool // select the card at ColIndex/CardIndex
 	ColIndex  int
 	CardIndex int
+	CardFile  string // select the card with this filename, wherever it is now
+	Filter    string // preset board filter ("" = none)
+}
+
+// BoardSwitchedMsg is sent by the board view after it swaps the open board in place
+type BoardSwitchedMsg struct {
+	BoardPath string
+}
+
+// BoardRenamedMsg is sent after a board's directory moved from OldPath to NewPath
+type BoardRenamedMsg struct {
+	OldPath string
+	NewPath string
+}
+
+// BoardDeletedMsg is sent after a board was moved to the trash, or when the
+// board view is left after its directory went missing
+type BoardDeletedMsg struct {
+	Path string
+}
+
+// BoardMissingMsg is sent when the open board's directory is found gone,
+// e.g. deleted by a sync tool; the board view stays on its missing screen
+type BoardMissingMsg struct {
+	Path string
+}
+
+// CardRegister is a card taken with y on a board, to be put into a column of
+// any board with P. It outlives the board view, so the card can go to a
+// board opened later.
+type CardRegister struct {
+	BoardPath string
+	Filename  string
+	Title     string
+	Projects  []string // the source board's projects, linked when put within its workspace
+}
+
+// CardRegisterMsg is sent when the board register changes; Register is nil
+// once it is emptied.
+type CardRegisterMsg struct {
+	Register *CardRegister
+}
+
+// MoveCardToTasksMsg requests converting a card to a task and deleting the card
+type MoveCardToTasksMsg struct {
+	BoardPath string
+	Filename  string
+}
+
+// MoveTaskToBoardMsg requests turning a task into a card on the board at
+// BoardPath and deleting the task
+type MoveTaskToBoardMsg struct {
+	Task      data.Task
+	BoardPath string
+}
+
+// MoveCardToBoardMsg requests moving the card at ColIndex/CardIndex of the
+// board at BoardPath to the board at TargetPath
+type MoveCardToBoardMsg struct {
+	BoardPath  string
+	ColIndex   int
+	CardIndex  int
+	TargetPath string
+}
+
+// CaptureTaskMsg requests adding Line to the first todo.txt
+type CaptureTaskMsg struct {
+	Line string
+}
+
+// GotoDateMsg requests moving the agenda views to a specific date
+type GotoDateMsg struct {
+	Date time.Time
 }
 
 // FocusTaskMsg requests focusing on a specific task in the task manager
@@ -37,12 +117,26 @@ type FocusTaskMsg struct {
 	TaskID string
 }
 
+// ToggleMyDayMsg requests adding the item with Key to today's My Day list,
+// or removing it when it is already there
+type ToggleMyDayMsg struct {
+	Key string
+}
+
 // OpenProjectMsg requests opening a specific project detail view
 type OpenProjectMsg struct {
 	ProjectName     string
 	WorkspaceRootDir string
 }
 
+// RenameProjectMsg requests renaming a project in one workspace, after which
+// its detail view is reopened under the new name
+type RenameProjectMsg struct {
+	WorkspaceRootDir string
+	OldName          string
+	NewName          string
+}
+
 // DataRefreshMsg signals that data should be reloaded
 type DataRefreshMsg struct{}
 
@@ -53,6 +147,12 @@ type CreateSubProjectMsg struct {
 	WsDir         string
 }
 
+// OpenJournalMsg requests opening a day's journal note in $EDITOR, creating
+// it first if needed
+type OpenJournalMsg struct {
+	Day time.Time // zero for today
+}
+
 // RequestExitMsg is sent by child views when the user wants to quit
 type RequestExitMsg struct{}
 
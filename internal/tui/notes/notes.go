@@ -9,6 +9,7 @@ import (
 
 	notespkg "wydo/internal/notes"
 	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
 	"wydo/internal/workspace"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -67,6 +68,7 @@ type NotesModel struct {
 type flatEntry struct {
 	wsRoot string
 	note   notespkg.PinnedNote
+	tags   string // "#a #b" from the note's frontmatter, if it is a parsed note
 }
 
 // editorFinishedMsg is sent when the editor process exits.
@@ -100,8 +102,15 @@ func (m *NotesModel) SetData(workspaces []*workspace.Workspace) {
 	for _, ws := range workspaces {
 		pinned, _ := notespkg.ReadPinnedNotes(ws.RootDir)
 		m.entries = append(m.entries, wsEntry{ws: ws, notes: pinned})
+
+		tagsByPath := make(map[string]string)
+		for _, n := range ws.Notes {
+			if tags := n.TagLabel(); tags != "" {
+				tagsByPath[n.FilePath] = tags
+			}
+		}
 		for _, n := range pinned {
-			m.flat = append(m.flat, flatEntry{wsRoot: ws.RootDir, note: n})
+			m.flat = append(m.flat, flatEntry{wsRoot: ws.RootDir, note: n, tags: tagsByPath[n.AbsPath]})
 		}
 	}
 
@@ -127,7 +136,7 @@ func (m NotesModel) HintText() string {
 	case modeConfirmUnpin:
 		return "y:confirm  n/esc:cancel"
 	default:
-		return "j/k:navigate  enter:open  p:pin  d:unpin  ?:help  q:quit"
+		return "j/k:navigate  enter:open  t:journal  p:pin  d:unpin  ?:help  q:quit"
 	}
 }
 
@@ -185,6 +194,8 @@ func (m NotesModel) updateList(msg tea.KeyMsg) (NotesModel, tea.Cmd) {
 		}
 	case "p":
 		return m.startPin()
+	case "t":
+		return m, func() tea.Msg { return messages.OpenJournalMsg{} }
 	}
 	return m, nil
 }
@@ -401,7 +412,10 @@ func (m NotesModel) viewList() string {
 			prefix = "► "
 		}
 		label := entry.note.Label
-		path := pathStyle.Render("  " + entry.note.RelPath)
+		path := "  " + shared.FileLink(entry.note.AbsPath, pathStyle.Render(entry.note.RelPath))
+		if entry.tags != "" {
+			path += tagStyle.Render("  " + entry.tags)
+		}
 		lines = append(lines, style.Render(prefix+label)+path)
 	}
 
@@ -12,13 +12,12 @@ var (
 			Foreground(theme.Text).
 			Padding(0, 2)
 
-	selectedListItemStyle = lipgloss.NewStyle().
-				Foreground(theme.Warning).
-				Bold(true).
-				Padding(0, 2)
+	selectedListItemStyle = theme.Selected.Padding(0, 2)
 
 	pathStyle = theme.Muted
 
+	tagStyle = theme.Tag
+
 	sectionHeaderStyle = lipgloss.NewStyle().
 				Foreground(theme.Accent).
 				Bold(true)
@@ -2,11 +2,11 @@ package projects
 
 import (
 	"strings"
-	"time"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/clock"
 	"wydo/internal/tui/shared"
 	"wydo/internal/workspace"
 )
@@ -176,7 +176,7 @@ func (m DateEditorModel) updateEditLabel(msg tea.KeyMsg) (DateEditorModel, tea.C
 			}
 			m.pendingProject = rootName
 			m.mode = dateEditorPickDate
-			now := time.Now()
+			now := clock.Now()
 			dp := shared.NewDatePickerModel(&now, "Pick Date")
 			dp.SetSize(m.width, m.height)
 			m.datePicker = &dp
@@ -211,7 +211,7 @@ func (m DateEditorModel) updateSelectProject(msg tea.KeyMsg) (DateEditorModel, t
 	case "enter":
 		m.pendingProject = m.projectNames[m.projectCursor]
 		m.mode = dateEditorPickDate
-		now := time.Now()
+		now := clock.Now()
 		dp := shared.NewDatePickerModel(&now, "Pick Date")
 		dp.SetSize(m.width, m.height)
 		m.datePicker = &dp
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	xansi "github.com/charmbracelet/x/ansi"
+	"wydo/internal/clock"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/kanban/operations"
 	"wydo/internal/notes"
@@ -70,6 +71,8 @@ const (
 	detailModeNewTaskEditor             // task editor modal
 	detailModeNewBoardPick              // board selector for new card
 	detailModeChildPicker               // picking a child project to open
+	detailModeRenameName                // text input for the new project name
+	detailModeRenamePreview             // confirming a rename after seeing its counts
 )
 
 // DetailModel shows project details with notes, tasks, and cards in a
@@ -94,6 +97,7 @@ type DetailModel struct {
 	allNotes  []notes.Note
 	cardBoard   map[string]kanbanmodels.Board  // card filename → parent board
 	cardColumn  map[string]string              // card filename → column name
+	taskNotes   map[string]data.Annotation     // task ID → latest annotation
 
 	// Column state
 	columns        [colCount][]detailRow
@@ -120,6 +124,11 @@ type DetailModel struct {
 	createBoardPicker      *kanban.BoardSelectorModel
 	pendingProject         *workspace.Project
 
+	// Rename flow state
+	renameInput   *taskview.TextInputModel
+	renameTo      string
+	renamePreview *workspace.RenamePreview
+
 	// Data for task editor
 	allProjectItems []kanban.ProjectPickerItem
 	allContexts     []string
@@ -186,6 +195,7 @@ func (p detailChildPicker) View() string {
 	lines = append(lines, titleStyle.Render("Switch to Child Project"))
 	lines = append(lines, "")
 
+	listStart := len(lines)
 	if len(p.entries) == 0 {
 		lines = append(lines, pathStyle.Render("No children"))
 	} else {
@@ -200,12 +210,13 @@ func (p detailChildPicker) View() string {
 		}
 	}
 
-	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
-	box := lipgloss.NewStyle().
+	boxStyle := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("4")).
-		Padding(1, 2).
-		Render(content)
+		Padding(1, 2)
+	lines = shared.FitModalList(lines, listStart, len(lines), p.cursor, boxStyle, p.height)
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := boxStyle.Render(content)
 	return lipgloss.Place(p.width, p.height, lipgloss.Center, lipgloss.Center, box)
 }
 
@@ -231,59 +242,56 @@ func (p projectURLPicker) Update(msg tea.KeyMsg) (projectURLPicker, string, bool
 }
 
 func (p projectURLPicker) View() string {
-	var s strings.Builder
-
-	s.WriteString(titleStyle.Render("Open URL"))
-	s.WriteString("\n\n")
+	lines := []string{titleStyle.Render("Open URL"), ""}
+	listStart := len(lines)
+	cursorRow := 0
 
 	if len(p.entries) == 0 {
-		s.WriteString(pathStyle.Render("No URLs"))
-		s.WriteString("\n")
+		lines = append(lines, pathStyle.Render("No URLs"))
 	} else {
 		lastProject := ""
 		for i, e := range p.entries {
 			if e.projectName != lastProject {
 				if lastProject != "" {
-					s.WriteString("\n")
+					lines = append(lines, "")
 				}
-				s.WriteString(sectionHeaderStyle.Render(e.projectName))
-				s.WriteString("\n")
+				lines = append(lines, sectionHeaderStyle.Render(e.projectName))
 				lastProject = e.projectName
 			}
 			prefix := "  "
 			if i == p.cursor {
 				prefix = "> "
+				cursorRow = len(lines) - listStart
 			}
 			u := e.url
 			var line string
 			if u.Label != "" {
 				if i == p.cursor {
-					line = selectedDetailItemStyle.Render(prefix+u.Label) + pathStyle.Render("  "+u.URL)
+					line = selectedDetailItemStyle.Render(prefix+u.Label) + pathStyle.Render("  "+shared.Hyperlink(u.URL, u.URL))
 				} else {
-					line = detailItemStyle.Render(prefix+u.Label) + pathStyle.Render("  "+u.URL)
+					line = detailItemStyle.Render(prefix+u.Label) + pathStyle.Render("  "+shared.Hyperlink(u.URL, u.URL))
 				}
 			} else {
 				if i == p.cursor {
-					line = selectedDetailItemStyle.Render(prefix + u.URL)
+					line = selectedDetailItemStyle.Render(prefix + shared.Hyperlink(u.URL, u.URL))
 				} else {
-					line = pathStyle.Render(prefix + u.URL)
+					line = pathStyle.Render(prefix + shared.Hyperlink(u.URL, u.URL))
 				}
 			}
-			s.WriteString(line)
-			s.WriteString("\n")
+			lines = append(lines, line)
 		}
 	}
+	listEnd := len(lines)
 
-	s.WriteString("\n")
-	s.WriteString(pathStyle.Render("j/k: navigate  enter: open  esc: cancel"))
+	lines = append(lines, "")
+	lines = append(lines, pathStyle.Render("j/k: navigate  enter: open  esc: cancel"))
 
 	box := lipgloss.NewStyle().
 		Border(lipgloss.RoundedBorder()).
 		BorderForeground(lipgloss.Color("4")).
-		Padding(1, 2).
-		Render(s.String())
-
-	return lipgloss.Place(p.width, p.height, lipgloss.Center, lipgloss.Center, box)
+		Padding(1, 2)
+	lines = shared.FitModalList(lines, listStart, listEnd, cursorRow, box, p.height)
+	return lipgloss.Place(p.width, p.height, lipgloss.Center, lipgloss.Center, box.Render(strings.Join(lines, "\n")))
 }
 
 func NewDetailModel(name, wsDir string, n []notes.Note, tasks []data.Task, cards []kanbanmodels.Card, boards []kanbanmodels.Board, allBoards []kanbanmodels.Board, project *workspace.Project, registry *workspace.ProjectRegistry, children []*workspace.Project, indexPreview string, allTasks []data.Task, allNotes []notes.Note, allProjectItems []kanban.ProjectPickerItem, allContexts []string) DetailModel {
@@ -336,10 +344,36 @@ func NewDetailModel(name, wsDir string, n []notes.Note, tasks []data.Task, cards
 		m.projectCards[name] = cards
 	}
 
+	m.taskNotes = latestAnnotations(m.projectTasks)
 	m.rebuildAllColumns()
 	return m
 }
 
+// latestAnnotations loads the sidecar annotations for every listed task and
+// returns the most recent one per task ID. Each tasks/ directory is read once.
+func latestAnnotations(byProject map[string][]data.Task) map[string]data.Annotation {
+	result := make(map[string]data.Annotation)
+	loaded := make(map[string]map[string][]data.Annotation)
+	for _, tasks := range byProject {
+		for _, t := range tasks {
+			key := t.GetAnnotationKey()
+			if key == "" || t.File == "" {
+				continue
+			}
+			dir := filepath.Dir(t.File)
+			anns, ok := loaded[dir]
+			if !ok {
+				anns, _ = data.LoadAnnotations(dir)
+				loaded[dir] = anns
+			}
+			if list := anns[key]; len(list) > 0 {
+				result[t.ID] = list[len(list)-1]
+			}
+		}
+	}
+	return result
+}
+
 // prependIndexNote prepends the project's index file (name.md) to the front of the
 // notes slice if the file exists, so it always appears first in the Notes column.
 func prependIndexNote(proj *workspace.Project, rest []notes.Note) []notes.Note {
@@ -544,7 +578,7 @@ func (m DetailModel) IsTyping() bool {
 	if m.mode == detailModeDateEditor && m.dateEditor != nil && m.dateEditor.IsTyping() {
 		return true
 	}
-	if m.mode == detailModeNewNoteName || m.mode == detailModeNewTaskName {
+	if m.mode == detailModeNewNoteName || m.mode == detailModeNewTaskName || m.mode == detailModeRenameName {
 		return true
 	}
 	return false
@@ -561,8 +595,10 @@ func (m DetailModel) HintText() string {
 		return "n:add  d:delete  e:edit label  D:edit date  enter:save  esc:cancel"
 	case detailModeSubProjectPick:
 		return "j/k:navigate  enter:select  esc:cancel"
-	case detailModeNewNoteName, detailModeNewTaskName:
+	case detailModeNewNoteName, detailModeNewTaskName, detailModeRenameName:
 		return "enter:confirm  esc:cancel"
+	case detailModeRenamePreview:
+		return "y/enter:rename  n/esc:cancel"
 	case detailModeNewTaskEditor:
 		return "enter:save  esc:cancel"
 	case detailModeNewBoardPick:
@@ -570,7 +606,7 @@ func (m DetailModel) HintText() string {
 	case detailModeChildPicker:
 		return "j/k:navigate  enter:open  esc:cancel"
 	}
-	return "h/l:columns  j/k:navigate  space/enter:expand  enter:open  n:new  u:urls  d:dates  [:parent  ]:children  esc:back"
+	return "h/l:columns  j/k:navigate  space/enter:expand  enter:open  n:new  r:rename  u:urls  d:dates  [:parent  ]:children  esc:back"
 }
 
 func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
@@ -595,6 +631,10 @@ func (m DetailModel) Update(msg tea.Msg) (DetailModel, tea.Cmd) {
 			return m.updateNewBoardPick(msg)
 		case detailModeChildPicker:
 			return m.updateChildPicker(msg)
+		case detailModeRenameName:
+			return m.updateRenameInput(msg)
+		case detailModeRenamePreview:
+			return m.updateRenamePreview(msg)
 		}
 		return m.handleKey(msg)
 	case noteEditorFinishedMsg:
@@ -614,6 +654,9 @@ func (m DetailModel) handleKey(msg tea.KeyMsg) (DetailModel, tea.Cmd) {
 	case "n":
 		return m.handleNew()
 
+	case "r":
+		return m.startRename()
+
 	case "esc", "q":
 		return m, messages.SwitchView(messages.ViewProjects)
 
@@ -911,6 +954,12 @@ func (m DetailModel) View() string {
 	if m.mode == detailModeChildPicker && m.childPicker != nil {
 		return m.childPicker.View()
 	}
+	if m.mode == detailModeRenameName && m.renameInput != nil {
+		return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, m.renameInput.View())
+	}
+	if m.mode == detailModeRenamePreview && m.renamePreview != nil {
+		return m.viewRenamePreview()
+	}
 
 	var lines []string
 
@@ -940,9 +989,9 @@ func (m DetailModel) View() string {
 				urlStr = urlStr[:maxURLLen-3] + "..."
 			}
 			if u.Label == "" {
-				urlLines = append(urlLines, pathStyle.Render("    "+urlStr))
+				urlLines = append(urlLines, pathStyle.Render("    "+shared.Hyperlink(u.URL, urlStr)))
 			} else {
-				urlLines = append(urlLines, urlLabelStyle.Render("    "+u.Label)+pathStyle.Render("  "+urlStr))
+				urlLines = append(urlLines, urlLabelStyle.Render("    "+u.Label)+pathStyle.Render("  "+shared.Hyperlink(u.URL, urlStr)))
 			}
 		}
 	}
@@ -950,7 +999,7 @@ func (m DetailModel) View() string {
 	// Build date lines (right half of header row)
 	var dateLines []string
 	if allDates := m.collectAllDates(); len(allDates) > 0 {
-		now := time.Now()
+		now := clock.Now()
 		today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
 		lastProject := ""
 		for _, e := range allDates {
@@ -1075,7 +1124,7 @@ func (m DetailModel) renderColumn(colIdx int, fixedHeight int, colWidth int) str
 			end = len(rows)
 		}
 		for i := scrollOff; i < end; i++ {
-			s.WriteString(m.renderRow(rows[i], i == cursor && focused, col, colWidth))
+			s.WriteString(m.renderRow(rows[i], i == cursor && focused, i == cursor && !focused, col, colWidth))
 			s.WriteString("\n")
 		}
 	}
@@ -1093,11 +1142,19 @@ func (m DetailModel) renderColumn(colIdx int, fixedHeight int, colWidth int) str
 	return lipgloss.NewStyle().Width(colWidth).Height(fixedHeight).Render(s.String())
 }
 
-func (m DetailModel) renderRow(row detailRow, isSelected bool, col colKind, colWidth int) string {
+// renderRow renders one row of a column. isSelected marks the cursor row of
+// the focused column, hovered the cursor row of the others.
+func (m DetailModel) renderRow(row detailRow, isSelected, hovered bool, col colKind, colWidth int) string {
 	prefix := "  "
 	if isSelected {
 		prefix = "► "
 	}
+	itemStyle := colItemStyle
+	if isSelected {
+		itemStyle = colItemSelectedStyle
+	} else if hovered {
+		itemStyle = colItemHoverStyle
+	}
 	indent := strings.Repeat("  ", row.depth)
 
 	var rendered string
@@ -1120,6 +1177,8 @@ func (m DetailModel) renderRow(row detailRow, isSelected bool, col colKind, colW
 		content := fmt.Sprintf("%s%s%s %s (%s)", indent, prefix, marker, row.projectName, countStr)
 		if isSelected {
 			rendered = colItemSelectedStyle.Render(content)
+		} else if hovered {
+			rendered = childProjectHoverStyle.Render(content)
 		} else {
 			rendered = childProjectStyle.Render(content)
 		}
@@ -1129,19 +1188,17 @@ func (m DetailModel) renderRow(row detailRow, isSelected bool, col colKind, colW
 		if display == "" {
 			display = filepath.Base(row.note.FilePath)
 		}
-		if isSelected {
-			rendered = colItemSelectedStyle.Render(prefix + display)
-		} else {
-			rendered = colItemStyle.Render(prefix + display)
+		if tags := row.note.TagLabel(); tags != "" {
+			display += "  " + tags
 		}
+		rendered = itemStyle.Render(prefix + display)
 
 	case rowKindTask:
 		taskLine := shared.StyledTaskLine(row.task)
-		if isSelected {
-			rendered = colItemSelectedStyle.Render(prefix) + taskLine
-		} else {
-			rendered = colItemStyle.Render(prefix) + taskLine
+		if ann, ok := m.taskNotes[row.task.ID]; ok {
+			taskLine += annotationStyle.Render(" ✎ " + ann.Text)
 		}
+		rendered = itemStyle.Render(prefix) + taskLine
 
 	case rowKindCard:
 		title := row.card.Title
@@ -1158,31 +1215,20 @@ func (m DetailModel) renderRow(row detailRow, isSelected bool, col colKind, colW
 			if jiraKey != "" {
 				jiraStr = " " + jiraKey
 			}
-			rightWidth := len(jiraStr) + len(statusStr)
-			prefixWidth := len(prefix)
+			rightWidth := lipgloss.Width(jiraStr) + lipgloss.Width(statusStr)
+			prefixWidth := lipgloss.Width(prefix)
 			maxTitleWidth := colWidth - prefixWidth - rightWidth
 			if maxTitleWidth < 1 {
 				maxTitleWidth = 1
 			}
 			// Truncate title if it won't fit.
-			if len(title) > maxTitleWidth {
-				if maxTitleWidth > 3 {
-					title = title[:maxTitleWidth-3] + "..."
-				} else {
-					title = title[:maxTitleWidth]
-				}
-			}
+			title = shared.Truncate(title, maxTitleWidth)
 			// Padding between title and right-aligned jira+status.
-			padding := maxTitleWidth - len(title)
+			padding := maxTitleWidth - lipgloss.Width(title)
 			if padding < 0 {
 				padding = 0
 			}
-			var titlePart string
-			if isSelected {
-				titlePart = colItemSelectedStyle.Render(prefix + title)
-			} else {
-				titlePart = colItemStyle.Render(prefix + title)
-			}
+			titlePart := itemStyle.Render(prefix + title)
 			var statusColor lipgloss.Color
 			if isDone {
 				statusColor = lipgloss.Color("2")
@@ -1203,19 +1249,13 @@ func (m DetailModel) renderRow(row detailRow, isSelected bool, col colKind, colW
 			if jiraKey != "" {
 				jiraStr = " " + jiraKey
 			}
-			prefixWidth := len(prefix)
-			maxTitleWidth := colWidth - prefixWidth - len(jiraStr)
+			prefixWidth := lipgloss.Width(prefix)
+			maxTitleWidth := colWidth - prefixWidth - lipgloss.Width(jiraStr)
 			if maxTitleWidth < 1 {
 				maxTitleWidth = 1
 			}
-			if len(title) > maxTitleWidth && maxTitleWidth > 3 {
-				title = title[:maxTitleWidth-3] + "..."
-			}
-			if isSelected {
-				rendered = colItemSelectedStyle.Render(prefix + title)
-			} else {
-				rendered = colItemStyle.Render(prefix + title)
-			}
+			title = shared.Truncate(title, maxTitleWidth)
+			rendered = itemStyle.Render(prefix + title)
 			if jiraStr != "" {
 				rendered += lipgloss.NewStyle().Foreground(lipgloss.Color("69")).Render(jiraStr)
 			}
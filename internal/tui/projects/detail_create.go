@@ -2,10 +2,10 @@ package projects
 
 import (
 	"os"
-	"os/exec"
 	"path/filepath"
 	"strings"
 
+	"wydo/internal/config"
 	"wydo/internal/kanban/fs"
 	"wydo/internal/kanban/operations"
 	"wydo/internal/tasks/data"
@@ -190,6 +190,7 @@ func (m DetailModel) handleTextInputResult(msg taskview.TextInputResultMsg) (Det
 	if msg.Cancelled {
 		m.createNoteInput = nil
 		m.createTaskInput = nil
+		m.renameInput = nil
 		m.mode = detailModeNormal
 		return m, nil
 	}
@@ -198,6 +199,8 @@ func (m DetailModel) handleTextInputResult(msg taskview.TextInputResultMsg) (Det
 		return m.finishNoteCreation(msg.Value)
 	case detailModeNewTaskName:
 		return m.finishTaskNameEntry(msg.Value)
+	case detailModeRenameName:
+		return m.finishRenameName(msg.Value)
 	}
 	m.mode = detailModeNormal
 	return m, nil
@@ -245,14 +248,16 @@ func (m DetailModel) finishTaskNameEntry(name string) (DetailModel, tea.Cmd) {
 	}
 
 	projectName := ""
+	contexts := []string{}
 	if m.pendingProject != nil {
 		projectName = m.pendingProject.Name
+		contexts = append(contexts, m.pendingProject.DefaultContexts...)
 	}
 
 	task := &data.Task{
 		Name:     strings.TrimSpace(name),
 		Projects: []string{projectName},
-		Contexts: []string{},
+		Contexts: contexts,
 		Tags:     make(map[string]string),
 	}
 
@@ -286,7 +291,8 @@ func (m DetailModel) handleTaskEditorResult(msg taskview.TaskEditorResultMsg) (D
 	}
 
 	task := msg.Task
-	updateCmd := func() tea.Msg { return taskview.TaskUpdateMsg{Task: task} }
+	annotations := msg.Annotations
+	updateCmd := func() tea.Msg { return taskview.TaskUpdateMsg{Task: task, Annotations: annotations} }
 	refreshCmd := func() tea.Msg { return messages.DataRefreshMsg{} }
 	return m, tea.Batch(updateCmd, refreshCmd)
 }
@@ -310,11 +316,14 @@ func (m DetailModel) updateNewBoardPick(msg tea.KeyMsg) (DetailModel, tea.Cmd) {
 	return m.finishCardCreation(selectedPath)
 }
 
-// finishCardCreation loads the board, creates the card, and opens it in $EDITOR.
+// finishCardCreation loads the board, creates the card, and opens it in the
+// board's card editor.
 func (m DetailModel) finishCardCreation(boardPath string) (DetailModel, tea.Cmd) {
 	projectName := ""
+	var defaultTags []string
 	if m.pendingProject != nil {
 		projectName = m.pendingProject.Name
+		defaultTags = m.pendingProject.DefaultTags
 	}
 
 	board, err := fs.ReadBoard(boardPath)
@@ -323,18 +332,14 @@ func (m DetailModel) finishCardCreation(boardPath string) (DetailModel, tea.Cmd)
 		return m, nil
 	}
 
-	card, err := operations.CreateCardFromTask(&board, "", []string{projectName}, []string{}, nil, nil, 0)
+	// Task contexts become card tags, so the project's default tags ride along
+	card, err := operations.CreateCardFromTask(&board, data.Task{Projects: []string{projectName}, Contexts: defaultTags})
 	if err != nil {
 		logs.Logger.Printf("Error creating card: %v", err)
 		return m, nil
 	}
 
-	cardPath := filepath.Join(board.Path, "cards", card.Filename)
-	editor := os.Getenv("EDITOR")
-	if editor == "" {
-		editor = "vim"
-	}
-	c := exec.Command(editor, cardPath)
+	c := operations.CardEditorCommand(board, card.Filename, config.Get().CardEditorFor(m.wsDir))
 	return m, tea.ExecProcess(c, func(err error) tea.Msg {
 		return cardEditorFinishedMsg{err: err}
 	})
@@ -0,0 +1,102 @@
+package projects
+
+import (
+	"fmt"
+	"strings"
+
+	"wydo/internal/tui/messages"
+	taskview "wydo/internal/tui/tasks"
+	"wydo/internal/workspace"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// startRename opens the new-name input for the project shown.
+func (m DetailModel) startRename() (DetailModel, tea.Cmd) {
+	if m.project == nil {
+		return m, nil
+	}
+	input := taskview.NewTextInput("Rename "+m.name, "new project name", nil)
+	input.SetValue(m.name)
+	input.SetWidth(60)
+	m.renameInput = input
+	m.mode = detailModeRenameName
+	return m, input.Init()
+}
+
+// updateRenameInput forwards keys to the new-name input. Its result arrives
+// as a TextInputResultMsg and continues in finishRenameName.
+func (m DetailModel) updateRenameInput(msg tea.KeyMsg) (DetailModel, tea.Cmd) {
+	if m.renameInput == nil {
+		m.mode = detailModeNormal
+		return m, nil
+	}
+	result, cmd := m.renameInput.Update(msg)
+	m.renameInput = result.(*taskview.TextInputModel)
+	return m, cmd
+}
+
+// finishRenameName counts what the rename would touch and asks to confirm.
+func (m DetailModel) finishRenameName(value string) (DetailModel, tea.Cmd) {
+	m.renameInput = nil
+	m.mode = detailModeNormal
+
+	newName := strings.TrimPrefix(strings.TrimSpace(value), "+")
+	if newName == "" || newName == m.name {
+		return m, nil
+	}
+	preview, err := workspace.PreviewRename(m.registry, m.allTasks, m.allBoards, m.name, newName)
+	if err != nil {
+		return m, tea.Printf("Error renaming project: %v", err)
+	}
+	m.renameTo = newName
+	m.renamePreview = &preview
+	m.mode = detailModeRenamePreview
+	return m, nil
+}
+
+// updateRenamePreview applies the rename on y or enter and drops it on n or esc.
+func (m DetailModel) updateRenamePreview(msg tea.KeyMsg) (DetailModel, tea.Cmd) {
+	switch msg.String() {
+	case "y", "enter":
+		rename := messages.RenameProjectMsg{WorkspaceRootDir: m.wsDir, OldName: m.name, NewName: m.renameTo}
+		m.renamePreview = nil
+		m.mode = detailModeNormal
+		return m, func() tea.Msg { return rename }
+	case "n", "esc":
+		m.renamePreview = nil
+		m.mode = detailModeNormal
+	}
+	return m, nil
+}
+
+func (m DetailModel) viewRenamePreview() string {
+	p := m.renamePreview
+	var lines []string
+	lines = append(lines, titleStyle.Render(fmt.Sprintf("Rename %s to %s", m.name, m.renameTo)))
+	lines = append(lines, "")
+	lines = append(lines, listItemStyle.Render(fmt.Sprintf("%d task(s) retagged", p.Tasks)))
+	lines = append(lines, listItemStyle.Render(fmt.Sprintf("%d card(s) updated", p.Cards)))
+	if p.Dirs > 0 {
+		verb := "renamed"
+		if p.Merge {
+			verb = "merged"
+		}
+		lines = append(lines, listItemStyle.Render(fmt.Sprintf("%d directory %s", p.Dirs, verb)))
+	}
+	if p.Merge {
+		lines = append(lines, "")
+		lines = append(lines, errorStyle.Render(m.renameTo+" already exists: the projects are merged"))
+	}
+	lines = append(lines, "")
+	lines = append(lines, pathStyle.Render("y/enter: rename  n/esc: cancel"))
+
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	box := lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(lipgloss.Color("4")).
+		Padding(1, 2).
+		Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
@@ -8,8 +8,11 @@ import (
 	"strings"
 	"time"
 
+	"wydo/internal/clock"
+	"wydo/internal/logs"
 	"wydo/internal/workspace"
 	"wydo/internal/tui/messages"
+	"wydo/internal/tui/shared"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
@@ -30,6 +33,7 @@ const (
 	modeSetParent       // selecting new parent for a project
 	modeDeleteVirtual   // confirm-delete a virtual project
 	modeArchiveConfirm  // confirm-archive a project
+	modeSelectTemplate  // pick a template for the project being created
 )
 
 // parentOption is a candidate parent in the reparent selector.
@@ -74,6 +78,13 @@ type ProjectsModel struct {
 	createDirs     []string // candidate projects/ dirs for create
 	createWSDir    string   // chosen workspace root
 
+	// Template flow state: the project named in modeCreate waits here while a template is picked
+	templatesDir       string   // directory of project templates; each subdirectory is one
+	templates          []string // template names, offered after "Blank project"
+	templateIdx        int      // 0 = blank project
+	pendingProjectsDir string
+	pendingProjectName string
+
 	// Rename flow state
 	renameEntry *projectEntry
 
@@ -132,6 +143,11 @@ func (m *ProjectsModel) SetSize(w, h int) {
 	m.height = h
 }
 
+// SetTemplatesDir sets the directory project templates are read from.
+func (m *ProjectsModel) SetTemplatesDir(dir string) {
+	m.templatesDir = dir
+}
+
 // IsTyping returns true when the view has an active text input.
 func (m ProjectsModel) IsTyping() bool {
 	return m.mode == modeSearch || m.mode == modeCreate || m.mode == modeRename ||
@@ -147,6 +163,8 @@ func (m ProjectsModel) HintText() string {
 		return "j/k:navigate  enter:select  esc:cancel"
 	case modeSelectDir:
 		return "j/k:navigate  enter:select  esc:cancel"
+	case modeSelectTemplate:
+		return "j/k:navigate  enter:create  esc:cancel"
 	case modeCreate:
 		return "enter:create  esc:cancel"
 	case modeRename:
@@ -273,6 +291,8 @@ func (m ProjectsModel) Update(msg tea.Msg) (ProjectsModel, tea.Cmd) {
 			return m.updateSelectDir(msg)
 		case modeCreate:
 			return m.updateCreate(msg)
+		case modeSelectTemplate:
+			return m.updateSelectTemplate(msg)
 		case modeRename:
 			return m.updateRename(msg)
 		case modeScaffoldConfirm:
@@ -684,29 +704,70 @@ func (m ProjectsModel) updateCreate(msg tea.KeyMsg) (ProjectsModel, tea.Cmd) {
 			return m, nil
 		}
 
-		projectDir := filepath.Join(targetDir, name)
-		if err := os.MkdirAll(projectDir, 0o755); err != nil {
-			m.err = fmt.Errorf("failed to create directory: %w", err)
+		// Offer templates, if there are any, before creating
+		templates, err := workspace.ListProjectTemplates(m.templatesDir)
+		if err != nil {
+			logs.Logger.Printf("Error reading project templates: %v", err)
+		}
+		if len(templates) > 0 {
+			m.templates = templates
+			m.templateIdx = 0
+			m.pendingProjectsDir = targetDir
+			m.pendingProjectName = name
+			m.mode = modeSelectTemplate
 			return m, nil
 		}
+		return m.createProject(targetDir, name, "")
+	}
 
-		// Write an index note
-		indexPath := filepath.Join(projectDir, name+".md")
-		content := fmt.Sprintf("# %s\n", name)
-		if err := os.WriteFile(indexPath, []byte(content), 0o644); err != nil {
-			m.err = fmt.Errorf("failed to write index note: %w", err)
-			return m, nil
-		}
+	var cmd tea.Cmd
+	m.textInput, cmd = m.textInput.Update(msg)
+	return m, cmd
+}
 
-		_ = workspace.RemoveFromVirtualArchive(m.createWSDir, name)
+func (m ProjectsModel) updateSelectTemplate(msg tea.KeyMsg) (ProjectsModel, tea.Cmd) {
+	switch msg.String() {
+	case "esc":
 		m.mode = modeList
 		m.textInput.SetValue("")
-		return m, func() tea.Msg { return messages.DataRefreshMsg{} }
+		return m, nil
+
+	case "j", "down":
+		if m.templateIdx < len(m.templates) {
+			m.templateIdx++
+		}
+
+	case "k", "up":
+		if m.templateIdx > 0 {
+			m.templateIdx--
+		}
+
+	case "enter":
+		template := ""
+		if m.templateIdx > 0 {
+			template = m.templates[m.templateIdx-1]
+		}
+		return m.createProject(m.pendingProjectsDir, m.pendingProjectName, template)
 	}
+	return m, nil
+}
 
-	var cmd tea.Cmd
-	m.textInput, cmd = m.textInput.Update(msg)
-	return m, cmd
+// createProject creates a project directory with its index note, scaffolded
+// from the named template when template isn't empty.
+func (m ProjectsModel) createProject(projectsDir, name, template string) (ProjectsModel, tea.Cmd) {
+	templateDir := ""
+	if template != "" {
+		templateDir = filepath.Join(m.templatesDir, template)
+	}
+	if err := workspace.CreateProject(projectsDir, name, templateDir); err != nil {
+		m.err = err
+		return m, nil
+	}
+
+	_ = workspace.RemoveFromVirtualArchive(m.createWSDir, name)
+	m.mode = modeList
+	m.textInput.SetValue("")
+	return m, func() tea.Msg { return messages.DataRefreshMsg{} }
 }
 
 func (m ProjectsModel) updateRename(msg tea.KeyMsg) (ProjectsModel, tea.Cmd) {
@@ -785,6 +846,8 @@ func (m ProjectsModel) View() string {
 		return m.viewSelectDir()
 	case modeCreate:
 		return m.viewCreate()
+	case modeSelectTemplate:
+		return m.viewSelectTemplate()
 	case modeRename:
 		return m.viewRename()
 	case modeScaffoldConfirm:
@@ -865,7 +928,7 @@ func (m ProjectsModel) viewList() string {
 				badgeSuffix = " " + virtualBadgeStyle.Render("(virtual)")
 			}
 			if m.multiWorkspace {
-				badgeSuffix += " " + pathStyle.Render(abbreviatePath(entry.RootDir))
+				badgeSuffix += " " + shared.FileLink(entry.RootDir, pathStyle.Render(abbreviatePath(entry.RootDir)))
 			}
 
 			nameLine := style.Render(cursorPrefix+indent+treePrefix+name) + badgeSuffix
@@ -969,6 +1032,30 @@ func (m ProjectsModel) viewSelectDir() string {
 	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
 }
 
+func (m ProjectsModel) viewSelectTemplate() string {
+	var lines []string
+	lines = append(lines, titleStyle.Render(fmt.Sprintf("Template for %q", m.pendingProjectName)))
+	lines = append(lines, "")
+
+	options := append([]string{"Blank project"}, m.templates...)
+	for i, option := range options {
+		style := listItemStyle
+		prefix := "  "
+		if i == m.templateIdx {
+			style = selectedListItemStyle
+			prefix = "► "
+		}
+		lines = append(lines, style.Render(prefix+option))
+	}
+
+	if m.err != nil {
+		lines = append(lines, "")
+		lines = append(lines, errorStyle.Render(fmt.Sprintf("Error: %v", m.err)))
+	}
+	content := lipgloss.JoinVertical(lipgloss.Left, lines...)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, content)
+}
+
 func (m ProjectsModel) viewCreate() string {
 	var lines []string
 	lines = append(lines, titleStyle.Render("Create New Project"))
@@ -1177,7 +1264,7 @@ func nextUpcomingDateInSubtree(rootName string, reg *workspace.ProjectRegistry)
 	if reg == nil {
 		return nil
 	}
-	now := time.Now()
+	now := clock.Now()
 	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
 	var best *workspace.ProjectDate
 	visited := make(map[string]bool)
@@ -14,10 +14,7 @@ var (
 			Foreground(theme.Text).
 			Padding(0, 2)
 
-	selectedListItemStyle = lipgloss.NewStyle().
-				Foreground(theme.Warning).
-				Bold(true).
-				Padding(0, 2)
+	selectedListItemStyle = theme.Selected.Padding(0, 2)
 
 	// Muted / path
 	pathStyle = theme.Muted
@@ -35,31 +32,36 @@ var (
 	sectionActiveStyle = lipgloss.NewStyle().
 				Bold(true).
 				Foreground(lipgloss.Color("16")).
-				Background(theme.Warning)
+				Background(theme.FocusBorder)
 
 	// Detail item
 	detailItemStyle = lipgloss.NewStyle().
 			Foreground(theme.Text).
 			Padding(0, 2)
 
-	selectedDetailItemStyle = lipgloss.NewStyle().
-				Foreground(theme.Warning).
-				Bold(true).
-				Padding(0, 2)
+	selectedDetailItemStyle = theme.Selected.Padding(0, 2)
 
 	// Column item styles — no padding, used in the column layout
 	colItemStyle = lipgloss.NewStyle().
 			Foreground(theme.Text)
 
-	colItemSelectedStyle = lipgloss.NewStyle().
-				Foreground(theme.Warning).
-				Bold(true)
+	colItemSelectedStyle = theme.Selected
+
+	// The cursor row of a column without focus
+	colItemHoverStyle = colItemStyle.Background(theme.Hover)
 
 	// Child project group headers in the detail view
 	childProjectStyle = lipgloss.NewStyle().
 				Foreground(theme.Accent).
 				Bold(true)
 
+	childProjectHoverStyle = childProjectStyle.Background(theme.Hover)
+
+	// Latest task annotation shown after task rows
+	annotationStyle = lipgloss.NewStyle().
+			Foreground(theme.TextMuted).
+			Italic(true)
+
 	// URL label (magenta)
 	urlLabelStyle = lipgloss.NewStyle().
 				Foreground(lipgloss.Color("205")).
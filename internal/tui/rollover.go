@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// rolloverInterval is how often the app checks whether the date changed, or
+// the machine woke from sleep, since the last check.
+const rolloverInterval = time.Minute
+
+// rolloverTickMsg carries the time of a rollover check.
+type rolloverTickMsg struct {
+	at time.Time
+}
+
+func rolloverTick() tea.Cmd {
+	return tea.Tick(rolloverInterval, func(t time.Time) tea.Msg { return rolloverTickMsg{at: t} })
+}
+
+// rolledOver reports whether the agenda shown as of last is stale at now:
+// the date changed, or the gap between the two is so much longer than the
+// tick that the machine must have been asleep in between. Only wall clock
+// time is compared, since the monotonic clock stops during sleep.
+func rolledOver(last, now time.Time) bool {
+	last, now = last.Round(0), now.Round(0)
+	if last.Year() != now.Year() || last.YearDay() != now.YearDay() {
+		return true
+	}
+	return now.Sub(last) > 3*rolloverInterval
+}
+
+// checkRollover reloads every view when the date changed or the machine woke
+// since the last tick, so a TUI left open overnight moves to the new today
+// and yesterday's open items show up as overdue. While a text input or modal
+// is open the reload waits for a later tick. It keeps the tick running.
+func (m AppModel) checkRollover(msg rolloverTickMsg) (AppModel, tea.Cmd) {
+	if !rolledOver(m.rolloverAt, msg.at) {
+		m.rolloverAt = msg.at
+		return m, rolloverTick()
+	}
+	if m.isChildInputActive() {
+		return m, rolloverTick()
+	}
+	m.rolloverAt = msg.at
+	return m, tea.Batch(rolloverTick(), func() tea.Msg { return DataRefreshMsg{} })
+}
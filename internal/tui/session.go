@@ -0,0 +1,120 @@
+package tui
+
+import (
+	"encoding/json"
+	"time"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/logs"
+	"wydo/internal/state"
+	"wydo/internal/tasks/filter"
+	kanbanview "wydo/internal/tui/kanban"
+)
+
+// viewNames maps the names accepted by --view (and saved in the session) to views.
+var viewNames = map[string]ViewType{
+	"day":      ViewAgendaDay,
+	"week":     ViewAgendaWeek,
+	"month":    ViewAgendaMonth,
+	"year":     ViewAgendaYear,
+	"tasks":    ViewTaskManager,
+	"boards":   ViewKanbanPicker,
+	"board":    ViewKanbanBoard,
+	"projects": ViewProjects,
+	"notes":    ViewNotes,
+	"goals":    ViewGoals,
+}
+
+// viewFromName returns the view for a --view name.
+func viewFromName(name string) (ViewType, bool) {
+	v, ok := viewNames[name]
+	return v, ok
+}
+
+// viewName returns the session name of a view. The project detail view is
+// saved as the projects list, since the open project isn't restored.
+func viewName(v ViewType) string {
+	if v == ViewProjectDetail {
+		return "projects"
+	}
+	for name, view := range viewNames {
+		if view == v {
+			return name
+		}
+	}
+	return "day"
+}
+
+// restoreSession reopens the view, board, agenda date and task filters
+// saved by saveSession. Anything that no longer exists is skipped.
+func (m *AppModel) restoreSession(s state.Session) {
+	if s.BoardPath != "" {
+		if loaded, err := fs.ReadBoard(s.BoardPath); err == nil {
+			m.boardView = kanbanview.NewBoardModel(loaded, collectAllProjects(m.workspaces), m.boards, projectsForBoard(m.workspaces, s.BoardPath))
+			m.boardView.SetFilterBodies(m.cfg.FilterCardBodies)
+			m.boardView.SetHighlightMatches(m.cfg.HighlightFilterMatches)
+			m.boardView.SetDefaultTags(defaultTagsForBoard(m.workspaces, s.BoardPath))
+			m.boardView.SetBoardInfo(boardInfo(m.workspaces))
+			m.boardView.SetViewStates(m.boardStates)
+			m.boardView.NavigateTo(s.ColIndex, s.CardIndex)
+			m.recordRecentBoard(s.BoardPath)
+			m.boardLoaded = true
+		} else {
+			logs.Logger.Printf("Session board %s not restored: %v", s.BoardPath, err)
+		}
+	}
+
+	if v, ok := viewFromName(s.View); ok && (v != ViewKanbanBoard || m.boardLoaded) {
+		m.currentView = v
+		if isAgendaView(v) {
+			m.lastAgendaView = v
+		}
+	}
+
+	if date, err := time.ParseInLocation("2006-01-02", s.AgendaDate, time.Local); err == nil {
+		m.dayView.SetDate(date)
+		m.weekView.SetDate(date)
+		m.monthView.SetDate(date)
+	}
+
+	if len(s.TaskFilter) > 0 {
+		var state filter.State
+		if err := json.Unmarshal(s.TaskFilter, &state); err == nil {
+			m.taskManagerView.SetFilter(state)
+		} else {
+			logs.Logger.Printf("Session task filter not restored: %v", err)
+		}
+	}
+}
+
+// saveSession records the current view, board cursor, agenda date and task
+// filters in the state file for the next start.
+func (m *AppModel) saveSession() {
+	if !m.cfg.RestoreSession {
+		return
+	}
+
+	s := &state.Session{View: viewName(m.currentView)}
+	if m.boardLoaded {
+		s.BoardPath = m.boardView.BoardPath()
+		s.ColIndex, s.CardIndex = m.boardView.Cursor()
+	}
+
+	date := m.dayView.Date()
+	switch m.lastAgendaView {
+	case ViewAgendaWeek:
+		date = m.weekView.Date()
+	case ViewAgendaMonth:
+		date = m.monthView.Date()
+	}
+	s.AgendaDate = date.Format("2006-01-02")
+
+	if raw, err := json.Marshal(m.taskManagerView.Filter()); err == nil {
+		s.TaskFilter = raw
+	}
+
+	m.state.Session = s
+	if err := m.state.Save(); err != nil {
+		logs.Logger.Printf("Error saving state: %v", err)
+	}
+}
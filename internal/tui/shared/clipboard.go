@@ -0,0 +1,27 @@
+package shared
+
+import (
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ClipboardMsg asks the app to put Text on the system clipboard. The app
+// writes ClipboardSequence(Text) with its next frame, so the sequence goes
+// out through the renderer instead of racing it on stdout.
+type ClipboardMsg struct {
+	Text string
+}
+
+// CopyToClipboard puts text on the system clipboard, by way of ClipboardMsg.
+func CopyToClipboard(text string) tea.Cmd {
+	return func() tea.Msg {
+		return ClipboardMsg{Text: text}
+	}
+}
+
+// ClipboardSequence returns the OSC 52 escape sequence that puts text on
+// the system clipboard. Most terminals (and tmux with set-clipboard on)
+// honor it, including over ssh; terminals without support ignore it.
+func ClipboardSequence(text string) string {
+	return ansi.SetSystemClipboard(text)
+}
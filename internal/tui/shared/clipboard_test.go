@@ -0,0 +1,14 @@
+package shared
+
+import "testing"
+
+func TestCopyToClipboard(t *testing.T) {
+	msg, ok := CopyToClipboard("wydo://card/x")().(ClipboardMsg)
+	if !ok || msg.Text != "wydo://card/x" {
+		t.Fatalf("got %#v, want a ClipboardMsg for the text", msg)
+	}
+	// OSC 52 with the text base64-encoded
+	if got, want := ClipboardSequence("hi"), "\x1b]52;c;aGk=\x07"; got != want {
+		t.Errorf("ClipboardSequence = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,37 @@
+package shared
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+// DaysUntil returns the number of calendar days from now to date: 0 today,
+// 1 tomorrow, negative once date has passed.
+func DaysUntil(date, now time.Time) int {
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	target := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	return int(target.Sub(today).Hours() / 24)
+}
+
+// Countdown formats a DaysUntil result as an offset counted up to the date:
+// "-3" three days out, "+0" today and "+2" two days late. Board cards, task
+// dates and agenda items all label dates with it.
+func Countdown(days int) string {
+	return fmt.Sprintf("%+d", -days)
+}
+
+// CountdownColor is the urgency color of a date days away: green beyond a
+// week, yellow within it and red from the day itself.
+func CountdownColor(days int) lipgloss.Color {
+	switch {
+	case days > 7:
+		return theme.Success
+	case days > 0:
+		return theme.Warning
+	default:
+		return theme.Danger
+	}
+}
@@ -0,0 +1,24 @@
+package shared
+
+import (
+	"testing"
+	"time"
+)
+
+func TestDaysUntilAndCountdown(t *testing.T) {
+	now := time.Date(2026, 10, 16, 23, 30, 0, 0, time.Local)
+	cases := []struct {
+		date time.Time
+		want string
+	}{
+		{time.Date(2026, 10, 16, 0, 0, 0, 0, time.UTC), "+0"},
+		{time.Date(2026, 10, 17, 0, 0, 0, 0, time.UTC), "-1"},
+		{time.Date(2026, 10, 26, 0, 0, 0, 0, time.UTC), "-10"},
+		{time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC), "+2"},
+	}
+	for _, c := range cases {
+		if got := Countdown(DaysUntil(c.date, now)); got != c.want {
+			t.Errorf("Countdown(%s) = %q, want %q", c.date.Format("2006-01-02"), got, c.want)
+		}
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+
+	"wydo/internal/clock"
 )
 
 type datePickerMode int
@@ -30,7 +32,7 @@ type DatePickerModel struct {
 }
 
 func NewDatePickerModel(currentDate *time.Time, title string) DatePickerModel {
-	now := time.Now()
+	now := clock.Now()
 
 	// Initialize cursor date
 	cursorDate := now
@@ -91,7 +93,7 @@ func (m DatePickerModel) updateCalendar(msg tea.KeyMsg) (DatePickerModel, tea.Cm
 		return m, nil
 	case "t":
 		// Jump to today
-		today := time.Now()
+		today := clock.Now()
 		m.cursorDate = today
 		m.viewMonth = time.Date(today.Year(), today.Month(), 1, 0, 0, 0, 0, time.Local)
 		return m, nil
@@ -163,6 +165,12 @@ func (m *DatePickerModel) ensureCursorInView() {
 }
 
 func (m DatePickerModel) parseTextInput(input string) (time.Time, error) {
+	return ParseDateInput(input)
+}
+
+// ParseDateInput parses a typed date: yyyy-MM-dd, MM-dd (current year),
+// today, tomorrow, or a relative day offset like +3 / -1.
+func ParseDateInput(input string) (time.Time, error) {
 	input = strings.TrimSpace(input)
 
 	// Handle relative dates
@@ -171,7 +179,7 @@ func (m DatePickerModel) parseTextInput(input string) (time.Time, error) {
 		if err != nil {
 			return time.Time{}, err
 		}
-		return time.Now().AddDate(0, 0, days), nil
+		return clock.Now().AddDate(0, 0, days), nil
 	}
 
 	if strings.HasPrefix(input, "-") {
@@ -179,17 +187,17 @@ func (m DatePickerModel) parseTextInput(input string) (time.Time, error) {
 		if err != nil {
 			return time.Time{}, err
 		}
-		return time.Now().AddDate(0, 0, -days), nil
+		return clock.Now().AddDate(0, 0, -days), nil
 	}
 
 	// Handle "tomorrow"
 	if strings.ToLower(input) == "tomorrow" {
-		return time.Now().AddDate(0, 0, 1), nil
+		return clock.Now().AddDate(0, 0, 1), nil
 	}
 
 	// Handle "today"
 	if strings.ToLower(input) == "today" {
-		return time.Now(), nil
+		return clock.Now(), nil
 	}
 
 	// Try full date format: 2026-03-15
@@ -198,7 +206,7 @@ func (m DatePickerModel) parseTextInput(input string) (time.Time, error) {
 	}
 
 	// Try short format: 03-15 (assumes current year)
-	now := time.Now()
+	now := clock.Now()
 	if parsed, err := time.Parse("01-02", input); err == nil {
 		return time.Date(now.Year(), parsed.Month(), parsed.Day(), 0, 0, 0, 0, time.Local), nil
 	}
@@ -206,6 +214,22 @@ func (m DatePickerModel) parseTextInput(input string) (time.Time, error) {
 	return time.Time{}, fmt.Errorf("invalid date format")
 }
 
+// DueBumpDays maps the quick due-date keys to a day offset: > and < move a
+// day later/earlier, } and { a week.
+func DueBumpDays(key string) (int, bool) {
+	switch key {
+	case ">":
+		return 1, true
+	case "<":
+		return -1, true
+	case "}":
+		return 7, true
+	case "{":
+		return -7, true
+	}
+	return 0, false
+}
+
 func (m DatePickerModel) View() string {
 	if m.mode == textInputMode {
 		return m.viewTextInput()
@@ -244,7 +268,7 @@ func (m DatePickerModel) viewCalendar() string {
 
 	// Start with offset for first day of month
 	currentDay := 1 - startWeekday
-	today := time.Now()
+	today := clock.Now()
 
 	for week := 0; week < 6; week++ {
 		for weekday := 0; weekday < 7; weekday++ {
@@ -331,6 +355,28 @@ func (m *DatePickerModel) SetSize(width, height int) {
 	m.height = height
 }
 
+// HelpEntries returns the picker's keybindings for the help overlay
+func (m DatePickerModel) HelpEntries() []HelpBind {
+	return []HelpBind{
+		{Key: "h / l", Desc: "Previous / next day"},
+		{Key: "k / j", Desc: "Previous / next week"},
+		{Key: "H / L", Desc: "Previous / next month (also - / +)"},
+		{Key: "t", Desc: "Jump to today"},
+		{Key: "i", Desc: "Type a date (yyyy-mm-dd, mm-dd, +3, today, tomorrow)"},
+		{Key: "c", Desc: "Clear the date"},
+		{Key: "enter", Desc: "Set the selected date"},
+		{Key: "esc", Desc: "Cancel"},
+	}
+}
+
+// HintText returns the hint bar text for the picker's current mode
+func (m DatePickerModel) HintText() string {
+	if m.mode == textInputMode {
+		return "type a date  enter:set  esc:back to calendar"
+	}
+	return "?:help  h/j/k/l:move  H/L:month  t:today  i:type  c:clear  enter:set  esc:cancel"
+}
+
 // IsTextInputActive returns true when the text input is focused in the date picker.
 func (m DatePickerModel) IsTextInputActive() bool {
 	return m.mode == textInputMode
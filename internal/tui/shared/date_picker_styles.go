@@ -26,10 +26,7 @@ var (
 				Foreground(theme.Primary).
 				Bold(true)
 
-	DatePickerCursorStyle = lipgloss.NewStyle().
-				Background(theme.Warning).
-				Foreground(lipgloss.Color("0")).
-				Bold(true)
+	DatePickerCursorStyle = theme.Selected
 
 	DatePickerExamplesStyle = lipgloss.NewStyle().
 				Foreground(theme.TextMuted).
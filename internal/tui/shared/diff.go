@@ -0,0 +1,142 @@
+package shared
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+// DiffOp says whether a diff segment is in both texts, only the old one, or only the new one.
+type DiffOp int
+
+const (
+	DiffEqual DiffOp = iota
+	DiffDelete
+	DiffInsert
+)
+
+// DiffSegment is a run of text with the same DiffOp.
+type DiffSegment struct {
+	Op   DiffOp
+	Text string
+}
+
+// maxDiffTokens caps the LCS table; longer texts are shown as a whole
+// delete followed by a whole insert.
+const maxDiffTokens = 2000
+
+var (
+	diffDeleteStyle = lipgloss.NewStyle().Foreground(theme.Danger).Strikethrough(true)
+	diffInsertStyle = lipgloss.NewStyle().Foreground(theme.Success).Underline(true)
+)
+
+// WordDiff compares before and after word by word. Whitespace runs are tokens of
+// their own, so line breaks survive in the segments.
+func WordDiff(before, after string) []DiffSegment {
+	a, b := diffTokens(before), diffTokens(after)
+	if len(a) > maxDiffTokens || len(b) > maxDiffTokens {
+		var segs []DiffSegment
+		segs = appendSegment(segs, DiffDelete, before)
+		return appendSegment(segs, DiffInsert, after)
+	}
+
+	// lcs[i][j] is the longest common subsequence of a[i:] and b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var segs []DiffSegment
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch {
+		case a[i] == b[j]:
+			segs = appendSegment(segs, DiffEqual, a[i])
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			segs = appendSegment(segs, DiffDelete, a[i])
+			i++
+		default:
+			segs = appendSegment(segs, DiffInsert, b[j])
+			j++
+		}
+	}
+	for ; i < len(a); i++ {
+		segs = appendSegment(segs, DiffDelete, a[i])
+	}
+	for ; j < len(b); j++ {
+		segs = appendSegment(segs, DiffInsert, b[j])
+	}
+	return segs
+}
+
+// RenderWordDiff renders WordDiff(before, after) inline: removed words struck
+// through in red, added words underlined in green.
+func RenderWordDiff(before, after string) string {
+	var sb strings.Builder
+	for _, seg := range WordDiff(before, after) {
+		switch seg.Op {
+		case DiffDelete:
+			sb.WriteString(renderDiffRun(diffDeleteStyle, seg.Text))
+		case DiffInsert:
+			sb.WriteString(renderDiffRun(diffInsertStyle, seg.Text))
+		default:
+			sb.WriteString(seg.Text)
+		}
+	}
+	return sb.String()
+}
+
+// renderDiffRun styles each line of text separately so the escape codes
+// never span a line break.
+func renderDiffRun(style lipgloss.Style, text string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = style.Render(line)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// appendSegment adds text to segs, merging it into the last segment when the op matches.
+func appendSegment(segs []DiffSegment, op DiffOp, text string) []DiffSegment {
+	if text == "" {
+		return segs
+	}
+	if n := len(segs); n > 0 && segs[n-1].Op == op {
+		segs[n-1].Text += text
+		return segs
+	}
+	return append(segs, DiffSegment{Op: op, Text: text})
+}
+
+// diffTokens splits s into alternating runs of whitespace and non-whitespace.
+func diffTokens(s string) []string {
+	var tokens []string
+	start, inSpace := 0, false
+	for i, r := range s {
+		space := unicode.IsSpace(r)
+		if i > start && space != inSpace {
+			tokens = append(tokens, s[start:i])
+			start = i
+		}
+		inSpace = space
+	}
+	if start < len(s) {
+		tokens = append(tokens, s[start:])
+	}
+	return tokens
+}
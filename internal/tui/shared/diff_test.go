@@ -0,0 +1,42 @@
+package shared
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWordDiff(t *testing.T) {
+	got := WordDiff("Fix the login bug +web", "Fix the signup bug +web @work")
+	want := []DiffSegment{
+		{DiffEqual, "Fix the "},
+		{DiffDelete, "login"},
+		{DiffInsert, "signup"},
+		{DiffEqual, " bug +web"},
+		{DiffInsert, " @work"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("WordDiff:\n got %+v\nwant %+v", got, want)
+	}
+}
+
+func TestWordDiff_KeepsLineBreaks(t *testing.T) {
+	var before, after string
+	for _, seg := range WordDiff("one\ntwo three\n", "one\ntwo four\nfive") {
+		if seg.Op != DiffInsert {
+			before += seg.Text
+		}
+		if seg.Op != DiffDelete {
+			after += seg.Text
+		}
+	}
+	if before != "one\ntwo three\n" || after != "one\ntwo four\nfive" {
+		t.Errorf("segments don't rebuild the inputs: %q / %q", before, after)
+	}
+}
+
+func TestWordDiff_Identical(t *testing.T) {
+	got := WordDiff("same text", "same text")
+	if len(got) != 1 || got[0].Op != DiffEqual {
+		t.Errorf("expected one equal segment, got %+v", got)
+	}
+}
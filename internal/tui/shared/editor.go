@@ -0,0 +1,22 @@
+package shared
+
+import (
+	"os"
+	"os/exec"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tui/messages"
+)
+
+// OpenInEditor opens path in $EDITOR (fallback: vim) and reloads the data
+// once the editor exits.
+func OpenInEditor(path string) tea.Cmd {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		editor = "vim"
+	}
+	c := exec.Command(editor, path)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return messages.DataRefreshMsg{}
+	})
+}
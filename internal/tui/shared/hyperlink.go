@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"net/url"
+	"path/filepath"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// hyperlinksEnabled is set once at startup from config.Hyperlinks. Terminals
+// without OSC 8 support usually ignore the sequence, but some print it, so
+// it's opt-in.
+var hyperlinksEnabled bool
+
+// SetHyperlinks turns OSC 8 hyperlink output on or off.
+func SetHyperlinks(enabled bool) {
+	hyperlinksEnabled = enabled
+}
+
+// Hyperlink wraps text in an OSC 8 hyperlink to target when hyperlinks are
+// enabled, and returns text unchanged otherwise.
+func Hyperlink(target, text string) string {
+	if !hyperlinksEnabled || target == "" {
+		return text
+	}
+	return ansi.SetHyperlink(target) + text + ansi.ResetHyperlink()
+}
+
+// FileLink is Hyperlink with a file:// URL for path.
+func FileLink(path, text string) string {
+	if !hyperlinksEnabled || path == "" {
+		return text
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return text
+	}
+	u := url.URL{Scheme: "file", Path: filepath.ToSlash(abs)}
+	return Hyperlink(u.String(), text)
+}
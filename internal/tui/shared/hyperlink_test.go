@@ -0,0 +1,40 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestHyperlink(t *testing.T) {
+	defer SetHyperlinks(false)
+
+	SetHyperlinks(false)
+	if got := Hyperlink("https://example.com", "link"); got != "link" {
+		t.Errorf("disabled: got %q, want plain text", got)
+	}
+
+	SetHyperlinks(true)
+	got := Hyperlink("https://example.com", "link")
+	want := "\x1b]8;;https://example.com\alink\x1b]8;;\a"
+	if got != want {
+		t.Errorf("enabled: got %q, want %q", got, want)
+	}
+	if w := ansi.StringWidth(got); w != 4 {
+		t.Errorf("width = %d, want 4", w)
+	}
+	if got := Hyperlink("", "link"); got != "link" {
+		t.Errorf("empty target: got %q, want plain text", got)
+	}
+}
+
+func TestFileLink(t *testing.T) {
+	defer SetHyperlinks(false)
+	SetHyperlinks(true)
+
+	got := FileLink("/tmp/my board", "board")
+	want := "\x1b]8;;file:///tmp/my%20board\aboard\x1b]8;;\a"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -1,6 +1,14 @@
 package shared
 
-import "strings"
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+
+	"wydo/internal/tui/theme"
+)
 
 // CenterContent renders content vertically centered in the available height.
 func CenterContent(content string, height int) string {
@@ -66,3 +74,29 @@ func CenterWithBottomHints(content, hints string, height int) string {
 
 	return strings.Join(lines, "\n")
 }
+
+// ClampContent cuts content to width x height cells: longer lines are cut
+// and lines past height dropped, so a view that outgrows the terminal never
+// wraps or pushes the bars around it off screen.
+func ClampContent(content string, width, height int) string {
+	width, height = max(width, 0), max(height, 0)
+	lines := strings.Split(content, "\n")
+	if len(lines) > height {
+		lines = lines[:height]
+	}
+	for i, line := range lines {
+		if ansi.StringWidth(line) > width {
+			lines[i] = ansi.Truncate(line, width, "")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// TooSmall renders, centered in width x height, the placeholder shown in
+// place of a view that needs at least minWidth x minHeight.
+func TooSmall(width, height, minWidth, minHeight int) string {
+	msg := theme.Warn.Render("Terminal too small") + "\n" +
+		theme.Muted.Render(fmt.Sprintf("need %dx%d, have %dx%d", minWidth, minHeight, width, height))
+	msg = ClampContent(msg, width, height)
+	return lipgloss.Place(max(width, 0), max(height, 0), lipgloss.Center, lipgloss.Center, msg)
+}
@@ -0,0 +1,35 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestClampContent(t *testing.T) {
+	content := "first line\nsecond\nthird line here"
+	got := ClampContent(content, 6, 2)
+	if want := "first \nsecond"; got != want {
+		t.Errorf("ClampContent = %q, want %q", got, want)
+	}
+	if got := ClampContent(content, 80, 10); got != content {
+		t.Errorf("content that fits changed: %q", got)
+	}
+	if got := ClampContent(content, -1, -1); got != "" {
+		t.Errorf("negative size = %q, want empty", got)
+	}
+}
+
+func TestTooSmall(t *testing.T) {
+	for _, size := range [][2]int{{40, 10}, {10, 1}, {0, 0}} {
+		w, h := size[0], size[1]
+		got := TooSmall(w, h, 80, 20)
+		if lipgloss.Width(got) > w || (h > 0 && lipgloss.Height(got) > h) {
+			t.Errorf("TooSmall(%d, %d) is %dx%d", w, h, lipgloss.Width(got), lipgloss.Height(got))
+		}
+	}
+	if got := TooSmall(40, 10, 80, 20); !strings.Contains(got, "need 80x20, have 40x10") {
+		t.Errorf("TooSmall missing the sizes:\n%s", got)
+	}
+}
@@ -0,0 +1,88 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+const (
+	modalMargin      = 4  // columns kept free on each side of a narrowed modal, in total
+	minModalWidth    = 30 // narrowest a modal box gets, even on tiny terminals
+	minModalListRows = 3  // list rows a modal always shows, scrolled or not
+)
+
+// ModalWidth narrows a modal's preferred box width to fit a terminal
+// termWidth columns wide. A termWidth of 0 (size not known yet) keeps the
+// preferred width.
+func ModalWidth(preferred, termWidth int) int {
+	if termWidth <= 0 || termWidth-modalMargin >= preferred {
+		return preferred
+	}
+	return max(termWidth-modalMargin, minModalWidth)
+}
+
+// ModalListRows is how many list rows fit in a modal on a screen height rows
+// tall when the box without its list (border, title, input, help) takes
+// chrome rows. A height of 0 means the size is not known yet and returns 0,
+// which ScrollList treats as no limit.
+func ModalListRows(height, chrome int) int {
+	if height <= 0 {
+		return 0
+	}
+	return max(height-chrome, minModalListRows)
+}
+
+// ScrollWindow returns the [start, end) range of a list of total rows that
+// fits in visible rows and keeps cursor on screen. The cursor sits on the
+// last visible row once the list has scrolled.
+func ScrollWindow(cursor, total, visible int) (start, end int) {
+	if visible <= 0 || total <= visible {
+		return 0, total
+	}
+	if cursor >= visible {
+		start = cursor - visible + 1
+	}
+	start = min(start, total-visible)
+	return start, start + visible
+}
+
+// ScrollList cuts rows to at most maxRows lines around the cursor row. The
+// first and last lines then count the rows hidden above and below, like the
+// indicators on board columns. A maxRows of 0, or rows that already fit, are
+// returned unchanged.
+func ScrollList(rows []string, cursor, maxRows int) []string {
+	if maxRows <= 0 || len(rows) <= maxRows {
+		return rows
+	}
+	// Both indicator lines are always reserved so the modal doesn't jump
+	start, end := ScrollWindow(cursor, len(rows), max(maxRows-2, 1))
+
+	out := make([]string, 0, maxRows)
+	if start > 0 {
+		out = append(out, theme.Muted.Render(fmt.Sprintf("  ▲ %d more above", start)))
+	} else {
+		out = append(out, "")
+	}
+	out = append(out, rows[start:end]...)
+	if end < len(rows) {
+		out = append(out, theme.Muted.Render(fmt.Sprintf("  ▼ %d more below", len(rows)-end)))
+	} else {
+		out = append(out, "")
+	}
+	return out
+}
+
+// FitModalList scrolls the list rows lines[start:end] of a modal so that the
+// modal, rendered with box, fits a screen height rows tall. cursor indexes
+// the list rows. Titles, inputs and help around the list are kept.
+func FitModalList(lines []string, start, end, cursor int, box lipgloss.Style, height int) []string {
+	chromeLines := append(append([]string{}, lines[:start]...), lines[end:]...)
+	chrome := lipgloss.Height(box.Render(strings.Join(chromeLines, "\n")))
+	rows := ScrollList(lines[start:end], cursor, ModalListRows(height, chrome))
+
+	fitted := append(append([]string{}, lines[:start]...), rows...)
+	return append(fitted, lines[end:]...)
+}
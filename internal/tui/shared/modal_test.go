@@ -0,0 +1,69 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestScrollWindow(t *testing.T) {
+	cases := []struct {
+		cursor, total, visible int
+		start, end             int
+	}{
+		{0, 5, 10, 0, 5}, // fits
+		{0, 20, 5, 0, 5}, // top
+		{4, 20, 5, 0, 5}, // last row of the first page
+		{5, 20, 5, 1, 6}, // scrolled by one
+		{19, 20, 5, 15, 20},
+		{3, 20, 0, 0, 20}, // no limit
+	}
+	for _, c := range cases {
+		start, end := ScrollWindow(c.cursor, c.total, c.visible)
+		if start != c.start || end != c.end {
+			t.Errorf("ScrollWindow(%d, %d, %d) = %d, %d; want %d, %d", c.cursor, c.total, c.visible, start, end, c.start, c.end)
+		}
+	}
+}
+
+func TestScrollList_KeepsCursorAndCountsHidden(t *testing.T) {
+	var rows []string
+	for i := 0; i < 20; i++ {
+		rows = append(rows, fmt.Sprintf("row %d", i))
+	}
+
+	got := ScrollList(rows, 10, 7)
+	if len(got) != 7 {
+		t.Fatalf("got %d lines, want 7", len(got))
+	}
+	if first := ansi.Strip(got[0]); !strings.Contains(first, "▲ 6 more above") {
+		t.Errorf("first line = %q, want the above indicator", first)
+	}
+	if got[5] != "row 10" {
+		t.Errorf("cursor row not last visible: %q", got[1:6])
+	}
+	if last := ansi.Strip(got[6]); !strings.Contains(last, "▼ 9 more below") {
+		t.Errorf("last line = %q, want the below indicator", last)
+	}
+
+	if got := ScrollList(rows[:3], 0, 7); len(got) != 3 {
+		t.Errorf("a list that fits should be unchanged, got %d lines", len(got))
+	}
+}
+
+func TestModalWidth(t *testing.T) {
+	if got := ModalWidth(60, 0); got != 60 {
+		t.Errorf("unknown terminal width: got %d, want 60", got)
+	}
+	if got := ModalWidth(60, 120); got != 60 {
+		t.Errorf("wide terminal: got %d, want 60", got)
+	}
+	if got := ModalWidth(60, 50); got != 46 {
+		t.Errorf("narrow terminal: got %d, want 46", got)
+	}
+	if got := ModalWidth(60, 20); got != minModalWidth {
+		t.Errorf("tiny terminal: got %d, want %d", got, minModalWidth)
+	}
+}
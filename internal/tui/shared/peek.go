@@ -0,0 +1,93 @@
+package shared
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+// PeekBodyLines is how many lines of a body a peek popup shows.
+const PeekBodyLines = 12
+
+// PeekField is one labelled value in a peek popup.
+type PeekField struct {
+	Label string
+	Value string
+}
+
+// PeekModel is a read-only popup with an item's details: a title, labelled
+// fields and the start of its body. Views fill it from their own items.
+type PeekModel struct {
+	title  string
+	kind   string
+	fields []PeekField
+	body   string
+	width  int
+	height int
+}
+
+// NewPeekModel creates a peek popup. kind names the item's source ("task",
+// "card", ...); fields with an empty value are skipped.
+func NewPeekModel(kind, title string, fields []PeekField, body string) PeekModel {
+	var kept []PeekField
+	for _, f := range fields {
+		if f.Value != "" {
+			kept = append(kept, f)
+		}
+	}
+	return PeekModel{title: title, kind: kind, fields: kept, body: body}
+}
+
+// SetSize updates the area the popup is centered in
+func (m *PeekModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// View renders the popup centered in its area.
+func (m PeekModel) View() string {
+	boxWidth := min(max(m.width-10, 40), 90)
+	contentWidth := boxWidth - 6 // border and padding
+	labelWidth := 0
+	for _, f := range m.fields {
+		labelWidth = max(labelWidth, lipgloss.Width(f.Label))
+	}
+	labelStyle := helpKeyStyle.Width(labelWidth + 2)
+	valueStyle := helpDescStyle.Width(max(contentWidth-labelWidth-2, 10))
+
+	var s strings.Builder
+	s.WriteString(theme.Muted.Render(m.kind) + " " + theme.ModalTitle.Render(m.title))
+	s.WriteString("\n")
+	if len(m.fields) > 0 {
+		s.WriteString("\n")
+		for _, f := range m.fields {
+			s.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, labelStyle.Render(f.Label), valueStyle.Render(f.Value)))
+			s.WriteString("\n")
+		}
+	}
+	if body := PeekBody(m.body, PeekBodyLines); body != "" {
+		s.WriteString("\n")
+		s.WriteString(lipgloss.NewStyle().Width(contentWidth).Render(body))
+		s.WriteString("\n")
+	}
+	s.WriteString("\n")
+	s.WriteString(theme.Muted.Render("enter: open • any other key: close"))
+
+	box := theme.ModalBox.Width(boxWidth).Render(s.String())
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
+
+// PeekBody trims blank lines around body and cuts it to maxLines, marking
+// the cut with "…".
+func PeekBody(body string, maxLines int) string {
+	body = strings.Trim(body, "\n")
+	if strings.TrimSpace(body) == "" {
+		return ""
+	}
+	lines := strings.Split(body, "\n")
+	if len(lines) > maxLines {
+		lines = append(lines[:maxLines], "…")
+	}
+	return strings.Join(lines, "\n")
+}
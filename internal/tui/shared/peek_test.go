@@ -0,0 +1,38 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestPeekBody(t *testing.T) {
+	if got := PeekBody("\n\n  \n", 5); got != "" {
+		t.Errorf("blank body = %q, want empty", got)
+	}
+	if got := PeekBody("\none\ntwo\n", 5); got != "one\ntwo" {
+		t.Errorf("short body = %q", got)
+	}
+	if got := PeekBody("1\n2\n3\n4", 2); got != "1\n2\n…" {
+		t.Errorf("long body = %q", got)
+	}
+}
+
+func TestPeekModelView(t *testing.T) {
+	m := NewPeekModel("task", "Write report", []PeekField{
+		{Label: "Line", Value: "Write report due:2026-03-01"},
+		{Label: "Empty", Value: ""},
+	}, "Body text")
+	m.SetSize(100, 30)
+
+	view := ansi.Strip(m.View())
+	for _, want := range []string{"task", "Write report", "Line", "due:2026-03-01", "Body text"} {
+		if !strings.Contains(view, want) {
+			t.Errorf("view is missing %q:\n%s", want, view)
+		}
+	}
+	if strings.Contains(view, "Empty") {
+		t.Errorf("view shows a field with no value:\n%s", view)
+	}
+}
@@ -0,0 +1,121 @@
+package shared
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+// PriorityLevels is the number of priority levels: A–F for tasks, 1–6 for cards.
+const PriorityLevels = 6
+
+// defaultPriorityColors are the badge backgrounds for levels 1–6 (A–F):
+// magenta, red, orange, yellow, green, gray.
+var defaultPriorityColors = [PriorityLevels]lipgloss.Color{"5", "1", "208", "3", "2", "8"}
+
+// priorityColors is the palette every priority badge is drawn with, set once
+// at startup from config.PriorityColors.
+var priorityColors = defaultPriorityColors
+
+// SetPriorityColors replaces the default badge colors. Keys are a task
+// priority letter (A–F) or a card priority number (1–6); values are anything
+// ParseColor accepts. Invalid entries are skipped and reported in the error.
+func SetPriorityColors(colors map[string]string) error {
+	priorityColors = defaultPriorityColors
+
+	keys := make([]string, 0, len(colors))
+	for k := range colors {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var bad []string
+	for _, k := range keys {
+		level := priorityLevel(k)
+		color, ok := ParseColor(colors[k])
+		if level == 0 || !ok {
+			bad = append(bad, fmt.Sprintf("%s: %q", k, colors[k]))
+			continue
+		}
+		priorityColors[level-1] = color
+	}
+	if len(bad) > 0 {
+		return fmt.Errorf("invalid priority_colors entries: %s", strings.Join(bad, ", "))
+	}
+	return nil
+}
+
+// priorityLevel maps a priority_colors key to a level 1–6, or 0 if invalid.
+func priorityLevel(key string) int {
+	key = strings.ToUpper(strings.TrimSpace(key))
+	if len(key) == 1 && key[0] >= 'A' && key[0] < 'A'+PriorityLevels {
+		return int(key[0]-'A') + 1
+	}
+	if n, err := strconv.Atoi(key); err == nil && n >= 1 && n <= PriorityLevels {
+		return n
+	}
+	return 0
+}
+
+// PriorityStyle returns the bold badge style for priority level 1–6 (A–F).
+// Anything outside that range gets the lowest level's style.
+func PriorityStyle(level int) lipgloss.Style {
+	if level < 1 || level > PriorityLevels {
+		level = PriorityLevels
+	}
+	fg := lipgloss.Color("16")
+	if level == PriorityLevels {
+		fg = lipgloss.Color("15")
+	}
+	return lipgloss.NewStyle().Bold(true).Background(priorityColors[level-1]).Foreground(fg)
+}
+
+// PriorityLegend renders one line naming each priority color, e.g.
+// "Priority  A·1  B·2 … F·6  highest → lowest".
+func PriorityLegend() string {
+	parts := []string{theme.Muted.Render("Priority")}
+	for level := 1; level <= PriorityLevels; level++ {
+		parts = append(parts, PriorityStyle(level).Render(fmt.Sprintf(" %c·%d ", 'A'+level-1, level)))
+	}
+	parts = append(parts, theme.Muted.Render("highest → lowest"))
+	return strings.Join(parts, " ")
+}
+
+// ParseColor resolves a color setting: a color name (mapped onto the theme
+// palette), an ANSI color number or a #hex color.
+func ParseColor(spec string) (lipgloss.Color, bool) {
+	spec = strings.ToLower(strings.TrimSpace(spec))
+	switch spec {
+	case "":
+		return "", false
+	case "red":
+		return theme.Danger, true
+	case "green":
+		return theme.Success, true
+	case "yellow":
+		return theme.Warning, true
+	case "blue":
+		return theme.Primary, true
+	case "cyan":
+		return theme.Secondary, true
+	case "magenta", "purple":
+		return theme.Accent, true
+	case "orange":
+		return lipgloss.Color("208"), true
+	case "gray", "grey":
+		return theme.TextMuted, true
+	case "white":
+		return theme.TextBright, true
+	}
+	if strings.HasPrefix(spec, "#") && (len(spec) == 4 || len(spec) == 7) {
+		return lipgloss.Color(spec), true
+	}
+	if n, err := strconv.Atoi(spec); err == nil && n >= 0 && n <= 255 {
+		return lipgloss.Color(spec), true
+	}
+	return "", false
+}
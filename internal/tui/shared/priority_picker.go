@@ -0,0 +1,114 @@
+package shared
+
+import (
+	"fmt"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tui/theme"
+)
+
+// PriorityPickerModel sets a priority level 1–6 with a single key: the task
+// letter (a–f) or the card number (1–6) of the level. 0 or backspace clears
+// it. The badge is drawn in the level's color as it changes, so tasks and
+// cards share one widget and one palette.
+type PriorityPickerModel struct {
+	title  string
+	level  int    // 0 = no priority
+	err    string // the last key was not a priority
+	width  int
+	height int
+}
+
+// NewPriorityPickerModel starts at level (0 for none). A card level above 6
+// is kept as it is until a key changes it, so enter doesn't lose it.
+func NewPriorityPickerModel(level int, title string) PriorityPickerModel {
+	if level < 0 {
+		level = 0
+	}
+	return PriorityPickerModel{title: title, level: level}
+}
+
+// SetSize sets the screen size the picker is centered in.
+func (m *PriorityPickerModel) SetSize(width, height int) {
+	m.width = width
+	m.height = height
+}
+
+// Update returns done=true on enter or esc; confirmed is true only for enter.
+func (m PriorityPickerModel) Update(msg tea.KeyMsg) (model PriorityPickerModel, done, confirmed bool) {
+	m.err = ""
+	key := msg.String()
+	switch key {
+	case "esc":
+		return m, true, false
+	case "enter":
+		return m, true, true
+	case "0", "backspace", "delete":
+		m.level = 0
+		return m, false, false
+	case "j", "down", "l", "right":
+		// Toward lower priorities, starting from the highest
+		if m.level < PriorityLevels {
+			m.level++
+		}
+		return m, false, false
+	case "k", "up", "h", "left":
+		if m.level > PriorityLevels {
+			m.level = PriorityLevels
+		} else if m.level > 1 {
+			m.level--
+		}
+		return m, false, false
+	}
+
+	if level := priorityLevel(key); level > 0 {
+		m.level = level
+	} else if len(msg.Runes) == 1 {
+		m.err = fmt.Sprintf("%q is not a priority: use a–f or 1–%d", key, PriorityLevels)
+	}
+	return m, false, false
+}
+
+// Level returns the chosen level 1–6, or 0 for no priority. An untouched
+// level above 6 is returned as it was.
+func (m PriorityPickerModel) Level() int {
+	return m.level
+}
+
+func (m PriorityPickerModel) View() string {
+	var lines []string
+	lines = append(lines, theme.ModalTitle.Render(m.title))
+	lines = append(lines, "")
+
+	badges := make([]string, 0, PriorityLevels)
+	for level := 1; level <= PriorityLevels; level++ {
+		label := fmt.Sprintf(" %c·%d ", 'A'+level-1, level)
+		if level == m.level {
+			badges = append(badges, PriorityStyle(level).Render(label))
+		} else {
+			badges = append(badges, theme.Muted.Render(label))
+		}
+	}
+	lines = append(lines, strings.Join(badges, " "))
+	lines = append(lines, "")
+
+	if m.level > PriorityLevels {
+		lines = append(lines, theme.Muted.Render(fmt.Sprintf("Priority: card %d (kept)", m.level)))
+	} else if m.level > 0 {
+		lines = append(lines, "Priority: "+PriorityStyle(m.level).Render(fmt.Sprintf(" %c ", 'A'+m.level-1))+
+			theme.Muted.Render(fmt.Sprintf("  card %d", m.level)))
+	} else {
+		lines = append(lines, theme.Muted.Render("Priority: (none)"))
+	}
+	if m.err != "" {
+		lines = append(lines, theme.Error.Render(m.err))
+	}
+
+	lines = append(lines, "")
+	lines = append(lines, theme.ModalHelp.Render("a-f/1-6: set • j/k: lower/higher • 0/backspace: clear • enter: save • esc: cancel"))
+
+	box := theme.ModalBox.Width(ModalWidth(60, m.width)).Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, box)
+}
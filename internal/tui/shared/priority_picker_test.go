@@ -0,0 +1,77 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pressPriority(m PriorityPickerModel, key string) (PriorityPickerModel, bool, bool) {
+	switch key {
+	case "enter":
+		return m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	case "esc":
+		return m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	case "backspace":
+		return m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	}
+	return m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+}
+
+func TestPriorityPicker_LettersAndNumbersSetTheSameLevel(t *testing.T) {
+	cases := map[string]int{"a": 1, "C": 3, "f": 6, "2": 2, "6": 6, "0": 0, "backspace": 0}
+	for key, want := range cases {
+		m := NewPriorityPickerModel(4, "Priority")
+		m, done, _ := pressPriority(m, key)
+		if done {
+			t.Errorf("%q closed the picker", key)
+		}
+		if m.Level() != want {
+			t.Errorf("%q: level = %d, want %d", key, m.Level(), want)
+		}
+	}
+}
+
+func TestPriorityPicker_RejectsOutOfRangeKeys(t *testing.T) {
+	m := NewPriorityPickerModel(2, "Priority")
+	for _, key := range []string{"7", "g", "z"} {
+		m, _, _ = pressPriority(m, key)
+		if m.Level() != 2 {
+			t.Errorf("%q changed the level to %d", key, m.Level())
+		}
+		if !strings.Contains(m.View(), "is not a priority") {
+			t.Errorf("%q: expected a validation message", key)
+		}
+	}
+	if m, _, _ = pressPriority(m, "b"); strings.Contains(m.View(), "is not a priority") {
+		t.Error("expected a valid key to clear the validation message")
+	}
+}
+
+func TestPriorityPicker_KeepsLevelsAboveSix(t *testing.T) {
+	m := NewPriorityPickerModel(9, "Priority")
+	if m.Level() != 9 {
+		t.Errorf("start level = %d, want 9 kept", m.Level())
+	}
+	if m, done, confirmed := pressPriority(m, "enter"); !done || !confirmed || m.Level() != 9 {
+		t.Errorf("enter saved level %d, want 9", m.Level())
+	}
+	if m, _, _ = pressPriority(m, "k"); m.Level() != PriorityLevels {
+		t.Errorf("k from 9 = %d, want %d", m.Level(), PriorityLevels)
+	}
+}
+
+func TestPriorityPicker_ConfirmAndCancel(t *testing.T) {
+	m := NewPriorityPickerModel(0, "Priority")
+	m, _, _ = pressPriority(m, "j")
+	if m.Level() != 1 {
+		t.Errorf("j from none = %d, want 1", m.Level())
+	}
+	if _, done, confirmed := pressPriority(m, "enter"); !done || !confirmed {
+		t.Error("enter should confirm")
+	}
+	if _, done, confirmed := pressPriority(m, "esc"); !done || confirmed {
+		t.Error("esc should cancel")
+	}
+}
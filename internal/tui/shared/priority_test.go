@@ -0,0 +1,58 @@
+package shared
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tui/theme"
+)
+
+func TestSetPriorityColors(t *testing.T) {
+	defer SetPriorityColors(nil)
+
+	err := SetPriorityColors(map[string]string{
+		"A":  "red",
+		"2":  "#00ff00",
+		"f":  "33",
+		"G":  "blue",
+		"3":  "chartreuse",
+		"10": "red",
+	})
+	if err == nil {
+		t.Fatal("expected an error for the invalid entries")
+	}
+	for _, key := range []string{"G", "3", "10"} {
+		if !strings.Contains(err.Error(), key+":") {
+			t.Errorf("error %q doesn't mention %s", err, key)
+		}
+	}
+
+	want := map[int]lipgloss.Color{1: theme.Danger, 2: "#00ff00", 3: "208", 6: "33"}
+	for level, color := range want {
+		if got := PriorityStyle(level).GetBackground(); got != color {
+			t.Errorf("level %d background = %v, want %v", level, got, color)
+		}
+	}
+
+	// Tasks and cards draw from the same palette
+	if got := taskPriorityStyle(data.PriorityB).GetBackground(); got != lipgloss.Color("#00ff00") {
+		t.Errorf("task priority B background = %v, want #00ff00", got)
+	}
+
+	if err := SetPriorityColors(nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := PriorityStyle(1).GetBackground(); got != lipgloss.Color("5") {
+		t.Errorf("reset level 1 background = %v, want 5", got)
+	}
+}
+
+func TestPriorityStyleOutOfRange(t *testing.T) {
+	for _, level := range []int{0, 7, -1} {
+		if got := PriorityStyle(level).GetBackground(); got != PriorityStyle(PriorityLevels).GetBackground() {
+			t.Errorf("level %d background = %v, want the lowest level's", level, got)
+		}
+	}
+}
@@ -3,19 +3,31 @@ package shared
 import (
 	"fmt"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/clock"
 	"wydo/internal/tasks/data"
 	"wydo/internal/tui/theme"
 )
 
+// MyDayGlyph marks tasks and cards picked for today's My Day list.
+const MyDayGlyph = "☀"
+
 // StyledTaskLine renders a task in a simple, readable format.
 // Format: [x] (A) Name +project @context due:date
 func StyledTaskLine(t data.Task) string {
 	var parts []string
 
+	// Done tasks, and tasks whose start: date is still ahead, are drawn
+	// in a single faded color
+	faded, fadedStyle := t.Done, theme.Done
+	if !t.Done && t.NotStarted(clock.Now()) {
+		faded, fadedStyle = true, theme.Muted
+	}
+
 	// Status checkbox
 	if t.Done {
 		parts = append(parts, theme.Done.Render("[x]"))
@@ -25,8 +37,8 @@ func StyledTaskLine(t data.Task) string {
 
 	// Priority
 	if t.Priority != 0 {
-		if t.Done {
-			parts = append(parts, theme.Done.Render("("+string(t.Priority)+")"))
+		if faded {
+			parts = append(parts, fadedStyle.Render("("+string(t.Priority)+")"))
 		} else {
 			parts = append(parts, taskPriorityStyle(t.Priority).Render("("+string(t.Priority)+")"))
 		}
@@ -34,8 +46,8 @@ func StyledTaskLine(t data.Task) string {
 
 	// Name
 	if t.Name != "" {
-		if t.Done {
-			parts = append(parts, theme.Done.Render(t.Name))
+		if faded {
+			parts = append(parts, fadedStyle.Render(t.Name))
 		} else {
 			parts = append(parts, t.Name)
 		}
@@ -43,8 +55,8 @@ func StyledTaskLine(t data.Task) string {
 
 	// Projects
 	for _, p := range t.Projects {
-		if t.Done {
-			parts = append(parts, theme.Done.Render("+"+p))
+		if faded {
+			parts = append(parts, fadedStyle.Render("+"+p))
 		} else {
 			parts = append(parts, theme.Project.Render("+"+p))
 		}
@@ -52,8 +64,8 @@ func StyledTaskLine(t data.Task) string {
 
 	// Contexts
 	for _, c := range t.Contexts {
-		if t.Done {
-			parts = append(parts, theme.Done.Render("@"+c))
+		if faded {
+			parts = append(parts, fadedStyle.Render("@"+c))
 		} else {
 			parts = append(parts, theme.Context.Render("@"+c))
 		}
@@ -65,21 +77,36 @@ func StyledTaskLine(t data.Task) string {
 		tagKeys = append(tagKeys, k)
 	}
 	sort.Strings(tagKeys)
+	urlShown := false
 	for _, k := range tagKeys {
 		v := t.Tags[k]
-		switch k {
-		case "url":
-			if t.Done {
-				parts = append(parts, theme.Done.Render("↗"))
-			} else {
-				parts = append(parts, theme.Tag.Render("↗"))
+		switch {
+		case data.IsURLTag(k):
+			// One marker for all URLs, with a count when there are several
+			if urlShown {
+				continue
+			}
+			urlShown = true
+			urls := t.GetURLs()
+			marker := "↗"
+			if len(urls) > 1 {
+				marker += strconv.Itoa(len(urls))
+			}
+			style := theme.Tag
+			if faded {
+				style = fadedStyle
+			}
+			link := ""
+			if len(urls) > 0 {
+				link = urls[0]
 			}
-		case "due", "scheduled":
-			parts = append(parts, renderDateTag(k, v, t.Done))
+			parts = append(parts, Hyperlink(link, style.Render(marker)))
+		case k == "due" || k == "scheduled" || k == "start":
+			parts = append(parts, renderDateTag(k, v, faded, fadedStyle))
 		default:
 			formatted := k + ":" + data.FormatTagValue(v)
-			if t.Done {
-				parts = append(parts, theme.Done.Render(formatted))
+			if faded {
+				parts = append(parts, fadedStyle.Render(formatted))
 			} else {
 				parts = append(parts, theme.Tag.Render(formatted))
 			}
@@ -97,71 +124,33 @@ func AgendaPriorityBadge(p data.Priority) string {
 
 // taskPriorityStyle returns a background-badge style for a todo.txt priority (A–F).
 func taskPriorityStyle(p data.Priority) lipgloss.Style {
-	var bg, fg lipgloss.Color
-	switch p {
-	case data.PriorityA:
-		bg, fg = lipgloss.Color("5"), lipgloss.Color("16")
-	case data.PriorityB:
-		bg, fg = lipgloss.Color("1"), lipgloss.Color("16")
-	case data.PriorityC:
-		bg, fg = lipgloss.Color("208"), lipgloss.Color("16")
-	case data.PriorityD:
-		bg, fg = lipgloss.Color("3"), lipgloss.Color("16")
-	case data.PriorityE:
-		bg, fg = lipgloss.Color("2"), lipgloss.Color("16")
-	default: // F and beyond
-		bg, fg = lipgloss.Color("8"), lipgloss.Color("15")
-	}
-	return lipgloss.NewStyle().Bold(true).Background(bg).Foreground(fg)
+	return PriorityStyle(int(p-data.PriorityA) + 1)
 }
 
-func renderDateTag(key, value string, done bool) string {
+func renderDateTag(key, value string, faded bool, fadedStyle lipgloss.Style) string {
 	prefix := "D"
-	if key == "scheduled" {
+	switch key {
+	case "scheduled":
 		prefix = "S"
+	case "start":
+		prefix = "St"
 	}
 
 	date, err := time.Parse("2006-01-02", value)
 	if err != nil {
 		// Fall back to existing key:value rendering
 		formatted := key + ":" + data.FormatTagValue(value)
-		if done {
-			return theme.Done.Render(formatted)
+		if faded {
+			return fadedStyle.Render(formatted)
 		}
 		return theme.Tag.Render(formatted)
 	}
 
-	now := time.Now()
-	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
-	targetDate := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
-	daysUntil := int(targetDate.Sub(today).Hours() / 24)
-
-	var relStr string
-	switch {
-	case daysUntil == 0:
-		relStr = "0d"
-	case daysUntil > 0:
-		relStr = fmt.Sprintf("+%dd", daysUntil)
-	default:
-		relStr = fmt.Sprintf("%dd", daysUntil)
-	}
-
-	label := fmt.Sprintf("%s:%s %s", prefix, date.Format("01-02"), relStr)
+	daysUntil := DaysUntil(date, clock.Now())
+	label := fmt.Sprintf("%s:%s %s", prefix, date.Format("01-02"), Countdown(daysUntil))
 
-	if done {
-		return theme.Done.Render(label)
+	if faded {
+		return fadedStyle.Render(label)
 	}
-
-	var style lipgloss.Style
-	if daysUntil > 7 {
-		style = lipgloss.NewStyle().Foreground(theme.Success)
-	} else if daysUntil > 0 {
-		style = lipgloss.NewStyle().Foreground(theme.Warning)
-	} else if daysUntil == 0 {
-		style = lipgloss.NewStyle().Foreground(theme.Primary)
-	} else {
-		style = lipgloss.NewStyle().Foreground(theme.Danger)
-	}
-
-	return style.Render(label)
+	return lipgloss.NewStyle().Foreground(CountdownColor(daysUntil)).Render(label)
 }
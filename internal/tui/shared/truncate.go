@@ -0,0 +1,16 @@
+package shared
+
+import "github.com/charmbracelet/x/ansi"
+
+// Truncate shortens s to at most width terminal cells, ending it with "..."
+// when it had to be cut. Widths are display cells, not bytes, so emoji and
+// CJK characters count as two and are never split.
+func Truncate(s string, width int) string {
+	if ansi.StringWidth(s) <= width {
+		return s
+	}
+	if width <= 3 {
+		return ansi.Truncate(s, max(width, 0), "")
+	}
+	return ansi.Truncate(s, width, "...")
+}
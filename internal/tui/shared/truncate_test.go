@@ -0,0 +1,31 @@
+package shared
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		in    string
+		width int
+		want  string
+	}{
+		{"short", 10, "short"},
+		{"hello world", 8, "hello..."},
+		{"日本語のタイトル", 8, "日本..."},
+		{"🚀 launch rocket", 8, "🚀 la..."},
+		{"abcdef", 3, "abc"},
+		{"🚀🚀", 3, "🚀"},
+	}
+	for _, tt := range tests {
+		got := Truncate(tt.in, tt.width)
+		if got != tt.want {
+			t.Errorf("Truncate(%q, %d) = %q, want %q", tt.in, tt.width, got, tt.want)
+		}
+		if w := ansi.StringWidth(got); w > tt.width {
+			t.Errorf("Truncate(%q, %d) is %d cells wide", tt.in, tt.width, w)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package tasks
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tui/shared"
+)
+
+// TasksUpdateMsg is sent when several tasks are updated at once, to write
+// them in one pass
+type TasksUpdateMsg struct {
+	Tasks   []data.Task
+	Summary string // reported once they are written
+}
+
+// bulkScheduleTasks returns the pending tasks shown, which ctrl+s schedules.
+func (m *TaskManagerModel) bulkScheduleTasks() []data.Task {
+	var tasks []data.Task
+	for _, task := range m.displayTasks {
+		if !task.Done {
+			tasks = append(tasks, task)
+		}
+	}
+	return tasks
+}
+
+// startBulkSchedule opens a date picker for the scheduled date of every
+// pending task the filters leave shown.
+func (m TaskManagerModel) startBulkSchedule() (TaskManagerModel, tea.Cmd) {
+	n := len(m.bulkScheduleTasks())
+	if n == 0 {
+		return m, tea.Printf("No pending tasks shown to schedule")
+	}
+	m.inputContext.TransitionTo(ModeBulkSchedule)
+	dp := shared.NewDatePickerModel(nil, fmt.Sprintf("Schedule %d Shown Tasks", n))
+	dp.SetSize(m.width, m.height)
+	m.datePicker = &dp
+	return m, dp.Init()
+}
+
+// confirmBulkSchedule asks before setting date ("" clears it) as the
+// scheduled date of the pending tasks shown.
+func (m TaskManagerModel) confirmBulkSchedule(date string) (TaskManagerModel, tea.Cmd) {
+	tasks := m.bulkScheduleTasks()
+	names := make([]string, 0, len(tasks))
+	for i := range tasks {
+		tasks[i].Tags = maps.Clone(tasks[i].Tags)
+		tasks[i].SetScheduledDate(date)
+		names = append(names, tasks[i].Name)
+	}
+	if len(names) > 5 {
+		names = append(names[:5], fmt.Sprintf("…and %d more", len(tasks)-5))
+	}
+
+	question := fmt.Sprintf("Clear the scheduled date of %d task(s)?", len(tasks))
+	summary := fmt.Sprintf("Cleared the scheduled date of %d task(s)", len(tasks))
+	if date != "" {
+		question = fmt.Sprintf("Schedule %d task(s) for %s?", len(tasks), date)
+		summary = fmt.Sprintf("Scheduled %d task(s) for %s", len(tasks), date)
+	}
+	m.pendingBulkUpdate = &TasksUpdateMsg{Tasks: tasks, Summary: summary}
+	m.confirmationModal = NewConfirmationModal(question, strings.Join(names, "\n"), 50)
+	m.inputContext.TransitionTo(ModeConfirmation)
+	return m, nil
+}
@@ -0,0 +1,67 @@
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestBulkSchedule_FilteredTasks(t *testing.T) {
+	golden.FixClock(t) // Wednesday 2026-03-04
+	dir := t.TempDir()
+	todo := filepath.Join(dir, "todo.txt")
+	lines := "Buy milk +errands\nPost parcel +errands\nx 2026-03-01 Return books +errands\nWrite report +work\n"
+	if err := os.WriteFile(todo, []byte(lines), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewTaskManagerModel(svc, []string{dir}, nil, nil)
+	m.SetSize(100, 30)
+	m.filterState.ProjectFilter = []string{"errands"}
+	m.refreshDisplayTasks()
+
+	send := func(msg tea.Msg) tea.Cmd {
+		var cmd tea.Cmd
+		m, cmd = m.Update(msg)
+		return cmd
+	}
+	send(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if m.inputContext.Mode != ModeBulkSchedule || m.datePicker == nil {
+		t.Fatalf("expected ctrl+s to open the date picker, mode %s", m.inputContext.String())
+	}
+	for range 3 {
+		send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("l")})
+	}
+	send(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.confirmationModal == nil || m.confirmationModal.Message != "Schedule 2 task(s) for 2026-03-07?" {
+		t.Fatalf("expected a confirmation counting the pending errands, got %+v", m.confirmationModal)
+	}
+
+	// Confirming hands all the changes to the app in one message
+	result := send(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})()
+	update, ok := send(result)().(TasksUpdateMsg)
+	if !ok || len(update.Tasks) != 2 {
+		t.Fatalf("expected a TasksUpdateMsg of 2 tasks, got %+v", update)
+	}
+	if err := svc.UpdateAll(update.Tasks); err != nil {
+		t.Fatal(err)
+	}
+	content, _ := os.ReadFile(todo)
+	for _, want := range []string{"Buy milk +errands scheduled:2026-03-07", "Post parcel +errands scheduled:2026-03-07", "Write report +work\n"} {
+		if !strings.Contains(string(content), want) {
+			t.Errorf("todo.txt missing %q:\n%s", want, content)
+		}
+	}
+	if strings.Contains(string(content), "Return books +errands scheduled") {
+		t.Errorf("expected the done task left alone:\n%s", content)
+	}
+}
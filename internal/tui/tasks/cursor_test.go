@@ -0,0 +1,40 @@
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestSetData_KeepsCursorOnTask(t *testing.T) {
+	dir := t.TempDir()
+	todo := filepath.Join(dir, "todo.txt")
+	if err := os.WriteFile(todo, []byte("(B) Second\n(C) Third\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewTaskManagerModel(svc, []string{dir}, nil, nil)
+	m.SetSize(80, 20)
+	m.cursor = 1
+	if got := m.displayTasks[m.cursor].Name; got != "Third" {
+		t.Fatalf("selected %q, want Third", got)
+	}
+
+	if err := os.WriteFile(todo, []byte("(A) First\n(B) Second\n(C) Third\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err = service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetData(svc)
+	if got := m.displayTasks[m.cursor].Name; got != "Third" {
+		t.Errorf("after reload selected %q, want the cursor kept on Third", got)
+	}
+}
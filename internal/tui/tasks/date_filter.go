@@ -0,0 +1,20 @@
+package tasks
+
+// dateFilterCustom and dateFilterClear are the date filter menu entries that
+// aren't expressions.
+const (
+	dateFilterCustom = "custom…"
+	dateFilterClear  = "clear"
+)
+
+// dateFilterPresets are the expressions offered by the date filter menu.
+var dateFilterPresets = []string{
+	"today",
+	"tomorrow",
+	"overdue",
+	"this-week",
+	"next-week",
+	"this-month",
+	"<+7d",
+	"no-date",
+}
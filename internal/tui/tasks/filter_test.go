@@ -1,9 +1,12 @@
 package tasks
 
 import (
+	"reflect"
 	"testing"
+	"time"
 
 	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/filter"
 )
 
 func makeTasks() []data.Task {
@@ -54,8 +57,8 @@ func TestFileViewDoneOnly_ExcludesPending(t *testing.T) {
 
 func TestStatusFilterDone_WithFileViewAll_ShowsDoneTasks(t *testing.T) {
 	tasks := makeTasks()
-	state := FilterState{StatusFilter: StatusDone}
-	filtered := ApplyFilters(tasks, state)
+	state := filter.State{StatusFilter: filter.StatusDone}
+	filtered := filter.Apply(tasks, state)
 
 	m := &TaskManagerModel{fileViewMode: FileViewAll}
 	result := m.applyFileViewFilter(filtered)
@@ -72,8 +75,8 @@ func TestStatusFilterDone_WithFileViewAll_ShowsDoneTasks(t *testing.T) {
 
 func TestStatusFilterDone_WithFileViewTodoOnly_ShowsNothing(t *testing.T) {
 	tasks := makeTasks()
-	state := FilterState{StatusFilter: StatusDone}
-	filtered := ApplyFilters(tasks, state)
+	state := filter.State{StatusFilter: filter.StatusDone}
+	filtered := filter.Apply(tasks, state)
 
 	m := &TaskManagerModel{fileViewMode: FileViewTodoOnly}
 	result := m.applyFileViewFilter(filtered)
@@ -82,3 +85,41 @@ func TestStatusFilterDone_WithFileViewTodoOnly_ShowsNothing(t *testing.T) {
 		t.Errorf("StatusDone + FileViewTodoOnly: expected 0 tasks, got %d", len(result))
 	}
 }
+
+func TestAgeBucket(t *testing.T) {
+	// Thursday 2026-07-16; the week started Monday 2026-07-13
+	now := time.Date(2026, 7, 16, 15, 0, 0, 0, time.Local)
+	cases := map[string]string{
+		"2026-07-16": "today",
+		"2026-07-13": "this week",
+		"2026-07-12": "this month",
+		"2026-06-30": "earlier",
+		"":           "",
+	}
+	for created, want := range cases {
+		if got := ageBucket(created, now); got != want {
+			t.Errorf("ageBucket(%q) = %q, want %q", created, got, want)
+		}
+	}
+}
+
+func TestSortAndGroupByCreated(t *testing.T) {
+	tasks := []data.Task{
+		{ID: "1", Name: "undated"},
+		{ID: "2", Name: "new", CreatedDate: time.Now().Format("2006-01-02")},
+		{ID: "3", Name: "old", CreatedDate: "2001-01-01"},
+	}
+	sorted := ApplySort(tasks, SortState{Field: SortByCreated, Ascending: true})
+	if sorted[0].Name != "old" || sorted[1].Name != "new" || sorted[2].Name != "undated" {
+		t.Errorf("unexpected order: %v, %v, %v", sorted[0].Name, sorted[1].Name, sorted[2].Name)
+	}
+
+	groups := ApplyGroups(tasks, GroupState{Field: GroupByCreated, Ascending: true}, nil)
+	var labels []string
+	for _, g := range groups {
+		labels = append(labels, g.Label)
+	}
+	if want := []string{"earlier", "today", "(none)"}; !reflect.DeepEqual(labels, want) {
+		t.Errorf("expected groups %v, got %v", want, labels)
+	}
+}
@@ -1,6 +1,8 @@
 package tasks
 
 import (
+	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/charmbracelet/bubbles/textinput"
@@ -11,13 +13,19 @@ import (
 
 var (
 	pickerTitleStyle    = theme.Title
-	pickerItemStyle     = lipgloss.NewStyle().PaddingLeft(2)
-	pickerSelectedStyle = lipgloss.NewStyle().Bold(true).Foreground(theme.Success).PaddingLeft(0)
+	pickerItemStyle     = lipgloss.NewStyle()
+	pickerSelectedStyle = theme.Selected
 	pickerCheckedStyle  = lipgloss.NewStyle().Foreground(theme.Secondary)
 	pickerCreateStyle   = lipgloss.NewStyle().Foreground(theme.Warning).Italic(true).PaddingLeft(2)
 	pickerBoxStyle      = theme.ModalBox.Padding(0, 1)
+	pickerCountStyle    = theme.Muted
+	pickerChipStyle     = lipgloss.NewStyle().Foreground(theme.SelectionFg).Background(theme.Surface).Padding(0, 1)
 )
 
+// maxPickerColumns caps how many columns a wide terminal gets; past that the
+// rows get too long to scan.
+const maxPickerColumns = 3
+
 // FuzzyPickerModel is a fuzzy-searchable list picker
 type FuzzyPickerModel struct {
 	Items       []string
@@ -29,7 +37,9 @@ type FuzzyPickerModel struct {
 	AllowCreate bool
 	Title       string
 	Width       int
-	MaxVisible  int
+	MaxVisible  int            // rows shown at once
+	Counts      map[string]int // usage count shown beside each item, if any
+	termWidth   int            // terminal width, for laying items out in columns
 	textInput   textinput.Model
 	filterMode  bool // true when actively typing filter
 }
@@ -62,6 +72,12 @@ func NewFuzzyPicker(items []string, title string, multiSelect bool, allowCreate
 	}
 }
 
+// SetWidth sets the terminal width. A wide terminal shows long lists in
+// several columns.
+func (m *FuzzyPickerModel) SetWidth(width int) {
+	m.termWidth = width
+}
+
 // Init implements tea.Model
 func (m *FuzzyPickerModel) Init() tea.Cmd {
 	return nil
@@ -123,17 +139,29 @@ func (m *FuzzyPickerModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 
 		case "up", "k":
-			if m.Cursor > 0 {
-				m.Cursor--
+			if m.Cursor >= m.columns() {
+				m.Cursor -= m.columns()
 			}
 			return m, nil
 
 		case "down", "j":
-			maxIdx := len(m.Filtered) - 1
-			if m.AllowCreate && m.Query != "" && !m.itemExists(m.Query) {
-				maxIdx++
+			maxIdx := m.maxIndex()
+			if m.Cursor+m.columns() <= maxIdx {
+				m.Cursor += m.columns()
+			} else if m.Cursor < maxIdx {
+				// Last row: step onto the create entry, or the last item
+				m.Cursor = maxIdx
 			}
-			if m.Cursor < maxIdx {
+			return m, nil
+
+		case "left", "h":
+			if m.Cursor > 0 && m.columns() > 1 {
+				m.Cursor--
+			}
+			return m, nil
+
+		case "right", "l":
+			if m.Cursor < m.maxIndex() && m.columns() > 1 {
 				m.Cursor++
 			}
 			return m, nil
@@ -156,15 +184,26 @@ func (m *FuzzyPickerModel) View() string {
 	content += pickerTitleStyle.Render(m.Title) + "\n"
 	content += m.textInput.View() + "\n\n"
 
-	startIdx := 0
-	if m.Cursor >= m.MaxVisible {
-		startIdx = m.Cursor - m.MaxVisible + 1
+	if chips := m.renderChips(); chips != "" {
+		content += chips + "\n\n"
 	}
 
-	for i := startIdx; i < len(m.Filtered) && i < startIdx+m.MaxVisible; i++ {
-		item := m.Filtered[i]
-		line := m.renderItem(item, i == m.Cursor, m.Selected[item])
-		content += line + "\n"
+	cols, cellWidth := m.columns(), m.cellWidth()
+	startRow := 0
+	if row := m.Cursor / cols; row >= m.MaxVisible {
+		startRow = row - m.MaxVisible + 1
+	}
+	for row := startRow; row < startRow+m.MaxVisible && row*cols < len(m.Filtered); row++ {
+		var cells []string
+		for i := row * cols; i < (row+1)*cols && i < len(m.Filtered); i++ {
+			item := m.Filtered[i]
+			cell := m.renderItem(item, i == m.Cursor, m.Selected[item])
+			if cols > 1 {
+				cell += strings.Repeat(" ", max(cellWidth-lipgloss.Width(cell), 0))
+			}
+			cells = append(cells, cell)
+		}
+		content += strings.TrimRight(strings.Join(cells, ""), " ") + "\n"
 	}
 
 	if m.AllowCreate && m.Query != "" && !m.itemExists(m.Query) {
@@ -206,12 +245,83 @@ func (m *FuzzyPickerModel) View() string {
 			}
 		}
 	}
+	if cols > 1 && !m.filterMode {
+		help = "[hjkl] move  " + help
+	}
 	content += "\n" + theme.Muted.Render(help)
 
-	return pickerBoxStyle.Width(m.Width).Render(content)
+	return pickerBoxStyle.Width(max(m.Width, cols*cellWidth+2)).Render(content)
+}
+
+// columns returns how many columns the items are laid out in: one, or as
+// many as fit the terminal when the list is longer than MaxVisible.
+func (m *FuzzyPickerModel) columns() int {
+	if m.termWidth == 0 || len(m.Items) <= m.MaxVisible {
+		return 1
+	}
+	// Leave room for the box border, padding and the view's margin
+	cols := (m.termWidth - 8) / m.cellWidth()
+	needed := (len(m.Items) + m.MaxVisible - 1) / m.MaxVisible
+	return max(1, min(cols, needed, maxPickerColumns))
+}
+
+// cellWidth returns the width of a column: the widest item line of all
+// items, so the layout holds still while filtering, and a gap.
+func (m *FuzzyPickerModel) cellWidth() int {
+	widest := 0
+	for _, item := range m.Items {
+		widest = max(widest, lipgloss.Width(m.renderItem(item, false, false)))
+	}
+	return widest + 2
+}
+
+// maxIndex returns the last cursor position, the create entry when shown.
+func (m *FuzzyPickerModel) maxIndex() int {
+	if m.AllowCreate && m.Query != "" && !m.itemExists(m.Query) {
+		return len(m.Filtered)
+	}
+	return len(m.Filtered) - 1
+}
+
+// renderChips renders the current selections of a multi-select picker as
+// chips, wrapped to the box, or "" when nothing is selected.
+func (m *FuzzyPickerModel) renderChips() string {
+	selected := m.GetSelected()
+	if !m.MultiSelect || len(selected) == 0 {
+		return ""
+	}
+	sort.Strings(selected)
+
+	width := max(m.Width, m.columns()*m.cellWidth()+2) - 2
+	var lines []string
+	line := ""
+	for _, item := range selected {
+		chip := pickerChipStyle.Render(item)
+		if line != "" && lipgloss.Width(line)+1+lipgloss.Width(chip) > width {
+			lines = append(lines, line)
+			line = ""
+		}
+		if line != "" {
+			line += " "
+		}
+		line += chip
+	}
+	return strings.Join(append(lines, line), "\n")
 }
 
 func (m *FuzzyPickerModel) renderItem(item string, cursor bool, checked bool) string {
+	return m.renderLabel(item, cursor, checked) + m.countSuffix(item)
+}
+
+// countSuffix returns " (n)" for an item with a usage count.
+func (m *FuzzyPickerModel) countSuffix(item string) string {
+	if n, ok := m.Counts[item]; ok {
+		return pickerCountStyle.Render(fmt.Sprintf(" (%d)", n))
+	}
+	return ""
+}
+
+func (m *FuzzyPickerModel) renderLabel(item string, cursor bool, checked bool) string {
 	prefix := "  "
 	if cursor {
 		prefix = "> "
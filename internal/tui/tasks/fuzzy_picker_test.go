@@ -0,0 +1,70 @@
+package tasks
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/golden"
+)
+
+func pickerKey(p *FuzzyPickerModel, key string) {
+	p.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(key)})
+}
+
+func TestFuzzyPicker_ColumnsOnWideTerminal(t *testing.T) {
+	var items []string
+	for i := 1; i <= 25; i++ {
+		items = append(items, fmt.Sprintf("ctx%02d", i))
+	}
+	p := NewFuzzyPicker(items, "Filter by Context", true, false)
+	if got := p.columns(); got != 1 {
+		t.Fatalf("expected one column before the width is known, got %d", got)
+	}
+
+	p.SetWidth(200)
+	if got := p.columns(); got != 3 {
+		t.Fatalf("expected 3 columns for 25 items on a wide terminal, got %d", got)
+	}
+	p.SetWidth(40)
+	if got := p.columns(); got != 2 {
+		t.Fatalf("expected 2 columns at 40 wide, got %d", got)
+	}
+
+	// j moves a row down, l a column right
+	pickerKey(p, "j")
+	pickerKey(p, "l")
+	if p.Cursor != 3 {
+		t.Errorf("expected the cursor on item 3, got %d", p.Cursor)
+	}
+	rows := strings.Split(golden.Normalize(p.View()), "\n")
+	for _, row := range rows {
+		if strings.Contains(row, "ctx01") && !strings.Contains(row, "ctx02") {
+			t.Errorf("expected ctx01 and ctx02 on one row:\n%s", strings.Join(rows, "\n"))
+		}
+	}
+}
+
+func TestFuzzyPicker_CountsAndChips(t *testing.T) {
+	p := NewFuzzyPicker([]string{"home", "work"}, "Filter by Context", true, false)
+	p.Counts = map[string]int{"home": 12, "work": 3}
+	p.PreSelect([]string{"work"})
+
+	view := golden.Normalize(p.View())
+	if !strings.Contains(view, "home (12)") || !strings.Contains(view, "work (3)") {
+		t.Errorf("expected counts beside the items:\n%s", view)
+	}
+
+	pickerKey(p, " ")
+	lines := strings.Split(golden.Normalize(p.View()), "\n")
+	var chips string
+	for _, line := range lines {
+		if strings.Contains(line, " home ") && strings.Contains(line, " work ") && !strings.Contains(line, "[") {
+			chips = line
+		}
+	}
+	if chips == "" {
+		t.Errorf("expected a line of chips for home and work:\n%s", strings.Join(lines, "\n"))
+	}
+}
@@ -1,9 +1,12 @@
 package tasks
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/tasks/filter"
+	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 )
 
@@ -18,7 +21,7 @@ var (
 // InfoBarModel displays mode, keybinds, and active filters
 type InfoBarModel struct {
 	InputContext   *InputModeContext
-	FilterState    *FilterState
+	FilterState    *filter.State
 	SortState      *SortState
 	GroupState     *GroupState
 	SearchQuery    string
@@ -26,6 +29,9 @@ type InfoBarModel struct {
 	Width          int
 	FileViewMode   FileViewMode
 	MultiWorkspace bool
+	Position       int    // 1-based cursor position shown as "15/230"; 0 hides it
+	Total          int    // number of tasks in the list
+	Count          string // pending count prefix of a motion such as 12j
 }
 
 // NewInfoBar creates a new info bar
@@ -36,9 +42,9 @@ func NewInfoBar() InfoBarModel {
 }
 
 // SetContext updates the info bar with current state
-func (m *InfoBarModel) SetContext(ctx *InputModeContext, filter *FilterState, sortState *SortState, groupState *GroupState, searchQuery string, fileViewMode FileViewMode, multiWorkspace bool) {
+func (m *InfoBarModel) SetContext(ctx *InputModeContext, state *filter.State, sortState *SortState, groupState *GroupState, searchQuery string, fileViewMode FileViewMode, multiWorkspace bool) {
 	m.InputContext = ctx
-	m.FilterState = filter
+	m.FilterState = state
 	m.SortState = sortState
 	m.GroupState = groupState
 	m.SearchQuery = searchQuery
@@ -46,6 +52,13 @@ func (m *InfoBarModel) SetContext(ctx *InputModeContext, filter *FilterState, so
 	m.MultiWorkspace = multiWorkspace
 }
 
+// SetPosition updates the list position and pending count shown on the mode line
+func (m *InfoBarModel) SetPosition(position, total int, count string) {
+	m.Position = position
+	m.Total = total
+	m.Count = count
+}
+
 // View renders the info bar (3 fixed lines)
 func (m *InfoBarModel) View() string {
 	var lines [3]string
@@ -53,16 +66,28 @@ func (m *InfoBarModel) View() string {
 	lines[0] = m.renderModeLine()
 	lines[1] = m.renderFiltersLine()
 	lines[2] = m.renderSearchLine()
+	for i := range lines {
+		// Cut rather than wrap so the bar keeps its height on narrow terminals
+		lines[i] = shared.Truncate(lines[i], m.Width)
+	}
 
 	content := strings.Join(lines[:], "\n")
 	return infoBarStyle.Width(m.Width).Render(content)
 }
 
 func (m *InfoBarModel) renderModeLine() string {
-	if m.InputContext == nil {
-		return modeStyle.Render("[Normal]")
+	mode := "Normal"
+	if m.InputContext != nil {
+		mode = m.InputContext.String()
 	}
-	return modeStyle.Render("[" + m.InputContext.String() + "]")
+	line := modeStyle.Render("[" + mode + "]")
+	if m.Position > 0 {
+		line += "  " + hintStyle.Render(fmt.Sprintf("%d/%d", m.Position, m.Total))
+	}
+	if m.Count != "" {
+		line += "  " + filterStyle.Render(m.Count)
+	}
+	return line
 }
 
 // RenderHints returns the styled keybind hints for the current mode.
@@ -85,26 +110,29 @@ func (m *InfoBarModel) RenderHintsRaw() string {
 		return hint
 
 	case ModeFilterSelect:
-		hint := "/:search  d:date  p:project  P:priority  t:context  s:status  f:file  esc:back"
+		hint := "/:search  d:date  p:project  P:priority  t:context  s:status  f:file  a:added this week  u:not started  esc:back"
 		if m.MultiWorkspace {
-			hint = "/:search  d:date  p:project  P:priority  t:context  s:status  f:file  w:workspace  esc:back"
+			hint = "/:search  d:date  p:project  P:priority  t:context  s:status  f:file  a:added this week  u:not started  w:workspace  esc:back"
 		}
 		return hint
 
 	case ModeSortSelect:
-		return "d:date  p:project  P:priority  t:context  esc:back"
+		return "d:date  p:project  P:priority  t:context  a:age  esc:back"
 
 	case ModeGroupSelect:
-		return "d:date  p:project  P:priority  t:context  f:file  esc:back"
+		return "d:date  p:project  P:priority  t:context  f:file  a:age  g:top  b:linked board  esc:back"
+
+	case ModeOpenSelect:
+		return "f:project directory  b:project board  esc:back"
 
 	case ModeSortDirection, ModeGroupDirection:
 		return "a:ascending  d:descending  esc:back"
 
 	case ModeSearch:
-		return "type to filter  j/k:navigate  enter:confirm  esc:clear"
+		return "type to filter  ↑/↓:history  j/k:navigate  enter:confirm  esc:clear"
 
 	case ModeDateInput:
-		return "format: yyyy-MM-dd  enter:apply  esc:cancel"
+		return "today  +3d  <+7d  >=2026-11-01  this-week  a..b  no-date  enter:apply  esc:cancel"
 
 	case ModeFuzzyPicker:
 		return "j/k:navigate  enter:select  esc:cancel"
@@ -123,6 +151,9 @@ func (m *InfoBarModel) RenderHintsRaw() string {
 
 	case ModeBoardPicker:
 		return "j/k:navigate  enter:select  esc:cancel"
+
+	case ModeOpenURL:
+		return "j/k:navigate  /:search  enter:open  esc:cancel"
 	}
 
 	return ""
@@ -146,9 +177,9 @@ func (m *InfoBarModel) renderFiltersLine() string {
 	if m.FileViewMode != FileViewTodoOnly {
 		var viewMode string
 		if m.FileViewMode == FileViewAll {
-			viewMode = "View: todo.txt + done.txt"
+			viewMode = "View: todo.txt + this year's done"
 		} else {
-			viewMode = "View: done.txt"
+			viewMode = "View: done (all years)"
 		}
 		parts = append(parts, lipgloss.NewStyle().
 			Foreground(theme.Secondary).
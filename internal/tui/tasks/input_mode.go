@@ -10,6 +10,7 @@ const (
 	ModeFilterSelect // 'f' pressed - choosing filter type
 	ModeSortSelect   // 's' pressed - choosing sort field
 	ModeGroupSelect  // 'g' pressed - choosing group field
+	ModeOpenSelect   // 'o' pressed - choosing what to open (project dir/board)
 
 	// Sub-modes for direction selection
 	ModeSortDirection  // after selecting sort field, choose asc/desc
@@ -28,6 +29,8 @@ const (
 	ModeEditContext       // 't'/'c' in editor - context picker
 	ModeEditProject       // 'p' in editor - project picker
 	ModeEditURL           // 'U' in editor - URL text input
+	ModeOpenURL           // 'u' with several URLs - picking which one to open
+	ModeAnnotate          // 'A' in editor - annotation text input
 
 	// Confirmation mode
 	ModeConfirmation // confirmation modal (e.g., archive)
@@ -37,6 +40,12 @@ const (
 
 	// Rename mode
 	ModeEditName // 'r' pressed - renaming task name
+
+	// Attach mode
+	ModeAttach // 'A' pressed - entering the path of a file to attach
+
+	// Bulk schedule mode
+	ModeBulkSchedule // ctrl+s pressed - picking the scheduled date of all shown tasks
 )
 
 // InputModeContext holds the current mode and related context
@@ -80,7 +89,8 @@ func (c *InputModeContext) IsGroupMode() bool {
 func (c *InputModeContext) IsEditorMode() bool {
 	return c.Mode == ModeTaskEditor || c.Mode == ModeEditDueDate ||
 		c.Mode == ModeEditScheduledDate || c.Mode == ModeEditContext ||
-		c.Mode == ModeEditProject || c.Mode == ModeEditURL
+		c.Mode == ModeEditProject || c.Mode == ModeEditURL ||
+		c.Mode == ModeAnnotate || c.Mode == ModeOpenURL
 }
 
 // TransitionTo moves to a new mode, preserving the previous mode
@@ -115,6 +125,8 @@ func (c *InputModeContext) String() string {
 		return "Sort"
 	case ModeGroupSelect:
 		return "Group"
+	case ModeOpenSelect:
+		return "Open"
 	case ModeSortDirection, ModeGroupDirection:
 		return "Direction"
 	case ModeSearch:
@@ -135,6 +147,10 @@ func (c *InputModeContext) String() string {
 		return "Edit Project"
 	case ModeEditURL:
 		return "Edit URL"
+	case ModeOpenURL:
+		return "Open URL"
+	case ModeAnnotate:
+		return "Annotate"
 	case ModeConfirmation:
 		return "Confirmation"
 	case ModeCreateTask:
@@ -143,6 +159,10 @@ func (c *InputModeContext) String() string {
 		return "Move to Board"
 	case ModeEditName:
 		return "Rename"
+	case ModeAttach:
+		return "Attach"
+	case ModeBulkSchedule:
+		return "Schedule Shown"
 	default:
 		return "Unknown"
 	}
@@ -0,0 +1,44 @@
+package tasks
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestTaskManagerView_MinimumSize(t *testing.T) {
+	dir := t.TempDir()
+	var todo strings.Builder
+	for i := range 30 {
+		fmt.Fprintf(&todo, "(A) A task with a long description to cut at narrow widths %d +project @context\n", i)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte(todo.String()), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewTaskManagerModel(svc, []string{dir}, nil, nil)
+
+	m.SetSize(MinWidth, MinHeight)
+	view := m.View()
+	if w, h := lipgloss.Width(view), lipgloss.Height(view); w > MinWidth || h > MinHeight {
+		t.Errorf("task list is %dx%d at its minimum %dx%d:\n%s", w, h, MinWidth, MinHeight, view)
+	}
+	if !strings.Contains(view, "> ") {
+		t.Errorf("expected the cursor row to stay visible:\n%s", view)
+	}
+
+	// Below the minimum the app shows a placeholder, but the math must not panic
+	for _, size := range [][2]int{{0, 0}, {1, 1}, {MinWidth - 1, MinHeight - 1}} {
+		m.SetSize(size[0], size[1])
+		_ = m.View()
+	}
+}
@@ -0,0 +1,60 @@
+package tasks
+
+import (
+	"fmt"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/tasks/data"
+)
+
+func pressKeys(m TaskManagerModel, keys ...string) TaskManagerModel {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "ctrl+d":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlD}
+		case "ctrl+u":
+			msg = tea.KeyMsg{Type: tea.KeyCtrlU}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		if m.inputContext.Mode == ModeGroupSelect {
+			m, _ = m.handleGroupSelect(msg)
+		} else {
+			m, _ = m.handleNormalMode(msg)
+		}
+	}
+	return m
+}
+
+func TestTaskManagerMotions(t *testing.T) {
+	m := TaskManagerModel{height: 26} // 20 visible rows
+	for i := range 100 {
+		m.displayTasks = append(m.displayTasks, data.Task{ID: fmt.Sprint(i), Name: fmt.Sprintf("task %d", i)})
+	}
+
+	cases := []struct {
+		keys []string
+		want int
+	}{
+		{[]string{"j"}, 1},
+		{[]string{"1", "2", "j"}, 12},
+		{[]string{"G"}, 99},
+		{[]string{"G", "g", "g"}, 0},
+		{[]string{"4", "2", "G"}, 41},
+		{[]string{"ctrl+d"}, 10},
+		{[]string{"ctrl+d", "ctrl+d", "ctrl+u"}, 10},
+		{[]string{"2", "0", "0", "j"}, 99},
+		{[]string{"0", "j"}, 1},
+	}
+	for _, c := range cases {
+		got := pressKeys(m, c.keys...)
+		if got.cursor != c.want {
+			t.Errorf("%v: cursor = %d, want %d", c.keys, got.cursor, c.want)
+		}
+		if got.count != "" {
+			t.Errorf("%v: count %q left pending", c.keys, got.count)
+		}
+	}
+}
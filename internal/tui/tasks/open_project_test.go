@@ -0,0 +1,82 @@
+package tasks
+
+import (
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/tasks/data"
+	kanbanview "wydo/internal/tui/kanban"
+)
+
+func TestTaskProjectBoards_FollowsProjectDirectories(t *testing.T) {
+	m := TaskManagerModel{
+		allProjectItems: []kanbanview.ProjectPickerItem{
+			{Name: "wydo", DirPath: "/ws/projects/wydo"},
+			{Name: "home"}, // virtual: only a +tag, no directory
+		},
+		boards: []kanbanmodels.Board{
+			{Name: "Wydo", Path: "/ws/boards/wydo", Project: "../../projects/wydo/wydo.md"},
+			{Name: "Old", Path: "/ws/boards/old", Project: "../../projects/wydo/wydo.md", Archived: true},
+			{Name: "Other", Path: "/ws/boards/other"},
+		},
+	}
+	task := &data.Task{Name: "ship", Projects: []string{"wydo", "home"}}
+
+	dirs := m.taskProjectDirs(task)
+	if len(dirs) != 1 || dirs[0].Name != "wydo" {
+		t.Fatalf("expected only the wydo project directory, got %+v", dirs)
+	}
+
+	boards := m.taskProjectBoards(task)
+	if len(boards) != 1 || boards[0].Name != "Wydo" {
+		t.Fatalf("expected the unarchived Wydo board, got %+v", boards)
+	}
+
+	if got := m.taskProjectBoards(&data.Task{Name: "chore", Projects: []string{"home"}}); len(got) != 0 {
+		t.Errorf("virtual project should have no boards, got %+v", got)
+	}
+}
+
+func TestLinkedBoard_ByNameThenProject(t *testing.T) {
+	m := TaskManagerModel{
+		allProjectItems: []kanbanview.ProjectPickerItem{
+			{Name: "wydo", DirPath: "/ws/projects/wydo"},
+		},
+		boards: []kanbanmodels.Board{
+			{Name: "Wydo", Path: "/ws/boards/wydo", Project: "../../projects/wydo/wydo.md"},
+			{Name: "Ops", Path: "/ws/boards/ops"},
+			{Name: "Ops Review", Path: "/ws/boards/ops-review"},
+			{Name: "Home", Path: "/ws/boards/home", Archived: true},
+		},
+	}
+
+	cases := []struct {
+		task data.Task
+		want string
+	}{
+		{data.Task{Name: "rotate ops keys"}, "Ops"},
+		{data.Task{Name: "prep the ops review deck"}, "Ops Review"},
+		{data.Task{Name: "stops the bleeding"}, ""},
+		{data.Task{Name: "fix home wifi"}, ""},
+		{data.Task{Name: "ship", Projects: []string{"wydo"}}, "Wydo"},
+		{data.Task{Name: "ops sync", Projects: []string{"wydo"}}, "Ops"},
+	}
+	for _, c := range cases {
+		board, ok := m.linkedBoard(&c.task)
+		if got := board.Name; !ok && c.want != "" || ok && got != c.want {
+			t.Errorf("%q: linked board = %q (ok=%v), want %q", c.task.Name, got, ok, c.want)
+		}
+	}
+}
+
+func TestTaskLinks_URLsThenAttachments(t *testing.T) {
+	task := data.ParseTask(`Review spec url:"https://example.com" attach:"attachments/spec.pdf"`, "", "/ws/tasks/todo.txt")
+	got := taskLinks(task, []string{"/other", "/ws"})
+	want := []kanbanmodels.CardURL{
+		{URL: "https://example.com"},
+		{Label: "spec.pdf", URL: "/ws/attachments/spec.pdf"},
+	}
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("taskLinks = %+v, want %+v", got, want)
+	}
+}
@@ -4,8 +4,11 @@ import (
 	"path/filepath"
 	"sort"
 	"strings"
+	"time"
 
+	"wydo/internal/clock"
 	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/filter"
 )
 
 // SortField represents what field to sort by
@@ -17,6 +20,7 @@ const (
 	SortByProject
 	SortByPriority
 	SortByContext
+	SortByCreated
 )
 
 // SortState holds sorting configuration
@@ -60,6 +64,8 @@ func (s *SortState) String() string {
 		field = "priority"
 	case SortByContext:
 		field = "context"
+	case SortByCreated:
+		field = "age"
 	}
 
 	dir := "asc"
@@ -80,6 +86,7 @@ const (
 	GroupByPriority
 	GroupByContext
 	GroupByFile
+	GroupByCreated
 )
 
 // GroupState holds grouping configuration
@@ -125,6 +132,8 @@ func (g *GroupState) String() string {
 		field = "context"
 	case GroupByFile:
 		field = "file"
+	case GroupByCreated:
+		field = "age"
 	}
 
 	dir := "asc"
@@ -223,6 +232,19 @@ func compareTasksBy(a, b data.Task, field SortField) int {
 			return -1
 		}
 		return strings.Compare(strings.ToLower(ctxA), strings.ToLower(ctxB))
+
+	case SortByCreated:
+		// Oldest first; tasks without a creation date sort to the end
+		if a.CreatedDate == "" && b.CreatedDate == "" {
+			return 0
+		}
+		if a.CreatedDate == "" {
+			return 1
+		}
+		if b.CreatedDate == "" {
+			return -1
+		}
+		return strings.Compare(a.CreatedDate, b.CreatedDate)
 	}
 
 	return 0
@@ -319,6 +341,9 @@ func getGroupKeys(task data.Task, field GroupField, roots []string) []string {
 
 	case GroupByFile:
 		return []string{RelativeFilePath(task.File, roots)}
+
+	case GroupByCreated:
+		return []string{ageBucket(task.CreatedDate, clock.Now())}
 	}
 
 	return []string{""}
@@ -341,11 +366,47 @@ func compareGroupKeys(a, b string, field GroupField) int {
 		return int(a[0]) - int(b[0])
 	}
 
+	// Age buckets, oldest first
+	if field == GroupByCreated {
+		return ageBucketOrder(a) - ageBucketOrder(b)
+	}
+
 	// For dates, string comparison works (ISO format)
 	// For text fields, case-insensitive comparison
 	return strings.Compare(strings.ToLower(a), strings.ToLower(b))
 }
 
+// ageBuckets are the GroupByCreated labels, oldest first
+var ageBuckets = []string{"earlier", "this month", "this week", "today"}
+
+// ageBucket returns the age group of a task created on the given date, or ""
+// when it has no creation date. Weeks start on Monday.
+func ageBucket(created string, now time.Time) string {
+	d, err := time.ParseInLocation("2006-01-02", created, now.Location())
+	if err != nil {
+		return ""
+	}
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch {
+	case !d.Before(today):
+		return "today"
+	case !d.Before(filter.WeekStart(now)):
+		return "this week"
+	case d.Year() == now.Year() && d.Month() == now.Month():
+		return "this month"
+	}
+	return "earlier"
+}
+
+func ageBucketOrder(label string) int {
+	for i, b := range ageBuckets {
+		if b == label {
+			return i
+		}
+	}
+	return len(ageBuckets)
+}
+
 // ExtractUniqueProjects returns all unique project names from tasks
 func ExtractUniqueProjects(tasks []data.Task) []string {
 	seen := make(map[string]bool)
@@ -378,6 +439,20 @@ func ExtractUniqueContexts(tasks []data.Task) []string {
 	return result
 }
 
+// CountTasksBy counts the tasks for each value keys returns for a task,
+// e.g. the tasks of each project. Empty values are not counted.
+func CountTasksBy(tasks []data.Task, keys func(data.Task) []string) map[string]int {
+	counts := make(map[string]int)
+	for _, task := range tasks {
+		for _, key := range keys(task) {
+			if key != "" {
+				counts[key]++
+			}
+		}
+	}
+	return counts
+}
+
 // ExtractUniqueFiles returns all unique relative file paths from tasks
 func ExtractUniqueFiles(tasks []data.Task, roots []string) []string {
 	seen := make(map[string]bool)
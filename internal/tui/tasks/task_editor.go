@@ -6,6 +6,7 @@ import (
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/convert"
 	"wydo/internal/kanban/operations"
 	"wydo/internal/tasks/data"
 	"wydo/internal/tui/shared"
@@ -29,7 +30,12 @@ type TaskEditorModel struct {
 	inputContext    InputModeContext
 	fuzzyPicker     *FuzzyPickerModel
 	datePicker      *shared.DatePickerModel
+	priorityPicker  *shared.PriorityPickerModel
 	urlInput        *TextInputModel
+	urlPicker       *kanbanview.URLPickerModel
+	annotationInput *TextInputModel
+	annotations     []data.Annotation // already persisted annotations
+	newAnnotations  []string          // annotations added in this session
 	projectPicker   *kanbanview.ProjectPickerModel
 	allProjectItems []kanbanview.ProjectPickerItem
 	allContexts     []string
@@ -39,9 +45,11 @@ type TaskEditorModel struct {
 
 // TaskEditorResultMsg is sent when the editor closes
 type TaskEditorResultMsg struct {
-	Task      data.Task
-	Saved     bool
-	Cancelled bool
+	Task        data.Task
+	Original    data.Task // the task as it was when the editor opened
+	Annotations []string
+	Saved       bool
+	Cancelled   bool
 }
 
 // NewTaskEditor creates a new task editor for the given task
@@ -64,6 +72,7 @@ func NewTaskEditor(task *data.Task, allProjectItems []kanbanview.ProjectPickerIt
 		inputContext:    InputModeContext{Mode: ModeTaskEditor},
 		allProjectItems: allProjectItems,
 		allContexts:     allContexts,
+		annotations:     data.TaskAnnotations(*task),
 		Width:           60,
 	}
 }
@@ -79,6 +88,14 @@ func (m *TaskEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.urlInput != nil {
 		return m.updateURLInput(msg)
 	}
+	// Handle annotation input
+	if m.annotationInput != nil {
+		return m.updateAnnotationInput(msg)
+	}
+	// Handle URL picker
+	if m.urlPicker != nil {
+		return m.updateURLPicker(msg)
+	}
 	// Handle project picker
 	if m.projectPicker != nil {
 		return m.updateProjectPicker(msg)
@@ -87,6 +104,10 @@ func (m *TaskEditorModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	if m.datePicker != nil {
 		return m.updateDatePicker(msg)
 	}
+	// Handle priority picker
+	if m.priorityPicker != nil {
+		return m.updatePriorityPicker(msg)
+	}
 	// Handle sub-component updates
 	if m.fuzzyPicker != nil {
 		return m.updateFuzzyPicker(msg)
@@ -137,6 +158,7 @@ func (m *TaskEditorModel) handleTaskEditorKeys(msg tea.KeyMsg) (tea.Model, tea.C
 		// Edit projects
 		m.inputContext.Mode = ModeEditProject
 		picker := kanbanview.NewProjectPickerModel(m.task.Projects, m.allProjectItems)
+		picker.SetSize(m.Width, m.Height)
 		m.projectPicker = &picker
 		return m, picker.Init()
 
@@ -145,37 +167,53 @@ func (m *TaskEditorModel) handleTaskEditorKeys(msg tea.KeyMsg) (tea.Model, tea.C
 		m.inputContext.Mode = ModeEditContext
 		m.fuzzyPicker = NewFuzzyPicker(m.allContexts, "Select Contexts", true, false)
 		m.fuzzyPicker.PreSelect(m.task.Contexts)
+		m.fuzzyPicker.SetWidth(m.Width)
 		return m, nil
 
 	case "U":
-		// Edit URL
+		// Edit URLs (space-separated)
 		m.inputContext.Mode = ModeEditURL
-		m.urlInput = NewTextInput("URL", "https://example.com", nil)
+		m.urlInput = NewTextInput("URLs (space-separated)", "https://example.com", nil)
 		m.urlInput.SetWidth(m.Width)
-		if currentURL := m.task.GetURL(); currentURL != "" {
-			m.urlInput.SetValue(currentURL)
+		if urls := m.task.GetURLs(); len(urls) > 0 {
+			m.urlInput.SetValue(strings.Join(urls, " "))
 		}
 		return m, m.urlInput.Focus()
 
+	case "A":
+		// Add annotation
+		m.inputContext.Mode = ModeAnnotate
+		m.annotationInput = NewTextInput("Annotation", "waiting on Bob", nil)
+		m.annotationInput.SetWidth(m.Width)
+		return m, m.annotationInput.Focus()
+
 	case "u":
-		// Open URL in browser
-		if url := m.task.GetURL(); url != "" {
-			operations.OpenURL(url)
+		// Open URL in browser, picking one first if there are several
+		urls := m.task.GetURLs()
+		if len(urls) == 1 {
+			operations.OpenURL(urls[0])
+		} else if len(urls) > 1 {
+			picker := newTaskURLPicker(urls, m.Width, m.Height)
+			m.urlPicker = &picker
+			m.inputContext.Mode = ModeOpenURL
 		}
 		return m, nil
 
 	case "i":
-		// Cycle priority: A -> B -> C -> D -> E -> F -> none -> A
-		m.cyclePriority()
+		picker := shared.NewPriorityPickerModel(convert.TaskPriorityToCardPriority(m.task.Priority), "Priority")
+		picker.SetSize(m.Width, m.Height)
+		m.priorityPicker = &picker
 		return m, nil
 
 	case "enter":
 		// Save and close
 		return m, func() tea.Msg {
 			return TaskEditorResultMsg{
-				Task:      *m.task,
-				Saved:     true,
-				Cancelled: false,
+				Task:        *m.task,
+				Original:    m.originalTask,
+				Annotations: m.newAnnotations,
+				Saved:       true,
+				Cancelled:   false,
 			}
 		}
 
@@ -268,11 +306,28 @@ func (m *TaskEditorModel) updateDatePicker(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
+func (m *TaskEditorModel) updateURLPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+	picker, selectedURL, done := m.urlPicker.Update(keyMsg)
+	m.urlPicker = &picker
+	if done {
+		m.urlPicker = nil
+		m.inputContext.Mode = ModeTaskEditor
+		if selectedURL != "" {
+			operations.OpenURL(selectedURL)
+		}
+	}
+	return m, nil
+}
+
 func (m *TaskEditorModel) updateURLInput(msg tea.Msg) (tea.Model, tea.Cmd) {
 	// Check for result message
 	if result, ok := msg.(TextInputResultMsg); ok {
 		if !result.Cancelled {
-			m.task.SetURL(result.Value)
+			m.task.SetURLs(strings.Fields(result.Value))
 		}
 		m.urlInput = nil
 		m.inputContext.Mode = ModeTaskEditor
@@ -284,23 +339,35 @@ func (m *TaskEditorModel) updateURLInput(msg tea.Msg) (tea.Model, tea.Cmd) {
 	return m, cmd
 }
 
-func (m *TaskEditorModel) cyclePriority() {
-	switch m.task.Priority {
-	case data.PriorityNone:
-		m.task.Priority = data.PriorityA
-	case data.PriorityA:
-		m.task.Priority = data.PriorityB
-	case data.PriorityB:
-		m.task.Priority = data.PriorityC
-	case data.PriorityC:
-		m.task.Priority = data.PriorityD
-	case data.PriorityD:
-		m.task.Priority = data.PriorityE
-	case data.PriorityE:
-		m.task.Priority = data.PriorityF
-	case data.PriorityF:
-		m.task.Priority = data.PriorityNone
+func (m *TaskEditorModel) updateAnnotationInput(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if result, ok := msg.(TextInputResultMsg); ok {
+		if text := strings.TrimSpace(result.Value); !result.Cancelled && text != "" {
+			m.newAnnotations = append(m.newAnnotations, text)
+		}
+		m.annotationInput = nil
+		m.inputContext.Mode = ModeTaskEditor
+		return m, nil
 	}
+
+	_, cmd := m.annotationInput.Update(msg)
+	return m, cmd
+}
+
+func (m *TaskEditorModel) updatePriorityPicker(msg tea.Msg) (tea.Model, tea.Cmd) {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	updated, done, confirmed := m.priorityPicker.Update(keyMsg)
+	m.priorityPicker = &updated
+	if done {
+		if confirmed {
+			m.task.Priority = convert.CardPriorityToTaskPriority(updated.Level())
+		}
+		m.priorityPicker = nil
+	}
+	return m, nil
 }
 
 // View implements tea.Model
@@ -309,10 +376,22 @@ func (m *TaskEditorModel) View() string {
 	if m.urlInput != nil {
 		return m.urlInput.View()
 	}
+	// If annotation input is active, show it
+	if m.annotationInput != nil {
+		return m.annotationInput.View()
+	}
+	// If URL picker is active, show it
+	if m.urlPicker != nil {
+		return m.urlPicker.View()
+	}
 	// If date picker is active, show it
 	if m.datePicker != nil {
 		return m.datePicker.View()
 	}
+	// If priority picker is active, show it
+	if m.priorityPicker != nil {
+		return m.priorityPicker.View()
+	}
 	// If project picker is active, show it
 	if m.projectPicker != nil {
 		return lipgloss.Place(m.Width, m.Height, lipgloss.Center, lipgloss.Center, m.projectPicker.View())
@@ -400,19 +479,39 @@ func (m *TaskEditorModel) View() string {
 
 	// URL
 	content.WriteString(editorLabelStyle.Render("URL:"))
-	urlStr := m.task.GetURL()
+	var links []string
+	for _, u := range m.task.GetURLs() {
+		links = append(links, shared.Hyperlink(u, u))
+	}
+	urlStr := strings.Join(links, ", ")
 	if urlStr == "" {
 		urlStr = "(none)"
 	}
-	if m.task.GetURL() != m.originalTask.GetURL() {
+	if !slicesEqual(m.task.GetURLs(), m.originalTask.GetURLs()) {
 		content.WriteString(editorModifiedStyle.Render(urlStr + " *"))
 	} else {
 		content.WriteString(editorValueStyle.Render(urlStr))
 	}
-	content.WriteString("\n\n")
+	content.WriteString("\n")
+
+	// Annotations
+	if len(m.annotations) > 0 || len(m.newAnnotations) > 0 {
+		content.WriteString(editorLabelStyle.Render("Notes:"))
+		content.WriteString("\n")
+		for _, a := range m.annotations {
+			content.WriteString("  " + editorLabelStyle.UnsetWidth().Render(a.Time.Format("2006-01-02 15:04")) + " ")
+			content.WriteString(editorValueStyle.Render(a.Text))
+			content.WriteString("\n")
+		}
+		for _, text := range m.newAnnotations {
+			content.WriteString("  " + editorModifiedStyle.Render("(new) "+text+" *"))
+			content.WriteString("\n")
+		}
+	}
+	content.WriteString("\n")
 
 	// Help
-	content.WriteString(editorHelpStyle.Render("[d] due  [s] sched  [p] project  [t] context  [i] priority  [U] url  [u] open url"))
+	content.WriteString(editorHelpStyle.Render("[d] due  [s] sched  [p] project  [t] context  [i] priority  [U] url  [u] open url  [A] annotate"))
 	content.WriteString("\n")
 	content.WriteString(editorHelpStyle.Render("[enter] save  [esc] cancel"))
 
@@ -436,7 +535,10 @@ func (m *TaskEditorModel) IsModified() bool {
 	if !slicesEqual(m.task.Contexts, m.originalTask.Contexts) {
 		return true
 	}
-	if m.task.GetURL() != m.originalTask.GetURL() {
+	if !slicesEqual(m.task.GetURLs(), m.originalTask.GetURLs()) {
+		return true
+	}
+	if len(m.newAnnotations) > 0 {
 		return true
 	}
 	return false
@@ -2,17 +2,29 @@ package tasks
 
 import (
 	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"slices"
+	"strconv"
 	"strings"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/agenda"
+	"wydo/internal/attachments"
+	"wydo/internal/clock"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/kanban/operations"
 	"wydo/internal/logs"
 	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/filter"
 	"wydo/internal/tasks/service"
+	"wydo/internal/tui/messages"
 	"wydo/internal/tui/shared"
 	"wydo/internal/tui/theme"
 	kanbanview "wydo/internal/tui/kanban"
@@ -21,6 +33,17 @@ import (
 var (
 	groupHeaderStyle = lipgloss.NewStyle().Bold(true).Foreground(theme.Accent).MarginTop(1)
 	cursorStyle      = theme.Cursor
+	boardLinkStyle   = lipgloss.NewStyle().Foreground(theme.Accent)
+)
+
+// boardLinkGlyph marks tasks that gb can open a board for.
+const boardLinkGlyph = "▦"
+
+// MinWidth and MinHeight are the smallest area the task list lays out in:
+// the info bar and a few rows. Narrower lists cut their rows.
+const (
+	MinWidth  = 40
+	MinHeight = 10
 )
 
 // FileViewMode determines which file(s) to display tasks from
@@ -34,7 +57,14 @@ const (
 
 // TaskUpdateMsg is sent when a task is updated
 type TaskUpdateMsg struct {
-	Task data.Task
+	Task        data.Task
+	Annotations []string // new annotations to append after the update
+}
+
+// SearchSubmittedMsg is sent when a non-empty search query is confirmed,
+// so the app can record it in the search history
+type SearchSubmittedMsg struct {
+	Query string
 }
 
 // TaskEditorOpenMsg is sent to open the task editor
@@ -64,10 +94,7 @@ type ArchiveCompleteMsg struct {
 }
 
 // MoveTaskToBoardMsg is sent when a task should be moved to a kanban board
-type MoveTaskToBoardMsg struct {
-	Task      data.Task
-	BoardPath string
-}
+type MoveTaskToBoardMsg = messages.MoveTaskToBoardMsg
 
 // TaskManagerModel manages the task list view with filtering, sorting, and grouping
 type TaskManagerModel struct {
@@ -85,7 +112,7 @@ type TaskManagerModel struct {
 
 	// State
 	inputContext InputModeContext
-	filterState  FilterState
+	filterState  filter.State
 	sortState    SortState
 	groupState   GroupState
 
@@ -97,6 +124,7 @@ type TaskManagerModel struct {
 	confirmationModal *ConfirmationModal
 	datePicker        *shared.DatePickerModel // for direct date editing
 	projectPicker     *kanbanview.ProjectPickerModel
+	urlPicker         *kanbanview.URLPickerModel
 
 	// Direct edit state
 	directEditTaskID string
@@ -107,10 +135,25 @@ type TaskManagerModel struct {
 	// Pending delete (for confirmation modal)
 	pendingDeleteTaskID string
 
+	// Scheduled date change for all shown tasks, waiting on its confirmation
+	pendingBulkUpdate *TasksUpdateMsg
+
+	// Editor save held back while the user resolves a conflict with the file on disk
+	pendingConflictUpdate *TaskUpdateMsg
+
 	// Inline search
 	searchActive     bool
 	searchFilterMode bool // true when actively typing in search filter
 	searchInput      textinput.Model
+	searchHistory    []string // previous queries, most recent first
+	myDay            []string // My Day keys of today's picks
+	historyIndex     int      // position in searchHistory while cycling with up/down; -1 when not cycling
+
+	// Priority color legend above the task list, toggled with ctrl+l
+	showLegend bool
+
+	// Count typed before a motion, e.g. the "12" of 12j
+	count string
 
 	// Cached data for pickers
 	allProjects      []string
@@ -118,6 +161,11 @@ type TaskManagerModel struct {
 	allContexts      []string
 	allFiles         []string
 	allWorkspaces    []string
+	// Task counts shown beside the filter pickers' options
+	projectCounts   map[string]int
+	contextCounts   map[string]int
+	fileCounts      map[string]int
+	workspaceCounts map[string]int
 
 	// Picker context (what are we picking for)
 	pickerContext string // "filter-project", "filter-context", "filter-file", etc.
@@ -135,7 +183,7 @@ func NewTaskManagerModel(taskSvc service.TaskService, workspaceRoots []string, b
 		boards:          boards,
 		allProjectItems: allProjectItems,
 		inputContext:    NewInputModeContext(),
-		filterState:     NewFilterState(),
+		filterState:     filter.NewState(),
 		sortState:       NewSortState(),
 		groupState:      GroupState{Field: GroupByFile, Ascending: false},
 		infoBar:         NewInfoBar(),
@@ -150,6 +198,9 @@ func (m *TaskManagerModel) SetSize(width, height int) {
 	m.width = width
 	m.height = height
 	m.infoBar.Width = width
+	if m.projectPicker != nil {
+		m.projectPicker.SetSize(width, height)
+	}
 	m.ensureCursorVisible()
 }
 
@@ -159,11 +210,33 @@ func (m *TaskManagerModel) SetData(taskSvc service.TaskService) {
 	m.loadTasks()
 }
 
+// SetSearchHistory sets the previous search queries offered by up/down while searching.
+func (m *TaskManagerModel) SetSearchHistory(history []string) {
+	m.searchHistory = history
+}
+
+// SetMyDay sets the My Day keys of today's picks, which are marked with
+// shared.MyDayGlyph.
+func (m *TaskManagerModel) SetMyDay(keys []string) {
+	m.myDay = keys
+}
+
 // SetBoards updates the available boards
 func (m *TaskManagerModel) SetBoards(boards []kanbanmodels.Board) {
 	m.boards = boards
 }
 
+// Filter returns the active filters.
+func (m *TaskManagerModel) Filter() filter.State {
+	return m.filterState
+}
+
+// SetFilter replaces the active filters and refreshes the list.
+func (m *TaskManagerModel) SetFilter(f filter.State) {
+	m.filterState = f
+	m.refreshDisplayTasks()
+}
+
 // FocusTask moves the cursor to a specific task by ID
 func (m *TaskManagerModel) FocusTask(taskID string) {
 	for i, task := range m.displayTasks {
@@ -176,7 +249,12 @@ func (m *TaskManagerModel) FocusTask(taskID string) {
 }
 
 func (m *TaskManagerModel) loadTasks() {
-	tasks, err := m.taskSvc.List()
+	list := m.taskSvc.List
+	if m.fileViewMode == FileViewDoneOnly {
+		// Earlier years' done files are only read for the done view
+		list = m.taskSvc.ListAll
+	}
+	tasks, err := list()
 	if err != nil {
 		logs.Logger.Printf("Error loading tasks: %v", err)
 		return
@@ -188,7 +266,40 @@ func (m *TaskManagerModel) loadTasks() {
 	if len(m.workspaceRoots) > 1 {
 		m.allWorkspaces = ExtractUniqueWorkspaces(tasks, m.workspaceRoots)
 	}
+	m.projectCounts = CountTasksBy(tasks, func(t data.Task) []string { return t.Projects })
+	m.contextCounts = CountTasksBy(tasks, func(t data.Task) []string { return t.Contexts })
+	m.fileCounts = CountTasksBy(tasks, func(t data.Task) []string {
+		return []string{RelativeFilePath(t.File, m.workspaceRoots)}
+	})
+	m.workspaceCounts = CountTasksBy(tasks, func(t data.Task) []string {
+		return []string{WorkspaceForTask(t.File, m.workspaceRoots)}
+	})
+	// Stay on the selected task when the reload moves it, e.g. coming back
+	// from another view after tasks were added above it. IDs come from line
+	// numbers, so the task is found by its text first.
+	var selected *data.Task
+	if m.cursor >= 0 && m.cursor < len(m.displayTasks) {
+		task := m.displayTasks[m.cursor]
+		selected = &task
+	}
 	m.refreshDisplayTasks()
+	if selected != nil {
+		m.focusSameTask(*selected)
+	}
+}
+
+// focusSameTask moves the cursor to task as reloaded: the same line in the
+// same file, or failing that (the task was edited) the same ID.
+func (m *TaskManagerModel) focusSameTask(task data.Task) {
+	line := task.String()
+	for i, t := range m.displayTasks {
+		if t.File == task.File && t.String() == line {
+			m.cursor = i
+			m.ensureCursorVisible()
+			return
+		}
+	}
+	m.FocusTask(task.ID)
 }
 
 // Update handles messages for the task manager
@@ -213,7 +324,7 @@ func (m TaskManagerModel) Update(msg tea.Msg) (TaskManagerModel, tea.Cmd) {
 		return m.handleEditorResult(msg)
 	case ToggleFileViewMsg:
 		m.cycleFileViewMode()
-		m.refreshDisplayTasks()
+		m.loadTasks()
 		return m, nil
 	case StartArchiveMsg:
 		return m.handleStartArchive()
@@ -222,7 +333,7 @@ func (m TaskManagerModel) Update(msg tea.Msg) (TaskManagerModel, tea.Cmd) {
 	case ArchiveCompleteMsg:
 		m.confirmationModal = nil
 		m.loadTasks()
-		return m, tea.Printf("Archived %d tasks to done.txt", msg.Count)
+		return m, tea.Printf("Archived %d tasks to %s", msg.Count, data.DoneFileName(clock.Now().Year()))
 	}
 
 	// Handle inline search mode (before other sub-components)
@@ -260,6 +371,22 @@ func (m TaskManagerModel) Update(msg tea.Msg) (TaskManagerModel, tea.Cmd) {
 		return m, cmd
 	}
 
+	// Handle URL picker for tasks with several URLs
+	if m.urlPicker != nil {
+		if keyMsg, ok := msg.(tea.KeyMsg); ok {
+			picker, selectedURL, done := m.urlPicker.Update(keyMsg)
+			m.urlPicker = &picker
+			if done {
+				m.urlPicker = nil
+				m.inputContext.Reset()
+				if selectedURL != "" {
+					operations.OpenURL(selectedURL)
+				}
+			}
+		}
+		return m, nil
+	}
+
 	// Handle date picker for direct editing
 	if m.datePicker != nil {
 		if keyMsg, ok := msg.(tea.KeyMsg); ok {
@@ -307,6 +434,8 @@ func (m TaskManagerModel) Update(msg tea.Msg) (TaskManagerModel, tea.Cmd) {
 			return m.handleSortSelect(msg)
 		case ModeGroupSelect:
 			return m.handleGroupSelect(msg)
+		case ModeOpenSelect:
+			return m.handleOpenSelect(msg)
 		case ModeSortDirection:
 			return m.handleSortDirection(msg)
 		case ModeGroupDirection:
@@ -323,6 +452,11 @@ func (m TaskManagerModel) View() string {
 
 	// Update info bar with current state
 	m.infoBar.SetContext(&m.inputContext, &m.filterState, &m.sortState, &m.groupState, m.filterState.SearchQuery, m.fileViewMode, len(m.workspaceRoots) > 1)
+	if len(m.displayTasks) > m.visibleTaskRows() {
+		m.infoBar.SetPosition(m.cursor+1, len(m.displayTasks), m.count)
+	} else {
+		m.infoBar.SetPosition(0, 0, m.count)
+	}
 
 	// Info bar (always visible)
 	b.WriteString(m.infoBar.View())
@@ -335,11 +469,14 @@ func (m TaskManagerModel) View() string {
 	if m.datePicker != nil {
 		return m.datePicker.View()
 	}
+	if m.urlPicker != nil {
+		return m.urlPicker.View()
+	}
 	if m.confirmationModal != nil {
 		modal := m.confirmationModal.View()
 		// Center the modal on screen
 		return lipgloss.Place(
-			m.infoBar.Width, 30,
+			m.infoBar.Width, m.height,
 			lipgloss.Center, lipgloss.Center,
 			modal,
 			lipgloss.WithWhitespaceChars(" "),
@@ -365,6 +502,11 @@ func (m TaskManagerModel) View() string {
 		b.WriteString("\n")
 	}
 
+	if m.showLegend {
+		b.WriteString(shared.PriorityLegend())
+		b.WriteString("\n")
+	}
+
 	// Task list
 	if m.groupState.IsActive() && len(m.taskGroups) > 0 {
 		b.WriteString(m.renderGroupedTasks())
@@ -395,7 +537,7 @@ func (m *TaskManagerModel) renderFlatTasks() string {
 		if i == m.cursor {
 			prefix = cursorStyle.Render("> ")
 		}
-		b.WriteString(prefix + shared.StyledTaskLine(task) + "\n")
+		b.WriteString(shared.Truncate(prefix+m.taskLine(task), m.width) + "\n")
 	}
 
 	return b.String()
@@ -425,7 +567,7 @@ func (m *TaskManagerModel) renderGroupedTasks() string {
 
 		// Emit group header if any task in this group is visible
 		if taskIndex >= m.scrollOffset || (groupStart < m.scrollOffset && groupEnd > m.scrollOffset) {
-			b.WriteString(groupHeaderStyle.Render("-- " + group.Label + " --"))
+			b.WriteString(shared.Truncate(groupHeaderStyle.Render("-- "+group.Label+" --"), m.width))
 			b.WriteString("\n")
 			linesRendered++
 		}
@@ -439,7 +581,7 @@ func (m *TaskManagerModel) renderGroupedTasks() string {
 				if taskIndex == m.cursor {
 					prefix = cursorStyle.Render("> ")
 				}
-				b.WriteString(prefix + shared.StyledTaskLine(task) + "\n")
+				b.WriteString(shared.Truncate(prefix+m.taskLine(task), m.width) + "\n")
 				linesRendered++
 			}
 			taskIndex++
@@ -449,14 +591,45 @@ func (m *TaskManagerModel) renderGroupedTasks() string {
 	return b.String()
 }
 
+// taskLine renders a task row, with boardLinkGlyph when it links to a board
+// and shared.MyDayGlyph when it is picked for My Day.
+func (m *TaskManagerModel) taskLine(task data.Task) string {
+	line := shared.StyledTaskLine(task)
+	if _, ok := m.linkedBoard(&task); ok {
+		line += " " + boardLinkStyle.Render(boardLinkGlyph)
+	}
+	if slices.Contains(m.myDay, agenda.TaskMyDayKey(task)) {
+		line += " " + cursorStyle.Render(shared.MyDayGlyph)
+	}
+	return line
+}
+
 // Input handlers
 
 func (m TaskManagerModel) handleNormalMode(msg tea.KeyMsg) (TaskManagerModel, tea.Cmd) {
-	switch msg.String() {
+	key := msg.String()
+	if isCountDigit(key, m.count) {
+		m.count += key
+		return m, nil
+	}
+	count, counted := m.takeCount()
+
+	switch key {
 	case "j", "down":
-		m.moveCursor(1)
+		m.moveCursor(count)
 	case "k", "up":
-		m.moveCursor(-1)
+		m.moveCursor(-count)
+	case "G":
+		// G jumps to the bottom, {count}G to the count-th task
+		if counted {
+			m.jumpCursor(count - 1)
+		} else {
+			m.jumpCursor(len(m.displayTasks) - 1)
+		}
+	case "ctrl+d":
+		m.moveCursor(count * max(m.visibleTaskRows()/2, 1))
+	case "ctrl+u":
+		m.moveCursor(-count * max(m.visibleTaskRows()/2, 1))
 	case "enter":
 		return m.openTaskEditor()
 	case "d":
@@ -473,6 +646,8 @@ func (m TaskManagerModel) handleNormalMode(msg tea.KeyMsg) (TaskManagerModel, te
 		return m.startDirectNameEdit()
 	case "U":
 		return m.startDirectURLEdit()
+	case "ctrl+f":
+		return m.startAttach()
 	case "f":
 		m.inputContext.TransitionTo(ModeFilterSelect)
 		m.inputContext.Category = "filter"
@@ -482,6 +657,9 @@ func (m TaskManagerModel) handleNormalMode(msg tea.KeyMsg) (TaskManagerModel, te
 	case "g":
 		m.inputContext.TransitionTo(ModeGroupSelect)
 		m.inputContext.Category = "group"
+	case "o":
+		m.inputContext.TransitionTo(ModeOpenSelect)
+		m.inputContext.Category = "open"
 	case "F":
 		return m.startFileFilter()
 	case "W":
@@ -500,6 +678,19 @@ func (m TaskManagerModel) handleNormalMode(msg tea.KeyMsg) (TaskManagerModel, te
 		return m.handleOpenURL()
 	case "m":
 		return m.startMoveToBoard()
+	case "+":
+		if task := m.selectedTask(); task != nil {
+			key := agenda.TaskMyDayKey(*task)
+			return m, func() tea.Msg { return messages.ToggleMyDayMsg{Key: key} }
+		}
+	case "ctrl+s":
+		return m.startBulkSchedule()
+	case "ctrl+l":
+		m.showLegend = !m.showLegend
+		m.ensureCursorVisible()
+	case ">", "<", "}", "{":
+		days, _ := shared.DueBumpDays(msg.String())
+		return m.directBumpDueDate(days)
 	}
 	return m, nil
 }
@@ -523,6 +714,14 @@ func (m TaskManagerModel) handleFilterSelect(msg tea.KeyMsg) (TaskManagerModel,
 		m.inputContext.Reset()
 	case "f":
 		return m.startFileFilter()
+	case "a":
+		m.filterState.AddedThisWeek = !m.filterState.AddedThisWeek
+		m.refreshDisplayTasks()
+		m.inputContext.Reset()
+	case "u":
+		m.filterState.ShowUnstarted = !m.filterState.ShowUnstarted
+		m.refreshDisplayTasks()
+		m.inputContext.Reset()
 	case "w":
 		if len(m.workspaceRoots) > 1 {
 			return m.startWorkspaceFilter()
@@ -545,12 +744,22 @@ func (m TaskManagerModel) handleSortSelect(msg tea.KeyMsg) (TaskManagerModel, te
 	case "t", "c":
 		m.inputContext.Field = "context"
 		m.inputContext.TransitionTo(ModeSortDirection)
+	case "a":
+		m.inputContext.Field = "age"
+		m.inputContext.TransitionTo(ModeSortDirection)
 	}
 	return m, nil
 }
 
 func (m TaskManagerModel) handleGroupSelect(msg tea.KeyMsg) (TaskManagerModel, tea.Cmd) {
 	switch msg.String() {
+	case "g":
+		// gg jumps to the top
+		m.inputContext.Reset()
+		m.jumpCursor(0)
+	case "b":
+		m.inputContext.Reset()
+		return m.openLinkedBoard()
 	case "d":
 		m.inputContext.Field = "date"
 		m.inputContext.TransitionTo(ModeGroupDirection)
@@ -566,6 +775,19 @@ func (m TaskManagerModel) handleGroupSelect(msg tea.KeyMsg) (TaskManagerModel, t
 	case "f":
 		m.inputContext.Field = "file"
 		m.inputContext.TransitionTo(ModeGroupDirection)
+	case "a":
+		m.inputContext.Field = "age"
+		m.inputContext.TransitionTo(ModeGroupDirection)
+	}
+	return m, nil
+}
+
+func (m TaskManagerModel) handleOpenSelect(msg tea.KeyMsg) (TaskManagerModel, tea.Cmd) {
+	switch msg.String() {
+	case "f":
+		return m.startOpenProjectDir()
+	case "b":
+		return m.startOpenProjectBoard()
 	}
 	return m, nil
 }
@@ -598,6 +820,30 @@ func (m TaskManagerModel) handleSearchMode(msg tea.KeyMsg) (TaskManagerModel, te
 			// Exit filter mode, keep query, stay in search mode
 			m.searchFilterMode = false
 			m.searchInput.Blur()
+			m.historyIndex = -1
+			if query := strings.TrimSpace(m.searchInput.Value()); query != "" {
+				return m, func() tea.Msg { return SearchSubmittedMsg{Query: query} }
+			}
+			return m, nil
+
+		case "up", "down":
+			// Cycle through previous queries
+			if len(m.searchHistory) == 0 {
+				return m, nil
+			}
+			if msg.String() == "up" && m.historyIndex < len(m.searchHistory)-1 {
+				m.historyIndex++
+			} else if msg.String() == "down" && m.historyIndex >= 0 {
+				m.historyIndex--
+			}
+			value := ""
+			if m.historyIndex >= 0 {
+				value = m.searchHistory[m.historyIndex]
+			}
+			m.searchInput.SetValue(value)
+			m.searchInput.CursorEnd()
+			m.filterState.SearchQuery = value
+			m.refreshDisplayTasks()
 			return m, nil
 
 		case "esc":
@@ -668,6 +914,7 @@ func (m TaskManagerModel) handleEscape() (TaskManagerModel, tea.Cmd) {
 	// Close any open sub-component
 	if m.confirmationModal != nil {
 		m.confirmationModal = nil
+		m.pendingBulkUpdate = nil
 		m.inputContext.Reset()
 		return m, nil
 	}
@@ -701,7 +948,7 @@ func (m TaskManagerModel) handleEscape() (TaskManagerModel, tea.Cmd) {
 	m.sortState.Reset()
 	m.groupState = GroupState{Field: GroupByFile, Ascending: true}
 	m.fileViewMode = FileViewAll
-	m.refreshDisplayTasks()
+	m.loadTasks()
 	return m, nil
 }
 
@@ -716,6 +963,7 @@ func (m TaskManagerModel) startSearch() (TaskManagerModel, tea.Cmd) {
 	m.searchInput.SetValue(m.filterState.SearchQuery)
 	m.searchActive = true
 	m.searchFilterMode = true // Start in filter typing mode
+	m.historyIndex = -1
 	m.inputContext.TransitionTo(ModeSearch)
 	m.ensureCursorVisible()
 	return m, m.searchInput.Focus()
@@ -736,9 +984,7 @@ func (m TaskManagerModel) createNewTaskAndOpenEditor(taskName string) (TaskManag
 	}
 
 	// Generate a unique ID for the new task
-	timestamp := time.Now().Format("20060102150405")
-	randomPart := fmt.Sprintf("%d", time.Now().UnixNano()%10000)
-	newID := data.HashTaskLine(timestamp + randomPart)
+	newID := clock.NewID(taskName)
 
 	// Create new task (File will be set by Add when persisted)
 	newTask := &data.Task{
@@ -764,14 +1010,67 @@ func (m TaskManagerModel) handleOpenURL() (TaskManagerModel, tea.Cmd) {
 	if task == nil {
 		return m, nil
 	}
-	if url := task.GetURL(); url != "" {
-		operations.OpenURL(url)
+	links := taskLinks(*task, m.workspaceRoots)
+	if len(links) == 1 {
+		operations.OpenURL(links[0].URL)
+	} else if len(links) > 1 {
+		picker := kanbanview.NewURLPickerModel(links)
+		picker.SetSize(m.width, m.height)
+		m.urlPicker = &picker
+		m.inputContext.TransitionTo(ModeOpenURL)
 	}
 	return m, nil
 }
 
+// taskLinks lists what u opens for a task: its URLs, then its attachments
+// as paths under the task's workspace.
+func taskLinks(task data.Task, roots []string) []kanbanmodels.CardURL {
+	var links []kanbanmodels.CardURL
+	for _, u := range task.GetURLs() {
+		links = append(links, kanbanmodels.CardURL{URL: u})
+	}
+	if paths := task.GetAttachments(); len(paths) > 0 {
+		root := attachments.Root(roots, task.File)
+		for _, p := range paths {
+			links = append(links, kanbanmodels.CardURL{Label: filepath.Base(p), URL: filepath.Join(root, p)})
+		}
+	}
+	return links
+}
+
+// newTaskURLPicker builds the card URL picker for a task's URLs.
+func newTaskURLPicker(urls []string, width, height int) kanbanview.URLPickerModel {
+	cardURLs := make([]kanbanmodels.CardURL, len(urls))
+	for i, u := range urls {
+		cardURLs[i] = kanbanmodels.CardURL{URL: u}
+	}
+	picker := kanbanview.NewURLPickerModel(cardURLs)
+	picker.SetSize(width, height)
+	return picker
+}
+
+// startDateFilter opens the date filter menu: the preset expressions, a
+// custom one typed in, or clearing the filter.
 func (m TaskManagerModel) startDateFilter() (TaskManagerModel, tea.Cmd) {
-	m.textInput = NewDateInput("Due date filter")
+	items := append(append([]string{}, dateFilterPresets...), dateFilterCustom)
+	if m.filterState.DateFilter != nil {
+		items = append(items, dateFilterClear)
+	}
+	m.fuzzyPicker = NewFuzzyPicker(items, "Filter by Due Date", false, false)
+	if m.filterState.DateFilter != nil && m.filterState.DateFilter.Expr != "" {
+		m.fuzzyPicker.PreSelect([]string{m.filterState.DateFilter.Expr})
+	}
+	m.pickerContext = "filter-date"
+	m.inputContext.TransitionTo(ModeFuzzyPicker)
+	return m, nil
+}
+
+// startCustomDateFilter asks for a date filter expression, see filter.ParseDate.
+func (m TaskManagerModel) startCustomDateFilter() (TaskManagerModel, tea.Cmd) {
+	m.textInput = NewTextInput("Due date filter", "2026-11-03, +3d, <+7d, 2026-11-01..2026-11-15", filter.ValidateDate)
+	if m.filterState.DateFilter != nil {
+		m.textInput.Input.SetValue(m.filterState.DateFilter.Expr)
+	}
 	m.textInput.SetWidth(m.width)
 	m.inputContext.TransitionTo(ModeDateInput)
 	return m, m.textInput.Focus()
@@ -780,6 +1079,8 @@ func (m TaskManagerModel) startDateFilter() (TaskManagerModel, tea.Cmd) {
 func (m TaskManagerModel) startProjectFilter() (TaskManagerModel, tea.Cmd) {
 	m.fuzzyPicker = NewFuzzyPicker(m.allProjects, "Filter by Project", true, false)
 	m.fuzzyPicker.PreSelect(m.filterState.ProjectFilter)
+	m.fuzzyPicker.Counts = m.projectCounts
+	m.fuzzyPicker.SetWidth(m.width)
 	m.pickerContext = "filter-project"
 	m.inputContext.TransitionTo(ModeFuzzyPicker)
 	return m, nil
@@ -788,6 +1089,8 @@ func (m TaskManagerModel) startProjectFilter() (TaskManagerModel, tea.Cmd) {
 func (m TaskManagerModel) startContextFilter() (TaskManagerModel, tea.Cmd) {
 	m.fuzzyPicker = NewFuzzyPicker(m.allContexts, "Filter by Context", true, false)
 	m.fuzzyPicker.PreSelect(m.filterState.ContextFilter)
+	m.fuzzyPicker.Counts = m.contextCounts
+	m.fuzzyPicker.SetWidth(m.width)
 	m.pickerContext = "filter-context"
 	m.inputContext.TransitionTo(ModeFuzzyPicker)
 	return m, nil
@@ -796,6 +1099,8 @@ func (m TaskManagerModel) startContextFilter() (TaskManagerModel, tea.Cmd) {
 func (m TaskManagerModel) startFileFilter() (TaskManagerModel, tea.Cmd) {
 	m.fuzzyPicker = NewFuzzyPicker(m.allFiles, "Filter by File", true, false)
 	m.fuzzyPicker.PreSelect(m.filterState.FileFilter)
+	m.fuzzyPicker.Counts = m.fileCounts
+	m.fuzzyPicker.SetWidth(m.width)
 	m.pickerContext = "filter-file"
 	m.inputContext.TransitionTo(ModeFuzzyPicker)
 	return m, nil
@@ -804,6 +1109,8 @@ func (m TaskManagerModel) startFileFilter() (TaskManagerModel, tea.Cmd) {
 func (m TaskManagerModel) startWorkspaceFilter() (TaskManagerModel, tea.Cmd) {
 	m.fuzzyPicker = NewFuzzyPicker(m.allWorkspaces, "Filter by Workspace", true, false)
 	m.fuzzyPicker.PreSelect(m.filterState.WorkspaceFilter)
+	m.fuzzyPicker.Counts = m.workspaceCounts
+	m.fuzzyPicker.SetWidth(m.width)
 	m.pickerContext = "filter-workspace"
 	m.inputContext.TransitionTo(ModeFuzzyPicker)
 	return m, nil
@@ -846,6 +1153,8 @@ func (m *TaskManagerModel) applySortField(ascending bool) {
 		field = SortByPriority
 	case "context":
 		field = SortByContext
+	case "age":
+		field = SortByCreated
 	}
 
 	m.sortState.Field = field
@@ -867,6 +1176,8 @@ func (m *TaskManagerModel) applyGroupField(ascending bool) {
 		field = GroupByContext
 	case "file":
 		field = GroupByFile
+	case "age":
+		field = GroupByCreated
 	}
 
 	m.groupState.Field = field
@@ -889,10 +1200,8 @@ func (m TaskManagerModel) openTaskEditor() (TaskManagerModel, tea.Cmd) {
 }
 
 func (m TaskManagerModel) toggleTaskDone() (TaskManagerModel, tea.Cmd) {
-	logs.Logger.Println("space pressed")
 	task := m.selectedTask()
 	if task == nil {
-		logs.Logger.Println("no selected task")
 		return m, nil
 	}
 
@@ -913,6 +1222,20 @@ func (m TaskManagerModel) handlePickerResult(msg FuzzyPickerResultMsg) (TaskMana
 	}
 
 	switch m.pickerContext {
+	case "filter-date":
+		if len(msg.Selected) > 0 {
+			switch msg.Selected[0] {
+			case dateFilterCustom:
+				m.pickerContext = ""
+				return m.startCustomDateFilter()
+			case dateFilterClear:
+				m.filterState.DateFilter = nil
+			default:
+				if f, err := filter.ParseDate(msg.Selected[0], clock.Now()); err == nil {
+					m.filterState.DateFilter = f
+				}
+			}
+		}
 	case "filter-project":
 		m.filterState.ProjectFilter = msg.Selected
 	case "filter-context":
@@ -941,6 +1264,27 @@ func (m TaskManagerModel) handlePickerResult(msg FuzzyPickerResultMsg) (TaskMana
 			m.directEditTaskID = ""
 			return m, func() tea.Msg { return TaskUpdateMsg{Task: *task} }
 		}
+	case "open-project-dir":
+		if len(msg.Selected) > 0 {
+			for _, p := range m.taskProjectDirs(m.selectedTask()) {
+				if p.Name == msg.Selected[0] {
+					m.inputContext.Reset()
+					m.pickerContext = ""
+					return m, openProjectDir(p.DirPath)
+				}
+			}
+		}
+	case "open-board":
+		if len(msg.Selected) > 0 {
+			for _, b := range m.taskProjectBoards(m.selectedTask()) {
+				if b.Name == msg.Selected[0] {
+					m.inputContext.Reset()
+					m.pickerContext = ""
+					path := b.Path
+					return m, func() tea.Msg { return messages.OpenBoardMsg{BoardPath: path} }
+				}
+			}
+		}
 	case "move-to-board":
 		if len(msg.Selected) > 0 {
 			boardName := msg.Selected[0]
@@ -979,6 +1323,12 @@ func (m TaskManagerModel) handleTextInputResult(msg TextInputResultMsg) (TaskMan
 	if m.inputContext.Mode == ModeSearch {
 		m.filterState.SearchQuery = msg.Value
 		m.refreshDisplayTasks()
+	} else if m.inputContext.Mode == ModeDateInput {
+		m.filterState.DateFilter = nil
+		if f, err := filter.ParseDate(msg.Value, clock.Now()); err == nil {
+			m.filterState.DateFilter = f
+		}
+		m.refreshDisplayTasks()
 	} else if m.inputContext.Mode == ModeCreateTask {
 		// Create new task and open editor
 		return m.createNewTaskAndOpenEditor(msg.Value)
@@ -986,11 +1336,26 @@ func (m TaskManagerModel) handleTextInputResult(msg TextInputResultMsg) (TaskMan
 		// Direct URL editing
 		task := m.findTaskByID(m.directEditTaskID)
 		if task != nil {
-			task.SetURL(msg.Value)
+			task.SetURLs(strings.Fields(msg.Value))
 			m.directEditTaskID = ""
 			m.inputContext.Reset()
 			return m, func() tea.Msg { return TaskUpdateMsg{Task: *task} }
 		}
+	} else if m.inputContext.Mode == ModeAttach {
+		// Store the file with the task's workspace and tag the task with it
+		task := m.findTaskByID(m.directEditTaskID)
+		m.directEditTaskID = ""
+		m.inputContext.Reset()
+		if task != nil {
+			rel, err := attachments.Store(attachments.Root(m.workspaceRoots, task.File), msg.Value)
+			if err != nil {
+				logs.Logger.Printf("Error attaching file: %v", err)
+				return m, nil
+			}
+			task.AddAttachment(rel)
+			return m, func() tea.Msg { return TaskUpdateMsg{Task: *task} }
+		}
+		return m, nil
 	} else if m.inputContext.Mode == ModeEditName {
 		// Direct name (rename) editing
 		task := m.findTaskByID(m.directEditTaskID)
@@ -1015,17 +1380,49 @@ func (m TaskManagerModel) handleEditorResult(msg TaskEditorResultMsg) (TaskManag
 		return m, nil
 	}
 
+	update := TaskUpdateMsg{Task: msg.Task, Annotations: msg.Annotations}
+	if disk, changed := changedOnDisk(msg.Original); changed {
+		diskLine := "(task no longer in " + filepath.Base(msg.Task.File) + ")"
+		if disk != nil {
+			diskLine = disk.String()
+		}
+		m.pendingConflictUpdate = &update
+		m.confirmationModal = NewConfirmationModal(
+			"Task changed on disk. Overwrite it with your edit?",
+			shared.RenderWordDiff(diskLine, msg.Task.String())+"\n\n"+
+				theme.Muted.Render("struck out: on disk  underlined: yours"),
+			min(max(m.width-10, 50), 100),
+		)
+		m.inputContext.TransitionTo(ModeConfirmation)
+		return m, nil
+	}
+
 	// Send update message
 	return m, func() tea.Msg {
-		return TaskUpdateMsg{Task: msg.Task}
+		return update
 	}
 }
 
+// changedOnDisk re-reads the file of original, a task as loaded, and
+// reports whether its line has been edited (or removed) since. disk is the
+// current version, nil when the line is gone. New tasks never conflict.
+func changedOnDisk(original data.Task) (disk *data.Task, changed bool) {
+	if original.File == "" {
+		return nil, false
+	}
+	disk, err := data.ReadTaskByID(original.File, original.ID)
+	if err != nil {
+		logs.Logger.Printf("Error re-reading %s: %v", original.File, err)
+		return nil, false
+	}
+	return disk, disk == nil || disk.String() != original.String()
+}
+
 // Helpers
 
 func (m *TaskManagerModel) refreshDisplayTasks() {
 	// Apply filters
-	filtered := ApplyFilters(m.tasks, m.filterState)
+	filtered := filter.Apply(m.tasks, m.filterState)
 
 	// Apply workspace filter (needs roots context, separate from ApplyFilters)
 	filtered = ApplyWorkspaceFilter(filtered, m.filterState.WorkspaceFilter, m.workspaceRoots)
@@ -1059,6 +1456,34 @@ func (m *TaskManagerModel) refreshDisplayTasks() {
 	m.ensureCursorVisible()
 }
 
+// isCountDigit reports whether key extends a count prefix: 1-9 start one,
+// and 0 only continues it.
+func isCountDigit(key, count string) bool {
+	if len(key) != 1 || key[0] < '0' || key[0] > '9' {
+		return false
+	}
+	return key != "0" || count != ""
+}
+
+// takeCount returns and clears the pending count prefix, defaulting to 1.
+// counted reports whether one was typed.
+func (m *TaskManagerModel) takeCount() (count int, counted bool) {
+	if m.count == "" {
+		return 1, false
+	}
+	n, err := strconv.Atoi(m.count)
+	m.count = ""
+	if err != nil || n < 1 {
+		return 1, false
+	}
+	return n, true
+}
+
+// jumpCursor moves the cursor to index, clamped to the task list.
+func (m *TaskManagerModel) jumpCursor(index int) {
+	m.moveCursor(index - m.cursor)
+}
+
 func (m *TaskManagerModel) moveCursor(delta int) {
 	m.cursor += delta
 	if m.cursor < 0 {
@@ -1084,6 +1509,9 @@ func (m *TaskManagerModel) visibleTaskRows() int {
 	if m.searchActive {
 		used++ // search input line
 	}
+	if m.showLegend {
+		used++ // priority legend line
+	}
 	visible := m.height - used
 	if visible < 1 {
 		visible = 1
@@ -1107,10 +1535,10 @@ func (m *TaskManagerModel) ensureCursorVisible() {
 
 // handleStartArchive initiates the archive flow
 func (m TaskManagerModel) handleStartArchive() (TaskManagerModel, tea.Cmd) {
-	// Count completed tasks not yet in done.txt
+	// Count completed tasks not yet in a done file
 	count := 0
 	for _, task := range m.tasks {
-		if task.Done && !strings.HasSuffix(task.File, "done.txt") {
+		if task.Done && !data.IsDoneFile(task.File) {
 			count++
 		}
 	}
@@ -1122,7 +1550,7 @@ func (m TaskManagerModel) handleStartArchive() (TaskManagerModel, tea.Cmd) {
 	// Show confirmation modal
 	m.confirmationModal = NewConfirmationModal(
 		fmt.Sprintf("Archive %d completed task(s)?", count),
-		"This will move completed tasks from todo.txt to done.txt",
+		fmt.Sprintf("This will move completed tasks from todo.txt to %s", data.DoneFileName(clock.Now().Year())),
 		50,
 	)
 	m.inputContext.TransitionTo(ModeConfirmation)
@@ -1179,11 +1607,186 @@ func (m TaskManagerModel) startMoveToBoard() (TaskManagerModel, tea.Cmd) {
 	return m, nil
 }
 
+// taskProjectDirs returns the selected task's projects that own a directory.
+func (m TaskManagerModel) taskProjectDirs(task *data.Task) []kanbanview.ProjectPickerItem {
+	if task == nil {
+		return nil
+	}
+	var result []kanbanview.ProjectPickerItem
+	for _, item := range m.allProjectItems {
+		if item.DirPath != "" && task.HasProject(item.Name) {
+			result = append(result, item)
+		}
+	}
+	return result
+}
+
+// taskProjectBoards returns the unarchived boards linked to any of the task's
+// project directories.
+func (m TaskManagerModel) taskProjectBoards(task *data.Task) []kanbanmodels.Board {
+	var result []kanbanmodels.Board
+	for _, p := range m.taskProjectDirs(task) {
+		for i := range m.boards {
+			if !m.boards[i].Archived && m.boards[i].LinksProject(p.Name, p.DirPath) {
+				result = append(result, m.boards[i])
+			}
+		}
+	}
+	return result
+}
+
+// linkedBoard returns the board a task refers to: an unarchived board whose
+// name appears in the task's text, the longest if several do, or else the
+// only board linked to the task's projects.
+func (m TaskManagerModel) linkedBoard(task *data.Task) (kanbanmodels.Board, bool) {
+	if task == nil {
+		return kanbanmodels.Board{}, false
+	}
+	best := -1
+	for i := range m.boards {
+		b := m.boards[i]
+		if b.Archived || b.Name == "" || !containsWord(task.Name, b.Name) {
+			continue
+		}
+		if best < 0 || len(b.Name) > len(m.boards[best].Name) {
+			best = i
+		}
+	}
+	if best >= 0 {
+		return m.boards[best], true
+	}
+	if boards := m.taskProjectBoards(task); len(boards) == 1 {
+		return boards[0], true
+	}
+	return kanbanmodels.Board{}, false
+}
+
+// containsWord reports whether text contains word, ignoring case, with no
+// letter or digit directly before or after it.
+func containsWord(text, word string) bool {
+	text, word = strings.ToLower(text), strings.ToLower(word)
+	for from := 0; from <= len(text)-len(word); {
+		i := strings.Index(text[from:], word)
+		if i < 0 {
+			return false
+		}
+		start, end := from+i, from+i+len(word)
+		before, _ := utf8.DecodeLastRuneInString(text[:start])
+		after, _ := utf8.DecodeRuneInString(text[end:])
+		if !isWordRune(before) && !isWordRune(after) {
+			return true
+		}
+		from = start + 1
+	}
+	return false
+}
+
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+// openLinkedBoard opens the selected task's linked board with its filter set
+// to the task's first project.
+func (m TaskManagerModel) openLinkedBoard() (TaskManagerModel, tea.Cmd) {
+	task := m.selectedTask()
+	board, ok := m.linkedBoard(task)
+	if !ok {
+		return m, tea.Printf("No board linked to this task")
+	}
+	filter := ""
+	if len(task.Projects) > 0 {
+		filter = "+" + task.Projects[0]
+	}
+	path := board.Path
+	return m, func() tea.Msg { return messages.OpenBoardMsg{BoardPath: path, Filter: filter} }
+}
+
+func (m TaskManagerModel) startOpenProjectDir() (TaskManagerModel, tea.Cmd) {
+	m.inputContext.Reset()
+	dirs := m.taskProjectDirs(m.selectedTask())
+	switch len(dirs) {
+	case 0:
+		return m, tea.Printf("No project directory for this task")
+	case 1:
+		return m, openProjectDir(dirs[0].DirPath)
+	}
+
+	names := make([]string, len(dirs))
+	for i, p := range dirs {
+		names[i] = p.Name
+	}
+	m.fuzzyPicker = NewFuzzyPicker(names, "Open Project Directory", false, false)
+	m.pickerContext = "open-project-dir"
+	m.inputContext.TransitionTo(ModeFuzzyPicker)
+	return m, nil
+}
+
+func (m TaskManagerModel) startOpenProjectBoard() (TaskManagerModel, tea.Cmd) {
+	m.inputContext.Reset()
+	boards := m.taskProjectBoards(m.selectedTask())
+	switch len(boards) {
+	case 0:
+		return m, tea.Printf("No board linked to this task's projects")
+	case 1:
+		path := boards[0].Path
+		return m, func() tea.Msg { return messages.OpenBoardMsg{BoardPath: path} }
+	}
+
+	names := make([]string, len(boards))
+	for i, b := range boards {
+		names[i] = b.Name
+	}
+	m.fuzzyPicker = NewFuzzyPicker(names, "Open Board", false, false)
+	m.pickerContext = "open-board"
+	m.inputContext.TransitionTo(ModeFuzzyPicker)
+	return m, nil
+}
+
+// openProjectDir opens a project directory in $EDITOR, or in the system file
+// manager when no editor is set.
+func openProjectDir(dir string) tea.Cmd {
+	editor := os.Getenv("EDITOR")
+	if editor == "" {
+		if err := operations.OpenURL(dir); err != nil {
+			return tea.Printf("Could not open %s: %v", dir, err)
+		}
+		return nil
+	}
+	c := exec.Command(editor, dir)
+	return tea.ExecProcess(c, func(err error) tea.Msg {
+		return messages.DataRefreshMsg{}
+	})
+}
+
 // handleConfirmationResult processes the confirmation modal result
 func (m TaskManagerModel) handleConfirmationResult(msg ConfirmationResultMsg) (TaskManagerModel, tea.Cmd) {
 	m.confirmationModal = nil
 	m.inputContext.Reset()
 
+	// Conflict flow: overwrite with the edit, or drop it and show the file's version
+	if m.pendingConflictUpdate != nil {
+		update := *m.pendingConflictUpdate
+		m.pendingConflictUpdate = nil
+		if msg.Confirmed {
+			return m, func() tea.Msg { return update }
+		}
+		if err := m.taskSvc.Reload(); err != nil {
+			logs.Logger.Printf("Error reloading tasks: %v", err)
+		}
+		m.loadTasks()
+		return m, nil
+	}
+
+	// Bulk schedule flow
+	if m.pendingBulkUpdate != nil {
+		update := *m.pendingBulkUpdate
+		m.pendingBulkUpdate = nil
+		if msg.Confirmed {
+			return m, func() tea.Msg { return update }
+		}
+		return m, nil
+	}
+
 	if !msg.Confirmed {
 		m.pendingDeleteTaskID = ""
 		return m, nil
@@ -1201,7 +1804,7 @@ func (m TaskManagerModel) handleConfirmationResult(msg ConfirmationResultMsg) (T
 	// Archive flow
 	count := 0
 	for _, task := range m.tasks {
-		if task.Done && !strings.HasSuffix(task.File, "done.txt") {
+		if task.Done && !data.IsDoneFile(task.File) {
 			count++
 		}
 	}
@@ -1227,7 +1830,7 @@ func (m *TaskManagerModel) HintText() string {
 // IsInModalState returns true if the task manager is in a mode that should
 // block global key handling (editor, picker, input, search, or any non-normal mode)
 func (m *TaskManagerModel) IsInModalState() bool {
-	if m.taskEditor != nil || m.fuzzyPicker != nil || m.textInput != nil || m.datePicker != nil || m.searchActive || m.confirmationModal != nil {
+	if m.taskEditor != nil || m.fuzzyPicker != nil || m.textInput != nil || m.datePicker != nil || m.urlPicker != nil || m.searchActive || m.confirmationModal != nil {
 		return true
 	}
 	return m.inputContext.Mode != ModeNormal
@@ -1305,6 +1908,8 @@ func (m TaskManagerModel) startDirectContextEdit() (TaskManagerModel, tea.Cmd) {
 	m.directEditTaskID = task.ID
 	m.fuzzyPicker = NewFuzzyPicker(m.allContexts, "Select Contexts", true, false)
 	m.fuzzyPicker.PreSelect(task.Contexts)
+	m.fuzzyPicker.Counts = m.contextCounts
+	m.fuzzyPicker.SetWidth(m.width)
 	m.pickerContext = "edit-context"
 	m.inputContext.TransitionTo(ModeFuzzyPicker)
 	return m, nil
@@ -1317,6 +1922,7 @@ func (m TaskManagerModel) startDirectProjectEdit() (TaskManagerModel, tea.Cmd) {
 	}
 	m.directEditTaskID = task.ID
 	picker := kanbanview.NewProjectPickerModel(task.Projects, m.allProjectItems)
+	picker.SetSize(m.width, m.height)
 	m.projectPicker = &picker
 	return m, picker.Init()
 }
@@ -1345,6 +1951,15 @@ func (m TaskManagerModel) directCyclePriority() (TaskManagerModel, tea.Cmd) {
 	return m, func() tea.Msg { return TaskUpdateMsg{Task: *task} }
 }
 
+func (m TaskManagerModel) directBumpDueDate(days int) (TaskManagerModel, tea.Cmd) {
+	task := m.selectedTask()
+	if task == nil {
+		return m, nil
+	}
+	task.BumpDueDate(days, clock.Now())
+	return m, func() tea.Msg { return TaskUpdateMsg{Task: *task} }
+}
+
 func (m TaskManagerModel) startDirectNameEdit() (TaskManagerModel, tea.Cmd) {
 	task := m.selectedTask()
 	if task == nil {
@@ -1365,14 +1980,28 @@ func (m TaskManagerModel) startDirectURLEdit() (TaskManagerModel, tea.Cmd) {
 	}
 	m.directEditTaskID = task.ID
 	m.inputContext.TransitionTo(ModeEditURL)
-	m.textInput = NewTextInput("URL", "https://example.com", nil)
+	m.textInput = NewTextInput("URLs (space-separated)", "https://example.com", nil)
 	m.textInput.SetWidth(m.width)
-	if currentURL := task.GetURL(); currentURL != "" {
-		m.textInput.SetValue(currentURL)
+	if urls := task.GetURLs(); len(urls) > 0 {
+		m.textInput.SetValue(strings.Join(urls, " "))
 	}
 	return m, m.textInput.Focus()
 }
 
+// startAttach asks for the path of a file to attach to the selected task.
+func (m TaskManagerModel) startAttach() (TaskManagerModel, tea.Cmd) {
+	task := m.selectedTask()
+	if task == nil {
+		return m, nil
+	}
+	m.directEditTaskID = task.ID
+	m.inputContext.TransitionTo(ModeAttach)
+	m.textInput = NewTextInput("Attach file", "~/Downloads/spec.pdf (or drag a file here)", attachments.Validate)
+	m.textInput.Complete = attachments.Complete
+	m.textInput.SetWidth(m.width)
+	return m, m.textInput.Focus()
+}
+
 func (m TaskManagerModel) handleDatePickerUpdate(msg tea.KeyMsg) (TaskManagerModel, tea.Cmd) {
 	var cmd tea.Cmd
 	*m.datePicker, cmd = m.datePicker.Update(msg)
@@ -1380,6 +2009,14 @@ func (m TaskManagerModel) handleDatePickerUpdate(msg tea.KeyMsg) (TaskManagerMod
 	switch msg.String() {
 	case "enter", "c":
 		// Save date (or clear if 'c' was pressed)
+		if m.inputContext.Mode == ModeBulkSchedule {
+			dateStr := ""
+			if date := m.datePicker.GetDate(); date != nil {
+				dateStr = date.Format("2006-01-02")
+			}
+			m.datePicker = nil
+			return m.confirmBulkSchedule(dateStr)
+		}
 		task := m.findTaskByID(m.directEditTaskID)
 		if task != nil {
 			date := m.datePicker.GetDate()
@@ -21,6 +21,7 @@ type TextInputModel struct {
 	Input       textinput.Model
 	Prompt      string
 	Validator   func(string) error
+	Complete    func(string) string // completes the value on tab, if set
 	Placeholder string
 	Error       string
 	Width       int
@@ -46,11 +47,6 @@ func NewTextInput(prompt string, placeholder string, validator func(string) erro
 	}
 }
 
-// NewDateInput creates a text input configured for date entry
-func NewDateInput(prompt string) *TextInputModel {
-	return NewTextInput(prompt, "yyyy-MM-dd", ValidateDateFormat)
-}
-
 // Init implements tea.Model
 func (m *TextInputModel) Init() tea.Cmd {
 	return textinput.Blink
@@ -83,6 +79,14 @@ func (m *TextInputModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					Cancelled: true,
 				}
 			}
+
+		case "tab":
+			if m.Complete != nil {
+				m.Input.SetValue(m.Complete(m.Input.Value()))
+				m.Input.CursorEnd()
+				m.Error = ""
+				return m, nil
+			}
 		}
 	}
 
@@ -105,7 +109,11 @@ func (m *TextInputModel) View() string {
 		content += inputErrorStyle.Render("Error: " + m.Error) + "\n"
 	}
 
-	content += theme.Muted.Render("[enter] confirm  [esc] cancel")
+	if m.Complete != nil {
+		content += theme.Muted.Render("[tab] complete  [enter] confirm  [esc] cancel")
+	} else {
+		content += theme.Muted.Render("[enter] confirm  [esc] cancel")
+	}
 
 	return inputBoxStyle.Width(m.Width).Render(content)
 }
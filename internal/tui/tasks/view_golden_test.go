@@ -0,0 +1,35 @@
+package tasks
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"wydo/internal/golden"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/service"
+)
+
+func TestTaskManagerView_Golden(t *testing.T) {
+	golden.FixClock(t)
+	dir := t.TempDir()
+	todo := strings.Join([]string{
+		"(A) Call the landlord +home @phone due:2026-03-04",
+		"Write the migration guide +alpha due:2026-03-09",
+		"(B) Renew certificates +ops due:2026-03-02",
+		"Plan the offsite +team",
+		"x 2026-03-03 Fix CI +ops",
+	}, "\n") + "\n"
+	if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte(todo), 0644); err != nil {
+		t.Fatal(err)
+	}
+	svc, err := service.NewTaskService([]scanner.TaskDirInfo{{DirPath: dir, Files: []string{"todo.txt"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	m := NewTaskManagerModel(svc, []string{dir}, nil, nil)
+	m.SetSize(80, 20)
+	golden.Assert(t, "task_manager_80x20", strings.ReplaceAll(m.View(), dir, "$TASKS"))
+}
@@ -11,15 +11,26 @@ var (
 	TextMuted  = lipgloss.Color("8")
 	TextBright = lipgloss.Color("15")
 
-	Primary   = lipgloss.Color("4")  // blue
-	Secondary = lipgloss.Color("6")  // cyan
-	Accent    = lipgloss.Color("5")  // magenta
-	Success   = lipgloss.Color("2")  // green
-	Warning   = lipgloss.Color("3")  // yellow
-	Danger    = lipgloss.Color("1")  // red
+	Primary   = lipgloss.Color("4")   // blue
+	Secondary = lipgloss.Color("6")   // cyan
+	Accent    = lipgloss.Color("5")   // magenta
+	Success   = lipgloss.Color("2")   // green
+	Warning   = lipgloss.Color("3")   // yellow
+	Danger    = lipgloss.Color("1")   // red
 	Surface   = lipgloss.Color("236") // dark bg
-	Border    = lipgloss.Color("8")  // dim
-	BorderFocused = lipgloss.Color("4") // blue
+	Border    = lipgloss.Color("8")   // dim
+)
+
+// Interaction states. They stay clear of the priority and due-date colors
+// (magenta, red, orange, yellow, green, gray) so that a selected item never
+// reads as urgent, and an urgent one never reads as selected.
+var (
+	SelectionBg = lipgloss.Color("24")  // deep blue: the item under the cursor
+	SelectionFg = lipgloss.Color("15")  // text on SelectionBg
+	FocusBorder = lipgloss.Color("39")  // bright blue: the focused column, card or pane
+	Hover       = lipgloss.Color("237") // the cursor's place in a pane without focus
+	Disabled    = lipgloss.Color("240") // items that can't be chosen or edited
+	MoveBg      = lipgloss.Color("54")  // the card being moved in move mode
 )
 
 // ---------------------------------------------------------------------------
@@ -36,9 +47,9 @@ var (
 	Warn  = lipgloss.NewStyle().Bold(true).Foreground(Warning)
 	Ok    = lipgloss.NewStyle().Bold(true).Foreground(Success)
 
-	Cursor     = lipgloss.NewStyle().Bold(true).Foreground(Success)
-	Selected   = lipgloss.NewStyle().Bold(true).Foreground(Warning)
-	SelectedBg = lipgloss.NewStyle().Foreground(TextBright).Background(Surface)
+	Cursor       = lipgloss.NewStyle().Bold(true).Foreground(FocusBorder)
+	Selected     = lipgloss.NewStyle().Bold(true).Foreground(SelectionFg).Background(SelectionBg)
+	DisabledText = lipgloss.NewStyle().Foreground(Disabled).Italic(true)
 
 	Project  = lipgloss.NewStyle().Foreground(Secondary)
 	Context  = lipgloss.NewStyle().Foreground(Accent)
@@ -54,7 +65,7 @@ var (
 var (
 	ModalBox = lipgloss.NewStyle().
 			Border(lipgloss.RoundedBorder()).
-			BorderForeground(Primary).
+			BorderForeground(FocusBorder).
 			Padding(1, 2)
 
 	ModalTitle = lipgloss.NewStyle().Bold(true).Foreground(Warning)
@@ -0,0 +1,337 @@
+package tui
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"wydo/internal/clock"
+	"wydo/internal/config"
+	"wydo/internal/kanban/operations"
+	"wydo/internal/logs"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tui/shared"
+	"wydo/internal/tui/theme"
+)
+
+// tourStep is one state of the onboarding tour.
+//
+// The first steps are setup modals that create a workspace, a sample task and
+// a sample board. The rest switch to each view in turn and show a panel with
+// that view's most useful keys above the (highlighted) hint bar.
+type tourStep int
+
+const (
+	tourWelcome tourStep = iota
+	tourWorkspace
+	tourSampleTask
+	tourSampleBoard
+	tourAgenda
+	tourTasks
+	tourBoard
+	tourProjects
+	tourNotes
+	tourGoals
+	tourFinished
+)
+
+// tourViewStep describes a walkthrough step shown on top of a live view.
+type tourViewStep struct {
+	view  ViewType
+	title string
+	body  string
+	binds []shared.HelpBind
+}
+
+var tourViewSteps = map[tourStep]tourViewStep{
+	tourAgenda: {
+		view:  ViewAgendaDay,
+		title: "Agenda",
+		body:  "Everything due or scheduled today: tasks, cards, notes and project milestones.",
+		binds: []shared.HelpBind{{Key: "1-4", Desc: "day / week / month / year"}, {Key: "h / l", Desc: "previous / next"}, {Key: "enter", Desc: "open item"}, {Key: ":", Desc: "jump to a board, task or date"}},
+	},
+	tourTasks: {
+		view:  ViewTaskManager,
+		title: "Tasks",
+		body:  "Your todo.txt tasks from every workspace. The sample task is here.",
+		binds: []shared.HelpBind{{Key: "n", Desc: "new task"}, {Key: "space", Desc: "toggle done"}, {Key: "d / s", Desc: "due / scheduled date"}, {Key: "/", Desc: "search"}},
+	},
+	tourBoard: {
+		view:  ViewKanbanBoard,
+		title: "Boards",
+		body:  "Kanban boards keep one markdown file per card under boards/.",
+		binds: []shared.HelpBind{{Key: "h / l", Desc: "columns"}, {Key: "n", Desc: "new card"}, {Key: "m", Desc: "move card"}, {Key: "enter", Desc: "edit card"}},
+	},
+	tourProjects: {
+		view:  ViewProjects,
+		title: "Projects",
+		body:  "Directories under projects/ and +project tags gather notes, tasks and cards.",
+		binds: []shared.HelpBind{{Key: "enter", Desc: "open project"}, {Key: "/", Desc: "search"}},
+	},
+	tourNotes: {
+		view:  ViewNotes,
+		title: "Notes",
+		body:  "Any other markdown file in the workspace shows up here.",
+		binds: []shared.HelpBind{{Key: "j / k", Desc: "navigate"}, {Key: "enter", Desc: "open in $EDITOR"}},
+	},
+	tourGoals: {
+		view:  ViewGoals,
+		title: "Goals",
+		body:  "Monthly goals from goals.md, with progress from linked tasks and cards.",
+		binds: []shared.HelpBind{{Key: "h / l", Desc: "previous / next month"}},
+	},
+}
+
+// tourModel holds the onboarding tour state.
+type tourModel struct {
+	active    bool
+	step      tourStep
+	input     textinput.Model
+	workspace string // workspace chosen in the first step
+	boardPath string // sample board, opened in the board step
+	err       string
+}
+
+func newTourModel(workspace string) tourModel {
+	ti := textinput.New()
+	ti.CharLimit = 200
+	ti.Width = 50
+	return tourModel{active: true, step: tourWelcome, input: ti, workspace: workspace}
+}
+
+// updateTour handles keys while the tour is active.
+func (m AppModel) updateTour(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	t := &m.tour
+	switch t.step {
+	case tourWelcome:
+		switch msg.String() {
+		case "enter":
+			return m.advanceTour()
+		case "esc":
+			return m.endTour()
+		}
+		return m, nil
+
+	case tourWorkspace, tourSampleTask, tourSampleBoard:
+		switch msg.String() {
+		case "enter":
+			if err := m.applyTourInput(); err != nil {
+				t.err = err.Error()
+				return m, nil
+			}
+			return m.advanceTour()
+		case "esc":
+			return m.endTour()
+		case "tab":
+			// Skip this setup step
+			if t.step == tourWorkspace {
+				return m, nil
+			}
+			return m.advanceTour()
+		}
+		var cmd tea.Cmd
+		t.input, cmd = t.input.Update(msg)
+		return m, cmd
+
+	case tourFinished:
+		return m.endTour()
+	}
+
+	// Walkthrough steps
+	switch msg.String() {
+	case "enter", "l", "right", "n":
+		return m.advanceTour()
+	case "h", "left", "p":
+		if t.step > tourAgenda {
+			t.step--
+			return m, m.showTourView()
+		}
+	case "esc", "q":
+		return m.endTour()
+	}
+	return m, nil
+}
+
+// advanceTour moves to the next step and prepares its input or view.
+func (m AppModel) advanceTour() (tea.Model, tea.Cmd) {
+	t := &m.tour
+	t.step++
+	t.err = ""
+	switch t.step {
+	case tourWorkspace:
+		t.input.SetValue(t.workspace)
+		t.input.CursorEnd()
+		return m, t.input.Focus()
+	case tourSampleTask:
+		t.input.SetValue("Explore wydo +wydo due:" + clock.Now().Format("2006-01-02"))
+		t.input.CursorEnd()
+		return m, t.input.Focus()
+	case tourSampleBoard:
+		t.input.SetValue("Getting Started")
+		t.input.CursorEnd()
+		return m, t.input.Focus()
+	case tourAgenda:
+		t.input.Blur()
+		return m, tea.Batch(func() tea.Msg { return DataRefreshMsg{} }, m.showTourView())
+	case tourFinished:
+		return m, func() tea.Msg { return SwitchViewMsg{View: ViewAgendaDay} }
+	}
+	return m, m.showTourView()
+}
+
+// showTourView switches to the view the current walkthrough step describes.
+func (m AppModel) showTourView() tea.Cmd {
+	step, ok := tourViewSteps[m.tour.step]
+	if !ok {
+		return nil
+	}
+	if step.view == ViewKanbanBoard {
+		if m.tour.boardPath != "" {
+			path := m.tour.boardPath
+			return func() tea.Msg { return OpenBoardMsg{BoardPath: path} }
+		}
+		return func() tea.Msg { return SwitchViewMsg{View: ViewKanbanPicker} }
+	}
+	return func() tea.Msg { return SwitchViewMsg{View: step.view} }
+}
+
+// applyTourInput acts on the text entered in a setup step. Empty input skips
+// the sample task and board.
+func (m *AppModel) applyTourInput() error {
+	t := &m.tour
+	value := strings.TrimSpace(t.input.Value())
+
+	switch t.step {
+	case tourWorkspace:
+		if value == "" {
+			return fmt.Errorf("workspace directory required")
+		}
+		dir, err := filepath.Abs(config.ExpandPath(value))
+		if err != nil {
+			return err
+		}
+		for _, sub := range []string{"boards", "tasks"} {
+			if err := os.MkdirAll(filepath.Join(dir, sub), 0755); err != nil {
+				return err
+			}
+		}
+		if len(m.cfg.Workspaces) == 0 || m.cfg.Workspaces[0] != dir {
+			rest := m.cfg.Workspaces
+			if m.cfg.FirstRun && len(rest) > 0 {
+				// Replace the default workspace nobody chose yet
+				rest = rest[1:]
+			}
+			workspaces := append([]string{dir}, rest...)
+			if err := config.SaveWorkspaces(workspaces); err != nil {
+				return err
+			}
+			m.cfg.Workspaces = workspaces
+		}
+		t.workspace = dir
+
+	case tourSampleTask:
+		if value == "" {
+			return nil
+		}
+		if _, err := data.AppendTaskToFile(value, filepath.Join(t.workspace, "tasks", "todo.txt")); err != nil {
+			return err
+		}
+
+	case tourSampleBoard:
+		if value == "" {
+			return nil
+		}
+		board, err := operations.CreateBoard(filepath.Join(t.workspace, "boards"), value)
+		if err != nil {
+			return err
+		}
+		t.boardPath = board.Path
+	}
+	return nil
+}
+
+// endTour closes the tour and records it as completed.
+func (m AppModel) endTour() (tea.Model, tea.Cmd) {
+	m.tour.active = false
+	m.tour.input.Blur()
+	m.state.TourCompleted = true
+	if err := m.state.Save(); err != nil {
+		logs.Logger.Printf("Error saving state: %v", err)
+	}
+	return m, func() tea.Msg { return DataRefreshMsg{} }
+}
+
+// isTourModal reports whether the tour is showing a full-screen setup modal
+// rather than a panel over a live view.
+func (m AppModel) isTourModal() bool {
+	_, isViewStep := tourViewSteps[m.tour.step]
+	return m.tour.active && !isViewStep
+}
+
+// renderTourModal renders the setup and closing steps as a centered modal.
+func (m AppModel) renderTourModal() string {
+	t := m.tour
+	var title, body, help string
+
+	switch t.step {
+	case tourWelcome:
+		title = "Welcome to wydo"
+		body = "wydo keeps tasks, kanban boards, notes and goals as plain files\n" +
+			"in workspace directories and shows them in one agenda.\n\n" +
+			"This short tour sets up a workspace with a sample task and board,\n" +
+			"then walks through each view."
+		help = theme.Ok.Render("[enter]") + " Start  " + theme.Error.Render("[esc]") + " Skip tour"
+	case tourWorkspace:
+		title = "1/3  Create a workspace"
+		body = "Where should wydo keep your files? boards/ and tasks/ are created inside.\n\n" + t.input.View()
+		help = theme.Ok.Render("[enter]") + " Create  " + theme.Error.Render("[esc]") + " Skip tour"
+	case tourSampleTask:
+		title = "2/3  Add a sample task"
+		body = "Tasks use todo.txt syntax: +project @context due:yyyy-MM-dd.\n\n" + t.input.View()
+		help = theme.Ok.Render("[enter]") + " Add  " + theme.Muted.Render("[tab]") + " Skip step  " + theme.Error.Render("[esc]") + " Skip tour"
+	case tourSampleBoard:
+		title = "3/3  Create a sample board"
+		body = "Boards start with To Do, In Progress and Done columns.\n\n" + t.input.View()
+		help = theme.Ok.Render("[enter]") + " Create  " + theme.Muted.Render("[tab]") + " Skip step  " + theme.Error.Render("[esc]") + " Skip tour"
+	case tourFinished:
+		title = "You're all set"
+		body = "Press ? in any view for its keybindings, or run \"wydo tour\"\nto see this tour again."
+		help = theme.Ok.Render("[any key]") + " Start using wydo"
+	}
+
+	content := theme.ModalTitle.Render(title) + "\n\n" + body
+	if t.err != "" {
+		content += "\n\n" + theme.Error.Render(t.err)
+	}
+	content += "\n\n" + theme.ModalHelp.Render(help)
+
+	modal := theme.ModalBox.Width(min(76, m.width-4)).Render(content)
+	return lipgloss.Place(m.width, m.height, lipgloss.Center, lipgloss.Center, modal)
+}
+
+// renderTourPanel renders the walkthrough panel shown above the hint bar.
+func (m AppModel) renderTourPanel() string {
+	step := tourViewSteps[m.tour.step]
+	n := int(m.tour.step-tourAgenda) + 1
+	total := int(tourFinished - tourAgenda)
+
+	var sb strings.Builder
+	sb.WriteString(theme.ModalTitle.Render(fmt.Sprintf("Tour %d/%d: %s", n, total, step.title)))
+	sb.WriteString("\n")
+	sb.WriteString(step.body)
+	sb.WriteString("\n\n")
+	var binds []string
+	for _, b := range step.binds {
+		binds = append(binds, theme.Warn.Render(b.Key)+" "+b.Desc)
+	}
+	sb.WriteString(strings.Join(binds, "   "))
+	sb.WriteString("\n")
+	sb.WriteString(theme.ModalHelp.Render("enter/l: next   h: back   esc: end tour"))
+
+	panel := theme.ModalBox.Padding(0, 1).Width(min(maxContentWidth, m.width-2)).Render(sb.String())
+	return lipgloss.PlaceHorizontal(m.width, lipgloss.Center, panel)
+}
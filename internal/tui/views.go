@@ -9,18 +9,33 @@ const (
 	ViewAgendaDay    = messages.ViewAgendaDay
 	ViewAgendaWeek   = messages.ViewAgendaWeek
 	ViewAgendaMonth  = messages.ViewAgendaMonth
+	ViewAgendaYear   = messages.ViewAgendaYear
 	ViewKanbanPicker = messages.ViewKanbanPicker
 	ViewKanbanBoard  = messages.ViewKanbanBoard
 	ViewTaskManager   = messages.ViewTaskManager
 	ViewProjects      = messages.ViewProjects
 	ViewProjectDetail = messages.ViewProjectDetail
 	ViewNotes         = messages.ViewNotes
+	ViewGoals         = messages.ViewGoals
 )
 
 type SwitchViewMsg = messages.SwitchViewMsg
 type OpenBoardMsg = messages.OpenBoardMsg
+type BoardSwitchedMsg = messages.BoardSwitchedMsg
+type MoveCardToTasksMsg = messages.MoveCardToTasksMsg
+type MoveCardToBoardMsg = messages.MoveCardToBoardMsg
+type CardRegister = messages.CardRegister
+type CardRegisterMsg = messages.CardRegisterMsg
+type CaptureTaskMsg = messages.CaptureTaskMsg
+type BoardRenamedMsg = messages.BoardRenamedMsg
+type BoardDeletedMsg = messages.BoardDeletedMsg
+type BoardMissingMsg = messages.BoardMissingMsg
 type FocusTaskMsg = messages.FocusTaskMsg
+type ToggleMyDayMsg = messages.ToggleMyDayMsg
+type GotoDateMsg = messages.GotoDateMsg
 type OpenProjectMsg = messages.OpenProjectMsg
+type RenameProjectMsg = messages.RenameProjectMsg
 type DataRefreshMsg = messages.DataRefreshMsg
 type CreateSubProjectMsg = messages.CreateSubProjectMsg
+type OpenJournalMsg = messages.OpenJournalMsg
 type RequestExitMsg = messages.RequestExitMsg
@@ -0,0 +1,59 @@
+package tui
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/logs"
+	"wydo/internal/watch"
+)
+
+// filesChangedMsg is sent when task, board or card files changed on disk.
+// retry marks the one sent again after waiting for a prompt to close.
+type filesChangedMsg struct {
+	retry bool
+}
+
+// waitForChanges waits for the watcher's next burst of changes. Nil when
+// files aren't watched.
+func waitForChanges(w *watch.Watcher) tea.Cmd {
+	if w == nil {
+		return nil
+	}
+	return func() tea.Msg {
+		if _, ok := <-w.Changes(); !ok {
+			return nil
+		}
+		return filesChangedMsg{}
+	}
+}
+
+// Close stops watching files. Call it once the program has ended.
+func (m AppModel) Close() {
+	if m.watcher != nil {
+		m.watcher.Close()
+	}
+}
+
+// handleFilesChanged reloads everything after files changed outside wydo.
+// wydo's own writes don't come here (see watch), so a reload doesn't follow
+// each edit made in the app.
+// While a prompt or picker is open the reload waits, checking every second,
+// so it doesn't pull the data out from under it.
+func (m AppModel) handleFilesChanged(msg filesChangedMsg) (tea.Model, tea.Cmd) {
+	var wait tea.Cmd
+	if !msg.retry {
+		wait = waitForChanges(m.watcher)
+	} else {
+		m.reloadPending = false
+	}
+	if m.isChildInputActive() {
+		if m.reloadPending {
+			return m, wait
+		}
+		m.reloadPending = true
+		return m, tea.Batch(wait, tea.Tick(time.Second, func(time.Time) tea.Msg { return filesChangedMsg{retry: true} }))
+	}
+	logs.Logger.Println("Files changed on disk, reloading")
+	return m, tea.Batch(wait, func() tea.Msg { return DataRefreshMsg{} })
+}
@@ -0,0 +1,48 @@
+package tui
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/writeq"
+)
+
+// writeQueueTickMsg re-renders the hint bar while writes are queued, so its
+// badge follows the queue as the retries go through.
+type writeQueueTickMsg struct{}
+
+func writeQueueTick() tea.Cmd {
+	return tea.Tick(time.Second, func(time.Time) tea.Msg { return writeQueueTickMsg{} })
+}
+
+// watchWriteQueue keeps writeQueueTick running for as long as writes are
+// queued: it starts it when an update queued one and stops once the queue
+// is empty. tick is true when called for the tick itself.
+func (m AppModel) watchWriteQueue(cmd tea.Cmd, tick bool) (AppModel, tea.Cmd) {
+	if writeq.Pending() == 0 {
+		if tick {
+			// Only the tick ends itself, so at most one is ever running
+			m.watchingWrites = false
+		}
+		return m, cmd
+	}
+	if m.watchingWrites && !tick {
+		return m, cmd
+	}
+	m.watchingWrites = true
+	return m, tea.Batch(cmd, writeQueueTick())
+}
+
+// writeQueueBadge is the hint bar badge for queued writes, "" for none.
+func writeQueueBadge() string {
+	st := writeq.CurrentStatus()
+	switch len(st.Pending) {
+	case 0:
+		return ""
+	case 1:
+		return "⟳ 1 unsaved write, retrying"
+	default:
+		return fmt.Sprintf("⟳ %d unsaved writes, retrying", len(st.Pending))
+	}
+}
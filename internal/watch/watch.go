@@ -0,0 +1,157 @@
+// Package watch tells wydo when its task and board files change on disk, so
+// the TUI can pick up edits made in another editor or by a sync tool such as
+// Syncthing. Directories are watched rather than files, as editors and sync
+// tools often replace a file by renaming a new one over it.
+//
+// Changes come in bursts (a sync writing a dozen cards, an editor's write and
+// rename), so they are reported once the files have been quiet for a moment.
+// Files wydo wrote itself through writeq within that moment are left out:
+// its views already have those edits.
+package watch
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"wydo/internal/logs"
+	"wydo/internal/workspace"
+	"wydo/internal/writeq"
+)
+
+var log = logs.For("watch")
+
+// DefaultQuiet is how long the files must be left alone before a burst of
+// changes is reported.
+const DefaultQuiet = 300 * time.Millisecond
+
+// Watcher watches a set of directories for changes to task and card files.
+type Watcher struct {
+	fsw     *fsnotify.Watcher
+	quiet   time.Duration
+	changes chan struct{}
+
+	mu   sync.Mutex
+	dirs map[string]bool
+}
+
+// New starts a Watcher that reports a burst of changes once nothing has
+// changed for quiet. It watches nothing until Watch is called.
+func New(quiet time.Duration) (*Watcher, error) {
+	fsw, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+	w := &Watcher{
+		fsw:     fsw,
+		quiet:   quiet,
+		changes: make(chan struct{}, 1),
+		dirs:    make(map[string]bool),
+	}
+	go w.run()
+	return w, nil
+}
+
+// Watch sets the directories watched to dirs: new ones are added and the
+// ones no longer listed dropped. Directories that don't exist are skipped.
+func (w *Watcher) Watch(dirs []string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	want := make(map[string]bool, len(dirs))
+	for _, dir := range dirs {
+		want[filepath.Clean(dir)] = true
+	}
+	for dir := range w.dirs {
+		if !want[dir] {
+			w.fsw.Remove(dir)
+			delete(w.dirs, dir)
+		}
+	}
+	for dir := range want {
+		if w.dirs[dir] {
+			continue
+		}
+		if err := w.fsw.Add(dir); err != nil {
+			if !errors.Is(err, os.ErrNotExist) {
+				log.Warn("cannot watch directory", "dir", dir, "err", err)
+			}
+			continue
+		}
+		w.dirs[dir] = true
+	}
+}
+
+// Changes receives a value after each burst of changes. Changes made while
+// an earlier one is still unread are folded into it. It is closed by Close.
+func (w *Watcher) Changes() <-chan struct{} {
+	return w.changes
+}
+
+// Close stops watching.
+func (w *Watcher) Close() error {
+	return w.fsw.Close()
+}
+
+func (w *Watcher) run() {
+	defer close(w.changes)
+	timer := time.NewTimer(w.quiet)
+	timer.Stop()
+	for {
+		select {
+		case event, ok := <-w.fsw.Events:
+			if !ok {
+				return
+			}
+			if relevant(event) && !writeq.WrittenWithin(event.Name, w.quiet) {
+				log.Debug("file changed", "path", event.Name, "op", event.Op.String())
+				timer.Reset(w.quiet)
+			}
+		case err, ok := <-w.fsw.Errors:
+			if !ok {
+				return
+			}
+			log.Warn("watch error", "err", err)
+		case <-timer.C:
+			select {
+			case w.changes <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+// relevant reports whether event changed a task, board or card file, rather
+// than an editor's swap or backup file.
+func relevant(event fsnotify.Event) bool {
+	if event.Op == fsnotify.Chmod {
+		return false
+	}
+	name := filepath.Base(event.Name)
+	if strings.HasPrefix(name, ".") || strings.HasSuffix(name, "~") {
+		return false
+	}
+	switch filepath.Ext(name) {
+	case ".txt", ".md", ".tsv":
+		return true
+	}
+	return false
+}
+
+// Dirs returns the directories holding the workspaces' task files, boards
+// and cards.
+func Dirs(workspaces []*workspace.Workspace) []string {
+	var dirs []string
+	for _, ws := range workspaces {
+		for _, td := range ws.TaskDirs {
+			dirs = append(dirs, td.DirPath)
+		}
+		for _, board := range ws.Boards {
+			dirs = append(dirs, board.Path, filepath.Join(board.Path, "cards"))
+		}
+	}
+	return dirs
+}
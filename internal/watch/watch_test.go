@@ -0,0 +1,88 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"wydo/internal/writeq"
+)
+
+// waitChange reports whether w reports a change within d.
+func waitChange(w *Watcher, d time.Duration) bool {
+	select {
+	case <-w.Changes():
+		return true
+	case <-time.After(d):
+		return false
+	}
+}
+
+func TestWatcher_ReportsBurstOnce(t *testing.T) {
+	dir := t.TempDir()
+	w, err := New(50 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	w.Watch([]string{dir, filepath.Join(dir, "missing")})
+
+	for i := range 5 {
+		if err := os.WriteFile(filepath.Join(dir, "todo.txt"), []byte{byte('a' + i), '\n'}, 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if !waitChange(w, 2*time.Second) {
+		t.Fatal("expected a change after writing todo.txt")
+	}
+	if waitChange(w, 200*time.Millisecond) {
+		t.Error("expected the burst of writes reported once")
+	}
+}
+
+func TestWatcher_IgnoresOtherFilesAndDroppedDirs(t *testing.T) {
+	dir, other := t.TempDir(), t.TempDir()
+	w, err := New(20 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	w.Watch([]string{dir, other})
+	w.Watch([]string{dir})
+
+	for _, name := range []string{".todo.txt.swp", "board.md~", "notes.json"} {
+		os.WriteFile(filepath.Join(dir, name), []byte("x"), 0644)
+	}
+	os.WriteFile(filepath.Join(other, "todo.txt"), []byte("x"), 0644)
+	if waitChange(w, 200*time.Millisecond) {
+		t.Error("expected swap, backup and other files, and unwatched dirs, ignored")
+	}
+
+	os.WriteFile(filepath.Join(dir, "card.md"), []byte("x"), 0644)
+	if !waitChange(w, 2*time.Second) {
+		t.Error("expected a change after writing a card")
+	}
+}
+
+func TestWatcher_IgnoresOwnWrites(t *testing.T) {
+	dir := t.TempDir()
+	w, err := New(50 * time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	w.Watch([]string{dir})
+
+	if err := writeq.WriteFile(filepath.Join(dir, "card.md"), []byte("x"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if waitChange(w, 300*time.Millisecond) {
+		t.Error("expected a write made through writeq ignored")
+	}
+
+	os.WriteFile(filepath.Join(dir, "todo.txt"), []byte("x"), 0644)
+	if !waitChange(w, 2*time.Second) {
+		t.Error("expected a change after another program wrote todo.txt")
+	}
+}
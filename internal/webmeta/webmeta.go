@@ -0,0 +1,114 @@
+// Package webmeta reads what a card made from a link needs to know about the
+// page behind it: its title and the domain it is on.
+package webmeta
+
+import (
+	"context"
+	"fmt"
+	"html"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// Timeout bounds a title fetch, so a slow site never holds up a new card.
+const Timeout = 10 * time.Second
+
+// maxBody is how much of a page is read looking for its title. Titles sit in
+// the head, well within it.
+const maxBody = 512 << 10
+
+var client = &http.Client{Timeout: Timeout}
+
+var (
+	titleRe = regexp.MustCompile(`(?is)<title[^>]*>(.*?)</title>`)
+	metaRe  = regexp.MustCompile(`(?is)<meta\s[^>]*>`)
+	attrRe  = regexp.MustCompile(`(?is)([a-z][a-z0-9:_-]*)\s*=\s*("[^"]*"|'[^']*'|[^\s>]+)`)
+)
+
+// Normalize checks that raw is a web address and returns it in full, adding
+// https:// when the scheme is left out ("example.com/post").
+func Normalize(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("no URL given")
+	}
+	if !strings.Contains(raw, "://") {
+		raw = "https://" + raw
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return "", fmt.Errorf("not a web URL: %s", raw)
+	}
+	host := u.Hostname()
+	if host == "" || (!strings.Contains(host, ".") && host != "localhost") {
+		return "", fmt.Errorf("invalid URL: no host in %s", raw)
+	}
+	return u.String(), nil
+}
+
+// Domain returns the host of rawURL in lower case, without its port or a
+// leading "www.", or "" when rawURL has none.
+func Domain(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return strings.TrimPrefix(strings.ToLower(u.Hostname()), "www.")
+}
+
+// Title fetches rawURL and returns the page's title: its og:title when set,
+// else its <title>. It returns "" without error for a page that has neither.
+func Title(ctx context.Context, rawURL string) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return "", fmt.Errorf("%s: %s", rawURL, resp.Status)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != "" && !strings.Contains(ct, "html") {
+		return "", nil
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxBody))
+	if err != nil {
+		return "", err
+	}
+	return ParseTitle(string(body)), nil
+}
+
+// ParseTitle returns the title of an HTML page: its og:title when set, else
+// its <title>, with entities decoded and whitespace collapsed.
+func ParseTitle(page string) string {
+	for _, tag := range metaRe.FindAllString(page, -1) {
+		attrs := make(map[string]string)
+		for _, m := range attrRe.FindAllStringSubmatch(tag, -1) {
+			attrs[strings.ToLower(m[1])] = strings.Trim(m[2], `"'`)
+		}
+		if strings.EqualFold(attrs["property"], "og:title") && attrs["content"] != "" {
+			if title := clean(attrs["content"]); title != "" {
+				return title
+			}
+		}
+	}
+	if m := titleRe.FindStringSubmatch(page); m != nil {
+		return clean(m[1])
+	}
+	return ""
+}
+
+func clean(s string) string {
+	return strings.Join(strings.Fields(html.UnescapeString(s)), " ")
+}
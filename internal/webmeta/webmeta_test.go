@@ -0,0 +1,83 @@
+package webmeta
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		in, want string
+		ok       bool
+	}{
+		{"https://example.com/post", "https://example.com/post", true},
+		{"  example.com/post?id=1 ", "https://example.com/post?id=1", true},
+		{"http://localhost:8080/x", "http://localhost:8080/x", true},
+		{"ftp://example.com/file", "", false},
+		{"not a url", "", false},
+		{"", "", false},
+	}
+	for _, tt := range tests {
+		got, err := Normalize(tt.in)
+		if (err == nil) != tt.ok || got != tt.want {
+			t.Errorf("Normalize(%q) = %q, %v; want %q, ok=%v", tt.in, got, err, tt.want, tt.ok)
+		}
+	}
+}
+
+func TestDomain(t *testing.T) {
+	for in, want := range map[string]string{
+		"https://www.Example.com/post":     "example.com",
+		"https://blog.example.com:443/x":   "blog.example.com",
+		"http://localhost:8080/dashboards": "localhost",
+	} {
+		if got := Domain(in); got != want {
+			t.Errorf("Domain(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestParseTitle(t *testing.T) {
+	tests := []struct {
+		page, want string
+	}{
+		{"<html><head><title>\n  Go &amp; Rust\n</title></head></html>", "Go & Rust"},
+		{`<head><title>Site</title><meta content='The Post' property="og:title"></head>`, "The Post"},
+		{`<head><TITLE lang="en">Upper</TITLE><meta property="og:title" content=""></head>`, "Upper"},
+		{"<p>no title</p>", ""},
+	}
+	for _, tt := range tests {
+		if got := ParseTitle(tt.page); got != tt.want {
+			t.Errorf("ParseTitle(%q) = %q, want %q", tt.page, got, tt.want)
+		}
+	}
+}
+
+func TestTitle(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/post":
+			w.Header().Set("Content-Type", "text/html; charset=utf-8")
+			fmt.Fprint(w, "<title>A Post</title>")
+		case "/file.pdf":
+			w.Header().Set("Content-Type", "application/pdf")
+			fmt.Fprint(w, "%PDF-1.7")
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	if got, err := Title(context.Background(), srv.URL+"/post"); err != nil || got != "A Post" {
+		t.Errorf("Title(/post) = %q, %v", got, err)
+	}
+	if got, err := Title(context.Background(), srv.URL+"/file.pdf"); err != nil || got != "" {
+		t.Errorf("Title(/file.pdf) = %q, %v; want no title", got, err)
+	}
+	if _, err := Title(context.Background(), srv.URL+"/missing"); err == nil {
+		t.Error("expected an error for a 404")
+	}
+}
@@ -0,0 +1,61 @@
+package workspace
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+)
+
+// Diagnostic is a value found while loading a workspace that could not be
+// parsed and was ignored, such as a malformed due date.
+type Diagnostic struct {
+	File    string
+	Line    int // 1-based, 0 if unknown
+	Field   string
+	Message string
+}
+
+func (d Diagnostic) String() string {
+	if d.Line > 0 {
+		return fmt.Sprintf("%s:%d: %s: %s", d.File, d.Line, d.Field, d.Message)
+	}
+	return fmt.Sprintf("%s: %s: %s", d.File, d.Field, d.Message)
+}
+
+// collectDiagnostics gathers the parse warnings of the workspace's cards and
+// tasks, sorted by file and line.
+func collectDiagnostics(ws *Workspace) []Diagnostic {
+	var diags []Diagnostic
+	for _, board := range ws.Boards {
+		for _, col := range board.Columns {
+			for _, card := range col.Cards {
+				for _, w := range card.Warnings {
+					diags = append(diags, Diagnostic{
+						File:    filepath.Join(board.Path, "cards", card.Filename),
+						Line:    w.Line,
+						Field:   w.Field,
+						Message: w.Message,
+					})
+				}
+			}
+		}
+	}
+	for _, task := range ws.Tasks {
+		warnings := task.DateTagWarnings()
+		keys := make([]string, 0, len(warnings))
+		for k := range warnings {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			diags = append(diags, Diagnostic{File: task.File, Line: task.Line, Field: k, Message: warnings[k]})
+		}
+	}
+	sort.SliceStable(diags, func(i, j int) bool {
+		if diags[i].File != diags[j].File {
+			return diags[i].File < diags[j].File
+		}
+		return diags[i].Line < diags[j].Line
+	})
+	return diags
+}
@@ -0,0 +1,51 @@
+package workspace
+
+import (
+	"fmt"
+
+	"wydo/internal/scanner"
+)
+
+// ScanError is a workspace or board that could not be scanned or loaded and
+// was skipped.
+type ScanError struct {
+	Path string
+	Err  error
+}
+
+func (e ScanError) Error() string {
+	return fmt.Sprintf("%s: %v", e.Path, e.Err)
+}
+
+// LoadAll scans and loads each workspace directory, skipping the ones that
+// fail. It returns the loaded workspaces, their task directories, and the
+// errors of the skipped directories.
+func LoadAll(dirs []string, ignore ...string) ([]*Workspace, []scanner.TaskDirInfo, []ScanError) {
+	var workspaces []*Workspace
+	var taskDirs []scanner.TaskDirInfo
+	var errs []ScanError
+	for _, dir := range dirs {
+		scan, err := scanner.ScanWorkspace(dir, ignore...)
+		if err != nil {
+			errs = append(errs, ScanError{Path: dir, Err: err})
+			continue
+		}
+		ws, err := Load(scan)
+		if err != nil {
+			errs = append(errs, ScanError{Path: dir, Err: err})
+			continue
+		}
+		workspaces = append(workspaces, ws)
+		taskDirs = append(taskDirs, scan.TaskDirs...)
+	}
+	return workspaces, taskDirs, errs
+}
+
+// AllScanErrors returns errs followed by the board errors of each workspace.
+func AllScanErrors(workspaces []*Workspace, errs []ScanError) []ScanError {
+	all := append([]ScanError{}, errs...)
+	for _, ws := range workspaces {
+		all = append(all, ws.ScanErrors...)
+	}
+	return all
+}
@@ -0,0 +1,92 @@
+package workspace
+
+import (
+	"sort"
+	"strings"
+)
+
+// projectMatching decides which project spellings name the same project,
+// see SetProjectMatching.
+var projectMatching struct {
+	caseSensitive bool
+	aliases       map[string]string // spelling (folded unless case-sensitive) → project name
+}
+
+// SetProjectMatching sets how the project registry merges spellings of a
+// project name. Case is folded ("Alpha" is "alpha") unless caseSensitive;
+// aliases maps other spellings to a project's name ("alpha-project" →
+// "alpha"). Workspaces loaded afterwards use it.
+func SetProjectMatching(caseSensitive bool, aliases map[string]string) {
+	projectMatching.caseSensitive = caseSensitive
+	projectMatching.aliases = make(map[string]string, len(aliases))
+	for alias, name := range aliases {
+		alias = strings.TrimPrefix(strings.TrimSpace(alias), "+")
+		name = strings.TrimPrefix(strings.TrimSpace(name), "+")
+		if alias != "" && name != "" {
+			projectMatching.aliases[foldProjectName(alias)] = name
+		}
+	}
+}
+
+func foldProjectName(name string) string {
+	if projectMatching.caseSensitive {
+		return name
+	}
+	return strings.ToLower(name)
+}
+
+// canonicalProjectName returns the name an alias stands for, else name.
+func canonicalProjectName(name string) string {
+	if target, ok := projectMatching.aliases[foldProjectName(name)]; ok {
+		return target
+	}
+	return name
+}
+
+// projectKey is what the registry files a project under: spellings with the
+// same key are the same project.
+func projectKey(name string) string {
+	return foldProjectName(canonicalProjectName(name))
+}
+
+// SameProject reports whether a and b are spellings of the same project.
+func SameProject(a, b string) bool {
+	return projectKey(a) == projectKey(b)
+}
+
+// ProjectMerge is a project found under several spellings, which the
+// registry treats as one.
+type ProjectMerge struct {
+	Name      string   // the name the project is listed under
+	Spellings []string // the other spellings merged into it
+	Aliased   []string // those of Spellings merged by an alias rather than case
+}
+
+// Merges lists the projects found under more than one spelling, by name.
+func (r *ProjectRegistry) Merges() []ProjectMerge {
+	var result []ProjectMerge
+	for key, spellings := range r.spellings {
+		p := r.projects[key]
+		if p == nil {
+			continue
+		}
+		m := ProjectMerge{Name: p.Name}
+		for s := range spellings {
+			if s == p.Name {
+				continue
+			}
+			m.Spellings = append(m.Spellings, s)
+			if canonicalProjectName(s) != s {
+				m.Aliased = append(m.Aliased, s)
+			}
+		}
+		if len(m.Spellings) == 0 {
+			continue
+		}
+		sort.Strings(m.Spellings)
+		sort.Strings(m.Aliased)
+		result = append(result, m)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
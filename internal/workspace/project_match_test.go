@@ -0,0 +1,61 @@
+package workspace
+
+import (
+	"reflect"
+	"testing"
+
+	kanbanmodels "wydo/internal/kanban/models"
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/data"
+)
+
+func TestProjectRegistry_MergesSpellings(t *testing.T) {
+	SetProjectMatching(false, map[string]string{"Alpha-Project": "alpha"})
+	defer SetProjectMatching(false, nil)
+
+	tasks := []data.Task{
+		{Name: "one", Projects: []string{"alpha"}},
+		{Name: "two", Projects: []string{"Alpha"}},
+		{Name: "three", Projects: []string{"alpha-project"}},
+		{Name: "four", Projects: []string{"beta"}},
+	}
+	boards := []kanbanmodels.Board{{Columns: []kanbanmodels.Column{{Cards: []kanbanmodels.Card{
+		{Title: "card", Projects: []string{"ALPHA"}},
+	}}}}}
+	r := BuildProjectRegistry(&scanner.WorkspaceScan{}, tasks, boards, "")
+
+	if n := len(r.List()); n != 2 {
+		t.Fatalf("got %d projects, want alpha and beta", n)
+	}
+	if p := r.Get("Alpha-project"); p == nil || p.Name != "alpha" {
+		t.Fatalf("Get(Alpha-project) = %+v, want alpha", p)
+	}
+	if n := len(r.TasksForProject("Alpha", tasks)); n != 3 {
+		t.Errorf("TasksForProject(Alpha) = %d tasks, want 3", n)
+	}
+	if n := len(r.CardsForProject("alpha", boards)); n != 1 {
+		t.Errorf("CardsForProject(alpha) = %d cards, want 1", n)
+	}
+
+	want := []ProjectMerge{{Name: "alpha", Spellings: []string{"ALPHA", "Alpha", "alpha-project"}, Aliased: []string{"alpha-project"}}}
+	if got := r.Merges(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Merges() = %+v, want %+v", got, want)
+	}
+}
+
+func TestProjectRegistry_CaseSensitive(t *testing.T) {
+	SetProjectMatching(true, nil)
+	defer SetProjectMatching(false, nil)
+
+	tasks := []data.Task{
+		{Name: "one", Projects: []string{"alpha"}},
+		{Name: "two", Projects: []string{"Alpha"}},
+	}
+	r := BuildProjectRegistry(&scanner.WorkspaceScan{}, tasks, nil, "")
+	if n := len(r.List()); n != 2 {
+		t.Errorf("got %d projects, want Alpha and alpha apart", n)
+	}
+	if len(r.Merges()) != 0 {
+		t.Errorf("Merges() = %+v, want none", r.Merges())
+	}
+}
@@ -0,0 +1,410 @@
+package workspace
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/tasks/data"
+	"wydo/internal/writeq"
+)
+
+// RelocateStep is one file or directory moved by a merge or split.
+type RelocateStep struct {
+	From string
+	To   string
+	// Append adds From to the end of the existing To instead of moving it,
+	// for task files and annotation sidecars present on both sides
+	Append bool
+	// Lines, when set, moves only these 1-based lines of From, appending them
+	// to To; the rest of From stays
+	Lines []int
+}
+
+func (s RelocateStep) String() string {
+	switch {
+	case len(s.Lines) == 1:
+		return fmt.Sprintf("%s → %s (1 line)", s.From, s.To)
+	case len(s.Lines) > 0:
+		return fmt.Sprintf("%s → %s (%d lines)", s.From, s.To, len(s.Lines))
+	case s.Append:
+		return fmt.Sprintf("%s → %s (appended)", s.From, s.To)
+	}
+	return fmt.Sprintf("%s → %s", s.From, s.To)
+}
+
+// RelocatePlan lists the steps of a merge or split, so they can be shown
+// before anything is moved.
+type RelocatePlan struct {
+	Src   string
+	Dst   string
+	Steps []RelocateStep
+
+	// relinks are the boards whose project link must be rewritten once moved,
+	// keyed by the new board directory, to the new project index file
+	relinks map[string]string
+	claimed map[string]bool
+}
+
+func newRelocatePlan(src, dst string) *RelocatePlan {
+	return &RelocatePlan{Src: src, Dst: dst, claimed: make(map[string]bool)}
+}
+
+// PlanMerge plans moving everything in the workspace at src into the one at
+// dst. Boards keep their directory name, with a -2, -3, ... suffix when dst
+// already has a board of that name. Task files and annotation sidecars
+// (and any other .txt file) are appended to the dst file of the same name.
+// Project and note directories are merged, and a note whose name is taken
+// gets a suffix. Hidden files are left in src.
+func PlanMerge(src, dst string) (*RelocatePlan, error) {
+	src, dst = filepath.Clean(src), filepath.Clean(dst)
+	if src == dst {
+		return nil, fmt.Errorf("cannot merge a workspace into itself")
+	}
+	if strings.HasPrefix(dst, src+string(filepath.Separator)) || strings.HasPrefix(src, dst+string(filepath.Separator)) {
+		return nil, fmt.Errorf("cannot merge nested workspaces %s and %s", src, dst)
+	}
+	for _, dir := range []string{src, dst} {
+		info, err := os.Stat(dir)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("%s is not a directory", dir)
+		}
+	}
+
+	plan := newRelocatePlan(src, dst)
+	if err := plan.addTree(src, dst, true); err != nil {
+		return nil, err
+	}
+	return plan, nil
+}
+
+// addTree adds the steps moving the contents of srcDir into dstDir.
+func (p *RelocatePlan) addTree(srcDir, dstDir string, root bool) error {
+	entries, err := os.ReadDir(srcDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if strings.HasPrefix(name, ".") && !(root && name == virtualArchiveFilename) {
+			continue
+		}
+		from := filepath.Join(srcDir, name)
+		to := filepath.Join(dstDir, name)
+
+		if entry.IsDir() {
+			if isBoardDir(from) {
+				// Two boards never merge: the second one gets its own name
+				p.add(RelocateStep{From: from, To: p.unique(to)})
+				continue
+			}
+			if info, err := os.Stat(to); err == nil && info.IsDir() && !p.claimed[to] {
+				if err := p.addTree(from, to, false); err != nil {
+					return err
+				}
+				continue
+			}
+			p.add(RelocateStep{From: from, To: p.unique(to)})
+			continue
+		}
+
+		if _, err := os.Stat(to); err == nil && appendable(name) {
+			p.Steps = append(p.Steps, RelocateStep{From: from, To: to, Append: true})
+			continue
+		}
+		p.add(RelocateStep{From: from, To: p.unique(to)})
+	}
+	return nil
+}
+
+func (p *RelocatePlan) add(step RelocateStep) {
+	p.claimed[step.To] = true
+	p.Steps = append(p.Steps, step)
+}
+
+// unique returns path, or path with a -2, -3, ... suffix before its
+// extension when path exists or another step already moves something there.
+func (p *RelocatePlan) unique(path string) string {
+	ext := filepath.Ext(path)
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		ext = ""
+	}
+	base := strings.TrimSuffix(path, ext)
+	candidate := path
+	for i := 2; ; i++ {
+		if _, err := os.Stat(candidate); os.IsNotExist(err) && !p.claimed[candidate] {
+			return candidate
+		}
+		candidate = fmt.Sprintf("%s-%d%s", base, i, ext)
+	}
+}
+
+// appendable reports whether a file of this name is appended to an existing
+// one on merge rather than renamed.
+func appendable(name string) bool {
+	return strings.HasSuffix(name, ".txt") || name == data.AnnotationsFile
+}
+
+func isBoardDir(dir string) bool {
+	_, err := os.Stat(filepath.Join(dir, "board.md"))
+	return err == nil
+}
+
+// PlanSplit plans moving everything that belongs to project, and to the
+// projects below it, out of ws into a new workspace at newDir: the project
+// directory, the boards linked to it, its task lines (with their
+// annotations) and the notes elsewhere that list it in their frontmatter.
+// newDir must not exist or be empty.
+func PlanSplit(ws *Workspace, project, newDir string) (*RelocatePlan, error) {
+	proj := ws.Projects.Get(project)
+	if proj == nil {
+		return nil, fmt.Errorf("project %q not found", project)
+	}
+	newDir = filepath.Clean(newDir)
+	if entries, err := os.ReadDir(newDir); err == nil && len(entries) > 0 {
+		return nil, fmt.Errorf("%s is not empty", newDir)
+	}
+	if strings.HasPrefix(newDir, ws.RootDir+string(filepath.Separator)) {
+		return nil, fmt.Errorf("%s is inside the workspace %s", newDir, ws.RootDir)
+	}
+
+	plan := newRelocatePlan(ws.RootDir, newDir)
+	plan.relinks = make(map[string]string)
+	members := ws.Projects.withDescendants(proj)
+	names := make(map[string]bool, len(members)) // by projectKey
+	for _, m := range members {
+		names[projectKey(m.Name)] = true
+	}
+	inProjectDir := func(path string) bool {
+		return proj.DirPath != "" && strings.HasPrefix(path, proj.DirPath+string(filepath.Separator))
+	}
+
+	newProjectDir := ""
+	if proj.DirPath != "" {
+		newProjectDir = filepath.Join(newDir, "projects", proj.Name)
+		plan.add(RelocateStep{From: proj.DirPath, To: newProjectDir})
+	}
+
+	for _, b := range ws.Boards {
+		if proj.DirPath == "" {
+			break
+		}
+		for _, m := range members {
+			if m.DirPath != proj.DirPath && !inProjectDir(m.DirPath) {
+				continue
+			}
+			if !b.LinksProject(m.Name, m.DirPath) {
+				continue
+			}
+			newIndex := filepath.Join(newProjectDir, strings.TrimPrefix(m.DirPath, proj.DirPath), m.Name+".md")
+			newBoard := filepath.Join(newProjectDir, strings.TrimPrefix(b.Path, proj.DirPath))
+			if !inProjectDir(b.Path) {
+				newBoard = plan.unique(filepath.Join(newDir, "boards", filepath.Base(b.Path)))
+				plan.add(RelocateStep{From: b.Path, To: newBoard})
+			}
+			if rel, err := filepath.Rel(newBoard, newIndex); err == nil && rel != b.Project {
+				plan.relinks[newBoard] = rel
+			}
+			break
+		}
+	}
+
+	lines := make(map[string][]int)
+	keys := make(map[string]bool)
+	for _, t := range ws.Tasks {
+		if inProjectDir(t.File) || !tasksBelongTo(t, names) {
+			continue
+		}
+		lines[t.File] = append(lines[t.File], t.Line)
+		if key := t.GetAnnotationKey(); key != "" {
+			keys[key] = true
+		}
+	}
+	files := make([]string, 0, len(lines))
+	for f := range lines {
+		files = append(files, f)
+	}
+	sort.Strings(files)
+	for _, f := range files {
+		plan.Steps = append(plan.Steps, RelocateStep{From: f, To: filepath.Join(newDir, "tasks", filepath.Base(f)), Lines: lines[f]})
+		sidecar := data.AnnotationsPath(f)
+		if n, err := annotationLines(sidecar, keys); err == nil && len(n) > 0 && !plan.claimed[sidecar] {
+			plan.claimed[sidecar] = true
+			plan.Steps = append(plan.Steps, RelocateStep{From: sidecar, To: filepath.Join(newDir, "tasks", data.AnnotationsFile), Lines: n})
+		}
+	}
+
+	for _, n := range ws.Notes {
+		if inProjectDir(n.FilePath) {
+			continue
+		}
+		for _, name := range n.Projects {
+			if names[projectKey(name)] {
+				rel, err := filepath.Rel(ws.RootDir, n.FilePath)
+				if err != nil {
+					return nil, err
+				}
+				plan.add(RelocateStep{From: n.FilePath, To: plan.unique(filepath.Join(newDir, rel))})
+				break
+			}
+		}
+	}
+
+	if len(plan.Steps) == 0 {
+		return nil, fmt.Errorf("nothing belongs to project %q", project)
+	}
+	return plan, nil
+}
+
+// withDescendants returns p followed by every project below it.
+func (r *ProjectRegistry) withDescendants(p *Project) []*Project {
+	result := []*Project{p}
+	for i := 0; i < len(result); i++ {
+		children := r.ChildrenOf(result[i].Name)
+		sort.Slice(children, func(a, b int) bool { return children[a].Name < children[b].Name })
+		result = append(result, children...)
+	}
+	return result
+}
+
+func tasksBelongTo(t data.Task, names map[string]bool) bool {
+	for _, p := range t.Projects {
+		if names[projectKey(p)] {
+			return true
+		}
+	}
+	return false
+}
+
+// annotationLines returns the 1-based lines of the sidecar at path that
+// annotate one of keys.
+func annotationLines(path string, keys map[string]bool) ([]int, error) {
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	content, err := writeq.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var result []int
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		key, _, _ := strings.Cut(scanner.Text(), "\t")
+		if keys[key] {
+			result = append(result, lineNum)
+		}
+	}
+	return result, scanner.Err()
+}
+
+// Apply carries out the plan. Directories left empty in Src by the moves are
+// removed; Src itself is kept.
+func (p *RelocatePlan) Apply() error {
+	for _, step := range p.Steps {
+		if err := os.MkdirAll(filepath.Dir(step.To), 0755); err != nil {
+			return err
+		}
+		var err error
+		switch {
+		case len(step.Lines) > 0:
+			err = moveLines(step.From, step.To, step.Lines)
+		case step.Append:
+			err = appendAndRemove(step.From, step.To)
+		default:
+			err = writeq.Rename(step.From, step.To)
+		}
+		if err != nil {
+			return fmt.Errorf("%s: %w", step.From, err)
+		}
+		if len(step.Lines) == 0 {
+			p.pruneEmpty(filepath.Dir(step.From))
+		}
+	}
+
+	for boardDir, link := range p.relinks {
+		board, err := fs.ReadBoard(boardDir)
+		if err != nil {
+			return err
+		}
+		board.Project = link
+		if err := fs.WriteBoard(board); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// pruneEmpty removes dir and its parents below Src while they are empty.
+func (p *RelocatePlan) pruneEmpty(dir string) {
+	for dir != p.Src && strings.HasPrefix(dir, p.Src+string(filepath.Separator)) {
+		if os.Remove(dir) != nil {
+			return
+		}
+		dir = filepath.Dir(dir)
+	}
+}
+
+// appendAndRemove appends the file from to the file to, starting on a line
+// of its own, and removes from.
+func appendAndRemove(from, to string) error {
+	content, err := writeq.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	if len(content) > 0 && content[len(content)-1] != '\n' {
+		content = append(content, '\n')
+	}
+	if err := appendOnNewLine(to, content); err != nil {
+		return err
+	}
+	return writeq.Remove(from)
+}
+
+// moveLines appends the given 1-based lines of from to to and removes them
+// from from.
+func moveLines(from, to string, lines []int) error {
+	content, err := writeq.ReadFile(from)
+	if err != nil {
+		return err
+	}
+	take := make(map[int]bool, len(lines))
+	for _, n := range lines {
+		take[n] = true
+	}
+	var moved bytes.Buffer
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	lineNum := 0
+	for scanner.Scan() {
+		lineNum++
+		if take[lineNum] {
+			moved.WriteString(scanner.Text())
+			moved.WriteByte('\n')
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return err
+	}
+	if err := appendOnNewLine(to, moved.Bytes()); err != nil {
+		return err
+	}
+	return data.RemoveLines(from, lines)
+}
+
+// appendOnNewLine appends content to path, first ending its last line if it
+// doesn't end in a newline.
+func appendOnNewLine(path string, content []byte) error {
+	if existing, err := writeq.ReadFile(path); err == nil && len(existing) > 0 && existing[len(existing)-1] != '\n' {
+		content = append([]byte("\n"), content...)
+	}
+	return writeq.AppendFile(path, content, 0644)
+}
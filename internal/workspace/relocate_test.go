@@ -0,0 +1,164 @@
+package workspace
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"wydo/internal/scanner"
+)
+
+func writeTestFile(t *testing.T, path, content string) {
+	t.Helper()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func readTestFile(t *testing.T, path string) string {
+	t.Helper()
+	content, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v", path, err)
+	}
+	return string(content)
+}
+
+func TestMerge_HandlesCollisions(t *testing.T) {
+	src, dst := t.TempDir(), t.TempDir()
+	writeTestFile(t, filepath.Join(src, "boards", "sprint", "board.md"), "# sprint src\n\n## Todo\n")
+	writeTestFile(t, filepath.Join(src, "boards", "home", "board.md"), "# home\n\n## Todo\n")
+	writeTestFile(t, filepath.Join(src, "tasks", "todo.txt"), "Call Bob +home")
+	writeTestFile(t, filepath.Join(src, "tasks", "done.txt"), "x Old task\n")
+	writeTestFile(t, filepath.Join(src, "projects", "alpha", "plan.md"), "# src plan\n")
+	writeTestFile(t, filepath.Join(src, "projects", "alpha", "extra.md"), "# extra\n")
+	writeTestFile(t, filepath.Join(src, "notes", "ideas.md"), "# src ideas\n")
+	writeTestFile(t, filepath.Join(src, ".git", "HEAD"), "ref\n")
+
+	writeTestFile(t, filepath.Join(dst, "boards", "sprint", "board.md"), "# sprint dst\n\n## Todo\n")
+	writeTestFile(t, filepath.Join(dst, "tasks", "todo.txt"), "Write report\n")
+	writeTestFile(t, filepath.Join(dst, "projects", "alpha", "plan.md"), "# dst plan\n")
+	writeTestFile(t, filepath.Join(dst, "notes", "ideas.md"), "# dst ideas\n")
+
+	plan, err := PlanMerge(src, dst)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	if got := readTestFile(t, filepath.Join(dst, "boards", "sprint", "board.md")); !strings.Contains(got, "sprint dst") {
+		t.Errorf("dst sprint board overwritten: %q", got)
+	}
+	if got := readTestFile(t, filepath.Join(dst, "boards", "sprint-2", "board.md")); !strings.Contains(got, "sprint src") {
+		t.Errorf("src sprint board not moved to sprint-2: %q", got)
+	}
+	readTestFile(t, filepath.Join(dst, "boards", "home", "board.md"))
+	if got := readTestFile(t, filepath.Join(dst, "tasks", "todo.txt")); got != "Write report\nCall Bob +home\n" {
+		t.Errorf("todo.txt = %q", got)
+	}
+	readTestFile(t, filepath.Join(dst, "tasks", "done.txt"))
+	if got := readTestFile(t, filepath.Join(dst, "projects", "alpha", "plan-2.md")); got != "# src plan\n" {
+		t.Errorf("plan-2.md = %q", got)
+	}
+	readTestFile(t, filepath.Join(dst, "projects", "alpha", "extra.md"))
+	readTestFile(t, filepath.Join(dst, "notes", "ideas-2.md"))
+
+	// Only the hidden files stay behind
+	entries, _ := os.ReadDir(src)
+	if len(entries) != 1 || entries[0].Name() != ".git" {
+		t.Errorf("expected only .git left in src, got %v", entries)
+	}
+}
+
+func TestMerge_RejectsSameOrNested(t *testing.T) {
+	dir := t.TempDir()
+	if _, err := PlanMerge(dir, dir); err == nil {
+		t.Error("expected an error merging a workspace into itself")
+	}
+	nested := filepath.Join(dir, "sub")
+	os.MkdirAll(nested, 0755)
+	if _, err := PlanMerge(nested, dir); err == nil {
+		t.Error("expected an error merging nested workspaces")
+	}
+}
+
+func TestSplit_ExtractsProject(t *testing.T) {
+	root, newDir := t.TempDir(), filepath.Join(t.TempDir(), "alpha-ws")
+	writeTestFile(t, filepath.Join(root, "projects", "alpha", "alpha.md"), "# alpha\n")
+	writeTestFile(t, filepath.Join(root, "projects", "alpha", "projects", "beta", "beta.md"), "# beta\n")
+	writeTestFile(t, filepath.Join(root, "boards", "sprint", "board.md"),
+		"---\nproject: ../../projects/alpha/projects/beta/beta.md\n---\n\n# sprint\n\n## Todo\n")
+	writeTestFile(t, filepath.Join(root, "boards", "home", "board.md"), "# home\n\n## Todo\n")
+	writeTestFile(t, filepath.Join(root, "tasks", "todo.txt"), "Ship it +alpha ann:k1\nPaint fence +home\nReview +beta\n")
+	writeTestFile(t, filepath.Join(root, "tasks", "annotations.tsv"), "k1\t2026-01-02T10:00:00Z\twaiting\nk2\t2026-01-02T10:00:00Z\tother\n")
+	writeTestFile(t, filepath.Join(root, "notes", "2026-03-02-meeting.md"), "---\nprojects: [alpha]\n---\n\n# Meeting\n")
+	writeTestFile(t, filepath.Join(root, "notes", "garden.md"), "# Garden\n")
+
+	scan, err := scanner.ScanWorkspace(root)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	ws, err := Load(scan)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	plan, err := PlanSplit(ws, "alpha", newDir)
+	if err != nil {
+		t.Fatalf("plan: %v", err)
+	}
+	if err := plan.Apply(); err != nil {
+		t.Fatalf("apply: %v", err)
+	}
+
+	readTestFile(t, filepath.Join(newDir, "projects", "alpha", "projects", "beta", "beta.md"))
+	readTestFile(t, filepath.Join(newDir, "notes", "2026-03-02-meeting.md"))
+	readTestFile(t, filepath.Join(root, "notes", "garden.md"))
+	readTestFile(t, filepath.Join(root, "boards", "home", "board.md"))
+	if _, err := os.Stat(filepath.Join(root, "projects")); !os.IsNotExist(err) {
+		t.Errorf("expected the emptied projects/ dir to be removed")
+	}
+
+	if got := readTestFile(t, filepath.Join(newDir, "tasks", "todo.txt")); got != "Ship it +alpha ann:k1\nReview +beta\n" {
+		t.Errorf("new todo.txt = %q", got)
+	}
+	if got := readTestFile(t, filepath.Join(root, "tasks", "todo.txt")); got != "Paint fence +home\n" {
+		t.Errorf("old todo.txt = %q", got)
+	}
+	if got := readTestFile(t, filepath.Join(newDir, "tasks", "annotations.tsv")); !strings.HasPrefix(got, "k1\t") || strings.Contains(got, "k2") {
+		t.Errorf("new annotations.tsv = %q", got)
+	}
+
+	// The moved board still links to beta
+	scan, err = scanner.ScanWorkspace(newDir)
+	if err != nil {
+		t.Fatalf("scan new: %v", err)
+	}
+	moved, err := Load(scan)
+	if err != nil {
+		t.Fatalf("load new: %v", err)
+	}
+	if boards := moved.Projects.BoardsForProject("beta", moved.Boards); len(boards) != 1 {
+		t.Errorf("expected the sprint board linked to beta in the new workspace, got %d", len(boards))
+	}
+}
+
+func TestSplit_RejectsNonEmptyTarget(t *testing.T) {
+	root, target := t.TempDir(), t.TempDir()
+	writeTestFile(t, filepath.Join(root, "projects", "alpha", "alpha.md"), "# alpha\n")
+	writeTestFile(t, filepath.Join(target, "x.md"), "# x\n")
+	scan, _ := scanner.ScanWorkspace(root)
+	ws, _ := Load(scan)
+	if _, err := PlanSplit(ws, "alpha", target); err == nil {
+		t.Error("expected an error splitting into a non-empty directory")
+	}
+	if _, err := PlanSplit(ws, "missing", filepath.Join(target, "new")); err == nil {
+		t.Error("expected an error for an unknown project")
+	}
+}
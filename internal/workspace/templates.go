@@ -0,0 +1,91 @@
+package workspace
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// templateProjectPlaceholder is replaced with the new project's name in a
+// template's file names and file contents.
+const templateProjectPlaceholder = "{{project}}"
+
+// ListProjectTemplates returns the names of the project templates in dir:
+// each subdirectory is one template. A missing dir has no templates.
+func ListProjectTemplates(dir string) ([]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var names []string
+	for _, e := range entries {
+		if e.IsDir() && !strings.HasPrefix(e.Name(), ".") {
+			names = append(names, e.Name())
+		}
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// CreateProject creates the project directory name inside projectsDir. With
+// a templateDir, the template's subdirectories, notes and boards are copied
+// in first, with {{project}} replaced by name. The index note name.md is
+// written unless the template provided one.
+func CreateProject(projectsDir, name, templateDir string) error {
+	projectDir := filepath.Join(projectsDir, name)
+	if err := os.MkdirAll(projectDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create directory: %w", err)
+	}
+
+	if templateDir != "" {
+		if err := copyProjectTemplate(templateDir, projectDir, name); err != nil {
+			return fmt.Errorf("failed to apply template %s: %w", filepath.Base(templateDir), err)
+		}
+	}
+
+	indexPath := filepath.Join(projectDir, name+".md")
+	if _, err := os.Stat(indexPath); os.IsNotExist(err) {
+		content := fmt.Sprintf("# %s\n", name)
+		if err := os.WriteFile(indexPath, []byte(content), 0o644); err != nil {
+			return fmt.Errorf("failed to write index note: %w", err)
+		}
+	}
+	return nil
+}
+
+// copyProjectTemplate copies templateDir into projectDir, filling in the
+// project name. Existing files are left alone.
+func copyProjectTemplate(templateDir, projectDir, name string) error {
+	fill := func(s string) string {
+		return strings.ReplaceAll(s, templateProjectPlaceholder, name)
+	}
+
+	return filepath.WalkDir(templateDir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(templateDir, path)
+		if err != nil || rel == "." {
+			return err
+		}
+		target := filepath.Join(projectDir, fill(rel))
+
+		if d.IsDir() {
+			return os.MkdirAll(target, 0o755)
+		}
+		if _, err := os.Stat(target); err == nil {
+			return nil
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		return os.WriteFile(target, []byte(fill(string(content))), 0o644)
+	})
+}
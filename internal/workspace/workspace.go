@@ -9,9 +9,11 @@ import (
 	"strings"
 	"time"
 
+	"wydo/internal/goals"
 	"wydo/internal/kanban/fs"
 	kanbanmodels "wydo/internal/kanban/models"
 	"wydo/internal/notes"
+	"wydo/internal/refs"
 	"wydo/internal/scanner"
 	"wydo/internal/tasks/data"
 	"wydo/internal/tasks/service"
@@ -28,6 +30,13 @@ type Workspace struct {
 	Projects *ProjectRegistry
 	TaskDirs []scanner.TaskDirInfo
 	TaskSvc  service.TaskService
+	Goals    []goals.Goal
+	// Refs indexes the tasks, notes and cards that refer to each card
+	Refs *refs.Index
+	// Diagnostics lists dates and frontmatter that could not be parsed
+	Diagnostics []Diagnostic
+	// ScanErrors lists boards that could not be read and were left out
+	ScanErrors []ScanError
 }
 
 // Load creates a Workspace from a scan result
@@ -41,6 +50,7 @@ func Load(scan *scanner.WorkspaceScan) (*Workspace, error) {
 	for _, bi := range scan.Boards {
 		board, err := fs.ReadBoard(bi.Path)
 		if err != nil {
+			ws.ScanErrors = append(ws.ScanErrors, ScanError{Path: bi.Path, Err: err})
 			continue
 		}
 		ws.Boards = append(ws.Boards, board)
@@ -70,9 +80,32 @@ func Load(scan *scanner.WorkspaceScan) (*Workspace, error) {
 	// Build project registry
 	ws.Projects = BuildProjectRegistry(scan, ws.Tasks, ws.Boards, scan.RootDir)
 
+	ws.Goals = loadGoals(ws)
+	ws.Refs = refs.Build(scan.RootDir, ws.Tasks, ws.Boards, scan.NotePaths)
+	ws.Diagnostics = collectDiagnostics(ws)
+
 	return ws, nil
 }
 
+// loadGoals reads the workspace-level goals.md plus one per directory project.
+func loadGoals(ws *Workspace) []goals.Goal {
+	var result []goals.Goal
+	if gs, err := goals.ReadGoals(filepath.Join(ws.RootDir, goals.GoalsFilename), ""); err == nil {
+		result = append(result, gs...)
+	}
+	projects := ws.Projects.List()
+	sort.Slice(projects, func(i, j int) bool { return projects[i].Name < projects[j].Name })
+	for _, p := range projects {
+		if p.DirPath == "" {
+			continue
+		}
+		if gs, err := goals.ReadGoals(filepath.Join(p.DirPath, goals.GoalsFilename), p.Name); err == nil {
+			result = append(result, gs...)
+		}
+	}
+	return result
+}
+
 // MoveProjectToParent moves a project's directory under a new parent project (or to root).
 // If newParent is nil, moves to the first ProjectsDir of the workspace (root-level).
 // Updates project.DirPath and project.Parent in memory; caller must emit DataRefreshMsg.
@@ -218,6 +251,63 @@ func (ws *Workspace) RenameProject(oldName, newName string) error {
 	return nil
 }
 
+// RenamePreview counts what RenameProject would change.
+type RenamePreview struct {
+	Tasks int  // task lines whose +project tag changes
+	Cards int  // card files whose projects frontmatter changes
+	Dirs  int  // project directories renamed or merged
+	Merge bool // the new name is an existing project, so the two are merged
+}
+
+// String describes the preview, e.g. "3 tasks, 2 cards, 1 directory".
+func (p RenamePreview) String() string {
+	count := func(n int, one, many string) string {
+		if n == 1 {
+			return fmt.Sprintf("%d %s", n, one)
+		}
+		return fmt.Sprintf("%d %s", n, many)
+	}
+	return count(p.Tasks, "task", "tasks") + ", " + count(p.Cards, "card", "cards") + ", " + count(p.Dirs, "directory", "directories")
+}
+
+// PreviewRename counts the tasks, cards and directories that renaming
+// oldName to newName would touch, without changing anything.
+func (ws *Workspace) PreviewRename(oldName, newName string) (RenamePreview, error) {
+	return PreviewRename(ws.Projects, ws.Tasks, ws.Boards, oldName, newName)
+}
+
+// PreviewRename is Workspace.PreviewRename over an already loaded registry,
+// tasks and boards, for views that hold those rather than the workspace.
+func PreviewRename(registry *ProjectRegistry, tasks []data.Task, boards []kanbanmodels.Board, oldName, newName string) (RenamePreview, error) {
+	var p RenamePreview
+	project := registry.Get(oldName)
+	if project == nil {
+		return p, fmt.Errorf("project %q not found", oldName)
+	}
+	p.Merge = registry.Get(newName) != nil
+	if project.DirPath != "" {
+		p.Dirs = 1
+	}
+	for _, t := range tasks {
+		if t.HasProject(oldName) {
+			p.Tasks++
+		}
+	}
+	for _, b := range boards {
+		for _, col := range b.Columns {
+			for _, card := range col.Cards {
+				for _, name := range card.Projects {
+					if strings.EqualFold(name, oldName) {
+						p.Cards++
+						break
+					}
+				}
+			}
+		}
+	}
+	return p, nil
+}
+
 // DeleteVirtualProject removes all references to a virtual project from tasks and cards,
 // and removes it from the virtual archive file.
 func DeleteVirtualProject(ws *Workspace, projectName string) error {
@@ -355,17 +445,24 @@ type Project struct {
 	Archived bool
 	Dates    []ProjectDate        // from index frontmatter
 	URLs     []kanbanmodels.CardURL // from index frontmatter
+	// DefaultContexts are added to tasks created from the project detail view
+	DefaultContexts []string
+	// DefaultTags are added to cards created for the project or on its boards
+	DefaultTags []string
 }
 
-// ProjectRegistry manages project discovery and cross-entity queries within a workspace
+// ProjectRegistry manages project discovery and cross-entity queries within a workspace.
+// Spellings of a name that SetProjectMatching merges are one project.
 type ProjectRegistry struct {
-	projects map[string]*Project
+	projects  map[string]*Project        // projectKey → project
+	spellings map[string]map[string]bool // projectKey → names it was found under
 }
 
 // BuildProjectRegistry builds a registry from scan results, tasks, and boards
 func BuildProjectRegistry(scan *scanner.WorkspaceScan, tasks []data.Task, boards []kanbanmodels.Board, wsRoot string) *ProjectRegistry {
 	r := &ProjectRegistry{
-		projects: make(map[string]*Project),
+		projects:  make(map[string]*Project),
+		spellings: make(map[string]map[string]bool),
 	}
 
 	// 1. From directory structure
@@ -393,9 +490,8 @@ func BuildProjectRegistry(scan *scanner.WorkspaceScan, tasks []data.Task, boards
 
 	// Apply virtual archive: mark archived virtual projects
 	if wsRoot != "" {
-		virtualArchived := readVirtualArchive(wsRoot)
-		for _, p := range r.projects {
-			if p.DirPath == "" && virtualArchived[p.Name] {
+		for name := range readVirtualArchive(wsRoot) {
+			if p := r.Get(name); p != nil && p.DirPath == "" {
 				p.Archived = true
 			}
 		}
@@ -405,33 +501,47 @@ func BuildProjectRegistry(scan *scanner.WorkspaceScan, tasks []data.Task, boards
 }
 
 func (r *ProjectRegistry) ensureProject(name, dirPath, parent string) {
-	if existing, ok := r.projects[name]; ok {
+	key := projectKey(name)
+	if r.spellings[key] == nil {
+		r.spellings[key] = make(map[string]bool)
+	}
+	r.spellings[key][name] = true
+	if dirPath == "" {
+		// An alias names the project it stands for; a directory keeps its own name
+		name = canonicalProjectName(name)
+	}
+
+	if existing, ok := r.projects[key]; ok {
 		// Upgrade virtual project with directory info
 		if dirPath != "" && existing.DirPath == "" {
+			existing.Name = name
 			existing.DirPath = dirPath
-			archived, dates, urls := readProjectFrontmatter(dirPath, name)
-			existing.Archived = archived
+			fm, dates := readProjectFrontmatter(dirPath, name)
+			existing.Archived = fm.Archived
 			existing.Dates = dates
-			existing.URLs = urls
+			existing.URLs = fm.URLs
+			existing.DefaultContexts = fm.DefaultContexts
+			existing.DefaultTags = fm.DefaultTags
 		}
 		if parent != "" && existing.Parent == "" {
 			existing.Parent = parent
 		}
 		return
 	}
-	var archived bool
+	var fm projectIndexFM
 	var dates []ProjectDate
-	var urls []kanbanmodels.CardURL
 	if dirPath != "" {
-		archived, dates, urls = readProjectFrontmatter(dirPath, name)
+		fm, dates = readProjectFrontmatter(dirPath, name)
 	}
-	r.projects[name] = &Project{
-		Name:     name,
-		DirPath:  dirPath,
-		Parent:   parent,
-		Archived: archived,
-		Dates:    dates,
-		URLs:     urls,
+	r.projects[key] = &Project{
+		Name:            name,
+		DirPath:         dirPath,
+		Parent:          parent,
+		Archived:        fm.Archived,
+		Dates:           dates,
+		URLs:            fm.URLs,
+		DefaultContexts: fm.DefaultContexts,
+		DefaultTags:     fm.DefaultTags,
 	}
 }
 
@@ -442,20 +552,23 @@ type projectIndexFM struct {
 		Label string `yaml:"label"`
 		Date  string `yaml:"date"`
 	} `yaml:"dates,omitempty"`
-	URLs []kanbanmodels.CardURL `yaml:"urls,omitempty"`
+	URLs            []kanbanmodels.CardURL `yaml:"urls,omitempty"`
+	DefaultContexts []string               `yaml:"default_contexts,omitempty"`
+	DefaultTags     []string               `yaml:"default_tags,omitempty"`
 }
 
-// readProjectFrontmatter reads the project index file and returns archived status, dates, and URLs.
-func readProjectFrontmatter(dirPath, name string) (archived bool, dates []ProjectDate, urls []kanbanmodels.CardURL) {
+// readProjectFrontmatter reads the project index file and returns its
+// frontmatter along with the dates that parsed.
+func readProjectFrontmatter(dirPath, name string) (fm projectIndexFM, dates []ProjectDate) {
 	indexPath := filepath.Join(dirPath, name+".md")
 	content, err := os.ReadFile(indexPath)
 	if err != nil {
-		return false, nil, nil
+		return fm, nil
 	}
 
 	lines := bytes.Split(content, []byte("\n"))
 	if len(lines) == 0 || !bytes.Equal(bytes.TrimSpace(lines[0]), []byte("---")) {
-		return false, nil, nil
+		return fm, nil
 	}
 
 	var frontmatterEnd int
@@ -466,13 +579,12 @@ func readProjectFrontmatter(dirPath, name string) (archived bool, dates []Projec
 		}
 	}
 	if frontmatterEnd == 0 {
-		return false, nil, nil
+		return fm, nil
 	}
 
 	frontmatterBytes := bytes.Join(lines[1:frontmatterEnd], []byte("\n"))
-	var fm projectIndexFM
 	if err := yaml.Unmarshal(frontmatterBytes, &fm); err != nil {
-		return false, nil, nil
+		return projectIndexFM{}, nil
 	}
 
 	for _, d := range fm.Dates {
@@ -483,7 +595,7 @@ func readProjectFrontmatter(dirPath, name string) (archived bool, dates []Projec
 		dates = append(dates, ProjectDate{Label: d.Label, Date: t})
 	}
 
-	return fm.Archived, dates, fm.URLs
+	return fm, dates
 }
 
 // writeProjectFrontmatter serializes the project's archived flag and dates back to the index file,
@@ -509,7 +621,8 @@ func writeProjectFrontmatter(project *Project) error {
 	}
 
 	// Build new frontmatter — only emit if something is non-zero
-	needsFM := project.Archived || len(project.Dates) > 0 || len(project.URLs) > 0
+	needsFM := project.Archived || len(project.Dates) > 0 || len(project.URLs) > 0 ||
+		len(project.DefaultContexts) > 0 || len(project.DefaultTags) > 0
 	var buf bytes.Buffer
 	if needsFM {
 		buf.WriteString("---\n")
@@ -532,6 +645,8 @@ func writeProjectFrontmatter(project *Project) error {
 				}
 			}
 		}
+		writeStringList(&buf, "default_contexts", project.DefaultContexts)
+		writeStringList(&buf, "default_tags", project.DefaultTags)
 		buf.WriteString("---\n\n")
 	}
 	buf.Write(body)
@@ -539,6 +654,17 @@ func writeProjectFrontmatter(project *Project) error {
 	return os.WriteFile(indexPath, buf.Bytes(), 0644)
 }
 
+// writeStringList writes a YAML list field, or nothing when values is empty.
+func writeStringList(buf *bytes.Buffer, key string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+	buf.WriteString(key + ":\n")
+	for _, v := range values {
+		buf.WriteString(fmt.Sprintf("  - %s\n", v))
+	}
+}
+
 // SetProjectArchived sets the archived state for a project by updating its index file frontmatter.
 // Returns an error for virtual projects (no DirPath).
 func SetProjectArchived(project *Project, archived bool) error {
@@ -631,11 +757,11 @@ func WriteProjectURLs(project *Project, urls []kanbanmodels.CardURL) error {
 	return writeProjectFrontmatter(project)
 }
 
-// ChildrenOf returns all projects whose Parent equals name (case-insensitive).
+// ChildrenOf returns all projects whose Parent is name (see SameProject).
 func (r *ProjectRegistry) ChildrenOf(name string) []*Project {
 	var result []*Project
 	for _, p := range r.projects {
-		if strings.EqualFold(p.Parent, name) {
+		if p.Parent != "" && SameProject(p.Parent, name) {
 			result = append(result, p)
 		}
 	}
@@ -687,33 +813,44 @@ func (r *ProjectRegistry) List() []*Project {
 	return result
 }
 
-// Get returns a project by name
+// Get returns a project by name, under any of its spellings
 func (r *ProjectRegistry) Get(name string) *Project {
-	return r.projects[name]
+	return r.projects[projectKey(name)]
 }
 
 // TasksForProject returns tasks linked to a specific project
 func (r *ProjectRegistry) TasksForProject(name string, allTasks []data.Task) []data.Task {
 	var result []data.Task
 	for _, t := range allTasks {
-		if t.HasProject(name) {
+		if anySameProject(t.Projects, name) {
 			result = append(result, t)
 		}
 	}
 	return result
 }
 
-// NotesForProject returns notes whose FilePath is under the project's directory.
-// Returns nil for virtual projects (no DirPath).
+// anySameProject reports whether any of names is a spelling of project.
+func anySameProject(names []string, project string) bool {
+	for _, n := range names {
+		if SameProject(n, project) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotesForProject returns notes whose FilePath is under the project's directory,
+// plus notes anywhere that list the project in their `projects` frontmatter.
 func (r *ProjectRegistry) NotesForProject(name string, allNotes []notes.Note) []notes.Note {
-	proj := r.projects[name]
-	if proj == nil || proj.DirPath == "" {
+	proj := r.Get(name)
+	if proj == nil {
 		return nil
 	}
 	prefix := proj.DirPath + "/"
 	var result []notes.Note
 	for _, n := range allNotes {
-		if strings.HasPrefix(n.FilePath, prefix) {
+		inDir := proj.DirPath != "" && strings.HasPrefix(n.FilePath, prefix)
+		if inDir || anySameProject(n.Projects, name) {
 			result = append(result, n)
 		}
 	}
@@ -749,18 +886,13 @@ func (r *ProjectRegistry) ProjectsDirs(workspaceRoot string) []string {
 // BoardsForProject returns boards whose project frontmatter links to the given project.
 // Returns nil for virtual projects (no DirPath).
 func (r *ProjectRegistry) BoardsForProject(name string, allBoards []kanbanmodels.Board) []kanbanmodels.Board {
-	proj := r.projects[name]
+	proj := r.Get(name)
 	if proj == nil || proj.DirPath == "" {
 		return nil
 	}
-	indexPath := filepath.Join(proj.DirPath, proj.Name+".md")
 	var result []kanbanmodels.Board
 	for _, b := range allBoards {
-		if b.Project == "" {
-			continue
-		}
-		resolved := filepath.Clean(filepath.Join(b.Path, b.Project))
-		if resolved == indexPath {
+		if b.LinksProject(proj.Name, proj.DirPath) {
 			result = append(result, b)
 		}
 	}
@@ -805,7 +937,7 @@ func (r *ProjectRegistry) ProjectsForBoard(boardPath string, allBoards []kanbanm
 		if cur.Parent == "" {
 			break
 		}
-		cur = r.projects[cur.Parent]
+		cur = r.Get(cur.Parent)
 	}
 	return result
 }
@@ -816,11 +948,8 @@ func (r *ProjectRegistry) CardsForProject(name string, boards []kanbanmodels.Boa
 	for _, board := range boards {
 		for _, col := range board.Columns {
 			for _, card := range col.Cards {
-				for _, p := range card.Projects {
-					if strings.EqualFold(p, name) {
-						result = append(result, card)
-						break
-					}
+				if anySameProject(card.Projects, name) {
+					result = append(result, card)
 				}
 			}
 		}
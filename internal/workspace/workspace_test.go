@@ -1,11 +1,13 @@
 package workspace
 
 import (
+	"fmt"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
 
+	"wydo/internal/kanban/fs"
 	"wydo/internal/scanner"
 )
 
@@ -434,6 +436,45 @@ func TestRenameProject_IndexNoteRenamed(t *testing.T) {
 	}
 }
 
+func TestPreviewRename_CountsWithoutChanging(t *testing.T) {
+	tmp := t.TempDir()
+
+	os.MkdirAll(filepath.Join(tmp, "projects", "alpha"), 0755)
+	cardsDir := filepath.Join(tmp, "boards", "testboard", "cards")
+	os.MkdirAll(cardsDir, 0755)
+	os.WriteFile(filepath.Join(tmp, "boards", "testboard", "board.md"), []byte("# Test Board\n\n## Todo\n- [One](cards/one.md)\n- [Two](cards/two.md)\n"), 0644)
+	os.WriteFile(filepath.Join(cardsDir, "one.md"), []byte("---\nprojects:\n  - Alpha\n---\n# One\n"), 0644)
+	os.WriteFile(filepath.Join(cardsDir, "two.md"), []byte("---\nprojects:\n  - beta\n---\n# Two\n"), 0644)
+	tasksDir := filepath.Join(tmp, "tasks")
+	os.MkdirAll(tasksDir, 0755)
+	todo := filepath.Join(tasksDir, "todo.txt")
+	os.WriteFile(todo, []byte("Task 1 +alpha\nTask 2 +alpha @home\nTask 3 +beta\n"), 0644)
+
+	scan, _ := scanner.ScanWorkspace(tmp)
+	ws, _ := Load(scan)
+
+	p, err := ws.PreviewRename("alpha", "beta")
+	if err != nil {
+		t.Fatalf("preview error: %v", err)
+	}
+	if p.Tasks != 2 || p.Cards != 1 || p.Dirs != 1 || !p.Merge {
+		t.Errorf("got %+v, want 2 tasks, 1 card, 1 dir, merge", p)
+	}
+	if got, want := p.String(), "2 tasks, 1 card, 1 directory"; got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+	if _, err := os.Stat(filepath.Join(tmp, "projects", "alpha")); err != nil {
+		t.Error("preview should not rename the directory")
+	}
+	if content, _ := os.ReadFile(todo); !strings.Contains(string(content), "Task 1 +alpha") {
+		t.Errorf("preview should not change tasks:\n%s", content)
+	}
+
+	if _, err := ws.PreviewRename("missing", "beta"); err == nil {
+		t.Error("expected an error for an unknown project")
+	}
+}
+
 func TestMergeProject_CardDedup(t *testing.T) {
 	// A card with projects: [alpha, beta] should become projects: [beta] after merge
 	tmp := t.TempDir()
@@ -651,6 +692,55 @@ func TestBoardsForProject_UsesProjectFrontmatter(t *testing.T) {
 	}
 }
 
+func TestNotesForProject_IncludesFrontmatterProjects(t *testing.T) {
+	tmp := t.TempDir()
+
+	projDir := filepath.Join(tmp, "projects", "alpha")
+	os.MkdirAll(projDir, 0755)
+	os.WriteFile(filepath.Join(projDir, "alpha.md"), []byte("# alpha\n"), 0644)
+	os.WriteFile(filepath.Join(projDir, "2026-03-01-kickoff.md"), []byte("# kickoff\n"), 0644)
+
+	// Stored outside the project dir, linked by frontmatter
+	notesDir := filepath.Join(tmp, "journal")
+	os.MkdirAll(notesDir, 0755)
+	os.WriteFile(filepath.Join(notesDir, "2026-03-02-standup.md"), []byte(
+		"---\nprojects: [alpha, \"+beta\"]\ntags: [meeting, \"#weekly\"]\n---\n\n# standup\n",
+	), 0644)
+	os.WriteFile(filepath.Join(notesDir, "2026-03-03-other.md"), []byte("# other\n"), 0644)
+
+	scan, err := scanner.ScanWorkspace(tmp)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	ws, err := Load(scan)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	var standupTags []string
+	for _, n := range ws.Notes {
+		if strings.HasSuffix(n.FilePath, "standup.md") {
+			standupTags = n.Tags
+			if strings.Join(n.Projects, ",") != "alpha,beta" {
+				t.Errorf("expected projects alpha,beta, got %v", n.Projects)
+			}
+		}
+	}
+	if strings.Join(standupTags, ",") != "meeting,weekly" {
+		t.Errorf("expected tags meeting,weekly, got %v", standupTags)
+	}
+
+	alphaNotes := ws.Projects.NotesForProject("alpha", ws.Notes)
+	var names []string
+	for _, n := range alphaNotes {
+		names = append(names, filepath.Base(n.FilePath))
+	}
+	got := strings.Join(names, ",")
+	if !strings.Contains(got, "kickoff") || !strings.Contains(got, "standup") || strings.Contains(got, "other") {
+		t.Errorf("expected kickoff and standup notes for alpha, got %q", got)
+	}
+}
+
 func TestProjectsForBoard_UsesProjectFrontmatter(t *testing.T) {
 	tmp := t.TempDir()
 
@@ -735,3 +825,142 @@ func TestMergeDirs_RecursiveSubdirs(t *testing.T) {
 		t.Error("existing.txt should still be in dst")
 	}
 }
+
+func TestCreateProject_FromTemplate(t *testing.T) {
+	templatesDir := t.TempDir()
+	tmpl := filepath.Join(templatesDir, "client")
+	files := map[string]string{
+		"{{project}}.md":                    "# {{project}}\n\nClient project.\n",
+		"meetings/kickoff.md":               "# Kickoff for {{project}}\n",
+		"boards/{{project}}/board.md":       "# {{project}}\n\n## Backlog\n\n## Doing\n\n## Done\n",
+		"boards/{{project}}/cards/.gitkeep": "",
+	}
+	for rel, content := range files {
+		path := filepath.Join(tmpl, rel)
+		os.MkdirAll(filepath.Dir(path), 0o755)
+		os.WriteFile(path, []byte(content), 0o644)
+	}
+	os.MkdirAll(filepath.Join(templatesDir, ".hidden"), 0o755)
+
+	names, err := ListProjectTemplates(templatesDir)
+	if err != nil || len(names) != 1 || names[0] != "client" {
+		t.Fatalf("ListProjectTemplates = %v, %v; want [client]", names, err)
+	}
+
+	projectsDir := t.TempDir()
+	if err := CreateProject(projectsDir, "acme", tmpl); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+
+	projectDir := filepath.Join(projectsDir, "acme")
+	index, _ := os.ReadFile(filepath.Join(projectDir, "acme.md"))
+	if string(index) != "# acme\n\nClient project.\n" {
+		t.Errorf("index note = %q", index)
+	}
+	kickoff, _ := os.ReadFile(filepath.Join(projectDir, "meetings", "kickoff.md"))
+	if string(kickoff) != "# Kickoff for acme\n" {
+		t.Errorf("kickoff note = %q", kickoff)
+	}
+
+	board, err := fs.ReadBoard(filepath.Join(projectDir, "boards", "acme"))
+	if err != nil {
+		t.Fatalf("ReadBoard: %v", err)
+	}
+	var columns []string
+	for _, col := range board.Columns {
+		columns = append(columns, col.Name)
+	}
+	if strings.Join(columns, ",") != "Backlog,Doing,Done" {
+		t.Errorf("board columns = %v", columns)
+	}
+}
+
+func TestCreateProject_Blank(t *testing.T) {
+	projectsDir := t.TempDir()
+	if err := CreateProject(projectsDir, "solo", ""); err != nil {
+		t.Fatalf("CreateProject: %v", err)
+	}
+	index, err := os.ReadFile(filepath.Join(projectsDir, "solo", "solo.md"))
+	if err != nil || string(index) != "# solo\n" {
+		t.Errorf("index note = %q, %v", index, err)
+	}
+
+	if names, err := ListProjectTemplates(filepath.Join(projectsDir, "missing")); err != nil || names != nil {
+		t.Errorf("missing templates dir = %v, %v; want none", names, err)
+	}
+}
+
+func TestLoad_CollectsDiagnostics(t *testing.T) {
+	tmp := t.TempDir()
+	files := map[string]string{
+		"tasks/todo.txt":           "Fine task due:2026-03-01\nBad task due:2026-13-45 scheduled:tomorrow\n",
+		"boards/b/board.md":        "# B\n\n## To Do\n\n[Card](./cards/card.md)\n",
+		"boards/b/cards/card.md":   "---\ntags: []\ndue: 03/01/2026\n---\n\n# Card\n",
+		"boards/b/cards/unused.md": "# Unused\n",
+	}
+	for rel, content := range files {
+		path := filepath.Join(tmp, rel)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	scan, err := scanner.ScanWorkspace(tmp)
+	if err != nil {
+		t.Fatalf("scan error: %v", err)
+	}
+	ws, err := Load(scan)
+	if err != nil {
+		t.Fatalf("load error: %v", err)
+	}
+
+	var got []string
+	for _, d := range ws.Diagnostics {
+		rel, _ := filepath.Rel(tmp, d.File)
+		got = append(got, fmt.Sprintf("%s:%d:%s", rel, d.Line, d.Field))
+	}
+	want := []string{
+		"boards/b/cards/card.md:3:due",
+		"tasks/todo.txt:2:due",
+		"tasks/todo.txt:2:scheduled",
+	}
+	if strings.Join(got, " ") != strings.Join(want, " ") {
+		t.Errorf("diagnostics = %v, want %v", got, want)
+	}
+}
+
+func TestProjectDefaults_ReadAndPreservedOnArchive(t *testing.T) {
+	tmp := t.TempDir()
+	projDir := filepath.Join(tmp, "projects", "alpha")
+	os.MkdirAll(projDir, 0755)
+	os.WriteFile(filepath.Join(projDir, "alpha.md"), []byte(
+		"---\ndefault_contexts:\n  - work\ndefault_tags:\n  - backend\n  - alpha\n---\n\n# alpha\n",
+	), 0644)
+
+	scan, err := scanner.ScanWorkspace(tmp)
+	if err != nil {
+		t.Fatalf("scan: %v", err)
+	}
+	ws, err := Load(scan)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+	p := ws.Projects.Get("alpha")
+	if p == nil {
+		t.Fatal("project alpha not found")
+	}
+	if strings.Join(p.DefaultContexts, ",") != "work" || strings.Join(p.DefaultTags, ",") != "backend,alpha" {
+		t.Fatalf("defaults = %v / %v", p.DefaultContexts, p.DefaultTags)
+	}
+
+	if err := SetProjectArchived(p, true); err != nil {
+		t.Fatalf("archive: %v", err)
+	}
+	fm, _ := readProjectFrontmatter(projDir, "alpha")
+	if !fm.Archived || strings.Join(fm.DefaultContexts, ",") != "work" || strings.Join(fm.DefaultTags, ",") != "backend,alpha" {
+		t.Errorf("after archive frontmatter = %+v", fm)
+	}
+}
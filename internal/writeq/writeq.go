@@ -0,0 +1,407 @@
+// Package writeq writes files so that transient failures don't lose edits.
+// On network filesystems, behind a sync tool's lock or on a mount that has
+// briefly gone read-only, a write can fail for a moment and succeed later.
+// WriteFile retries such a write a few times and, if it still fails, queues
+// it and retries it in the background with backoff until it goes through.
+//
+// While a file has a queued write, reads through ReadFile see the queued
+// content and later writes replace it, so wydo keeps working on its own
+// edits. Errors that retrying can't fix, such as a missing directory or
+// permission denied, are returned as before.
+//
+// The paths written are remembered for a while, so a file watcher can tell
+// wydo's own writes from other programs' (see WrittenWithin).
+package writeq
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	"wydo/internal/logs"
+)
+
+var log = logs.For("writeq")
+
+// Backoff of the background retries: the delay doubles from retryMin up to
+// retryMax. Variables so tests can shorten them.
+var (
+	retryMin = time.Second
+	retryMax = 30 * time.Second
+)
+
+// quickRetries are the delays before the retries WriteFile makes itself,
+// before queueing. Short, as the caller (often the UI) waits for them.
+var quickRetries = []time.Duration{25 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond}
+
+// writeFile is the actual write; replaced in tests.
+var writeFile = os.WriteFile
+
+type entry struct {
+	data     []byte
+	perm     os.FileMode
+	delay    time.Duration
+	next     time.Time
+	attempts int
+}
+
+var (
+	mu      sync.Mutex
+	pending = make(map[string]*entry)
+	wake    = make(chan struct{}, 1)
+	start   sync.Once
+	flushed = sync.NewCond(&mu)
+	lastErr error // latest failure of a queued write, nil once all are written
+
+	// moveMu is held by Rename and by each background write from checking
+	// its path is still queued until the write is done, so a write can't
+	// land on a path just renamed away and bring the old file back
+	moveMu sync.Mutex
+
+	writtenMu sync.Mutex
+	written   = make(map[string]time.Time) // path → when it was last written
+)
+
+// writtenKeep is how long written paths are remembered.
+const writtenKeep = time.Minute
+
+// Transient reports whether a write error may go away on a retry.
+func Transient(err error) bool {
+	for _, errno := range []syscall.Errno{
+		syscall.EBUSY, syscall.EAGAIN, syscall.ETXTBSY, syscall.EROFS,
+		syscall.EIO, syscall.EINTR, syscall.ENOLCK, syscall.ESTALE, syscall.EDEADLK,
+	} {
+		if errors.Is(err, errno) {
+			return true
+		}
+	}
+	return false
+}
+
+// WriteFile writes data to path like os.WriteFile. A write that fails with
+// a transient error is queued and WriteFile returns nil; other errors are
+// returned. A path with a queued write gets the new data queued instead, so
+// the writes land in order.
+func WriteFile(path string, data []byte, perm os.FileMode) error {
+	mu.Lock()
+	if e, ok := pending[path]; ok {
+		e.data = append([]byte(nil), data...)
+		e.perm = perm
+		mu.Unlock()
+		return nil
+	}
+	mu.Unlock()
+
+	noteWritten(path)
+	err := writeFile(path, data, perm)
+	for _, d := range quickRetries {
+		if err == nil || !Transient(err) {
+			break
+		}
+		time.Sleep(d)
+		noteWritten(path)
+		err = writeFile(path, data, perm)
+	}
+	if err == nil || !Transient(err) {
+		return err
+	}
+
+	enqueue(path, data, perm, err)
+	return nil
+}
+
+// AppendFile appends data to path, creating it if needed. Like WriteFile it
+// queues the write on a transient failure, then as the whole new content.
+func AppendFile(path string, data []byte, perm os.FileMode) error {
+	mu.Lock()
+	if e, ok := pending[path]; ok {
+		e.data = append(e.data, data...)
+		mu.Unlock()
+		return nil
+	}
+	mu.Unlock()
+
+	err := appendFile(path, data, perm)
+	if err == nil || !Transient(err) {
+		return err
+	}
+	current, rerr := os.ReadFile(path)
+	if rerr != nil && !os.IsNotExist(rerr) {
+		return err
+	}
+	return WriteFile(path, append(current, data...), perm)
+}
+
+func appendFile(path string, data []byte, perm os.FileMode) error {
+	noteWritten(path)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// ReadFile reads path like os.ReadFile, but returns the queued content of a
+// file whose write is still queued.
+func ReadFile(path string) ([]byte, error) {
+	mu.Lock()
+	if e, ok := pending[path]; ok {
+		data := append([]byte(nil), e.data...)
+		mu.Unlock()
+		return data, nil
+	}
+	mu.Unlock()
+	return os.ReadFile(path)
+}
+
+// Rename renames a file or directory like os.Rename and moves the queued
+// writes of oldPath, or of files below it, along with it.
+func Rename(oldPath, newPath string) error {
+	moveMu.Lock()
+	defer moveMu.Unlock()
+	noteWritten(oldPath, newPath)
+	if err := os.Rename(oldPath, newPath); err != nil {
+		return err
+	}
+	mu.Lock()
+	defer mu.Unlock()
+	for path, e := range pending {
+		if path == oldPath {
+			delete(pending, path)
+			pending[newPath] = e
+		} else if rest, ok := strings.CutPrefix(path, oldPath+string(filepath.Separator)); ok {
+			delete(pending, path)
+			pending[filepath.Join(newPath, rest)] = e
+		}
+	}
+	return nil
+}
+
+// Remove removes a file or empty directory like os.Remove and drops the
+// queued writes of path, or of files below it, so they can't bring it back.
+// The writes are dropped even if the removal fails, as a path whose file was
+// never written is not there to remove.
+func Remove(path string) error {
+	moveMu.Lock()
+	defer moveMu.Unlock()
+	noteWritten(path)
+	mu.Lock()
+	for p := range pending {
+		if p == path || strings.HasPrefix(p, path+string(filepath.Separator)) {
+			delete(pending, p)
+		}
+	}
+	if len(pending) == 0 {
+		lastErr = nil
+		flushed.Broadcast()
+	}
+	mu.Unlock()
+	return os.Remove(path)
+}
+
+// IsPending reports whether path has a queued write.
+func IsPending(path string) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	_, ok := pending[path]
+	return ok
+}
+
+// WrittenWithin reports whether path was written or renamed through this
+// package within the last d.
+func WrittenWithin(path string, d time.Duration) bool {
+	writtenMu.Lock()
+	defer writtenMu.Unlock()
+	at, ok := written[filepath.Clean(path)]
+	return ok && time.Since(at) <= d
+}
+
+// noteWritten records that paths are about to be written. It is called
+// before the write, so that a watcher seeing the write already finds it.
+func noteWritten(paths ...string) {
+	now := time.Now()
+	writtenMu.Lock()
+	defer writtenMu.Unlock()
+	for path, at := range written {
+		if now.Sub(at) > writtenKeep {
+			delete(written, path)
+		}
+	}
+	for _, path := range paths {
+		written[filepath.Clean(path)] = now
+	}
+}
+
+// Status is the state of the queue.
+type Status struct {
+	Pending []string // paths with a queued write, sorted
+	Err     error    // the latest failure of a queued write
+}
+
+// Pending returns the number of queued writes.
+func Pending() int {
+	mu.Lock()
+	defer mu.Unlock()
+	return len(pending)
+}
+
+// CurrentStatus returns the queued paths and the latest failure.
+func CurrentStatus() Status {
+	mu.Lock()
+	defer mu.Unlock()
+	s := Status{Err: lastErr}
+	for path := range pending {
+		s.Pending = append(s.Pending, path)
+	}
+	sort.Strings(s.Pending)
+	return s
+}
+
+// Wait blocks until the queue is empty or timeout passes, retrying every
+// queued write at once first. It returns false on timeout.
+func Wait(timeout time.Duration) bool {
+	mu.Lock()
+	for _, e := range pending {
+		e.next = time.Time{}
+	}
+	mu.Unlock()
+	signal()
+
+	done := make(chan struct{})
+	go func() {
+		mu.Lock()
+		for len(pending) > 0 {
+			flushed.Wait()
+		}
+		mu.Unlock()
+		close(done)
+	}()
+	select {
+	case <-done:
+		return true
+	case <-time.After(timeout):
+		return false
+	}
+}
+
+func enqueue(path string, data []byte, perm os.FileMode, err error) {
+	log.Warn("queued write", "path", path, "err", err)
+	mu.Lock()
+	if e, ok := pending[path]; ok {
+		// Queued by another caller meanwhile; the later data wins
+		e.data = append([]byte(nil), data...)
+		e.perm = perm
+	} else {
+		pending[path] = &entry{
+			data:  append([]byte(nil), data...),
+			perm:  perm,
+			delay: retryMin,
+			next:  time.Now().Add(retryMin),
+		}
+	}
+	lastErr = err
+	mu.Unlock()
+	start.Do(func() { go work() })
+	signal()
+}
+
+func signal() {
+	select {
+	case wake <- struct{}{}:
+	default:
+	}
+}
+
+// work retries the queued writes as they come due.
+func work() {
+	for {
+		wait := retry()
+		if wait < 0 {
+			<-wake
+			continue
+		}
+		select {
+		case <-wake:
+		case <-time.After(wait):
+		}
+	}
+}
+
+// retry writes each queued file that is due and returns how long until the
+// next one is, or -1 when the queue is empty.
+func retry() time.Duration {
+	now := time.Now()
+	mu.Lock()
+	var due []string
+	for path, e := range pending {
+		if !e.next.After(now) {
+			due = append(due, path)
+		}
+	}
+	mu.Unlock()
+
+	for _, path := range due {
+		// Check the path again: it may have been renamed or written since
+		moveMu.Lock()
+		mu.Lock()
+		e, ok := pending[path]
+		var data []byte
+		var perm os.FileMode
+		if ok {
+			data, perm = append([]byte(nil), e.data...), e.perm
+		}
+		mu.Unlock()
+		if !ok {
+			moveMu.Unlock()
+			continue
+		}
+		noteWritten(path)
+		err := writeFile(path, data, perm)
+		moveMu.Unlock()
+
+		mu.Lock()
+		cur, ok := pending[path]
+		switch {
+		case !ok:
+			// Written meanwhile
+		case err == nil && string(cur.data) == string(data):
+			delete(pending, path)
+			log.Info("wrote queued write", "path", path, "retries", cur.attempts+1)
+		case err == nil:
+			// Newer data was queued while writing; write that next
+			cur.next = time.Time{}
+		default:
+			// Keep retrying even errors that don't look transient: the
+			// edit is only in memory, and the status bar shows it is stuck
+			cur.attempts++
+			lastErr = err
+			cur.delay = min(cur.delay*2, retryMax)
+			cur.next = time.Now().Add(cur.delay)
+		}
+		mu.Unlock()
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if len(pending) == 0 {
+		lastErr = nil
+		flushed.Broadcast()
+		return -1
+	}
+	next := time.Duration(-1)
+	for _, e := range pending {
+		if d := time.Until(e.next); next < 0 || d < next {
+			next = max(d, 0)
+		}
+	}
+	return next
+}
@@ -0,0 +1,209 @@
+package writeq
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"sync"
+	"syscall"
+	"testing"
+	"time"
+)
+
+// failWrites makes the next n writes fail with err, then lets them through.
+func failWrites(t *testing.T, n int, err error) {
+	t.Helper()
+	var mu sync.Mutex
+	left := n
+	oldWrite, oldMin, oldQuick := writeFile, retryMin, quickRetries
+	writeFile = func(path string, data []byte, perm os.FileMode) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if left > 0 {
+			left--
+			return &os.PathError{Op: "open", Path: path, Err: err}
+		}
+		return os.WriteFile(path, data, perm)
+	}
+	retryMin, quickRetries = 10*time.Millisecond, nil
+	t.Cleanup(func() { writeFile, retryMin, quickRetries = oldWrite, oldMin, oldQuick })
+}
+
+func TestWriteFile_QueuesTransientFailure(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "card.md")
+	failWrites(t, 3, syscall.EBUSY)
+
+	if err := WriteFile(path, []byte("first"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if !IsPending(path) {
+		t.Fatal("expected the write to be queued")
+	}
+	// A later write replaces the queued data and reads see it
+	if err := WriteFile(path, []byte("second"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if got, _ := ReadFile(path); string(got) != "second" {
+		t.Errorf("ReadFile = %q, want the queued %q", got, "second")
+	}
+	if st := CurrentStatus(); len(st.Pending) != 1 || !errors.Is(st.Err, syscall.EBUSY) {
+		t.Errorf("unexpected status %+v", st)
+	}
+
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+	got, err := os.ReadFile(path)
+	if err != nil || string(got) != "second" {
+		t.Errorf("file = %q, %v; want %q", got, err, "second")
+	}
+	if st := CurrentStatus(); len(st.Pending) != 0 || st.Err != nil {
+		t.Errorf("unexpected status after flush %+v", st)
+	}
+}
+
+func TestWriteFile_ReturnsPermanentError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "card.md")
+	if err := WriteFile(path, []byte("x"), 0644); err == nil {
+		t.Fatal("expected an error for a missing directory")
+	}
+	if IsPending(path) {
+		t.Error("a permanent failure must not be queued")
+	}
+}
+
+func TestAppendFile_AppendsToQueuedContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "todo.txt")
+	if err := os.WriteFile(path, []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	failWrites(t, 2, syscall.EROFS)
+
+	if err := WriteFile(path, []byte("b\n"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := AppendFile(path, []byte("c\n"), 0644); err != nil {
+		t.Fatalf("AppendFile: %v", err)
+	}
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+	if got, _ := os.ReadFile(path); string(got) != "b\nc\n" {
+		t.Errorf("file = %q, want %q", got, "b\nc\n")
+	}
+}
+
+func TestRename_MovesQueuedWrites(t *testing.T) {
+	dir := t.TempDir()
+	oldDir, newDir := filepath.Join(dir, "old"), filepath.Join(dir, "new")
+	if err := os.Mkdir(oldDir, 0755); err != nil {
+		t.Fatal(err)
+	}
+	failWrites(t, 1, syscall.EBUSY)
+	if err := WriteFile(filepath.Join(oldDir, "board.md"), []byte("x"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := Rename(oldDir, newDir); err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if !IsPending(filepath.Join(newDir, "board.md")) {
+		t.Fatal("expected the queued write to follow the rename")
+	}
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+	if _, err := os.Stat(filepath.Join(oldDir, "board.md")); !os.IsNotExist(err) {
+		t.Errorf("old path written after rename: %v", err)
+	}
+}
+
+func TestRemove_DropsQueuedWrites(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "card.md")
+	if err := os.WriteFile(path, []byte("before"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	failWrites(t, 1, syscall.EBUSY)
+	if err := WriteFile(path, []byte("x"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := Remove(path); err != nil {
+		t.Fatalf("Remove: %v", err)
+	}
+	if IsPending(path) {
+		t.Fatal("expected the queued write to be dropped")
+	}
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("removed file written again: %v", err)
+	}
+}
+
+func TestRename_DuringRetryDoesNotRecreateOldPath(t *testing.T) {
+	dir := t.TempDir()
+	oldPath, newPath := filepath.Join(dir, "old.md"), filepath.Join(dir, "new.md")
+	if err := os.WriteFile(oldPath, []byte("before"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The first write fails; the background retry renames the file while
+	// it is writing
+	renamed := make(chan error, 1)
+	var calls int
+	oldWrite, oldMin, oldQuick := writeFile, retryMin, quickRetries
+	writeFile = func(path string, data []byte, perm os.FileMode) error {
+		calls++
+		if calls == 1 {
+			return &os.PathError{Op: "open", Path: path, Err: syscall.EBUSY}
+		}
+		if calls == 2 {
+			go func() { renamed <- Rename(oldPath, newPath) }()
+			time.Sleep(50 * time.Millisecond)
+		}
+		return os.WriteFile(path, data, perm)
+	}
+	retryMin, quickRetries = 10*time.Millisecond, nil
+	t.Cleanup(func() { writeFile, retryMin, quickRetries = oldWrite, oldMin, oldQuick })
+
+	if err := WriteFile(oldPath, []byte("x"), 0644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+	if err := <-renamed; err != nil {
+		t.Fatalf("Rename: %v", err)
+	}
+	if !Wait(5 * time.Second) {
+		t.Fatal("queue did not drain")
+	}
+	if _, err := os.Stat(oldPath); !os.IsNotExist(err) {
+		t.Errorf("old path written after rename: %v", err)
+	}
+	if got, _ := os.ReadFile(newPath); string(got) != "x" {
+		t.Errorf("new path = %q, want %q", got, "x")
+	}
+}
+
+func TestWrittenWithin(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "todo.txt")
+	if WrittenWithin(path, time.Minute) {
+		t.Fatal("expected a path never written not reported")
+	}
+	if err := AppendFile(path, []byte("a\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if !WrittenWithin(path, time.Minute) || !WrittenWithin(dir+"/./todo.txt", time.Minute) {
+		t.Error("expected the appended path reported")
+	}
+	if err := Rename(path, filepath.Join(dir, "done.txt")); err != nil {
+		t.Fatal(err)
+	}
+	if !WrittenWithin(filepath.Join(dir, "done.txt"), time.Minute) {
+		t.Error("expected a rename's new path reported")
+	}
+	time.Sleep(5 * time.Millisecond)
+	if WrittenWithin(path, time.Millisecond) {
+		t.Error("expected a write older than d not reported")
+	}
+}
@@ -6,15 +6,21 @@ import (
 	"log"
 	"os"
 	"strings"
+	"time"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"wydo/internal/agenda"
+	"wydo/internal/attachments"
 	"wydo/internal/cli"
+	"wydo/internal/clock"
 	"wydo/internal/config"
 	"wydo/internal/logs"
-	"wydo/internal/scanner"
+	"wydo/internal/notify"
+	"wydo/internal/tasks/data"
 	"wydo/internal/tasks/service"
 	"wydo/internal/tui"
 	"wydo/internal/workspace"
+	"wydo/internal/writeq"
 )
 
 func main() {
@@ -22,6 +28,12 @@ func main() {
 	workspacesFlag := flag.String("workspaces", "", "Workspace directories (comma-separated)")
 	flag.StringVar(workspacesFlag, "w", "", "Workspace directories (shorthand, comma-separated)")
 	viewFlag := flag.String("view", "", "Initial view: day, week, month, tasks, boards")
+	boardFlag := flag.String("board", "", "Open a board by name")
+	cardFlag := flag.String("card", "", "Select the card of --board with this title")
+	filterFlag := flag.String("filter", "", "Preset filter of --board, or of --view tasks (e.g. \"+alpha @home (A) due:today\")")
+	compactFlag := flag.Bool("compact", false, "Single auto-refreshing pane: today's agenda, or one column of --board")
+	columnFlag := flag.String("column", "", "Column shown by --compact --board (default: the first)")
+	debugFlag := flag.Bool("debug", false, "Log debug detail too, to the log file and the ctrl+g log overlay")
 	flag.Parse()
 
 	// Build CLIFlags
@@ -29,13 +41,24 @@ func main() {
 		Workspaces: config.ParseCommaSeparated(*workspacesFlag),
 	}
 
+	// $WYDO_NOW pins the clock, for screenshots and reproducing reports
+	if err := clock.SetFromEnv(); err != nil {
+		log.Fatal(err)
+	}
+
+	// Pick up a config.json left in ~/.config/wydo by older versions
+	if err := config.MigrateLegacyConfig(); err != nil {
+		log.Printf("Warning: could not migrate legacy config: %v", err)
+	}
+
 	// Load configuration
 	cfg, err := config.Load(cliFlags)
 	if err != nil {
 		log.Fatalf("Failed to load config: %v", err)
 	}
 
-	// Ensure config file exists
+	// Ensure config file exists; a missing one means this is the first run
+	cfg.FirstRun = !config.ConfigFileExists()
 	if err := config.EnsureConfigFile(); err != nil {
 		log.Printf("Warning: could not create config file: %v", err)
 	}
@@ -45,28 +68,35 @@ func main() {
 		log.Fatalf("Failed to create directories: %v", err)
 	}
 
+	// Older versions logged into the first workspace
+	if err := config.MigrateLegacyLog(cfg.GetFirstWorkspace()); err != nil {
+		log.Printf("Warning: could not migrate legacy log: %v", err)
+	}
+
 	// Reinitialize logger
-	if err := logs.Initialize(cfg.GetFirstWorkspace()); err != nil {
+	stateDir, err := config.StateDir()
+	if err != nil {
+		stateDir = ""
+	}
+	logs.SetDebug(*debugFlag)
+	if err := logs.Initialize(stateDir); err != nil {
 		fmt.Fprintf(os.Stderr, "Warning: Could not initialize logger: %v\n", err)
 	}
 
-	// Scan and load all workspaces
-	var workspaces []*workspace.Workspace
-	var allTaskDirs []scanner.TaskDirInfo
+	data.SetStampCreated(cfg.StampCreatedDate)
+	agenda.SetExcluded(cfg.AgendaExclude)
+	attachments.SetSymlink(cfg.SymlinkAttachments)
+	if pm := cfg.ProjectMatching; pm != nil {
+		workspace.SetProjectMatching(pm.CaseSensitive, pm.Aliases)
+	}
+	if s := cfg.SMTP; s != nil {
+		notify.Configure(&notify.SMTP{Host: s.Host, Port: s.Port, Username: s.Username, Password: s.Password, From: s.From})
+	}
 
-	for _, wsDir := range cfg.Workspaces {
-		scan, err := scanner.ScanWorkspace(wsDir)
-		if err != nil {
-			logs.Logger.Printf("Warning: could not scan workspace %s: %v", wsDir, err)
-			continue
-		}
-		ws, err := workspace.Load(scan)
-		if err != nil {
-			logs.Logger.Printf("Warning: could not load workspace %s: %v", wsDir, err)
-			continue
-		}
-		workspaces = append(workspaces, ws)
-		allTaskDirs = append(allTaskDirs, scan.TaskDirs...)
+	// Scan and load all workspaces
+	workspaces, allTaskDirs, scanErrs := workspace.LoadAll(cfg.Workspaces, cfg.Ignore...)
+	for _, e := range scanErrs {
+		logs.Logger.Printf("Warning: could not load workspace %s", e.Error())
 	}
 
 	// Build a combined task service for CLI use (aggregates all workspaces)
@@ -90,30 +120,96 @@ func main() {
 		case "tasks":
 			cfg.DefaultView = "tasks"
 		case "agenda":
+			if len(args) > 1 {
+				// Flags print the agenda instead of launching the TUI
+				exit(cli.Run(args, taskSvc, workspaces))
+			}
 			cfg.DefaultView = "day"
 		case "projects":
 			cfg.DefaultView = "projects"
+		case "goals":
+			cfg.DefaultView = "goals"
+		case "tour":
+			cfg.ShowTour = true
+		case "status":
+			exit(cli.RunStatus(args[1:], taskSvc, workspaces, scanErrs))
+		case "stats", "doctor", "cards", "card", "project", "board", "print", "journal", "workspace", "merge-todo":
+			// These work without a task service: they read workspaces or the
+			// files given, and print and journal leave tasks out when it is nil
+			exit(cli.Run(args, taskSvc, workspaces))
 		default:
 			if taskSvc == nil {
 				fmt.Fprintln(os.Stderr, "Error: could not initialize task service")
 				os.Exit(1)
 			}
-			exitCode := cli.Run(args, taskSvc)
-			os.Exit(exitCode)
+			exitCode := cli.Run(args, taskSvc, workspaces)
+			exit(exitCode)
 		}
 	}
 
-	// Apply --view flag override
+	// Apply --view / --board / --card / --filter flag overrides
 	if *viewFlag != "" {
 		cfg.DefaultView = *viewFlag
 	}
+	if *boardFlag != "" {
+		cfg.DefaultView = "boards"
+		cfg.DefaultBoard = *boardFlag
+	}
+	if *cardFlag != "" && cfg.DefaultBoard == "" {
+		fmt.Fprintln(os.Stderr, "Error: --card needs --board")
+		os.Exit(2)
+	}
+	if *filterFlag != "" && cfg.DefaultBoard == "" && cfg.DefaultView != "tasks" {
+		fmt.Fprintln(os.Stderr, "Error: --filter needs --board or --view tasks")
+		os.Exit(2)
+	}
+	cfg.DefaultCard = *cardFlag
+	cfg.DefaultFilter = *filterFlag
+	// Any view chosen on the command line wins over restoring the last session
+	cfg.ExplicitView = len(args) > 0 || *viewFlag != "" || *boardFlag != ""
+
+	// Compact pane for small terminals such as a tmux sidebar
+	if *compactFlag {
+		logs.Logger.Println("Starting app in compact mode")
+		p := tea.NewProgram(tui.NewCompactModel(cfg, *boardFlag, *columnFlag), tea.WithAltScreen())
+		if _, err := p.Run(); err != nil {
+			fmt.Println("Error running program:", err)
+			os.Exit(1)
+		}
+		return
+	}
 
 	// TUI mode
 	logs.Logger.Println("Starting app in TUI mode")
-	appModel := tui.NewAppModel(cfg, workspaces)
+	appModel := tui.NewAppModel(cfg, workspaces, scanErrs)
 	p := tea.NewProgram(appModel, tea.WithAltScreen())
-	if _, err := p.Run(); err != nil {
+	final, err := p.Run()
+	if m, ok := final.(tui.AppModel); ok {
+		m.Close()
+	}
+	if err != nil {
 		fmt.Println("Error running program:", err)
-		os.Exit(1)
+		exit(1)
+	}
+	exit(0)
+}
+
+// exit gives writes that failed transiently, and card watchers'
+// notifications, a few seconds to go through before the process ends.
+// Writes still failing then are listed, as those edits are lost.
+func exit(code int) {
+	if !writeq.Wait(10 * time.Second) {
+		st := writeq.CurrentStatus()
+		fmt.Fprintf(os.Stderr, "Error: could not save %d file(s): %v\n", len(st.Pending), st.Err)
+		for _, path := range st.Pending {
+			fmt.Fprintf(os.Stderr, "  %s\n", path)
+		}
+		if code == 0 {
+			code = 1
+		}
+	}
+	if !notify.Wait(5 * time.Second) {
+		logs.Logger.Println("Warning: exiting with card notifications unsent")
 	}
+	os.Exit(code)
 }
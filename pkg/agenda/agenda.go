@@ -0,0 +1,101 @@
+// Package agenda is the public API to wydo's agenda, the dated items of all
+// workspaces by day, for Go programs that embed wydo instead of running it.
+//
+// The types are aliases of wydo's own and change when those do. The API is
+// not yet kept compatible across releases.
+package agenda
+
+import (
+	"time"
+
+	"wydo/internal/agenda"
+	"wydo/internal/tasks/service"
+	"wydo/pkg/tasks"
+	"wydo/pkg/workspace"
+)
+
+// Item is a task, card, note or project date on a day, with the reason it
+// is there.
+type Item = agenda.AgendaItem
+
+// Bucket is the items of one day, grouped by source.
+type Bucket = agenda.DateBucket
+
+// DateRange is the days from Start to End, both included.
+type DateRange = agenda.DateRange
+
+// Source is where an item comes from.
+type Source = agenda.ItemSource
+
+const (
+	SourceTask        = agenda.SourceTask
+	SourceCard        = agenda.SourceCard
+	SourceNote        = agenda.SourceNote
+	SourceProjectDate = agenda.SourceProjectDate
+)
+
+// Reason is why an item is on its day.
+type Reason = agenda.DateReason
+
+const (
+	ReasonDue       = agenda.ReasonDue
+	ReasonScheduled = agenda.ReasonScheduled
+	ReasonNote      = agenda.ReasonNote
+	ReasonMilestone = agenda.ReasonMilestone
+	ReasonStart     = agenda.ReasonStart
+)
+
+// DayRange returns the range of the day of date.
+func DayRange(date time.Time) DateRange {
+	return agenda.DayRange(date)
+}
+
+// WeekRange returns the Monday to Sunday week of date.
+func WeekRange(date time.Time) DateRange {
+	return agenda.WeekRange(date)
+}
+
+// MonthRange returns the calendar month of date.
+func MonthRange(date time.Time) DateRange {
+	return agenda.MonthRange(date)
+}
+
+// Query returns the days in r that have items, from the tasks of svc and
+// the boards, notes and project dates of the workspaces. svc may be nil.
+func Query(svc tasks.Service, workspaces []*workspace.Workspace, r DateRange) []Bucket {
+	return agenda.QueryAgenda(internalService(svc), workspace.Boards(workspaces), workspace.Notes(workspaces),
+		agenda.CollectProjectDates(workspaces), r)
+}
+
+// Overdue returns the pending tasks and cards due or scheduled before
+// cutoff.
+func Overdue(svc tasks.Service, workspaces []*workspace.Workspace, cutoff time.Time) []Item {
+	return agenda.QueryOverdueItems(internalService(svc), workspace.Boards(workspaces), cutoff)
+}
+
+// internalService returns svc as the service wydo's agenda queries take,
+// which may be nil.
+func internalService(svc tasks.Service) service.TaskService {
+	if svc == nil {
+		return nil
+	}
+	if s, ok := svc.(service.TaskService); ok {
+		return s
+	}
+	return batchService{svc}
+}
+
+// batchService gives a Service from outside wydo the UpdateAll of wydo's
+// own, made of one Update per task.
+type batchService struct {
+	tasks.Service
+}
+
+func (s batchService) UpdateAll(ts []tasks.Task) error {
+	for _, t := range ts {
+		if err := s.Update(t); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package agenda_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"wydo/pkg/agenda"
+	"wydo/pkg/kanban"
+	"wydo/pkg/tasks"
+	"wydo/pkg/workspace"
+)
+
+// TestQuery_Embedding goes through the public packages only, as a program
+// embedding wydo would.
+func TestQuery_Embedding(t *testing.T) {
+	root := t.TempDir()
+	today := time.Now().Format("2006-01-02")
+	if err := os.MkdirAll(filepath.Join(root, "tasks"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	line := "Call Bob due:" + today + "\n"
+	if err := os.WriteFile(filepath.Join(root, "tasks", "todo.txt"), []byte(line), 0644); err != nil {
+		t.Fatal(err)
+	}
+	board, err := kanban.CreateBoard(filepath.Join(root, "boards"), "Work")
+	if err != nil {
+		t.Fatalf("CreateBoard: %v", err)
+	}
+	if _, err := kanban.CreateCard(&board, "To Do"); err != nil {
+		t.Fatalf("CreateCard: %v", err)
+	}
+	due := time.Now()
+	if err := kanban.SetCardDueDate(&board, 0, 0, &due); err != nil {
+		t.Fatalf("SetCardDueDate: %v", err)
+	}
+
+	wss, dirs, errs := workspace.Load([]string{root})
+	if len(errs) > 0 {
+		t.Fatalf("Load: %v", errs)
+	}
+	if got := len(workspace.Boards(wss)); got != 1 {
+		t.Fatalf("got %d boards, want 1", got)
+	}
+	svc, err := tasks.NewService(dirs)
+	if err != nil {
+		t.Fatalf("NewService: %v", err)
+	}
+
+	buckets := agenda.Query(svc, wss, agenda.DayRange(time.Now()))
+	if len(buckets) != 1 {
+		t.Fatalf("got %d buckets, want 1", len(buckets))
+	}
+	b := buckets[0]
+	if len(b.Tasks) != 1 || b.Tasks[0].Task.Name != "Call Bob" || b.Tasks[0].Reason != agenda.ReasonDue {
+		t.Errorf("unexpected tasks %+v", b.Tasks)
+	}
+	if len(b.Cards) != 1 || b.Cards[0].Source != agenda.SourceCard {
+		t.Errorf("unexpected cards %+v", b.Cards)
+	}
+}
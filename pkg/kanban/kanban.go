@@ -0,0 +1,146 @@
+// Package kanban is the public API to wydo's boards and cards, for Go
+// programs that embed wydo instead of running it.
+//
+// A board is a directory with a board.md listing its columns and a cards/
+// directory of markdown cards. The functions here change a board in memory
+// and write it back, as the TUI does, so changes made through them are seen
+// by wydo and the other way round. Cards are addressed by column and card
+// index into Board.Columns.
+//
+// The types are aliases of wydo's own and change when those do. The API is
+// not yet kept compatible across releases.
+package kanban
+
+import (
+	"time"
+
+	"wydo/internal/kanban/fs"
+	"wydo/internal/kanban/models"
+	"wydo/internal/kanban/operations"
+	"wydo/pkg/tasks"
+)
+
+// Board is a board with its columns and their cards.
+type Board = models.Board
+
+// Column is a board column.
+type Column = models.Column
+
+// Card is a card and its frontmatter.
+type Card = models.Card
+
+// CardURL is a card link with an optional label.
+type CardURL = models.CardURL
+
+// ReadBoard loads the board in the directory boardPath with its cards.
+func ReadBoard(boardPath string) (Board, error) {
+	return fs.ReadBoard(boardPath)
+}
+
+// WriteBoard writes board.md; card files are left alone.
+func WriteBoard(board Board) error {
+	return fs.WriteBoard(board)
+}
+
+// ReadCard loads one card file.
+func ReadCard(cardPath string) (Card, error) {
+	return fs.ReadCard(cardPath)
+}
+
+// WriteCard writes a card file. Frontmatter fields wydo doesn't know are
+// kept.
+func WriteCard(card Card, path string) error {
+	return fs.WriteCard(card, path)
+}
+
+// CreateBoard creates a board named name in a new directory under rootDir
+// with the columns To Do, In Progress and Done.
+func CreateBoard(rootDir, name string) (Board, error) {
+	return operations.CreateBoard(rootDir, name)
+}
+
+// CreateCard adds an empty card at the end of the named column.
+func CreateCard(board *Board, column string) (Card, error) {
+	return operations.CreateCard(board, column)
+}
+
+// CreateCardFromTask adds a card made from a task to the board's new-card
+// column.
+func CreateCardFromTask(board *Board, task tasks.Task) (Card, error) {
+	return operations.CreateCardFromTask(board, task)
+}
+
+// RenameCard sets a card's title and renames its file to match.
+func RenameCard(board *Board, col, card int, title string) error {
+	return operations.RenameCard(board, col, card, title)
+}
+
+// DeleteCard deletes a card and its file.
+func DeleteCard(board *Board, col, card int) error {
+	return operations.DeleteCard(board, col, card)
+}
+
+// MoveCard moves a card to the end of another column, recording the move
+// in its history and stamping date_completed in a done column.
+func MoveCard(board *Board, fromCol, card, toCol int) error {
+	return operations.MoveCard(board, fromCol, card, toCol)
+}
+
+// MoveCardToBoard moves a card to the end of a column of another board.
+// projects are added to the card's projects.
+func MoveCardToBoard(src *Board, col, card int, dst *Board, dstCol int, projects []string) error {
+	return operations.MoveCardToBoardColumn(src, col, card, dst, dstCol, projects)
+}
+
+// SetCardDueDate sets a card's due date; nil clears it.
+func SetCardDueDate(board *Board, col, card int, due *time.Time) error {
+	return operations.UpdateCardDueDate(board, col, card, due)
+}
+
+// SetCardScheduledDate sets a card's scheduled date; nil clears it.
+func SetCardScheduledDate(board *Board, col, card int, scheduled *time.Time) error {
+	return operations.UpdateCardScheduledDate(board, col, card, scheduled)
+}
+
+// SetCardPriority sets a card's priority, 1 (highest) to 6; 0 clears it.
+func SetCardPriority(board *Board, col, card, priority int) error {
+	return operations.UpdateCardPriority(board, col, card, priority)
+}
+
+// SetCardTags replaces a card's tags.
+func SetCardTags(board *Board, col, card int, tags []string) error {
+	return operations.UpdateCardTags(board, col, card, tags)
+}
+
+// SetCardProjects replaces a card's projects.
+func SetCardProjects(board *Board, col, card int, projects []string) error {
+	return operations.UpdateCardProjects(board, col, card, projects)
+}
+
+// SetCardBlocked sets the reason a card is blocked; "" unblocks it.
+func SetCardBlocked(board *Board, col, card int, reason string) error {
+	return operations.SetCardBlocked(board, col, card, reason)
+}
+
+// ToggleCardArchive archives or unarchives a card.
+func ToggleCardArchive(board *Board, col, card int) error {
+	return operations.ToggleCardArchive(board, col, card)
+}
+
+// AddColumn inserts a column at position; -1 puts it before the last
+// column.
+func AddColumn(board *Board, name string, position int) error {
+	return operations.AddColumn(board, name, position)
+}
+
+// RenameColumn renames a column.
+func RenameColumn(board *Board, col int, name string) error {
+	return operations.RenameColumn(board, col, name)
+}
+
+// DeleteColumn deletes a column, moving its cards to the column before it
+// (or after it, for the first). Done columns and the last column can't be
+// deleted.
+func DeleteColumn(board *Board, col int) error {
+	return operations.DeleteColumn(board, col)
+}
@@ -0,0 +1,80 @@
+// Package tasks is the public API to wydo's todo.txt tasks, for Go programs
+// that embed wydo instead of running it.
+//
+// The types are aliases of wydo's own, so values pass freely between this
+// package and the other pkg/ packages, and they change when wydo's do. The
+// API is not yet kept compatible across releases.
+package tasks
+
+import (
+	"wydo/internal/scanner"
+	"wydo/internal/tasks/data"
+	"wydo/internal/tasks/service"
+)
+
+// Task is one todo.txt line.
+type Task = data.Task
+
+// Priority is a task's (A)-(F) priority; PriorityNone for none.
+type Priority = data.Priority
+
+const (
+	PriorityA    = data.PriorityA
+	PriorityB    = data.PriorityB
+	PriorityC    = data.PriorityC
+	PriorityD    = data.PriorityD
+	PriorityE    = data.PriorityE
+	PriorityF    = data.PriorityF
+	PriorityNone = data.PriorityNone
+)
+
+// Project is a +project with its task counts.
+type Project = data.Project
+
+// Dir is a tasks/ directory and the .txt files found in it, as returned by
+// workspace.Load.
+type Dir = scanner.TaskDirInfo
+
+// Service reads and changes the tasks of one or more task directories.
+// Tasks are addressed by their ID, a hash of their line.
+type Service interface {
+	List() ([]Task, error)
+	ListByProject(project string) ([]Task, error)
+	ListByContext(context string) ([]Task, error)
+	ListPending() ([]Task, error)
+	ListDone() ([]Task, error)
+	ListAll() ([]Task, error) // List plus the done-*.txt archives
+	Get(id string) (*Task, error)
+	Add(rawLine string) (*Task, error)
+	Update(task Task) error
+	Complete(id string) error
+	Delete(id string) error
+	Archive() error
+	Annotate(id, text string) error
+	GetProjects() map[string]Project
+	TodoFile() string // the file Add appends to
+	Reload() error
+}
+
+// BatchUpdater is implemented by a Service that can write several updates
+// in one pass, as the one NewService returns does. It is separate from
+// Service so that Service implementations outside wydo need not write it.
+type BatchUpdater interface {
+	UpdateAll(tasks []Task) error
+}
+
+// Compile-time checks that wydo's service satisfies the public interfaces
+var (
+	_ Service      = service.TaskService(nil)
+	_ BatchUpdater = service.TaskService(nil)
+)
+
+// NewService returns a Service over the given task directories.
+func NewService(dirs []Dir) (Service, error) {
+	return service.NewTaskService(dirs)
+}
+
+// Parse parses a todo.txt line. id and file are stored on the task as is.
+func Parse(line, id, file string) Task {
+	return data.ParseTask(line, id, file)
+}
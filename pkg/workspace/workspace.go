@@ -0,0 +1,65 @@
+// Package workspace is the public API to wydo's workspace scanning, for Go
+// programs that embed wydo instead of running it.
+//
+// A workspace is a directory wydo scans recursively for boards/, tasks/ and
+// projects/ directories and notes. Loading gives everything found, ready to
+// pass to the other pkg/ packages:
+//
+//	wss, dirs, errs := workspace.Load([]string{"~/wydo"})
+//	svc, err := tasks.NewService(dirs)
+//	buckets := agenda.Query(svc, wss, agenda.WeekRange(time.Now()))
+//
+// The types are aliases of wydo's own and change when those do. The API is
+// not yet kept compatible across releases.
+package workspace
+
+import (
+	"wydo/internal/config"
+	"wydo/internal/notes"
+	"wydo/internal/workspace"
+	"wydo/pkg/kanban"
+	"wydo/pkg/tasks"
+)
+
+// Workspace is everything loaded from one workspace directory.
+type Workspace = workspace.Workspace
+
+// ScanError is a workspace or board that could not be read and was left out.
+type ScanError = workspace.ScanError
+
+// Project is a project directory or a project only named by tasks and cards.
+type Project = workspace.Project
+
+// Note is a dated markdown note.
+type Note = notes.Note
+
+// Load scans each workspace directory and loads what it finds. A leading ~
+// in a directory is expanded. ignore holds gitignore-style patterns skipped
+// in every workspace, as the config's ignore list does. Directories that
+// can't be read are skipped and returned as errors; so are unreadable
+// boards, in each workspace's ScanErrors.
+func Load(dirs []string, ignore ...string) ([]*Workspace, []tasks.Dir, []ScanError) {
+	expanded := make([]string, len(dirs))
+	for i, dir := range dirs {
+		expanded[i] = config.ExpandPath(dir)
+	}
+	return workspace.LoadAll(expanded, ignore...)
+}
+
+// Boards returns the boards of all workspaces.
+func Boards(workspaces []*Workspace) []kanban.Board {
+	var boards []kanban.Board
+	for _, ws := range workspaces {
+		boards = append(boards, ws.Boards...)
+	}
+	return boards
+}
+
+// Notes returns the notes of all workspaces.
+func Notes(workspaces []*Workspace) []Note {
+	var all []Note
+	for _, ws := range workspaces {
+		all = append(all, ws.Notes...)
+	}
+	return all
+}